@@ -0,0 +1,50 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// ArchiveLabel is the default label used to mark archived issues in installations
+// that don't support archiving, eg: Jira Cloud.
+const ArchiveLabel = "archived"
+
+// ProxyArchiveIssue archives an issue using PUT /issue/{key}/archive endpoint in local
+// installation. Jira Cloud doesn't support archiving issues in all plans, so the issue
+// is labelled with the given label and closed instead.
+func ProxyArchiveIssue(c *jira.Client, key, label string) error {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.ArchiveIssueV2(key)
+	}
+
+	if err := c.UpdateLabels(key, jira.UpdateOpAdd, []string{label}); err != nil {
+		return err
+	}
+	return closeIssue(c, key)
+}
+
+// ProxyUnarchiveIssue restores an archived issue using PUT /issue/{key}/restore endpoint
+// in local installation and removes the archive label from the issue otherwise. The issue
+// isn't reopened in the latter case.
+func ProxyUnarchiveIssue(c *jira.Client, key, label string) error {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.RestoreIssueV2(key)
+	}
+	return c.UpdateLabels(key, jira.UpdateOpRemove, []string{label})
+}
+
+// closeIssue transitions the issue to the first available status in the done category.
+// Nothing is done if the issue is already in such status.
+func closeIssue(c *jira.Client, key string) error {
+	err := TransitionTo(c, key, func(s *jira.Status) bool {
+		return s.StatusCategory.Key == jira.StatusCategoryDone
+	})
+	if errors.Is(err, ErrNoTransition) {
+		return fmt.Errorf("issue is labelled but no transition to a done status is available")
+	}
+	return err
+}
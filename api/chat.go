@@ -0,0 +1,13 @@
+package api
+
+import "github.com/ankitpokhrel/jira-cli/pkg/chat"
+
+// PostToChat posts the message to the incoming webhook of the chat service
+// with the configured timeout and proxy.
+func PostToChat(service, webhookURL string, msg *chat.Message) error {
+	opts := []chat.PostFunc{chat.WithTimeout(httpTimeout())}
+	if proxyURL != nil {
+		opts = append(opts, chat.WithProxy(proxyURL))
+	}
+	return chat.Post(service, webhookURL, msg, opts...)
+}
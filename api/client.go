@@ -1,19 +1,44 @@
 package api
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
 
 	"github.com/spf13/viper"
 
+	"github.com/ankitpokhrel/jira-cli/internal/auth"
+	"github.com/ankitpokhrel/jira-cli/internal/cache"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter"
+	"github.com/ankitpokhrel/jira-cli/pkg/oauth"
 )
 
-const clientTimeout = 15 * time.Second
+const (
+	clientTimeout = 15 * time.Second
+	clientRetries = 2
+)
+
+// ErrNoTransition denotes that the issue can't reach the requested status directly.
+var ErrNoTransition = errors.New("no transition to the requested status is available")
 
-var jiraClient *jira.Client
+var (
+	jiraClient *jira.Client
+	cacheDir   string
+	cacheTTL   time.Duration
+	proxyURL   *url.URL
+	clientCert *tls.Certificate
+	rootCAs    *x509.CertPool
+
+	oauthSource *oauth.TokenSource
+	oauthServer string
+)
 
 // Client initializes and returns jira client.
 func Client(config jira.Config) *jira.Client {
@@ -21,6 +46,34 @@ func Client(config jira.Config) *jira.Client {
 		return jiraClient
 	}
 
+	jiraClient = newClient(config)
+
+	return jiraClient
+}
+
+// DetectAuthType tells which authentication the server expects the token with. Jira Cloud takes
+// API tokens with basic auth, whereas Jira Server and Data Center also take Personal Access Tokens
+// as bearer tokens. It fails if the server rejects both.
+func DetectAuthType(config jira.Config) (jira.AuthType, error) {
+	var err error
+
+	for _, at := range []jira.AuthType{jira.AuthTypeBasic, jira.AuthTypeBearer} {
+		config.AuthType = at
+
+		if _, err = newClient(config).Me(); err == nil {
+			return at, nil
+		}
+
+		var e *jira.ErrUnexpectedResponse
+		if !errors.As(err, &e) || e.StatusCode != http.StatusUnauthorized {
+			return "", err
+		}
+	}
+
+	return "", err
+}
+
+func newClient(config jira.Config) *jira.Client {
 	if config.Server == "" {
 		config.Server = viper.GetString("server")
 	}
@@ -28,13 +81,7 @@ func Client(config jira.Config) *jira.Client {
 		config.Login = viper.GetString("login")
 	}
 	if config.APIToken == "" {
-		config.APIToken = viper.GetString("api_token")
-	}
-	if config.APIToken == "" {
-		netrcConfig, _ := netrc.Read(config.Server, config.Login)
-		if netrcConfig != nil {
-			config.APIToken = netrcConfig.Password
-		}
+		config.APIToken = APIToken(config.Server, config.Login)
 	}
 
 	if config.AuthType == "" {
@@ -42,13 +89,144 @@ func Client(config jira.Config) *jira.Client {
 	}
 	config.Insecure = viper.GetBool("insecure")
 
-	jiraClient = jira.NewClient(
-		config,
-		jira.WithTimeout(clientTimeout),
-		jira.WithInsecureTLS(config.Insecure),
+	// Requests authorized with OAuth need to go through the API gateway.
+	if oauthSource != nil {
+		config.Server = oauthServer
+		config.AuthType = jira.AuthTypeOAuth
+	}
+
+	opts := append(
+		transportOptions(config.Insecure),
+		jira.WithMaxRetries(viper.GetUint("max_retries")),
+		jira.WithRetries(httpRetries(), httpRetryOn()),
 	)
+	if cacheDir != "" || oauthSource != nil {
+		opts = append(opts, jira.WithTransport(func(next http.RoundTripper) http.RoundTripper {
+			if cacheDir != "" {
+				next = cache.NewTransport(next, cacheDir, cacheTTL)
+			}
+			// Responses are cached per token, so the token is added before caching.
+			if oauthSource != nil {
+				next = oauth.NewTransport(next, oauthSource)
+			}
+			return next
+		}))
+	}
 
-	return jiraClient
+	return jira.NewClient(config, opts...)
+}
+
+// HTTPClient returns a client for requests outside the Jira api, eg: to exchange OAuth tokens,
+// that goes through the same proxy and uses the same TLS settings as the Jira client.
+func HTTPClient() *http.Client {
+	return jira.NewClient(jira.Config{}, transportOptions(viper.GetBool("insecure"))...).HTTPClient()
+}
+
+// transportOptions returns the options to reach the server: the timeout, the proxy and TLS settings.
+func transportOptions(insecure bool) []jira.ClientFunc {
+	opts := []jira.ClientFunc{
+		jira.WithTimeout(httpTimeout()),
+		jira.WithInsecureTLS(insecure),
+	}
+	if proxyURL != nil {
+		opts = append(opts, jira.WithProxy(proxyURL))
+	}
+	if clientCert != nil {
+		opts = append(opts, jira.WithClientCert(clientCert))
+	}
+	if rootCAs != nil {
+		opts = append(opts, jira.WithRootCAs(rootCAs))
+	}
+	return opts
+}
+
+// APIToken returns the token of the user of the server from the api_token key, eg: set with
+// JIRA_API_TOKEN env, the .netrc file or the keyring of the OS, in that order.
+func APIToken(server, login string) string {
+	if token := viper.GetString("api_token"); token != "" {
+		return token
+	}
+	if netrcConfig, _ := netrc.Read(server, login); netrcConfig != nil {
+		return netrcConfig.Password
+	}
+	if server != "" {
+		token, _ := auth.Token(server, login)
+		return token
+	}
+	return ""
+}
+
+// httpTimeout returns the time to wait for a connection to the server configured
+// with the http.timeout key, eg: 30s.
+func httpTimeout() time.Duration {
+	if to := viper.GetDuration("http.timeout"); to > 0 {
+		return to
+	}
+	return clientTimeout
+}
+
+// httpRetries returns the number of times requests failing with transient
+// errors are retried, configured with the http.retries key.
+func httpRetries() uint {
+	if viper.IsSet("http.retries") {
+		return viper.GetUint("http.retries")
+	}
+	return clientRetries
+}
+
+// httpRetryOn returns the transient errors requests are retried on, configured
+// with the http.retry_on key, eg: [network, 502, 503].
+func httpRetryOn() []string {
+	if on := viper.GetStringSlice("http.retry_on"); len(on) > 0 {
+		return on
+	}
+	return jira.DefaultRetryOn
+}
+
+// UseCache caches the responses of metadata endpoints, eg: fields and boards, in the directory
+// for the given duration. It needs to be called before the client is initialized.
+func UseCache(dir string, ttl time.Duration) {
+	cacheDir, cacheTTL = dir, ttl
+}
+
+// UseProxy sends the requests through the given proxy instead of the one in HTTPS_PROXY
+// env. It needs to be called before the client is initialized.
+func UseProxy(u *url.URL) {
+	proxyURL = u
+}
+
+// UseClientCert presents the certificate to servers that require mutual TLS.
+// It needs to be called before the client is initialized.
+func UseClientCert(cert *tls.Certificate) {
+	clientCert = cert
+}
+
+// UseRootCAs verifies server certificates with the given CAs instead of the
+// system CAs. It needs to be called before the client is initialized.
+func UseRootCAs(pool *x509.CertPool) {
+	rootCAs = pool
+}
+
+// UseOAuth authorizes requests with the tokens of the source and sends them to the
+// given server, ie: the site on the API gateway. It needs to be called before the
+// client is initialized.
+func UseOAuth(source *oauth.TokenSource, server string) {
+	oauthSource, oauthServer = source, server
+}
+
+// IsUnreachable tells if a request failed because the server couldn't be reached,
+// eg: when offline, as opposed to the server rejecting the request. Only failures to
+// resolve or connect to the server are considered, as the request provably never
+// reached it. Timeouts and read errors are not, as the server may have applied it.
+func IsUnreachable(err error) bool {
+	var (
+		de *net.DNSError
+		oe *net.OpError
+	)
+	if errors.As(err, &de) {
+		return true
+	}
+	return errors.As(err, &oe) && oe.Op == "dial"
 }
 
 // ProxyCreate uses either a v2 or v3 version of the Jira POST /issue
@@ -94,21 +272,27 @@ func ProxyGetIssue(c *jira.Client, key string, opts ...filter.Filter) (*jira.Iss
 // ProxySearch uses either a v2 or v3 version of the Jira GET /search endpoint
 // to search for the relevant issues based on configured installation type.
 // Defaults to v3 if installation type is not defined in the config.
+//
+// Jira caps the number of results returned per request, so the results are
+// fetched page by page until the limit or the total number of issues is reached.
+// All issues are fetched if the limit is 0.
 func ProxySearch(c *jira.Client, jql string, limit uint) (*jira.SearchResult, error) {
-	var (
-		issues *jira.SearchResult
-		err    error
-	)
+	return ProxySearchWithProgress(c, jql, limit, nil)
+}
 
+// ProxySearchWithProgress is like ProxySearch but reports the number of issues
+// fetched so far to the progress func.
+func ProxySearchWithProgress(
+	c *jira.Client, jql string, limit uint, progress ProgressFunc,
+) (*jira.SearchResult, error) {
 	it := viper.GetString("installation")
 
-	if it == jira.InstallationTypeLocal {
-		issues, err = c.SearchV2(jql, limit)
-	} else {
-		issues, err = c.Search(jql, limit)
-	}
-
-	return issues, err
+	return FetchPages(func(from, limit uint) (*jira.SearchResult, error) {
+		if it == jira.InstallationTypeLocal {
+			return c.SearchFromV2(jql, from, limit)
+		}
+		return c.SearchFrom(jql, from, limit)
+	}, limit, progress)
 }
 
 // ProxyAssignIssue uses either a v2 or v3 version of the PUT /issue/{key}/assignee
@@ -119,12 +303,7 @@ func ProxyAssignIssue(c *jira.Client, key string, user *jira.User, def string) e
 	assignee := def
 
 	if user != nil {
-		switch it {
-		case jira.InstallationTypeLocal:
-			assignee = user.Name
-		default:
-			assignee = user.AccountID
-		}
+		assignee = userIdentifier(user)
 	}
 
 	if it == jira.InstallationTypeLocal {
@@ -133,6 +312,63 @@ func ProxyAssignIssue(c *jira.Client, key string, user *jira.User, def string) e
 	return c.AssignIssue(key, assignee)
 }
 
+// ProxyAddWatcher uses POST /issue/{key}/watchers endpoint to add the user
+// to the issue watchers, identifying the user based on the installation type.
+func ProxyAddWatcher(c *jira.Client, key string, user *jira.User) error {
+	return c.AddWatcher(key, userIdentifier(user))
+}
+
+// ProxyRemoveWatcher uses DELETE /issue/{key}/watchers endpoint to remove the user
+// from the issue watchers, identifying the user based on the installation type.
+// Defaults to account id if installation type is not defined in the config.
+func ProxyRemoveWatcher(c *jira.Client, key string, user *jira.User) error {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.RemoveWatcherV2(key, userIdentifier(user))
+	}
+	return c.RemoveWatcher(key, userIdentifier(user))
+}
+
+// ProxyAddGroupUser uses POST /group/user endpoint to add the user to the group,
+// identifying the user based on the installation type.
+// Defaults to account id if installation type is not defined in the config.
+func ProxyAddGroupUser(c *jira.Client, group string, user *jira.User) error {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.AddGroupUserV2(group, userIdentifier(user))
+	}
+	return c.AddGroupUser(group, userIdentifier(user))
+}
+
+// ProxyRemoveGroupUser uses DELETE /group/user endpoint to remove the user from the group,
+// identifying the user based on the installation type.
+// Defaults to account id if installation type is not defined in the config.
+func ProxyRemoveGroupUser(c *jira.Client, group string, user *jira.User) error {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.RemoveGroupUserV2(group, userIdentifier(user))
+	}
+	return c.RemoveGroupUser(group, userIdentifier(user))
+}
+
+// userIdentifier returns the username in local installation and the account id otherwise.
+func userIdentifier(user *jira.User) string {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		if user.Login != "" {
+			return user.Login
+		}
+		return user.Name
+	}
+	return user.AccountID
+}
+
+// ProxyChangelog fetches the complete changelog of an issue, oldest first. It uses the
+// paginated GET /issue/{key}/changelog endpoint in cloud installation and falls back to
+// the changelog expand of GET /issue/{key} endpoint in local installation.
+func ProxyChangelog(c *jira.Client, key string, pageSize int) ([]*jira.ChangelogHistory, error) {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.GetIssueChangelogV2(key)
+	}
+	return c.GetAllIssueChangelog(key, pageSize)
+}
+
 // ProxyUserSearch uses either v2 or v3 version of the GET /user/assignable/search
 // endpoint to search for the users assignable to the given issue.
 // Defaults to v3 if installation type is not defined in the config.
@@ -153,6 +389,17 @@ func ProxyUserSearch(c *jira.Client, opts *jira.UserSearchOptions) ([]*jira.User
 	return users, err
 }
 
+// ProxyFindUsers uses either v2 or v3 version of the GET /user/search endpoint
+// to search for the users matching the query. Jira server expects the query in the
+// username parameter, which also matches names and emails of the users.
+// Defaults to v3 if installation type is not defined in the config.
+func ProxyFindUsers(c *jira.Client, query string, limit int) ([]*jira.User, error) {
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		return c.FindUsersV2(&jira.UserSearchOptions{Username: query, MaxResults: limit})
+	}
+	return c.FindUsers(&jira.UserSearchOptions{Query: query, MaxResults: limit})
+}
+
 // ProxyTransitions uses either v2 or v3 version of the GET /issue/{key}/transitions
 // endpoint to fetch valid transitions for an issue.
 // Defaults to v3 if installation type is not defined in the config.
@@ -172,3 +419,38 @@ func ProxyTransitions(c *jira.Client, key string) ([]*jira.Transition, error) {
 
 	return transitions, err
 }
+
+// TransitionTo transitions the issue to the first status matching the given func.
+// Nothing is done if the issue is already in such status. ErrNoTransition is returned
+// if none of the available transitions leads to a matching status.
+func TransitionTo(c *jira.Client, key string, match func(*jira.Status) bool) error {
+	iss, err := ProxyGetIssue(c, key)
+	if err != nil {
+		return err
+	}
+	trs, err := ProxyTransitions(c, key)
+	if err != nil {
+		return err
+	}
+
+	var next *jira.Transition
+	for _, tr := range trs {
+		if tr.To == nil || !match(tr.To) {
+			continue
+		}
+		if tr.To.Name == iss.Fields.Status.Name {
+			return nil
+		}
+		if next == nil {
+			next = tr
+		}
+	}
+	if next == nil {
+		return ErrNoTransition
+	}
+
+	_, err = c.Transition(key, &jira.TransitionRequest{
+		Transition: &jira.TransitionRequestData{ID: next.ID.String(), Name: next.Name},
+	})
+	return err
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestProxyAssignIssue(t *testing.T) {
+	var (
+		path string
+		body map[string]interface{}
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, body = r.URL.Path, nil
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+	defer viper.Set("installation", "")
+
+	cases := []struct {
+		name         string
+		installation string
+		user         *jira.User
+		expectedPath string
+		expectedBody map[string]interface{}
+	}{
+		{
+			name:         "it assigns by account id in cloud installation",
+			installation: jira.InstallationTypeCloud,
+			user:         &jira.User{AccountID: "a-1", Login: "jon", Name: "Jon Doe"},
+			expectedPath: "/rest/api/3/issue/TEST-1/assignee",
+			expectedBody: map[string]interface{}{"accountId": "a-1"},
+		},
+		{
+			name:         "it assigns by account id if installation is not set",
+			user:         &jira.User{AccountID: "a-1", Name: "Jon Doe"},
+			expectedPath: "/rest/api/3/issue/TEST-1/assignee",
+			expectedBody: map[string]interface{}{"accountId": "a-1"},
+		},
+		{
+			name:         "it assigns by username in local installation",
+			installation: jira.InstallationTypeLocal,
+			user:         &jira.User{Login: "jon", Name: "Jon Doe"},
+			expectedPath: "/rest/api/2/issue/TEST-1/assignee",
+			expectedBody: map[string]interface{}{"name": "jon"},
+		},
+		{
+			name:         "it falls back to display name without username in local installation",
+			installation: jira.InstallationTypeLocal,
+			user:         &jira.User{Name: "jon"},
+			expectedPath: "/rest/api/2/issue/TEST-1/assignee",
+			expectedBody: map[string]interface{}{"name": "jon"},
+		},
+	}
+
+	for _, tc := range cases {
+		viper.Set("installation", tc.installation)
+
+		assert.NoError(t, ProxyAssignIssue(client, "TEST-1", tc.user, ""), tc.name)
+		assert.Equal(t, tc.expectedPath, path, tc.name)
+		assert.Equal(t, tc.expectedBody, body, tc.name)
+	}
+}
+
+func TestTransitionTo(t *testing.T) {
+	var (
+		status     string
+		transition map[string]interface{}
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/issue/TEST-1":
+			_, _ = w.Write([]byte(`{"key":"TEST-1","fields":{"status":{"name":"` + status + `"}}}`))
+		case "/rest/api/3/issue/TEST-1/transitions":
+			_, _ = w.Write([]byte(`{"transitions":[` +
+				`{"id":"11","name":"Start","to":{"id":"3","name":"In Progress","statusCategory":{"key":"indeterminate"}}},` +
+				`{"id":"21","name":"Close","to":{"id":"5","name":"Closed","statusCategory":{"key":"done"}}},` +
+				`{"id":"31","name":"Resolve","to":{"id":"6","name":"Resolved","statusCategory":{"key":"done"}}}]}`))
+		case "/rest/api/2/issue/TEST-1/transitions":
+			transition = nil
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&transition))
+			w.WriteHeader(204)
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+	done := func(s *jira.Status) bool { return s.StatusCategory.Key == jira.StatusCategoryDone }
+
+	status = "To Do"
+	assert.NoError(t, TransitionTo(client, "TEST-1", done))
+	assert.Equal(t, map[string]interface{}{"id": "21", "name": "Close"}, transition["transition"])
+
+	transition = nil
+	status = "Resolved"
+	assert.NoError(t, TransitionTo(client, "TEST-1", done))
+	assert.Nil(t, transition)
+
+	err := TransitionTo(client, "TEST-1", func(s *jira.Status) bool { return s.Name == "Blocked" })
+	assert.ErrorIs(t, err, ErrNoTransition)
+	assert.Nil(t, transition)
+}
+
+func TestIsUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+	}))
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	err := client.AddIssueComment("TEST-1", "Comment", false)
+	assert.Error(t, err)
+	assert.False(t, IsUnreachable(err))
+
+	server.Close()
+
+	// Connections to the closed server are refused.
+	client = jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	err = client.AddIssueComment("TEST-1", "Comment", false)
+	assert.Error(t, err)
+	assert.True(t, IsUnreachable(err))
+
+	assert.False(t, IsUnreachable(nil))
+}
+
+func TestIsUnreachableAfterSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The connection is dropped after the request is read, so it may have been applied.
+		conn, _, err := w.(http.Hijacker).Hijack()
+		assert.NoError(t, err)
+		_ = conn.Close()
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	err := client.AddIssueComment("TEST-1", "Comment", false)
+	assert.Error(t, err)
+	assert.False(t, IsUnreachable(err))
+}
+
+func TestDetectAuthType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer pat", "Basic dGVzdDpwYXNzd29yZA==":
+			_, _ = w.Write([]byte(`{"name": "test"}`))
+		default:
+			w.WriteHeader(401)
+		}
+	}))
+	defer server.Close()
+
+	at, err := DetectAuthType(jira.Config{Server: server.URL, Login: "test", APIToken: "password"})
+	assert.NoError(t, err)
+	assert.Equal(t, jira.AuthTypeBasic, at)
+
+	at, err = DetectAuthType(jira.Config{Server: server.URL, Login: "test", APIToken: "pat"})
+	assert.NoError(t, err)
+	assert.Equal(t, jira.AuthTypeBearer, at)
+
+	_, err = DetectAuthType(jira.Config{Server: server.URL, Login: "test", APIToken: "invalid"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"sync"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	// maxPageSize is the page size requested to fetch all issues.
+	// Jira caps it to the maximum number of results it allows.
+	maxPageSize = 1000
+	// maxConcurrentPages is the number of pages fetched at once.
+	maxConcurrentPages = 4
+)
+
+// PageFunc fetches a page of at most limit issues starting at the given offset.
+type PageFunc func(from, limit uint) (*jira.SearchResult, error)
+
+// ProgressFunc reports the number of issues fetched so far out of the total.
+type ProgressFunc func(fetched, total int)
+
+// FetchPages fetches issues page by page using fn until the limit is reached,
+// or all issues if the limit is 0.
+//
+// Jira caps the number of results returned per request, so the first page tells
+// the page size along with the total number of issues. Remaining pages are then
+// fetched concurrently and appended in order.
+func FetchPages(fn PageFunc, limit uint, progress ProgressFunc) (*jira.SearchResult, error) {
+	size := limit
+	if size == 0 || size > maxPageSize {
+		size = maxPageSize
+	}
+
+	out, err := fn(0, size)
+	if err != nil {
+		return nil, err
+	}
+
+	total := uint(out.Total)
+	if limit > 0 && limit < total {
+		total = limit
+	}
+	size = uint(len(out.Issues))
+
+	if progress != nil {
+		progress(len(out.Issues), int(total))
+	}
+	if size == 0 || size >= total {
+		return out, nil
+	}
+
+	var offsets []uint
+	for from := size; from < total; from += size {
+		offsets = append(offsets, from)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		errs    []error
+		pages   = make([][]*jira.Issue, len(offsets))
+		fetched = len(out.Issues)
+		sem     = make(chan struct{}, maxConcurrentPages)
+	)
+
+	for i, from := range offsets {
+		wg.Add(1)
+
+		go func(i int, from uint) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			n := size
+			if from+n > total {
+				n = total - from
+			}
+			res, err := fn(from, n)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			pages[i] = res.Issues
+
+			fetched += len(res.Issues)
+			if progress != nil {
+				progress(fetched, int(total))
+			}
+		}(i, from)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	for _, p := range pages {
+		out.Issues = append(out.Issues, p...)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// pages returns a page func over the given number of issues that caps the page size.
+func pages(total, pageSize int, calls *[]string) PageFunc {
+	var mu sync.Mutex
+
+	return func(from, limit uint) (*jira.SearchResult, error) {
+		mu.Lock()
+		*calls = append(*calls, fmt.Sprintf("%d:%d", from, limit))
+		mu.Unlock()
+
+		n := int(limit)
+		if n > pageSize {
+			n = pageSize
+		}
+
+		out := jira.SearchResult{StartAt: int(from), Total: total}
+		for i := int(from); i < total && i < int(from)+n; i++ {
+			out.Issues = append(out.Issues, &jira.Issue{Key: fmt.Sprintf("TEST-%d", i+1)})
+		}
+		return &out, nil
+	}
+}
+
+func TestFetchPages(t *testing.T) {
+	var calls []string
+
+	res, err := FetchPages(pages(250, 100, &calls), 20, nil)
+	assert.NoError(t, err)
+	assert.Len(t, res.Issues, 20)
+	assert.Equal(t, []string{"0:20"}, calls)
+
+	calls = nil
+	res, err = FetchPages(pages(250, 100, &calls), 150, nil)
+	assert.NoError(t, err)
+	assert.Len(t, res.Issues, 150)
+	assert.ElementsMatch(t, []string{"0:150", "100:50"}, calls)
+
+	var progress []int
+
+	calls = nil
+	res, err = FetchPages(pages(250, 100, &calls), 0, func(fetched, total int) {
+		assert.Equal(t, 250, total)
+		progress = append(progress, fetched)
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Issues, 250)
+	assert.ElementsMatch(t, []string{"0:1000", "100:100", "200:50"}, calls)
+	assert.Equal(t, 250, progress[len(progress)-1])
+
+	for i, iss := range res.Issues {
+		assert.Equal(t, fmt.Sprintf("TEST-%d", i+1), iss.Key)
+	}
+}
+
+func TestFetchPagesError(t *testing.T) {
+	fn := func(from, limit uint) (*jira.SearchResult, error) {
+		if from > 0 {
+			return nil, errors.New("oops")
+		}
+		return &jira.SearchResult{Total: 3, Issues: []*jira.Issue{{Key: "TEST-1"}}}, nil
+	}
+
+	_, err := FetchPages(fn, 0, nil)
+	assert.EqualError(t, err, "oops")
+}
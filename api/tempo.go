@@ -0,0 +1,134 @@
+package api
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tempo"
+)
+
+// WorklogProviderTempo is a worklog provider backed by Tempo Timesheets.
+const WorklogProviderTempo = "tempo"
+
+var tempoClient *tempo.Client
+
+// TempoClient initializes and returns tempo client.
+func TempoClient(config tempo.Config) *tempo.Client {
+	if tempoClient != nil {
+		return tempoClient
+	}
+
+	if config.Server == "" {
+		config.Server = viper.GetString("tempo.server")
+	}
+	if config.Token == "" {
+		config.Token = viper.GetString("tempo.token")
+	}
+	if config.Token == "" {
+		config.Token = os.Getenv("JIRA_TEMPO_TOKEN")
+	}
+	config.Debug = config.Debug || viper.GetBool("debug")
+
+	opts := []tempo.ClientFunc{tempo.WithTimeout(httpTimeout())}
+	if proxyURL != nil {
+		opts = append(opts, tempo.WithProxy(proxyURL))
+	}
+
+	tempoClient = tempo.NewClient(config, opts...)
+
+	return tempoClient
+}
+
+// IsTempoWorklogProvider checks if worklogs are managed by Tempo.
+func IsTempoWorklogProvider() bool {
+	return viper.GetString("worklog.provider") == WorklogProviderTempo
+}
+
+// ProxyAddWorklog adds a worklog either using Jira or Tempo based on the configured
+// worklog provider. Attributes are only supported by Tempo and are ignored otherwise,
+// whereas visibility is only supported by Jira. It returns the created worklog.
+func ProxyAddWorklog(
+	c *jira.Client, key, comment, started, timeSpent, adjustEstimate, estimate string,
+	visibility *jira.WorklogVisibility, attrs []tempo.Attribute,
+) (*jira.Worklog, error) {
+	if !IsTempoWorklogProvider() {
+		return c.AddIssueWorklog(key, comment, started, timeSpent, adjustEstimate, estimate, visibility)
+	}
+
+	st, err := time.Parse(jira.RFC3339MilliLayout, started)
+	if err != nil {
+		return nil, err
+	}
+	secs, err := jira.ParseTimeSpent(timeSpent)
+	if err != nil {
+		return nil, err
+	}
+	me, err := c.Me()
+	if err != nil {
+		return nil, err
+	}
+
+	wl, err := TempoClient(tempo.Config{}).AddWorklog(&tempo.WorklogRequest{
+		IssueKey:         key,
+		TimeSpentSeconds: secs,
+		StartDate:        st.Format("2006-01-02"),
+		StartTime:        st.Format("15:04:05"),
+		Description:      comment,
+		AuthorAccountID:  me.AccountID,
+		Attributes:       attrs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromTempoWorklog(wl), nil
+}
+
+// ProxyWorklogs fetches all worklogs of an issue either from Jira or Tempo
+// based on the configured worklog provider.
+func ProxyWorklogs(c *jira.Client, key string, pageSize int) ([]*jira.Worklog, error) {
+	if !IsTempoWorklogProvider() {
+		return c.GetAllIssueWorklogs(key, pageSize)
+	}
+
+	worklogs, err := TempoClient(tempo.Config{}).AllIssueWorklogs(key, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*jira.Worklog, 0, len(worklogs))
+	for _, wl := range worklogs {
+		out = append(out, fromTempoWorklog(wl))
+	}
+	return out, nil
+}
+
+// ProxyDeleteWorklog deletes a worklog either using Jira or Tempo based on the
+// configured worklog provider. Tempo adjusts the remaining estimate on its own.
+func ProxyDeleteWorklog(c *jira.Client, key, id, adjustEstimate, estimate string) error {
+	if !IsTempoWorklogProvider() {
+		return c.DeleteIssueWorklog(key, id, adjustEstimate, estimate)
+	}
+	return TempoClient(tempo.Config{}).DeleteWorklog(id)
+}
+
+func fromTempoWorklog(wl *tempo.Worklog) *jira.Worklog {
+	started := wl.StartDate + "T" + wl.StartTime
+	if st, err := time.ParseInLocation("2006-01-02T15:04:05", started, time.Local); err == nil {
+		started = st.Format(jira.RFC3339MilliLayout)
+	}
+
+	return &jira.Worklog{
+		ID:               strconv.Itoa(wl.ID),
+		IssueID:          wl.Issue.Key,
+		Author:           jira.User{AccountID: wl.Author.AccountID, Name: wl.Author.Name},
+		Comment:          wl.Description,
+		Started:          started,
+		TimeSpent:        jira.FormatTimeSpent(time.Duration(wl.TimeSpentSeconds) * time.Second),
+		TimeSpentSeconds: wl.TimeSpentSeconds,
+	}
+}
@@ -5,12 +5,25 @@ import (
 	"os"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/root"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 )
 
 func main() {
 	rootCmd := root.NewCmdRoot()
-	if _, err := rootCmd.ExecuteC(); err != nil {
+
+	args, err := root.ExpandAlias(rootCmd, os.Args[1:])
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "%s\n", err)
 		os.Exit(1)
 	}
+	if code, ok := root.RunExtension(rootCmd, args); ok {
+		os.Exit(code)
+	}
+	rootCmd.SetArgs(args)
+
+	// Errors returned by cobra are about the usage of the command, eg: an unknown flag.
+	if cmd, err := rootCmd.ExecuteC(); err != nil {
+		root.UseJSONErrors(cmd)
+		cmdutil.ExitIfError(&cmdutil.UsageError{Err: err})
+	}
 }
@@ -0,0 +1,152 @@
+// Package activity aggregates comments, transitions and worklogs of issues into a chronological feed.
+package activity
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/internal/timesync"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Kind is a type of activity.
+type Kind string
+
+// Kinds of activity.
+const (
+	KindComment    Kind = "comment"
+	KindTransition Kind = "transition"
+	KindWorklog    Kind = "worklog"
+)
+
+var reDuration = regexp.MustCompile(`^(\d+)([mhdw])$`)
+
+// Event is an activity on an issue.
+type Event struct {
+	Key     string    `json:"key"`
+	Summary string    `json:"summary"`
+	Kind    Kind      `json:"kind"`
+	Author  jira.User `json:"author"`
+	Created time.Time `json:"created"`
+	Text    string    `json:"text"`
+}
+
+// Filter selects the events made after Since, by Author if it is set.
+type Filter struct {
+	Since  time.Time
+	Author *jira.User
+}
+
+// Events returns the comments, transitions and worklogs of the issue that match the filter, oldest first.
+func (f *Filter) Events(
+	issue *jira.Issue, histories []*jira.ChangelogHistory, comments []*jira.Comment, worklogs []*jira.Worklog,
+) []*Event {
+	var out []*Event
+
+	add := func(kind Kind, author jira.User, created, text string) {
+		t, ok := f.matches(&author, created)
+		if !ok {
+			return
+		}
+		out = append(out, &Event{
+			Key:     issue.Key,
+			Summary: issue.Fields.Summary,
+			Kind:    kind,
+			Author:  author,
+			Created: t,
+			Text:    text,
+		})
+	}
+
+	for _, h := range histories {
+		for _, item := range h.Items {
+			if item.Field != "status" {
+				continue
+			}
+			add(KindTransition, h.Author, h.Created, fmt.Sprintf("%s → %s", item.FromString, item.ToString))
+		}
+	}
+
+	for _, c := range comments {
+		add(KindComment, c.Author, c.Created, strings.TrimSpace(c.Body))
+	}
+
+	for _, wl := range worklogs {
+		created := wl.Created
+		if created == "" {
+			// Worklogs of Tempo don't have the created time.
+			created = wl.Started
+		}
+
+		text := wl.TimeSpent
+		if c := strings.TrimSpace(wl.Comment); c != "" {
+			text += ": " + c
+		}
+		add(KindWorklog, wl.Author, created, text)
+	}
+
+	Sort(out)
+
+	return out
+}
+
+func (f *Filter) matches(author *jira.User, created string) (time.Time, bool) {
+	t, err := time.Parse(jira.RFC3339MilliLayout, created)
+	if err != nil || !t.After(f.Since) {
+		return t, false
+	}
+	return t, f.Author == nil || isSameUser(author, f.Author)
+}
+
+func isSameUser(a, b *jira.User) bool {
+	switch {
+	case a.AccountID != "" && b.AccountID != "":
+		return a.AccountID == b.AccountID
+	case a.Login != "" && b.Login != "":
+		return a.Login == b.Login
+	}
+	return a.Name == b.Name
+}
+
+// Sort sorts the events chronologically.
+func Sort(events []*Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Created.Before(events[j].Created)
+	})
+}
+
+// ParseSince parses start of the feed relative to now. It accepts a duration in minutes,
+// hours, days or weeks, eg: 30m, 12h, 1d or 2w, where a day is 24 hours, as well as the
+// values accepted by timesync.ParseSince, ie: today, yesterday, a weekday or YYYY-MM-DD.
+func ParseSince(since string, now time.Time) (time.Time, error) {
+	m := reDuration.FindStringSubmatch(strings.ToLower(strings.TrimSpace(since)))
+	if m == nil {
+		t, err := timesync.ParseSince(since, now)
+		if err != nil {
+			return time.Time{}, fmt.Errorf(
+				"invalid since %q, expected a duration, eg: 1d, today, yesterday, a weekday or YYYY-MM-DD", since,
+			)
+		}
+		return t, nil
+	}
+
+	n, err := strconv.Atoi(m[1])
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch m[2] {
+	case "m":
+		return now.Add(-time.Duration(n) * time.Minute), nil
+	case "h":
+		return now.Add(-time.Duration(n) * time.Hour), nil
+	case "d":
+		return now.AddDate(0, 0, -n), nil
+	default:
+		return now.AddDate(0, 0, -7*n), nil
+	}
+}
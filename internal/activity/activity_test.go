@@ -0,0 +1,120 @@
+package activity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestFilterEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		me    = jira.User{AccountID: "me-1", Name: "Me"}
+		jane  = jira.User{AccountID: "jane-1", Name: "Jane Doe"}
+		since = time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC)
+		issue = &jira.Issue{Key: "TEST-1", Fields: jira.IssueFields{Summary: "Login is broken"}}
+	)
+
+	histories := []*jira.ChangelogHistory{
+		{
+			ID:      "1",
+			Author:  jane,
+			Created: "2022-05-01T09:00:00.000+0000",
+			Items:   []*jira.ChangelogItem{{Field: "status", FromString: "Open", ToString: "To Do"}},
+		},
+		{
+			ID:      "2",
+			Author:  me,
+			Created: "2022-05-01T10:05:00.000+0000",
+			Items: []*jira.ChangelogItem{
+				{Field: "status", FromString: "To Do", ToString: "In Progress"},
+				{Field: "labels", FromString: "", ToString: "backend"},
+			},
+		},
+	}
+	comments := []*jira.Comment{
+		{ID: "10", Author: jane, Body: "Old comment", Created: "2022-05-01T09:30:00.000+0000"},
+		{ID: "11", Author: jane, Body: "Can you check?\n", Created: "2022-05-01T10:06:30.000+0000"},
+	}
+	worklogs := []*jira.Worklog{
+		{ID: "20", Author: me, TimeSpent: "2h", Comment: "Debugging", Created: "2022-05-01T10:03:00.000+0000"},
+		{ID: "21", Author: me, TimeSpent: "30m", Started: "2022-05-01T10:10:00.000+0000"},
+	}
+
+	f := Filter{Since: since}
+	got := f.Events(issue, histories, comments, worklogs)
+
+	expected := []*Event{
+		{
+			Key: "TEST-1", Summary: "Login is broken", Kind: KindWorklog, Author: me,
+			Created: time.Date(2022, 5, 1, 10, 3, 0, 0, time.UTC), Text: "2h: Debugging",
+		},
+		{
+			Key: "TEST-1", Summary: "Login is broken", Kind: KindTransition, Author: me,
+			Created: time.Date(2022, 5, 1, 10, 5, 0, 0, time.UTC), Text: "To Do → In Progress",
+		},
+		{
+			Key: "TEST-1", Summary: "Login is broken", Kind: KindComment, Author: jane,
+			Created: time.Date(2022, 5, 1, 10, 6, 30, 0, time.UTC), Text: "Can you check?",
+		},
+		{
+			Key: "TEST-1", Summary: "Login is broken", Kind: KindWorklog, Author: me,
+			Created: time.Date(2022, 5, 1, 10, 10, 0, 0, time.UTC), Text: "30m",
+		},
+	}
+	assert.Len(t, got, len(expected))
+	for i, e := range expected {
+		assert.Equal(t, e.Kind, got[i].Kind)
+		assert.Equal(t, e.Author, got[i].Author)
+		assert.True(t, e.Created.Equal(got[i].Created))
+		assert.Equal(t, e.Text, got[i].Text)
+		assert.Equal(t, e.Summary, got[i].Summary)
+	}
+
+	f = Filter{Since: since, Author: &jane}
+	got = f.Events(issue, histories, comments, worklogs)
+
+	assert.Len(t, got, 1)
+	assert.Equal(t, KindComment, got[0].Kind)
+}
+
+func TestParseSince(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 5, 4, 15, 30, 0, 0, time.UTC) // Wednesday.
+
+	cases := []struct {
+		since    string
+		expected time.Time
+		err      string
+	}{
+		{since: "30m", expected: time.Date(2022, 5, 4, 15, 0, 0, 0, time.UTC)},
+		{since: "12h", expected: time.Date(2022, 5, 4, 3, 30, 0, 0, time.UTC)},
+		{since: "1d", expected: time.Date(2022, 5, 3, 15, 30, 0, 0, time.UTC)},
+		{since: "2W", expected: time.Date(2022, 4, 20, 15, 30, 0, 0, time.UTC)},
+		{since: "yesterday", expected: time.Date(2022, 5, 3, 0, 0, 0, 0, time.UTC)},
+		{since: "monday", expected: time.Date(2022, 5, 2, 0, 0, 0, 0, time.UTC)},
+		{since: "2022-04-01", expected: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{since: "1y", err: `invalid since "1y", expected a duration, eg: 1d, today, yesterday, a weekday or YYYY-MM-DD`},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.since, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseSince(tc.since, now)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
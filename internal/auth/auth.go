@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/oauth"
+)
+
+// FileName is the name of the file where OAuth credentials are persisted.
+const FileName = "oauth.json"
+
+// ErrNotLoggedIn is returned when there are no saved credentials.
+var ErrNotLoggedIn = fmt.Errorf("not logged in")
+
+// Credentials are the OAuth app and the token of the logged in user.
+// The app is needed to refresh the token.
+type Credentials struct {
+	ClientID     string      `json:"clientId"`
+	ClientSecret string      `json:"clientSecret"`
+	Site         string      `json:"site"`
+	CloudID      string      `json:"cloudId"`
+	Token        oauth.Token `json:"token"`
+}
+
+// Config returns the OAuth config of the app that sends requests with the given client.
+func (c *Credentials) Config(client *http.Client) *oauth.Config {
+	return &oauth.Config{ClientID: c.ClientID, ClientSecret: c.ClientSecret, HTTPClient: client}
+}
+
+// APIURL returns the base URL of the Jira API of the site on the API gateway.
+func (c *Credentials) APIURL() string {
+	r := oauth.Resource{ID: c.CloudID}
+	return r.APIURL()
+}
+
+// Store persists the credentials in a file readable only by the user.
+type Store struct {
+	path string
+}
+
+// NewStore creates a credentials store in the given directory.
+func NewStore(dir string) *Store {
+	return &Store{path: filepath.Join(dir, FileName)}
+}
+
+// Load returns the saved credentials.
+func (s *Store) Load() (*Credentials, error) {
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotLoggedIn
+		}
+		return nil, err
+	}
+
+	var c Credentials
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Save saves the credentials.
+func (s *Store) Save(c *Credentials) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path, b, 0o600)
+}
+
+// Clear removes the saved credentials.
+func (s *Store) Clear() error {
+	if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/oauth"
+)
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	s := NewStore(filepath.Join(dir, ".jira"))
+
+	_, err := s.Load()
+	assert.Equal(t, ErrNotLoggedIn, err)
+
+	expiry := time.Date(2022, 2, 2, 10, 0, 0, 0, time.UTC)
+	creds := Credentials{
+		ClientID:     "abc",
+		ClientSecret: "s3cr3t",
+		Site:         "https://test.atlassian.net",
+		CloudID:      "1324a887",
+		Token:        oauth.Token{AccessToken: "access-1", RefreshToken: "refresh-1", Expiry: expiry},
+	}
+	assert.NoError(t, s.Save(&creds))
+
+	info, err := os.Stat(filepath.Join(dir, ".jira", FileName))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	got, err := s.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, creds, *got)
+	assert.Equal(t, "https://api.atlassian.com/ex/jira/1324a887", got.APIURL())
+
+	assert.NoError(t, s.Clear())
+	assert.NoError(t, s.Clear())
+
+	_, err = s.Load()
+	assert.Equal(t, ErrNotLoggedIn, err)
+}
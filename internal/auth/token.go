@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/keyring"
+)
+
+// KeyringService is the prefix of the keyring service API tokens are saved under.
+const KeyringService = "jira-cli"
+
+// Keyring functions are variables so that they can be replaced in tests.
+var (
+	keyringSet    = keyring.Set
+	keyringGet    = keyring.Get
+	keyringDelete = keyring.Delete
+)
+
+// SetToken saves the API token of the user of the server in the keyring of the OS.
+func SetToken(server, login, token string) error {
+	return keyringSet(keyringService(server), login, token)
+}
+
+// Token returns the API token of the user of the server saved in the keyring of the OS.
+func Token(server, login string) (string, error) {
+	return keyringGet(keyringService(server), login)
+}
+
+// DeleteToken removes the API token of the user of the server from the keyring of the OS.
+func DeleteToken(server, login string) error {
+	return keyringDelete(keyringService(server), login)
+}
+
+// keyringService returns the service the tokens of the server are saved under,
+// eg: jira-cli:https://company.atlassian.net.
+func keyringService(server string) string {
+	return KeyringService + ":" + strings.TrimSuffix(server, "/")
+}
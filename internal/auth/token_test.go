@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/keyring"
+)
+
+func TestToken(t *testing.T) {
+	secrets := make(map[string]string)
+
+	keyringSet = func(service, account, secret string) error {
+		secrets[service+"|"+account] = secret
+		return nil
+	}
+	keyringGet = func(service, account string) (string, error) {
+		s, ok := secrets[service+"|"+account]
+		if !ok {
+			return "", keyring.ErrNotFound
+		}
+		return s, nil
+	}
+	keyringDelete = func(service, account string) error {
+		if _, ok := secrets[service+"|"+account]; !ok {
+			return keyring.ErrNotFound
+		}
+		delete(secrets, service+"|"+account)
+		return nil
+	}
+	t.Cleanup(func() {
+		keyringSet, keyringGet, keyringDelete = keyring.Set, keyring.Get, keyring.Delete
+	})
+
+	assert.NoError(t, SetToken("https://test.atlassian.net/", "jane@test.com", "t0k3n"))
+	assert.Equal(t, map[string]string{"jira-cli:https://test.atlassian.net|jane@test.com": "t0k3n"}, secrets)
+
+	token, err := Token("https://test.atlassian.net", "jane@test.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "t0k3n", token)
+
+	_, err = Token("https://test.atlassian.net", "john@test.com")
+	assert.Equal(t, keyring.ErrNotFound, err)
+
+	assert.NoError(t, DeleteToken("https://test.atlassian.net", "jane@test.com"))
+	assert.Equal(t, keyring.ErrNotFound, DeleteToken("https://test.atlassian.net", "jane@test.com"))
+}
@@ -0,0 +1,156 @@
+// Package burndown computes the remaining estimate of a sprint per day and renders
+// it as a chart of block characters for the terminal.
+package burndown
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	dayLabelLayout = "Jan 02"
+	colWidth       = 2
+)
+
+// blocks are partial blocks used to draw the top of a bar in eighths.
+var blocks = []rune{' ', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// Issue is an estimated issue in a sprint.
+type Issue struct {
+	Estimate float64
+	Resolved time.Time // Zero if the issue is not resolved.
+}
+
+// Point is the remaining estimate at the end of a day of the sprint.
+type Point struct {
+	Day       time.Time
+	Remaining float64
+	Ideal     float64
+}
+
+// Compute calculates the remaining estimate at the end of each day from the start till
+// the end of the sprint. Days after now are skipped for sprints that are still running.
+//
+// An issue counts toward the remaining estimate until it is resolved, so estimate
+// changes and issues added during the sprint are reflected from the first day.
+func Compute(issues []Issue, start, end, now time.Time) []Point {
+	var total float64
+	for _, iss := range issues {
+		total += iss.Estimate
+	}
+
+	first := startOfDay(start)
+	last := startOfDay(end)
+	if n := startOfDay(now); n.Before(last) {
+		last = n
+	}
+	days := last.Sub(first).Hours() / 24                  //nolint:gomnd
+	sprintDays := startOfDay(end).Sub(first).Hours() / 24 //nolint:gomnd
+
+	points := make([]Point, 0, int(days)+1)
+	for i := 0; !first.AddDate(0, 0, i).After(last); i++ {
+		day := first.AddDate(0, 0, i)
+		eod := day.AddDate(0, 0, 1)
+
+		remaining := total
+		for _, iss := range issues {
+			if !iss.Resolved.IsZero() && iss.Resolved.Before(eod) {
+				remaining -= iss.Estimate
+			}
+		}
+
+		ideal := 0.0
+		if sprintDays > 0 {
+			ideal = math.Max(total*(1-float64(i+1)/(sprintDays+1)), 0)
+		}
+
+		points = append(points, Point{Day: day, Remaining: remaining, Ideal: ideal})
+	}
+
+	return points
+}
+
+// Chart renders the remaining estimate of each day as a bar with the given height in rows.
+// The ideal burndown is marked with dots above the bars.
+func Chart(points []Point, height int, unit string) string {
+	if len(points) == 0 || height <= 0 {
+		return ""
+	}
+
+	maxVal := 0.0
+	for _, p := range points {
+		maxVal = math.Max(maxVal, math.Max(p.Remaining, p.Ideal))
+	}
+	if maxVal == 0 {
+		maxVal = 1
+	}
+	step := maxVal / float64(height)
+
+	labels := map[int]string{
+		height - 1: formatValue(maxVal, unit),
+		0:          formatValue(0, unit),
+	}
+	if height > 2 { //nolint:gomnd
+		labels[height/2] = formatValue(step*float64(height/2), unit)
+	}
+	labelWidth := 0
+	for _, l := range labels {
+		if len(l) > labelWidth {
+			labelWidth = len(l)
+		}
+	}
+
+	var sb strings.Builder
+
+	for row := height - 1; row >= 0; row-- {
+		sb.WriteString(fmt.Sprintf("%*s ┤", labelWidth, labels[row]))
+
+		for _, p := range points {
+			cell := cellRune(p.Remaining/step - float64(row))
+			if cell == ' ' && int(p.Ideal/step) == row && p.Ideal > 0 {
+				cell = '·'
+			}
+			sb.WriteString(strings.Repeat(string(cell), colWidth))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("%*s └%s\n", labelWidth, "", strings.Repeat("─", len(points)*colWidth)))
+	sb.WriteString(fmt.Sprintf("%*s  %s\n", labelWidth, "", axisLabels(points)))
+
+	return sb.String()
+}
+
+func cellRune(fill float64) rune {
+	switch {
+	case fill >= 1:
+		return blocks[len(blocks)-1]
+	case fill <= 0:
+		return blocks[0]
+	}
+	return blocks[int(math.Ceil(fill*8))] //nolint:gomnd
+}
+
+func axisLabels(points []Point) string {
+	first := points[0].Day.Format(dayLabelLayout)
+	if len(points) == 1 {
+		return first
+	}
+
+	last := points[len(points)-1].Day.Format(dayLabelLayout)
+	width := len(points) * colWidth
+	if width < len(first)+len(last)+1 {
+		return first
+	}
+	return first + strings.Repeat(" ", width-len(first)-len(last)) + last
+}
+
+func formatValue(v float64, unit string) string {
+	return fmt.Sprintf("%.1f%s", v, unit)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,57 @@
+package burndown
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(day, hour int) time.Time {
+	return time.Date(2022, 1, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestCompute(t *testing.T) {
+	issues := []Issue{
+		{Estimate: 5, Resolved: date(10, 15)},
+		{Estimate: 3, Resolved: date(12, 9)},
+		{Estimate: 2},
+		{Estimate: 0, Resolved: date(11, 9)},
+	}
+
+	points := Compute(issues, date(10, 9), date(13, 17), date(20, 0))
+	assert.Equal(t, []Point{
+		{Day: date(10, 0), Remaining: 5, Ideal: 7.5},
+		{Day: date(11, 0), Remaining: 5, Ideal: 5},
+		{Day: date(12, 0), Remaining: 2, Ideal: 2.5},
+		{Day: date(13, 0), Remaining: 2, Ideal: 0},
+	}, points)
+
+	// Days after now are skipped for a running sprint.
+	points = Compute(issues, date(10, 9), date(13, 17), date(11, 12))
+	assert.Len(t, points, 2)
+	assert.Equal(t, date(11, 0), points[1].Day)
+}
+
+func TestChart(t *testing.T) {
+	points := []Point{
+		{Day: date(10, 0), Remaining: 8, Ideal: 6},
+		{Day: date(11, 0), Remaining: 5, Ideal: 4},
+		{Day: date(12, 0), Remaining: 1, Ideal: 2},
+		{Day: date(13, 0), Remaining: 1, Ideal: 0},
+	}
+
+	expected := "8.0h ┤██      \n" +
+		"4.0h ┤██▄▄    \n" +
+		"     ┤████··  \n" +
+		"0.0h ┤████▄▄▄▄\n" +
+		"     └────────\n" +
+		"      Jan 10\n"
+
+	assert.Equal(t, expected, Chart(points, 4, "h"))
+
+	// Last day is labelled if there is enough room.
+	points = append(points, Point{Day: date(14, 0)}, Point{Day: date(15, 0)}, Point{Day: date(16, 0)})
+	assert.Contains(t, Chart(points, 4, "h"), "      Jan 10  Jan 16\n")
+	assert.Equal(t, "", Chart(nil, 4, "h"))
+}
@@ -0,0 +1,156 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+const (
+	// DirName is the name of the directory where responses are cached.
+	DirName = "cache"
+	// DefaultTTL is the duration responses are cached for if it is not configured.
+	DefaultTTL = 24 * time.Hour
+)
+
+// cacheable matches the paths of metadata endpoints whose responses rarely change, ie: projects,
+// boards, fields, create metadata, assignable users and issue link types.
+var cacheable = []*regexp.Regexp{
+	regexp.MustCompile(`/rest/api/[23]/project(/[^/]+)?$`),
+	regexp.MustCompile(`/rest/api/[23]/(field|issueLinkType)$`),
+	regexp.MustCompile(`/rest/api/[23]/issue/createmeta(/.+)?$`),
+	regexp.MustCompile(`/rest/api/[23]/user/assignable/search$`),
+	regexp.MustCompile(`/rest/agile/1\.0/board$`),
+}
+
+// Transport is an http.RoundTripper that caches successful responses of metadata
+// endpoints in a directory, so that commands don't fetch them on each run.
+type Transport struct {
+	next http.RoundTripper
+	dir  string
+	ttl  time.Duration
+}
+
+type entry struct {
+	Created time.Time   `json:"created"`
+	Status  int         `json:"status"`
+	Header  http.Header `json:"header"`
+	Body    []byte      `json:"body"`
+}
+
+// NewTransport creates a transport that caches responses in the given directory
+// for the given duration and sends the requests using next.
+func NewTransport(next http.RoundTripper, dir string, ttl time.Duration) *Transport {
+	return &Transport{next: next, dir: dir, ttl: ttl}
+}
+
+// RoundTrip returns the cached response of the request if there is one that has not expired.
+// Otherwise, the request is sent and the response is cached. All responses are dropped once
+// a cached resource is changed, eg: when a project is created.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isCacheable(req.URL.Path) {
+		return t.next.RoundTrip(req)
+	}
+	if req.Method != http.MethodGet {
+		res, err := t.next.RoundTrip(req)
+		if err == nil && res.StatusCode < http.StatusBadRequest {
+			_ = Clear(t.dir)
+		}
+		return res, err
+	}
+
+	path := t.path(req)
+	if e, err := t.read(path); err == nil && time.Since(e.Created) < t.ttl {
+		return e.response(req), nil
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusOK {
+		return res, err
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	// Responses are still returned if they can't be cached.
+	_ = t.write(path, &entry{Created: time.Now(), Status: res.StatusCode, Header: res.Header, Body: body})
+
+	return res, nil
+}
+
+// Clear removes all cached responses in the directory.
+func Clear(dir string) error {
+	return os.RemoveAll(dir)
+}
+
+func isCacheable(path string) bool {
+	for _, re := range cacheable {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// path returns the file of the cached response. Responses are cached per user
+// as the results may depend on their permissions, eg: of createmeta endpoint.
+func (t *Transport) path(req *http.Request) string {
+	h := sha256.New()
+	_, _ = io.WriteString(h, req.URL.String())
+	_, _ = io.WriteString(h, req.Header.Get("Authorization"))
+
+	return filepath.Join(t.dir, hex.EncodeToString(h.Sum(nil))+".json")
+}
+
+func (t *Transport) read(path string) (*entry, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var e entry
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (t *Transport) write(path string, e *entry) error {
+	if err := os.MkdirAll(t.dir, 0o700); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, b, 0o600)
+}
+
+func (e *entry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.Status, http.StatusText(e.Status)),
+		StatusCode:    e.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header,
+		Body:          ioutil.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
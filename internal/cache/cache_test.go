@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransport(t *testing.T) {
+	hits := make(map[string]int)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits[r.Method+" "+r.URL.Path]++
+
+		switch r.URL.Path {
+		case "/rest/api/2/field", "/rest/api/3/issue/TEST-1":
+			_, _ = w.Write([]byte(`[{"id": "summary"}]`))
+		case "/rest/api/2/project":
+			if r.Method == http.MethodPost {
+				w.WriteHeader(201)
+				return
+			}
+			w.WriteHeader(500)
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := http.Client{Transport: NewTransport(http.DefaultTransport, dir, time.Hour)}
+
+	get := func(path string) (int, string) {
+		res, err := client.Get(server.URL + path)
+		assert.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+
+		b, err := ioutil.ReadAll(res.Body)
+		assert.NoError(t, err)
+
+		return res.StatusCode, string(b)
+	}
+
+	for i := 0; i < 2; i++ {
+		status, body := get("/rest/api/2/field")
+		assert.Equal(t, 200, status)
+		assert.Equal(t, `[{"id": "summary"}]`, body)
+	}
+	assert.Equal(t, 1, hits["GET /rest/api/2/field"])
+
+	// Other endpoints are not cached.
+	get("/rest/api/3/issue/TEST-1")
+	get("/rest/api/3/issue/TEST-1")
+	assert.Equal(t, 2, hits["GET /rest/api/3/issue/TEST-1"])
+
+	// Unsuccessful responses are not cached.
+	status, _ := get("/rest/api/2/project")
+	assert.Equal(t, 500, status)
+	get("/rest/api/2/project")
+	assert.Equal(t, 2, hits["GET /rest/api/2/project"])
+
+	// Changes to a cached resource drop all responses.
+	res, err := client.Post(server.URL+"/rest/api/2/project", "application/json", nil)
+	assert.NoError(t, err)
+	_ = res.Body.Close()
+
+	get("/rest/api/2/field")
+	assert.Equal(t, 2, hits["GET /rest/api/2/field"])
+}
+
+func TestTransportTTL(t *testing.T) {
+	hits := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := http.Client{Transport: NewTransport(http.DefaultTransport, t.TempDir(), 0)}
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Get(server.URL + "/rest/agile/1.0/board")
+		assert.NoError(t, err)
+		_ = res.Body.Close()
+	}
+	assert.Equal(t, 2, hits)
+}
+
+func TestClear(t *testing.T) {
+	dir := t.TempDir() + "/cache"
+
+	assert.NoError(t, Clear(dir))
+
+	tr := NewTransport(http.DefaultTransport, dir, time.Hour)
+	assert.NoError(t, tr.write(dir+"/a.json", &entry{Status: 200}))
+	assert.NoError(t, Clear(dir))
+
+	_, err := tr.read(dir + "/a.json")
+	assert.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type value struct {
+	Created time.Time       `json:"created"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// Get reads the value cached under the key into v. It returns false if there is no such
+// value or if it was cached longer than ttl ago. Values are dropped along with responses.
+func Get(dir, key string, ttl time.Duration, v interface{}) bool {
+	b, err := ioutil.ReadFile(valuePath(dir, key))
+	if err != nil {
+		return false
+	}
+
+	var val value
+	if err := json.Unmarshal(b, &val); err != nil || time.Since(val.Created) >= ttl {
+		return false
+	}
+	return json.Unmarshal(val.Value, v) == nil
+}
+
+// Set caches the value under the key.
+func Set(dir, key string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(value{Created: time.Now(), Value: raw})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(valuePath(dir, key), b, 0o600)
+}
+
+func valuePath(dir, key string) string {
+	h := sha256.Sum256([]byte("value:" + key))
+	return filepath.Join(dir, hex.EncodeToString(h[:])+".json")
+}
@@ -0,0 +1,26 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValue(t *testing.T) {
+	dir := t.TempDir()
+
+	var got []string
+	assert.False(t, Get(dir, "transitions:TEST-1", time.Minute, &got))
+
+	assert.NoError(t, Set(dir, "transitions:TEST-1", []string{"Done", "In Progress"}))
+
+	assert.True(t, Get(dir, "transitions:TEST-1", time.Minute, &got))
+	assert.Equal(t, []string{"Done", "In Progress"}, got)
+
+	assert.False(t, Get(dir, "transitions:TEST-2", time.Minute, &got))
+	assert.False(t, Get(dir, "transitions:TEST-1", 0, &got))
+
+	assert.NoError(t, Clear(dir))
+	assert.False(t, Get(dir, "transitions:TEST-1", time.Minute, &got))
+}
@@ -0,0 +1,178 @@
+package activity
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/activity"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	defaultLimit = 50
+	maxComments  = 50
+
+	helpText = `Activity displays the comments, transitions and worklogs of the issues in the project
+as a chronological feed, eg: to catch up on what happened while you were away.
+
+--since accepts a duration in minutes, hours, days or weeks, eg: 30m, 12h, 1d or 2w, as well as
+today, yesterday, a weekday or a date in YYYY-MM-DD format. Activities are looked up in the
+--limit most recently updated issues.`
+	examples = `$ jira activity
+
+# Display the activity of the last week in another project
+$ jira activity --project FOO --since 1w
+
+# Display my activity since yesterday
+$ jira activity --user me --since yesterday
+
+# Display the activity of a user in json
+$ jira activity --user jon@domain.tld -o json`
+)
+
+// NewCmdActivity is an activity command.
+func NewCmdActivity() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "activity",
+		Short:       "Activity displays recent comments, transitions and worklogs",
+		Long:        helpText,
+		Example:     examples,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Args:        cobra.NoArgs,
+		Run:         activityFeed,
+	}
+
+	cmd.Flags().String("since", "1d", "Display the activity since the given time, eg: 12h, 1d, yesterday")
+	cmd.Flags().String("user", "", `Display the activity of the given user, "me" for yourself`)
+	cmd.Flags().Uint("limit", defaultLimit, "Maximum number of recently updated issues to look up")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+	cmd.Flags().Bool("no-truncate", false, "Show all available text in plain mode")
+
+	return &cmd
+}
+
+type activityParams struct {
+	since      time.Time
+	user       string
+	limit      uint
+	plain      bool
+	noHeaders  bool
+	noTruncate bool
+	output     string
+	debug      bool
+}
+
+func activityFeed(cmd *cobra.Command, _ []string) {
+	params := parseFlags(cmd)
+	project := viper.GetString("project.key")
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	filter := activity.Filter{Since: params.since}
+	if params.user != "" {
+		u, err := cmdcommon.FindUser(client, params.user)
+		cmdutil.ExitIfError(err)
+
+		filter.Author = u
+	}
+
+	events, err := func() ([]*activity.Event, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching activity in project %q...", project))
+		defer s.Stop()
+
+		return fetch(client, &filter, project, params.limit)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if params.output == view.OutputJSON {
+		cmdutil.ExitIfError(view.NewActivity(events).RenderJSON(os.Stdout))
+		return
+	}
+
+	if len(events) == 0 {
+		cmdutil.Failed("No activity found in project %q since %s", project, params.since.Format("2006-01-02 15:04"))
+	}
+
+	v := view.NewActivity(events, view.WithActivityDisplayFormat(view.DisplayFormat{
+		Plain:      params.plain,
+		NoHeaders:  params.noHeaders,
+		NoTruncate: params.noTruncate,
+	}))
+	cmdutil.ExitIfError(v.Render())
+}
+
+// fetch returns the events of the issues of the project updated since the start of the filter.
+func fetch(client *jira.Client, filter *activity.Filter, project string, limit uint) ([]*activity.Event, error) {
+	jql := cmdcommon.UpdatedSinceJQL(fmt.Sprintf("project = %q", project), filter.Since, "DESC")
+
+	res, err := api.ProxySearch(client, jql, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := cmdcommon.FetchIssueActivity(client, res.Issues, maxComments, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*activity.Event
+	for _, a := range activities {
+		events = append(events, filter.Events(a.Issue, a.Histories, a.Comments, a.Worklogs)...)
+	}
+	activity.Sort(events)
+
+	return events, nil
+}
+
+func parseFlags(cmd *cobra.Command) *activityParams {
+	flags := cmd.Flags()
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	since, err := flags.GetString("since")
+	cmdutil.ExitIfError(err)
+
+	start, err := activity.ParseSince(since, time.Now())
+	cmdutil.ExitIfError(err)
+
+	user, err := flags.GetString("user")
+	cmdutil.ExitIfError(err)
+
+	limit, err := flags.GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	plain, err := flags.GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := flags.GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	noTruncate, err := flags.GetBool("no-truncate")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+	if output != "" && output != view.OutputJSON {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	return &activityParams{
+		since:      start,
+		user:       user,
+		limit:      limit,
+		plain:      plain,
+		noHeaders:  noHeaders,
+		noTruncate: noTruncate,
+		output:     output,
+		debug:      debug,
+	}
+}
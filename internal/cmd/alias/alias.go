@@ -0,0 +1,37 @@
+package alias
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias/set"
+)
+
+const helpText = `Alias manages shortcuts for commands you run often.
+
+Aliases are saved in the config, so teams can share their common invocations by sharing the config.
+They are expanded before the command is run: $1, $2 and so on are replaced with the arguments
+passed to the alias, and the arguments not used by any of them are appended.`
+
+// NewCmdAlias is an alias command.
+func NewCmdAlias() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "alias",
+		Short:       "Alias manages command shortcuts",
+		Long:        helpText,
+		Aliases:     []string{"aliases"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        alias,
+		// Aliases are only saved in the config, so a token is not required.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+	}
+
+	cmd.AddCommand(set.NewCmdSet(), list.NewCmdList(), delete.NewCmdDelete())
+
+	return &cmd
+}
+
+func alias(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,40 @@
+package delete
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// NewCmdDelete is an alias delete command.
+func NewCmdDelete() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete NAME",
+		Short:   "Delete removes an alias",
+		Long:    "Delete removes the alias from the config.",
+		Example: "$ jira alias delete standup",
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the alias",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  del,
+	}
+}
+
+func del(_ *cobra.Command, args []string) {
+	file := viper.ConfigFileUsed()
+	if !jiraConfig.Exists(file) {
+		cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
+	}
+
+	ok, err := jiraConfig.DeleteAlias(file, args[0])
+	cmdutil.ExitIfError(err)
+	if !ok {
+		cmdutil.Failed("Alias %q not found", args[0])
+	}
+
+	cmdutil.Success("Alias %q deleted", args[0])
+}
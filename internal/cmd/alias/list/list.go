@@ -0,0 +1,46 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// NewCmdList is an alias list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List lists the aliases",
+		Long:    "List lists the aliases in the config along with their expansions.",
+		Aliases: []string{"lists", "ls"},
+		Args:    cobra.NoArgs,
+		Run:     list,
+	}
+}
+
+func list(*cobra.Command, []string) {
+	aliases := jiraConfig.Aliases()
+	if len(aliases) == 0 {
+		cmdutil.Failed("No aliases found.\nRun 'jira alias set NAME EXPANSION' to add an alias.")
+	}
+
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "NAME\tEXPANSION")
+	for _, name := range names {
+		fmt.Fprintf(tw, "%s\t%s\n", name, aliases[name])
+	}
+	cmdutil.ExitIfError(tw.Flush())
+}
@@ -0,0 +1,65 @@
+package set
+
+import (
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/extension"
+)
+
+const (
+	helpText = `Set saves an alias that expands to the given command, replacing the existing alias.
+
+$1, $2 and so on in the expansion are replaced with the arguments passed to the alias, and the
+arguments not used by any of them are appended. Quote the expansion to keep it a single argument.`
+	examples = `$ jira alias set standup 'issue list --jql "assignee = currentUser() AND updated >= -1d"'
+$ jira standup --plain
+
+# Use arguments of the alias
+$ jira alias set done 'issue move $1 Done --comment "Closed via CLI"'
+$ jira done ISSUE-1`
+)
+
+// NewCmdSet is an alias set command.
+func NewCmdSet() *cobra.Command {
+	return &cobra.Command{
+		Use:     "set NAME EXPANSION",
+		Short:   "Set saves an alias",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the alias, can't be the name of a command\n" +
+				"EXPANSION\tCommand the alias expands to, without jira",
+		},
+		Args: cobra.ExactArgs(2),
+		Run:  set,
+	}
+}
+
+func set(cmd *cobra.Command, args []string) {
+	name, expansion := args[0], args[1]
+
+	if cmdcommon.IsReservedName(cmd, name) {
+		cmdutil.Failed("%q is a command, choose another name for the alias", name)
+	}
+
+	parts, err := shlex.Split(expansion)
+	if err != nil {
+		cmdutil.Failed("Invalid expansion: %s", err)
+	}
+	if len(parts) == 0 || !cmdcommon.IsReservedName(cmd, parts[0]) && extension.Find(parts[0]) == "" {
+		cmdutil.Failed("Expansion must start with a command or an extension, eg: issue list")
+	}
+
+	file := viper.ConfigFileUsed()
+	if !jiraConfig.Exists(file) {
+		cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
+	}
+	cmdutil.ExitIfError(jiraConfig.SetAlias(file, name, expansion))
+
+	cmdutil.Success("Alias %q saved, run it with 'jira %s'", name, name)
+}
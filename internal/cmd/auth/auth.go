@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/login"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/logout"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth/settoken"
+)
+
+const helpText = `Auth manages authentication with Jira.
+
+API tokens exported in JIRA_API_TOKEN env are used by default, use set-token to save the token
+in the keyring of the OS instead. Jira Cloud users can log in with OAuth 2.0 instead, tokens are
+saved in the config directory and refreshed when they expire.`
+
+// NewCmdAuth is an auth command.
+func NewCmdAuth() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "auth",
+		Short:       "Auth manages authentication with Jira",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        auth,
+	}
+
+	cmd.AddCommand(login.NewCmdLogin(), logout.NewCmdLogout(), settoken.NewCmdSetToken())
+
+	return &cmd
+}
+
+func auth(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,273 @@
+package login
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/auth"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/browser"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/oauth"
+)
+
+const (
+	defaultPort  = 8085
+	callbackPath = "/callback"
+	loginTimeout = 5 * time.Minute
+
+	helpText = `Login logs in to Jira Cloud with OAuth 2.0.
+
+Create an OAuth 2.0 (3LO) integration in the Atlassian developer console with Jira API
+permissions and http://localhost:8085/callback as the callback URL, then pass the client id
+and the secret of the app. The secret can also be set with JIRA_OAUTH_CLIENT_SECRET env.
+
+The authorization page is opened in the browser and the code is received by a local server
+started on --port. Tokens are saved in the config directory and refreshed when they expire.`
+	examples = `$ jira auth login --oauth --client-id abc123 --client-secret s3cr3t
+
+# Use another port, the callback URL of the app needs to match
+$ jira auth login --oauth --client-id abc123 --port 9000`
+)
+
+// NewCmdLogin is an auth login command.
+func NewCmdLogin() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "login",
+		Short:   "Login logs in to Jira Cloud with OAuth 2.0",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     login,
+	}
+
+	cmd.Flags().Bool("oauth", false, "Log in with OAuth 2.0 authorization code flow")
+	cmd.Flags().String("client-id", "", "Client id of the OAuth app")
+	cmd.Flags().String("client-secret", "", "Secret of the OAuth app, can also be set with JIRA_OAUTH_CLIENT_SECRET env")
+	cmd.Flags().Uint("port", defaultPort, "Port of the local server that receives the authorization code")
+	cmd.Flags().String("server", "", "Jira site to log in to, eg: https://company.atlassian.net\n"+
+		"Defaults to the server in the config")
+
+	return &cmd
+}
+
+func login(cmd *cobra.Command, _ []string) {
+	params := parseFlags(cmd.Flags())
+
+	if !params.oauth {
+		cmdutil.Failed("Only OAuth login is supported, use --oauth.\nExport JIRA_API_TOKEN env to use an API token instead.")
+	}
+	if params.clientID == "" || params.clientSecret == "" {
+		cmdutil.Failed("--client-id and --client-secret are required, " +
+			"the secret can also be set with JIRA_OAUTH_CLIENT_SECRET env")
+	}
+
+	conf := oauth.Config{
+		ClientID:     params.clientID,
+		ClientSecret: params.clientSecret,
+		RedirectURL:  fmt.Sprintf("http://localhost:%d%s", params.port, callbackPath),
+		HTTPClient:   api.HTTPClient(),
+	}
+
+	state, err := randomState()
+	cmdutil.ExitIfError(err)
+
+	ln, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", params.port))
+	if err != nil {
+		cmdutil.Failed("Unable to start the callback server: %s", err)
+	}
+
+	authURL := conf.AuthCodeURL(state)
+	fmt.Printf("Opening the authorization page in the browser, or visit:\n\n  %s\n\n", authURL)
+	_ = browser.Browse(authURL)
+
+	code, err := func() (string, error) {
+		s := cmdutil.Info("Waiting for authorization...")
+		defer s.Stop()
+
+		return waitForCode(ln, state, loginTimeout)
+	}()
+	cmdutil.ExitIfError(err)
+
+	ctx := context.Background()
+
+	token, resources, err := func() (*oauth.Token, []*oauth.Resource, error) {
+		s := cmdutil.Info("Fetching tokens...")
+		defer s.Stop()
+
+		token, err := conf.Exchange(ctx, code)
+		if err != nil {
+			return nil, nil, err
+		}
+		resources, err := conf.Resources(ctx, token.AccessToken)
+		return token, resources, err
+	}()
+	cmdutil.ExitIfError(err)
+
+	site, err := pickSite(resources, params.server)
+	cmdutil.ExitIfError(err)
+
+	store, err := jiraConfig.AuthStore()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(store.Save(&auth.Credentials{
+		ClientID:     conf.ClientID,
+		ClientSecret: conf.ClientSecret,
+		Site:         site.URL,
+		CloudID:      site.ID,
+		Token:        *token,
+	}))
+
+	cmdutil.Success("Logged in to %s", site.URL)
+
+	if file := viper.ConfigFileUsed(); jiraConfig.Exists(file) {
+		cmdutil.ExitIfError(jiraConfig.SetAuthType(file, jira.AuthTypeOAuth))
+	} else {
+		fmt.Println("Run 'JIRA_AUTH_TYPE=oauth jira init' to configure the tool.")
+	}
+}
+
+// waitForCode serves the callback URL until the authorization code is received.
+func waitForCode(ln net.Listener, state string, timeout time.Duration) (string, error) {
+	type result struct {
+		code string
+		err  error
+	}
+	ch := make(chan result, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		var (
+			q   = r.URL.Query()
+			res result
+		)
+
+		switch {
+		case q.Get("error") != "":
+			msg := q.Get("error_description")
+			if msg == "" {
+				msg = q.Get("error")
+			}
+			res.err = fmt.Errorf("authorization failed: %s", msg)
+		case q.Get("state") != state:
+			res.err = fmt.Errorf("authorization failed: state doesn't match, please try again")
+		case q.Get("code") == "":
+			res.err = fmt.Errorf("authorization failed: no code received")
+		default:
+			res.code = q.Get("code")
+		}
+
+		if res.err != nil {
+			http.Error(w, res.err.Error(), http.StatusBadRequest)
+		} else {
+			_, _ = fmt.Fprint(w, "You are logged in to Jira CLI, you can close this window.")
+		}
+
+		select {
+		case ch <- res:
+		default:
+		}
+	})
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = srv.Serve(ln) }()
+	defer func() { _ = srv.Close() }()
+
+	select {
+	case res := <-ch:
+		return res.code, res.err
+	case <-time.After(timeout):
+		return "", fmt.Errorf("timed out waiting for authorization")
+	}
+}
+
+// pickSite finds the site matching the server, or asks to pick one if no server is configured.
+func pickSite(resources []*oauth.Resource, server string) (*oauth.Resource, error) {
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("the app is not authorized for any Jira site")
+	}
+
+	if server != "" {
+		for _, r := range resources {
+			if strings.EqualFold(strings.TrimRight(r.URL, "/"), strings.TrimRight(server, "/")) {
+				return r, nil
+			}
+		}
+		return nil, fmt.Errorf("the app is not authorized for %s", server)
+	}
+	if len(resources) == 1 {
+		return resources[0], nil
+	}
+
+	options := make([]string, 0, len(resources))
+	for _, r := range resources {
+		options = append(options, r.URL)
+	}
+
+	var idx int
+	if err := survey.AskOne(&survey.Select{Message: "Jira site:", Options: options}, &idx); err != nil {
+		return nil, err
+	}
+	return resources[idx], nil
+}
+
+func randomState() (string, error) {
+	b := make([]byte, 16) //nolint:gomnd
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+type loginParams struct {
+	oauth        bool
+	clientID     string
+	clientSecret string
+	port         uint
+	server       string
+}
+
+func parseFlags(flags query.FlagParser) *loginParams {
+	useOAuth, err := flags.GetBool("oauth")
+	cmdutil.ExitIfError(err)
+
+	clientID, err := flags.GetString("client-id")
+	cmdutil.ExitIfError(err)
+
+	clientSecret, err := flags.GetString("client-secret")
+	cmdutil.ExitIfError(err)
+	if clientSecret == "" {
+		clientSecret = os.Getenv("JIRA_OAUTH_CLIENT_SECRET")
+	}
+
+	port, err := flags.GetUint("port")
+	cmdutil.ExitIfError(err)
+
+	server, err := flags.GetString("server")
+	cmdutil.ExitIfError(err)
+	if server == "" {
+		server = viper.GetString("server")
+	}
+
+	return &loginParams{
+		oauth:        useOAuth,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		port:         port,
+		server:       server,
+	}
+}
@@ -0,0 +1,88 @@
+package login
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/oauth"
+)
+
+func TestWaitForCode(t *testing.T) {
+	cases := []struct {
+		name   string
+		query  string
+		code   string
+		status int
+		err    string
+	}{
+		{
+			name:   "it receives the code",
+			query:  "code=code-1&state=xyz",
+			code:   "code-1",
+			status: 200,
+		},
+		{
+			name:   "it fails if the state doesn't match",
+			query:  "code=code-1&state=abc",
+			status: 400,
+			err:    "authorization failed: state doesn't match, please try again",
+		},
+		{
+			name:   "it fails if the user denies access",
+			query:  "error=access_denied&error_description=User+did+not+authorize&state=xyz",
+			status: 400,
+			err:    "authorization failed: User did not authorize",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			ln, err := net.Listen("tcp", "127.0.0.1:0")
+			assert.NoError(t, err)
+
+			go func() {
+				res, err := http.Get(fmt.Sprintf("http://%s%s?%s", ln.Addr(), callbackPath, tc.query))
+				if assert.NoError(t, err) {
+					assert.Equal(t, tc.status, res.StatusCode)
+					_ = res.Body.Close()
+				}
+			}()
+
+			code, err := waitForCode(ln, "xyz", 3*time.Second)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.code, code)
+		})
+	}
+}
+
+func TestPickSite(t *testing.T) {
+	resources := []*oauth.Resource{
+		{ID: "1", URL: "https://one.atlassian.net"},
+		{ID: "2", URL: "https://two.atlassian.net"},
+	}
+
+	r, err := pickSite(resources, "https://Two.atlassian.net/")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", r.ID)
+
+	_, err = pickSite(resources, "https://three.atlassian.net")
+	assert.EqualError(t, err, "the app is not authorized for https://three.atlassian.net")
+
+	r, err = pickSite(resources[:1], "")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", r.ID)
+
+	_, err = pickSite(nil, "")
+	assert.EqualError(t, err, "the app is not authorized for any Jira site")
+}
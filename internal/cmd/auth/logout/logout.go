@@ -0,0 +1,43 @@
+package logout
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/auth"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/keyring"
+)
+
+const helpText = `Logout removes the saved OAuth tokens and the API token saved in the keyring with set-token.
+
+Revoke the access of the app in your Atlassian account settings to invalidate the tokens.`
+
+// NewCmdLogout is an auth logout command.
+func NewCmdLogout() *cobra.Command {
+	return &cobra.Command{
+		Use:     "logout",
+		Short:   "Logout removes the saved tokens",
+		Long:    helpText,
+		Example: "$ jira auth logout",
+		Args:    cobra.NoArgs,
+		Run:     logout,
+	}
+}
+
+func logout(*cobra.Command, []string) {
+	store, err := jiraConfig.AuthStore()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(store.Clear())
+
+	err = auth.DeleteToken(viper.GetString("server"), viper.GetString("login"))
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) && !errors.Is(err, keyring.ErrUnsupported) {
+		cmdutil.ExitIfError(err)
+	}
+
+	cmdutil.Success("Logged out")
+}
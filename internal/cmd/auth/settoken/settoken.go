@@ -0,0 +1,91 @@
+package settoken
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/auth"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+const (
+	helpText = `Set-token saves the API token in the keyring of the OS so that it doesn't need to be
+exported in JIRA_API_TOKEN env or kept in a plain text file.
+
+Keychain is used on macOS, Credential Manager on Windows and Secret Service on Linux and BSDs,
+secret-tool needs to be installed for the latter. The token is saved for the server and the
+login in the config, and is used when JIRA_API_TOKEN env is not set and there is no .netrc entry.
+
+The token is prompted for or read from the standard input if it is piped.`
+	examples = `$ jira auth set-token
+
+# Read the token from a password manager
+$ pass show jira | jira auth set-token
+
+# Save the token before running 'jira init'
+$ jira auth set-token --server https://company.atlassian.net --login jane@company.com`
+)
+
+// NewCmdSetToken is an auth set-token command.
+func NewCmdSetToken() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "set-token",
+		Short:   "Set-token saves the API token in the keyring of the OS",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     setToken,
+	}
+
+	cmd.Flags().String("server", "", "Jira server the token is for, defaults to the server in the config")
+	cmd.Flags().String("login", "", "Login the token is for, defaults to the login in the config")
+
+	return &cmd
+}
+
+func setToken(cmd *cobra.Command, _ []string) {
+	server, err := cmd.Flags().GetString("server")
+	cmdutil.ExitIfError(err)
+	login, err := cmd.Flags().GetString("login")
+	cmdutil.ExitIfError(err)
+
+	if server == "" {
+		server = viper.GetString("server")
+	}
+	if login == "" {
+		login = viper.GetString("login")
+	}
+	if server == "" {
+		cmdutil.Failed("No server found in the config, use --server to set it")
+	}
+
+	token, err := readToken()
+	cmdutil.ExitIfError(err)
+	if token == "" {
+		cmdutil.Failed("Token can't be empty")
+	}
+
+	cmdutil.ExitIfError(auth.SetToken(server, login, token))
+
+	cmdutil.Success("Token saved in the keyring for %s", server)
+}
+
+func readToken() (string, error) {
+	if cmdutil.StdinHasData() {
+		b, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+
+	var token string
+	err := survey.AskOne(&survey.Password{Message: "API token"}, &token)
+
+	return strings.TrimSpace(token), err
+}
@@ -0,0 +1,36 @@
+package backlog
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/backlog/list"
+)
+
+const helpText = `Backlog manage the backlog of a project board. See available commands below.
+
+Use 'jira issue rank' to reorder issues in the backlog.`
+
+// NewCmdBacklog is a backlog command.
+func NewCmdBacklog() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "backlog",
+		Short:       "Backlog manage the backlog of a project board",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        backlog,
+	}
+
+	lc := list.NewCmdList()
+
+	cmd.AddCommand(lc)
+
+	cmd.PersistentFlags().Int("board", 0, "ID of the board to look into (defaults to the board in the config)")
+
+	list.SetFlags(lc)
+
+	return &cmd
+}
+
+func backlog(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
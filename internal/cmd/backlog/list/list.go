@@ -0,0 +1,171 @@
+package list
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `List lists issues in the backlog of a board in the rank order.
+
+You can use all flags supported by the issue list command to filter issues in the backlog.`
+	examples = `$ jira backlog list
+
+# List backlog issues of another board in a plain table view
+$ jira backlog list --board 12 --plain
+
+# List high priority bugs in the backlog
+$ jira backlog list -tBug -yHigh`
+)
+
+// NewCmdList is a backlog list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List lists issues in the backlog of a board",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Run:     backlogList,
+	}
+}
+
+// SetFlags sets flags supported by a backlog list command.
+func SetFlags(cmd *cobra.Command) {
+	list.SetFlags(cmd)
+
+	// Backlog is always displayed in the rank order.
+	cmdutil.ExitIfError(cmd.Flags().Lookup("order-by").Value.Set("rank"))
+	cmdutil.ExitIfError(cmd.Flags().Lookup("reverse").Value.Set("true"))
+
+	cmdutil.ExitIfError(cmd.Flags().MarkHidden("order-by"))
+	cmdutil.ExitIfError(cmd.Flags().MarkHidden("reverse"))
+	cmdutil.ExitIfError(cmd.Flags().MarkHidden("history"))
+}
+
+func backlogList(cmd *cobra.Command, _ []string) {
+	server := viper.GetString("server")
+	project := viper.GetString("project.key")
+	boardID := viper.GetInt("board.id")
+
+	if boardID == 0 {
+		cmdutil.Failed("No board configured, use --board or set a default board with 'jira init'")
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	loadList(cmd.Flags(), boardID, project, server, client)
+}
+
+func loadList(flags query.FlagParser, boardID int, project, server string, client *jira.Client) {
+	issues, total, err := func() ([]*jira.Issue, int, error) {
+		s := cmdutil.Info("Fetching backlog issues...")
+		defer s.Stop()
+
+		q, err := query.NewIssue(project, flags)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		jql := q.Get()
+
+		resp, err := api.FetchPages(func(from, limit uint) (*jira.SearchResult, error) {
+			return client.BacklogIssuesFrom(boardID, jql, from, limit)
+		}, q.Params().Limit, cmdutil.InfoProgress(s, "Fetching backlog issues..."))
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Issues, resp.Total, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, issues))
+		return
+	}
+	if output != "" && output != view.OutputCSV {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	format, err := flags.GetString("format")
+	cmdutil.ExitIfError(err)
+
+	if format != "" {
+		cmdutil.ExitIfError(view.RenderIssuesTemplate(os.Stdout, server, format, issues))
+		return
+	}
+
+	if total == 0 {
+		fmt.Println()
+		cmdutil.Failed("No result found in the backlog of board \"%s\"", cmdcommon.BoardName(client))
+		return
+	}
+
+	plain, err := flags.GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := flags.GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	noTruncate, err := flags.GetBool("no-truncate")
+	cmdutil.ExitIfError(err)
+
+	columnsFlag, err := flags.GetString("columns")
+	cmdutil.ExitIfError(err)
+
+	columns, customColumns, err := cmdcommon.IssueColumns(client, "backlog", columnsFlag)
+	cmdutil.ExitIfError(err)
+
+	groupByFlag, err := flags.GetString("group-by")
+	cmdutil.ExitIfError(err)
+
+	groupBy, err := cmdcommon.IssueGroupBy(groupByFlag)
+	cmdutil.ExitIfError(err)
+
+	v := view.IssueList{
+		Project: project,
+		Server:  server,
+		Total:   total,
+		Data:    issues,
+		FooterText: fmt.Sprintf(
+			"Showing %d of %d results in the backlog of board \"%s\"",
+			len(issues), total, cmdcommon.BoardName(client),
+		),
+		Refresh: func() {
+			loadList(flags, boardID, project, server, client)
+		},
+		Actions: cmdcommon.IssueActions(client, project),
+		Display: view.DisplayFormat{
+			Plain:         plain,
+			NoHeaders:     noHeaders,
+			NoTruncate:    noTruncate,
+			Columns:       columns,
+			CustomColumns: customColumns,
+			GroupBy:       groupBy,
+		},
+	}
+
+	if output == view.OutputCSV {
+		cmdutil.ExitIfError(v.RenderCSV(os.Stdout))
+		return
+	}
+
+	cmdutil.ExitIfError(v.Render())
+}
@@ -0,0 +1,68 @@
+package defaultboard
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Default sets the default board of a project in the config.
+
+Sprint and backlog commands use the default board of the project they look into,
+so you don't have to pass --board when switching projects with --project.`
+	examples = `$ jira board default BOARD_ID
+
+# Set the default board of another project
+$ jira board default BOARD_ID -pPROJ`
+)
+
+// NewCmdDefault is a board default command.
+func NewCmdDefault() *cobra.Command {
+	return &cobra.Command{
+		Use:     "default BOARD_ID",
+		Short:   "Default sets the default board of a project",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "BOARD_ID\tID of the board, eg: 12",
+		},
+		Run: setDefault,
+	}
+}
+
+func setDefault(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	boardID, err := strconv.Atoi(args[0])
+	if err != nil {
+		cmdutil.Failed("Invalid board id %q", args[0])
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	board, err := func() (*jira.Board, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching board #%d...", boardID))
+		defer s.Stop()
+
+		config, err := api.Client(jira.Config{Debug: debug}).GetBoardConfiguration(boardID)
+		if err != nil {
+			return nil, err
+		}
+		return &jira.Board{ID: config.ID, Name: config.Name, Type: config.Type}, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(jiraConfig.SetDefaultBoard(viper.ConfigFileUsed(), project, board))
+
+	cmdutil.Success("Board \"%s\" is now the default board of project \"%s\"", board.Name, project)
+}
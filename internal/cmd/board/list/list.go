@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -42,6 +43,17 @@ func List(cmd *cobra.Command, _ []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, boards))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
 	if total == 0 {
 		fmt.Println()
 		cmdutil.Failed("No boards found in project \"%s\"", project)
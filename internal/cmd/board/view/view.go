@@ -0,0 +1,89 @@
+package view
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `View displays columns of a board, statuses mapped to each column and the filter backing the board.`
+	examples = `$ jira board view BOARD_ID`
+)
+
+// NewCmdView is a board view command.
+func NewCmdView() *cobra.Command {
+	return &cobra.Command{
+		Use:     "view BOARD_ID",
+		Short:   "View displays board configuration",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "BOARD_ID\tID of the board, eg: 12",
+		},
+		Run: viewBoard,
+	}
+}
+
+func viewBoard(cmd *cobra.Command, args []string) {
+	boardID, err := strconv.Atoi(args[0])
+	if err != nil {
+		cmdutil.Failed("Invalid board id %q", args[0])
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	config, filter, statuses, err := func() (*jira.BoardConfiguration, *jira.SavedFilter, map[string]string, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching configuration of board #%d...", boardID))
+		defer s.Stop()
+
+		config, err := client.GetBoardConfiguration(boardID)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		// Filter and statuses only add details to the view, so the view
+		// is still displayed if the user doesn't have access to them.
+		filter, err := client.GetSavedFilter(config.Filter.ID)
+		if err != nil {
+			filter = nil
+		}
+
+		statuses := make(map[string]string)
+		if all, err := client.Statuses(); err == nil {
+			for _, st := range all {
+				statuses[st.ID] = st.Name
+			}
+		}
+
+		return config, filter, statuses, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, struct {
+			Configuration *jira.BoardConfiguration `json:"configuration"`
+			Filter        *jira.SavedFilter        `json:"filter"`
+		}{config, filter}))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	cmdutil.ExitIfError(view.NewBoardDetail(config, filter, statuses).Render())
+}
@@ -0,0 +1,32 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/cache/clear"
+)
+
+const helpText = `Cache manages the local cache of metadata, eg: projects, boards, fields and users.
+
+Metadata rarely changes, so it is cached for a day to save requests to the server. Use the
+cache.ttl key in the config to cache it for another duration, eg: 12h, and the --no-cache
+flag to fetch the metadata from the server in a single command.`
+
+// NewCmdCache is a cache command.
+func NewCmdCache() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "cache",
+		Short:       "Cache manages the local cache of metadata",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        cache,
+	}
+
+	cmd.AddCommand(clear.NewCmdClear())
+
+	return &cmd
+}
+
+func cache(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
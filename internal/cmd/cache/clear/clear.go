@@ -0,0 +1,32 @@
+package clear
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cache"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const helpText = `Clear removes the cached metadata, so that it is fetched from the server on the next run.`
+
+// NewCmdClear is a cache clear command.
+func NewCmdClear() *cobra.Command {
+	return &cobra.Command{
+		Use:     "clear",
+		Short:   "Clear removes the cached metadata",
+		Long:    helpText,
+		Example: "$ jira cache clear",
+		Args:    cobra.NoArgs,
+		Run:     clearCache,
+	}
+}
+
+func clearCache(*cobra.Command, []string) {
+	dir, err := jiraConfig.CacheDir()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(cache.Clear(dir))
+
+	cmdutil.Success("Cache cleared")
+}
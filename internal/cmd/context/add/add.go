@@ -0,0 +1,67 @@
+package add
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+const (
+	helpText = `Add saves the config in use as a context with the given name, replacing the existing
+context with the same name.
+
+The config is copied to the contexts directory, so 'jira init' can then overwrite the default
+config with the one of another instance to add as another context. While a context is in use,
+'jira init' regenerates the config of the context instead.`
+	examples = `$ jira context add work
+
+# Add the config of another instance
+$ jira init
+$ jira context add client --use
+
+# Add a config file
+$ jira context add sandbox --config ~/sandbox.yml`
+)
+
+// NewCmdAdd is a context add command.
+func NewCmdAdd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "add NAME",
+		Short:   "Add saves the config in use as a context",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the context, eg: work",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  add,
+	}
+
+	cmd.Flags().Bool("use", false, "Switch to the context once added")
+
+	return &cmd
+}
+
+func add(cmd *cobra.Command, args []string) {
+	use, err := cmd.Flags().GetBool("use")
+	cmdutil.ExitIfError(err)
+
+	config := viper.ConfigFileUsed()
+	if !jiraConfig.Exists(config) {
+		cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
+	}
+
+	store, err := jiraConfig.ContextStore()
+	cmdutil.ExitIfError(err)
+
+	ctx, err := store.Add(args[0], config)
+	cmdutil.ExitIfError(err)
+
+	if use {
+		cmdutil.ExitIfError(store.Use(ctx.Name))
+	}
+
+	cmdutil.Success("Context %q saved to %s", ctx.Name, ctx.Config)
+}
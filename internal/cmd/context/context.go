@@ -0,0 +1,37 @@
+package context
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/context/add"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/context/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/context/use"
+)
+
+const helpText = `Context manages named configs, so that you can switch between Jira instances, eg: a work
+Cloud site, a client Data Center and a sandbox, with a single command.
+
+A context bundles the server, the authentication and the project of the config. Pass --context
+or set JIRA_CONTEXT env to use another context for a single command.`
+
+// NewCmdContext is a context command.
+func NewCmdContext() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "context",
+		Short:       "Context manages configs of multiple Jira instances",
+		Long:        helpText,
+		Aliases:     []string{"contexts", "ctx"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        context,
+		// Contexts are managed locally, so neither a config nor a token is required.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+	}
+
+	cmd.AddCommand(list.NewCmdList(), use.NewCmdUse(), add.NewCmdAdd())
+
+	return &cmd
+}
+
+func context(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,58 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+)
+
+// NewCmdList is a context list command.
+func NewCmdList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List lists the contexts",
+		Long:    "List lists the contexts, the one in use is marked with an asterisk.",
+		Aliases: []string{"lists", "ls"},
+		Args:    cobra.NoArgs,
+		Run:     list,
+	}
+}
+
+func list(*cobra.Command, []string) {
+	store, err := jiraConfig.ContextStore()
+	cmdutil.ExitIfError(err)
+
+	all, err := store.All()
+	cmdutil.ExitIfError(err)
+
+	if len(all) == 0 {
+		cmdutil.Failed("No contexts found.\nRun 'jira context add NAME' to save the current config as a context.")
+	}
+
+	used := viper.ConfigFileUsed()
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "CURRENT\tNAME\tSERVER\tPROJECT\tCONFIG")
+	for _, c := range all {
+		current := ""
+		if c.Config == used {
+			current = "*"
+		}
+
+		conf := viper.New()
+		conf.SetConfigFile(c.Config)
+		_ = conf.ReadInConfig()
+
+		fmt.Fprintf(
+			tw, "%s\t%s\t%s\t%s\t%s\n",
+			current, c.Name, conf.GetString("server"), conf.GetString("project.key"), c.Config,
+		)
+	}
+	cmdutil.ExitIfError(tw.Flush())
+}
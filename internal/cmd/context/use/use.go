@@ -0,0 +1,67 @@
+package use
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/contexts"
+)
+
+const (
+	helpText = `Use switches to the context with the given name. Commands use the config of the context
+until another context is used. Pass --default to use the default config again.`
+	examples = `$ jira context use work
+
+# Use the default config again
+$ jira context use --default`
+)
+
+// NewCmdUse is a context use command.
+func NewCmdUse() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "use [NAME]",
+		Short:   "Use switches to another context",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the context",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  use,
+	}
+
+	cmd.Flags().Bool("default", false, "Use the default config")
+
+	return &cmd
+}
+
+func use(cmd *cobra.Command, args []string) {
+	useDefault, err := cmd.Flags().GetBool("default")
+	cmdutil.ExitIfError(err)
+
+	if useDefault == (len(args) == 1) {
+		cmdutil.Failed("Pass either the name of the context or --default")
+	}
+
+	store, err := jiraConfig.ContextStore()
+	cmdutil.ExitIfError(err)
+
+	if useDefault {
+		cmdutil.ExitIfError(store.Use(""))
+		cmdutil.Success("Using the default config")
+		return
+	}
+
+	err = store.Use(args[0])
+	if errors.Is(err, contexts.ErrNoContext) {
+		cmdutil.FailedWithCode(
+			cmdutil.ExitCodeNotFound, "Context %q not found, run 'jira context list' to see the available contexts", args[0],
+		)
+	}
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Using context %q", args[0])
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -38,7 +39,6 @@ func NewCmdAdd() *cobra.Command {
 func add(cmd *cobra.Command, args []string) {
 	server := viper.GetString("server")
 	project := viper.GetString("project.key")
-	projectType := viper.GetString("project.type")
 	params := parseFlags(cmd.Flags(), args, project)
 	client := api.Client(jira.Config{Debug: params.debug})
 
@@ -73,8 +73,19 @@ func add(cmd *cobra.Command, args []string) {
 		s := cmdutil.Info("Adding issues to the epic...")
 		defer s.Stop()
 
+		// Company-managed projects link issues to the epic with the epic link field
+		// while team-managed projects use the parent field, so we need to check the
+		// type of the project the epic belongs to.
+		projectType, err := cmdcommon.ProjectType(client, cmdcommon.ProjectKey(params.epicKey))
+		if err != nil {
+			return err
+		}
 		if projectType != jira.ProjectTypeNextGen {
-			return client.EpicIssuesAdd(params.epicKey, params.issues...)
+			if err := client.EpicIssuesAdd(params.epicKey, params.issues...); err != nil {
+				return err
+			}
+			passed = true
+			return nil
 		}
 
 		// If the project is of the next-gen type, we need to set the parent property for each issue.
@@ -96,17 +107,10 @@ func add(cmd *cobra.Command, args []string) {
 		return nil
 	}()
 
-	msg := fmt.Sprintf("Issues added to the epic %s\n%s/browse/%s", params.epicKey, server, params.epicKey)
-
-	if projectType != jira.ProjectTypeNextGen {
-		cmdutil.ExitIfError(err)
-		cmdutil.Success(msg)
-	} else {
-		if passed {
-			cmdutil.Success(msg)
-		}
-		cmdutil.ExitIfError(err)
+	if passed {
+		cmdutil.Success("Issues added to the epic %s\n%s/browse/%s", params.epicKey, server, params.epicKey)
 	}
+	cmdutil.ExitIfError(err)
 }
 
 func parseFlags(flags query.FlagParser, args []string, project string) *addParams {
@@ -47,7 +47,6 @@ func SetFlags(cmd *cobra.Command) {
 func create(cmd *cobra.Command, _ []string) {
 	server := viper.GetString("server")
 	project := viper.GetString("project.key")
-	projectType := viper.GetString("project.type")
 
 	params := parseFlags(cmd.Flags())
 	client := api.Client(jira.Config{Debug: params.debug})
@@ -56,10 +55,13 @@ func create(cmd *cobra.Command, _ []string) {
 		params: params,
 	}
 
+	projectType, err := cmdcommon.ProjectType(client, project)
+	cmdutil.ExitIfError(err)
+
 	if cc.isNonInteractive() {
 		cc.params.noInput = true
 
-		if cc.isMandatoryParamsMissing() {
+		if cc.isMandatoryParamsMissing(projectType) {
 			cmdutil.Failed(
 				"Params `--summary` and `--name` is mandatory when using a non-interactive mode",
 			)
@@ -84,6 +86,9 @@ func create(cmd *cobra.Command, _ []string) {
 	}
 
 	// TODO: Remove duplicates with issue/create.
+	// Assignee picked in the prompt, params.assignee is looked up otherwise.
+	var assignee, reporter *jira.User
+
 	if !params.noInput {
 		answer := struct{ Action string }{}
 		for answer.Action != cmdcommon.ActionSubmit {
@@ -122,6 +127,15 @@ func create(cmd *cobra.Command, _ []string) {
 						params.fixVersions = strings.Split(ans.FixVersions, ",")
 					}
 				}
+				for _, m := range ans.Metadata {
+					switch m {
+					case cmdcommon.MetadataAssignee:
+						assignee, err = cmdcommon.AskUser(client, project, "Assignee")
+					case cmdcommon.MetadataReporter:
+						reporter, err = cmdcommon.AskUser(client, project, "Reporter")
+					}
+					cmdutil.ExitIfError(err)
+				}
 			}
 		}
 	}
@@ -130,16 +144,26 @@ func create(cmd *cobra.Command, _ []string) {
 		s := cmdutil.Info("Creating an epic...")
 		defer s.Stop()
 
+		fixVersions, err := cmdcommon.ResolveVersions(client, project, params.fixVersions, false)
+		if err != nil {
+			return "", err
+		}
+		affectsVersions, err := cmdcommon.ResolveVersions(client, project, params.affectsVersions, false)
+		if err != nil {
+			return "", err
+		}
+
 		cr := jira.CreateRequest{
-			Project:     project,
-			IssueType:   jira.IssueTypeEpic,
-			Summary:     params.summary,
-			Body:        params.body,
-			Priority:    params.priority,
-			Labels:      params.labels,
-			Components:  params.components,
-			FixVersions: params.fixVersions,
-			EpicField:   viper.GetString("epic.name"),
+			Project:         project,
+			IssueType:       jira.IssueTypeEpic,
+			Summary:         params.summary,
+			Body:            params.body,
+			Priority:        params.priority,
+			Labels:          params.labels,
+			Components:      params.components,
+			FixVersions:     fixVersions,
+			AffectsVersions: affectsVersions,
+			EpicField:       viper.GetString("epic.name"),
 		}
 		if projectType != jira.ProjectTypeNextGen {
 			cr.Name = params.name
@@ -155,7 +179,7 @@ func create(cmd *cobra.Command, _ []string) {
 
 	cmdutil.Success("Epic created\n%s/browse/%s", server, key)
 
-	if params.assignee != "" {
+	if assignee == nil && params.assignee != "" {
 		user, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
 			Query:   params.assignee,
 			Project: project,
@@ -163,10 +187,18 @@ func create(cmd *cobra.Command, _ []string) {
 		if err != nil || len(user) == 0 {
 			cmdutil.Failed("Unable to find assignee")
 		}
-		if err = api.ProxyAssignIssue(client, key, user[0], jira.AssigneeDefault); err != nil {
+		assignee = user[0]
+	}
+	if assignee != nil {
+		if err = api.ProxyAssignIssue(client, key, assignee, jira.AssigneeDefault); err != nil {
 			cmdutil.Failed("Unable to set assignee: %s", err.Error())
 		}
 	}
+	if reporter != nil {
+		if err = client.Edit(key, &jira.EditRequest{Reporter: reporter}); err != nil {
+			cmdutil.Failed("Unable to set reporter: %s", err.Error())
+		}
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, key)
@@ -234,22 +266,28 @@ func (cc *createCmd) isNonInteractive() bool {
 	return cmdutil.StdinHasData() || cc.params.template == "-"
 }
 
-func (cc *createCmd) isMandatoryParamsMissing() bool {
+// isMandatoryParamsMissing checks for the mandatory params. Epics in
+// team-managed projects don't have a name, so it is only required otherwise.
+func (cc *createCmd) isMandatoryParamsMissing(projectType string) bool {
+	if projectType == jira.ProjectTypeNextGen {
+		return cc.params.summary == ""
+	}
 	return cc.params.summary == "" || cc.params.name == ""
 }
 
 type createParams struct {
-	name        string
-	summary     string
-	body        string
-	priority    string
-	assignee    string
-	labels      []string
-	components  []string
-	fixVersions []string
-	template    string
-	noInput     bool
-	debug       bool
+	name            string
+	summary         string
+	body            string
+	priority        string
+	assignee        string
+	labels          []string
+	components      []string
+	fixVersions     []string
+	affectsVersions []string
+	template        string
+	noInput         bool
+	debug           bool
 }
 
 func parseFlags(flags query.FlagParser) *createParams {
@@ -277,6 +315,9 @@ func parseFlags(flags query.FlagParser) *createParams {
 	fixVersions, err := flags.GetStringArray("fix-version")
 	cmdutil.ExitIfError(err)
 
+	affectsVersions, err := flags.GetStringArray("affects-version")
+	cmdutil.ExitIfError(err)
+
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
@@ -287,16 +328,17 @@ func parseFlags(flags query.FlagParser) *createParams {
 	cmdutil.ExitIfError(err)
 
 	return &createParams{
-		name:        name,
-		summary:     summary,
-		body:        body,
-		priority:    priority,
-		assignee:    assignee,
-		labels:      labels,
-		components:  components,
-		fixVersions: fixVersions,
-		template:    template,
-		noInput:     noInput,
-		debug:       debug,
+		name:            name,
+		summary:         summary,
+		body:            body,
+		priority:        priority,
+		assignee:        assignee,
+		labels:          labels,
+		components:      components,
+		fixVersions:     fixVersions,
+		affectsVersions: affectsVersions,
+		template:        template,
+		noInput:         noInput,
+		debug:           debug,
 	}
 }
@@ -9,7 +9,11 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/epic/remove"
 )
 
-const helpText = `Epic manage epics in a given project. See available commands below.`
+const helpText = `Epic manage epics in a given project. See available commands below.
+
+Issues are linked to epics with the epic link field in company-managed projects and with the
+parent field in team-managed projects. The project type is detected for you, so the commands
+work the same way in both.`
 
 // NewCmdEpic is an epic command.
 func NewCmdEpic() *cobra.Command {
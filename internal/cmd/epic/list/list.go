@@ -2,13 +2,14 @@ package list
 
 import (
 	"fmt"
-	"strings"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
@@ -78,15 +79,20 @@ func SetFlags(cmd *cobra.Command) {
 func epicList(cmd *cobra.Command, args []string) {
 	server := viper.GetString("server")
 	project := viper.GetString("project.key")
-	projectType := viper.GetString("project.type")
 
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	client := api.Client(jira.Config{Debug: debug})
 
+	projectType, err := cmdcommon.ProjectType(client, project)
+	cmdutil.ExitIfError(err)
+
 	if len(args) == 0 {
-		epicExplorerView(cmd.Flags(), project, projectType, server, client)
+		order, err := cmdcommon.ExplicitIssueOrder(cmd.Flags())
+		cmdutil.ExitIfError(err)
+
+		epicExplorerView(cmd.Flags(), project, projectType, server, client, order)
 	} else {
 		key := cmdutil.GetJiraIssueKey(project, args[0])
 		singleEpicView(cmd.Flags(), key, project, projectType, server, client)
@@ -106,17 +112,18 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 			return nil, 0, err
 		}
 
-		var resp *jira.SearchResult
-
 		if projectType == jira.ProjectTypeNextGen {
 			q.Params().Parent = key
 			q.Params().IssueType = ""
-
-			resp, err = client.Search(q.Get(), q.Params().Limit)
-		} else {
-			resp, err = client.EpicIssues(key, q.Get(), q.Params().Limit)
 		}
+		jql := q.Get()
 
+		resp, err := api.FetchPages(func(from, limit uint) (*jira.SearchResult, error) {
+			if projectType == jira.ProjectTypeNextGen {
+				return client.SearchFrom(jql, from, limit)
+			}
+			return client.EpicIssuesFrom(key, jql, from, limit)
+		}, q.Params().Limit, cmdutil.InfoProgress(s, "Fetching epic issues..."))
 		if err != nil {
 			return nil, 0, err
 		}
@@ -124,6 +131,25 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 	}()
 	cmdutil.ExitIfError(err)
 
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, issues))
+		return
+	}
+	if output != "" && output != view.OutputCSV {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	format, err := flags.GetString("format")
+	cmdutil.ExitIfError(err)
+
+	if format != "" {
+		cmdutil.ExitIfError(view.RenderIssuesTemplate(os.Stdout, server, format, issues))
+		return
+	}
+
 	if total == 0 {
 		fmt.Println()
 		cmdutil.Failed("No result found for given query in project \"%s\"", project)
@@ -139,7 +165,16 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 	noTruncate, err := flags.GetBool("no-truncate")
 	cmdutil.ExitIfError(err)
 
-	columns, err := flags.GetString("columns")
+	columnsFlag, err := flags.GetString("columns")
+	cmdutil.ExitIfError(err)
+
+	columns, customColumns, err := cmdcommon.IssueColumns(client, "epic", columnsFlag)
+	cmdutil.ExitIfError(err)
+
+	groupByFlag, err := flags.GetString("group-by")
+	cmdutil.ExitIfError(err)
+
+	groupBy, err := cmdcommon.IssueGroupBy(groupByFlag)
 	cmdutil.ExitIfError(err)
 
 	v := view.IssueList{
@@ -150,23 +185,28 @@ func singleEpicView(flags query.FlagParser, key, project, projectType, server st
 		Refresh: func() {
 			singleEpicView(flags, key, project, projectType, server, client)
 		},
+		Actions: cmdcommon.IssueActions(client, project),
 		Display: view.DisplayFormat{
-			Plain:      plain,
-			NoHeaders:  noHeaders,
-			NoTruncate: noTruncate,
-			Columns: func() []string {
-				if columns != "" {
-					return strings.Split(columns, ",")
-				}
-				return []string{}
-			}(),
+			Plain:         plain,
+			NoHeaders:     noHeaders,
+			NoTruncate:    noTruncate,
+			Columns:       columns,
+			CustomColumns: customColumns,
+			GroupBy:       groupBy,
 		},
 	}
 
+	if output == view.OutputCSV {
+		cmdutil.ExitIfError(v.RenderCSV(os.Stdout))
+		return
+	}
+
 	cmdutil.ExitIfError(v.Render())
 }
 
-func epicExplorerView(flags query.FlagParser, project, projectType, server string, client *jira.Client) {
+func epicExplorerView(
+	flags query.FlagParser, project, projectType, server string, client *jira.Client, order []query.OrderKey,
+) {
 	q, err := query.NewIssue(project, flags)
 	cmdutil.ExitIfError(err)
 
@@ -182,6 +222,17 @@ func epicExplorerView(flags query.FlagParser, project, projectType, server strin
 	}()
 	cmdutil.ExitIfError(err)
 
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, epics))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
 	if total == 0 {
 		fmt.Println()
 		cmdutil.Failed("No result found for given query in project \"%s\"", project)
@@ -194,19 +245,23 @@ func epicExplorerView(flags query.FlagParser, project, projectType, server strin
 		Server:  server,
 		Data:    epics,
 		Issues: func(key string) []*jira.Issue {
-			var resp *jira.SearchResult
-
 			if projectType == jira.ProjectTypeNextGen {
 				q.Params().Parent = key
 				q.Params().IssueType = ""
-
-				resp, err = client.Search(q.Get(), q.Params().Limit)
-			} else {
-				resp, err = client.EpicIssues(key, "", q.Params().Limit)
 			}
+			jql := q.Get()
+
+			resp, err := api.FetchPages(func(from, limit uint) (*jira.SearchResult, error) {
+				if projectType == jira.ProjectTypeNextGen {
+					return client.SearchFrom(jql, from, limit)
+				}
+				return client.EpicIssuesFrom(key, "", from, limit)
+			}, q.Params().Limit, nil)
 			if err != nil {
 				return []*jira.Issue{}
 			}
+			// Issues of classic epics are fetched without a JQL, so they are ordered here.
+			cmdcommon.SortIssues(resp.Issues, order)
 			return resp.Issues
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -36,7 +37,6 @@ func NewCmdRemove() *cobra.Command {
 
 func remove(cmd *cobra.Command, args []string) {
 	project := viper.GetString("project.key")
-	projectType := viper.GetString("project.type")
 	params := parseFlags(cmd.Flags(), args, project)
 	client := api.Client(jira.Config{Debug: params.debug})
 
@@ -67,11 +67,21 @@ func remove(cmd *cobra.Command, args []string) {
 		s := cmdutil.Info("Removing assigned epic from issues...")
 		defer s.Stop()
 
-		if projectType != jira.ProjectTypeNextGen {
-			return client.EpicIssuesRemove(params.issues...)
+		classic, nextGen, err := groupByProjectType(client, params.issues)
+		if err != nil {
+			return err
 		}
 
-		for _, iss := range params.issues {
+		if len(classic) > 0 {
+			if err := client.EpicIssuesRemove(classic...); err != nil {
+				msg := fmt.Sprintf("\n  - %s: %s", strings.Join(classic, ", "), cmdutil.NormalizeJiraError(err.Error()))
+				failed.WriteString(msg)
+			} else {
+				passed = true
+			}
+		}
+
+		for _, iss := range nextGen {
 			if err := client.Edit(iss, &jira.EditRequest{ParentIssueKey: jira.AssigneeNone}); err != nil {
 				msg := fmt.Sprintf("\n  - %s: %s", iss, cmdutil.NormalizeJiraError(err.Error()))
 				failed.WriteString(msg)
@@ -87,17 +97,42 @@ func remove(cmd *cobra.Command, args []string) {
 		return nil
 	}()
 
-	msg := "Epic unassigned from given issues"
+	if passed {
+		cmdutil.Success("Epic unassigned from given issues")
+	}
+	cmdutil.ExitIfError(err)
+}
 
-	if projectType != jira.ProjectTypeNextGen {
-		cmdutil.ExitIfError(err)
-		cmdutil.Success(msg)
-	} else {
-		if passed {
-			cmdutil.Success(msg)
+// groupByProjectType splits the issues into the ones in company-managed projects, that
+// use the epic link field, and the ones in team-managed projects, that use the parent field.
+func groupByProjectType(client *jira.Client, issues []string) ([]string, []string, error) {
+	var (
+		classic, nextGen []string
+		types            = make(map[string]string)
+	)
+
+	for _, iss := range issues {
+		project := cmdcommon.ProjectKey(iss)
+
+		pt, ok := types[project]
+		if !ok {
+			var err error
+
+			pt, err = cmdcommon.ProjectType(client, project)
+			if err != nil {
+				return nil, nil, err
+			}
+			types[project] = pt
+		}
+
+		if pt == jira.ProjectTypeNextGen {
+			nextGen = append(nextGen, iss)
+		} else {
+			classic = append(classic, iss)
 		}
-		cmdutil.ExitIfError(err)
 	}
+
+	return classic, nextGen, nil
 }
 
 func parseFlags(flags query.FlagParser, args []string, project string) *removeParams {
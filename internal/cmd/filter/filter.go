@@ -0,0 +1,31 @@
+package filter
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter/list"
+)
+
+const helpText = `Filter manages saved filters. See available commands below.
+
+Saved filters can be used to list issues with the --filter flag of the issue list command.`
+
+// NewCmdFilter is a filter command.
+func NewCmdFilter() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "filter",
+		Short:       "Filter manages saved filters",
+		Long:        helpText,
+		Aliases:     []string{"filters"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        filter,
+	}
+
+	cmd.AddCommand(list.NewCmdList())
+
+	return &cmd
+}
+
+func filter(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,80 @@
+package list
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira filter list
+
+# List filters in a plain table
+$ jira filter list --plain --no-headers
+
+# Get JQL of a filter in a script
+$ jira filter list -o json | jq -r '.[] | select(.name == "My open bugs") | .jql'`
+
+// NewCmdList is a filter list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists your favourite filters",
+		Long:    "List lists saved filters marked as favourite by you.",
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Args:    cobra.NoArgs,
+		Run:     list,
+	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+
+	return &cmd
+}
+
+func list(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	filters, err := func() ([]*jira.SavedFilter, error) {
+		s := cmdutil.Info("Fetching favourite filters...")
+		defer s.Stop()
+
+		return api.Client(jira.Config{Debug: debug}).FavouriteFilters()
+	}()
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.NewFilter(filters).RenderJSON(os.Stdout))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	if len(filters) == 0 {
+		cmdutil.Failed("No favourite filters found.")
+		return
+	}
+
+	v := view.NewFilter(filters, view.WithFilterDisplayFormat(view.DisplayFormat{
+		Plain:     plain,
+		NoHeaders: noHeaders,
+	}))
+
+	cmdutil.ExitIfError(v.Render())
+}
@@ -0,0 +1,33 @@
+package git
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git/installhooks"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git/processcommits"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git/uninstallhooks"
+)
+
+const helpText = `Git integrates the tool with the git repository in the current directory.`
+
+// NewCmdGit is a git command.
+func NewCmdGit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "git",
+		Short:       "Git integrates the tool with git repositories",
+		Long:        helpText,
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        git,
+	}
+
+	cmd.AddCommand(
+		installhooks.NewCmdInstallHooks(), uninstallhooks.NewCmdUninstallHooks(),
+		processcommits.NewCmdProcessCommits(),
+	)
+
+	return &cmd
+}
+
+func git(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
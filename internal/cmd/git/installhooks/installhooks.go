@@ -0,0 +1,70 @@
+package installhooks
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/gitbranch"
+)
+
+const (
+	helpText = `Install-hooks installs a prepare-commit-msg hook in the current git repository that prefixes
+commit messages with the issue key in the branch name, eg: ISSUE-1 for bug/ISSUE-1-fix-login.
+
+Messages that already mention the key, merge commits and amended commits are left as is. The
+prefix is a printf format set with --format, where %s is the issue key. The hook is a shell
+script, so it works without the tool, eg: in GUI clients.`
+	examples = `$ jira git install-hooks
+
+# Prefix commit messages like [ISSUE-1] Fix login
+$ jira git install-hooks --format "[%s] "
+
+# Replace an existing hook
+$ jira git install-hooks --force`
+)
+
+// NewCmdInstallHooks is a git install-hooks command.
+func NewCmdInstallHooks() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "install-hooks",
+		Short:   "Install the hook that prefixes commit messages with the issue key",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     install,
+		// Hooks are managed in the repository only, so a token is not required.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+	}
+
+	cmd.Flags().String(
+		"format", gitbranch.DefaultHookFormat, "Printf format of the commit message prefix, %s is the issue key",
+	)
+	cmd.Flags().Bool("force", false, "Replace the existing prepare-commit-msg hook")
+
+	return &cmd
+}
+
+func install(cmd *cobra.Command, _ []string) {
+	format, err := cmd.Flags().GetString("format")
+	cmdutil.ExitIfError(err)
+
+	force, err := cmd.Flags().GetBool("force")
+	cmdutil.ExitIfError(err)
+
+	wd, err := os.Getwd()
+	cmdutil.ExitIfError(err)
+
+	dir, err := gitbranch.HooksDir(wd)
+	cmdutil.ExitIfError(err)
+
+	path, err := gitbranch.InstallHook(dir, format, force)
+	if errors.Is(err, gitbranch.ErrHookExists) {
+		cmdutil.Failed("A %s hook already exists in %s, use --force to replace it", gitbranch.HookName, dir)
+	}
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Installed %s hook in %s", gitbranch.HookName, path)
+}
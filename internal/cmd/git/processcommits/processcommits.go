@@ -0,0 +1,294 @@
+package processcommits
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/move"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/gitlog"
+	"github.com/ankitpokhrel/jira-cli/internal/smartcommit"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	// notesRef is the git notes ref the processed commits are recorded in.
+	notesRef = "jira-cli"
+	// noteProcessed is the note of the commits whose commands all succeeded.
+	noteProcessed = "Smart commit processed by jira-cli"
+	// notePartial is the first line of the note of the commits whose commands partly succeeded,
+	// followed by the ids of the succeeded commands.
+	notePartial = "Smart commit partially processed by jira-cli"
+
+	helpText = `Process-commits performs the smart commit commands in the messages of your local commits,
+for Jira servers that don't process smart commits. It processes the commits on HEAD that are
+not on --since, the upstream of the current branch by default.
+
+A smart commit line starts with the issue keys followed by the commands:
+
+  ISSUE-1 #time 2h 30m Debugging #comment Fixed the login #done
+
+#time logs the time spent with the rest of the text as the worklog comment, #comment comments
+on the issue and any other command transitions the issue, eg: #start-progress for "Start Progress".
+
+Processed commits are recorded in git notes under refs/notes/jira-cli and skipped on the next
+run. If some commands of a commit fail, the succeeded ones are recorded too, so only the failed
+commands are performed again on the next run.`
+	examples = `$ jira git process-commits
+
+# Process the commits that are not on origin/main
+$ jira git process-commits --since origin/main
+
+# See the commands that would be performed
+$ jira git process-commits --dry-run`
+)
+
+// NewCmdProcessCommits is a git process-commits command.
+func NewCmdProcessCommits() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "process-commits",
+		Short:   "Perform smart commit commands in local commit messages",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     processCommits,
+	}
+
+	cmd.Flags().String("since", "@{upstream}", "Process the commits that are not on the given branch or commit")
+	cmd.Flags().String("author", "", "Process the commits of the given author (defaults to git user.email)")
+	cmd.Flags().Bool("dry-run", false, "Display the commands without performing them")
+
+	return &cmd
+}
+
+type params struct {
+	since  string
+	author string
+	dryRun bool
+	debug  bool
+}
+
+type commit struct {
+	*gitlog.Commit
+	smartCommits []*smartcommit.SmartCommit
+	done         map[string]bool
+}
+
+func processCommits(cmd *cobra.Command, _ []string) {
+	p := parseFlags(cmd)
+
+	wd, err := os.Getwd()
+	cmdutil.ExitIfError(err)
+
+	if p.author == "" {
+		p.author, err = gitlog.UserEmail(wd)
+		cmdutil.ExitIfError(err)
+	}
+
+	commits, err := gitlog.Range(wd, p.since, p.author)
+	cmdutil.ExitIfError(err)
+
+	noted, err := gitlog.Noted(wd, notesRef)
+	cmdutil.ExitIfError(err)
+
+	var pending []*commit
+	for _, c := range commits {
+		sc := smartcommit.Parse(c.Subject + "\n" + c.Body)
+		if len(sc) == 0 {
+			continue
+		}
+
+		done := make(map[string]bool)
+		if noted[c.Hash] {
+			note, err := gitlog.Note(wd, notesRef, c.Hash)
+			cmdutil.ExitIfError(err)
+
+			if !strings.HasPrefix(note, notePartial) {
+				continue
+			}
+			for _, id := range strings.Fields(strings.TrimPrefix(note, notePartial)) {
+				done[id] = true
+			}
+		}
+		pending = append(pending, &commit{Commit: c, smartCommits: sc, done: done})
+	}
+
+	if len(pending) == 0 {
+		cmdutil.Success("No unprocessed smart commits found since %s", p.since)
+		return
+	}
+
+	if p.dryRun {
+		for _, c := range pending {
+			_ = c.each(func(_, key string, a *smartcommit.Action) error {
+				fmt.Printf("%s %s: %s\n", shortHash(c.Hash), key, describe(a))
+				return nil
+			})
+		}
+		return
+	}
+
+	client := api.Client(jira.Config{Debug: p.debug})
+
+	var (
+		failed        strings.Builder
+		passed, total int
+	)
+
+	err = func() error {
+		s := cmdutil.Info("Processing smart commits")
+		defer s.Stop()
+
+		for _, c := range pending {
+			ok := true
+			err := c.each(func(id, key string, a *smartcommit.Action) error {
+				total++
+				if err := perform(client, key, c.Time, a); err != nil {
+					ok = false
+					failed.WriteString(fmt.Sprintf(
+						"\n  - %s %s: %s: %s",
+						shortHash(c.Hash), key, describe(a), cmdutil.NormalizeJiraError(err.Error()),
+					))
+					return nil
+				}
+				passed++
+
+				// Succeeded commands are recorded right away so that they are not performed
+				// again on the next run if another command of the commit fails.
+				return gitlog.AddNote(wd, notesRef, c.Hash, c.progress(id))
+			})
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			if err := gitlog.AddNote(wd, notesRef, c.Hash, noteProcessed); err != nil {
+				return err
+			}
+		}
+
+		if failed.Len() > 0 {
+			return &jira.ErrMultipleFailed{Msg: failed.String()}
+		}
+		return nil
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Performed %d of %d smart commit commands in %d commits", passed, total, len(pending))
+	}
+	cmdutil.ExitIfError(err)
+}
+
+// each calls fn for each command of the commit for each issue key that wasn't performed yet.
+// It stops at the first error returned by fn.
+func (c *commit) each(fn func(id, key string, a *smartcommit.Action) error) error {
+	for i, sc := range c.smartCommits {
+		for _, key := range sc.Keys {
+			for j, a := range sc.Actions {
+				// Identifies the j-th command of the i-th smart commit for the issue key.
+				id := fmt.Sprintf("%d:%s:%d", i, key, j)
+				if c.done[id] {
+					continue
+				}
+				if err := fn(id, key, a); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// progress marks the command as done and returns the note recording the done commands.
+func (c *commit) progress(id string) string {
+	c.done[id] = true
+
+	ids := make([]string, 0, len(c.done))
+	for id := range c.done {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return notePartial + "\n\n" + strings.Join(ids, "\n")
+}
+
+func perform(client *jira.Client, key string, committed time.Time, a *smartcommit.Action) error {
+	switch a.Kind {
+	case smartcommit.KindTime:
+		secs, err := jira.ParseTimeSpent(a.Value)
+		if err != nil {
+			return err
+		}
+		// The work is considered to have ended when the changes were committed.
+		started := committed.Add(-time.Duration(secs) * time.Second)
+
+		_, err = api.ProxyAddWorklog(
+			client, key, a.Text, started.Format(jira.RFC3339MilliLayout), a.Value, "", "", nil, nil,
+		)
+		return err
+	case smartcommit.KindComment:
+		return client.AddIssueComment(key, a.Value, false)
+	default:
+		if _, err := move.TransitionTo(client, key, a.Value); err != nil {
+			return err
+		}
+		if a.Text == "" {
+			return nil
+		}
+		return client.AddIssueComment(key, a.Text, false)
+	}
+}
+
+func describe(a *smartcommit.Action) string {
+	var s string
+
+	switch a.Kind {
+	case smartcommit.KindTime:
+		s = "log " + a.Value
+	case smartcommit.KindComment:
+		return fmt.Sprintf("comment %q", a.Value)
+	default:
+		s = fmt.Sprintf("transition to %q", a.Value)
+	}
+	if a.Text != "" {
+		s += fmt.Sprintf(" with comment %q", a.Text)
+	}
+	return s
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 7 { //nolint:gomnd
+		return hash[:7]
+	}
+	return hash
+}
+
+func parseFlags(cmd *cobra.Command) *params {
+	flags := cmd.Flags()
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	since, err := flags.GetString("since")
+	cmdutil.ExitIfError(err)
+
+	author, err := flags.GetString("author")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := flags.GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	return &params{
+		since:  since,
+		author: author,
+		dryRun: dryRun,
+		debug:  debug,
+	}
+}
@@ -0,0 +1,37 @@
+package uninstallhooks
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/gitbranch"
+)
+
+// NewCmdUninstallHooks is a git uninstall-hooks command.
+func NewCmdUninstallHooks() *cobra.Command {
+	return &cobra.Command{
+		Use:     "uninstall-hooks",
+		Short:   "Remove the hook installed by install-hooks",
+		Long:    "Uninstall-hooks removes the hook installed by install-hooks from the current git repository.",
+		Example: "$ jira git uninstall-hooks",
+		Args:    cobra.NoArgs,
+		Run:     uninstall,
+		// Hooks are managed in the repository only, so a token is not required.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+	}
+}
+
+func uninstall(*cobra.Command, []string) {
+	wd, err := os.Getwd()
+	cmdutil.ExitIfError(err)
+
+	dir, err := gitbranch.HooksDir(wd)
+	cmdutil.ExitIfError(err)
+
+	path, err := gitbranch.UninstallHook(dir)
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Removed %s", path)
+}
@@ -0,0 +1,59 @@
+package adduser
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Add-user adds a user to a group. The user is looked up by the display name, email,
+account ID or username in Jira server. Use "me" to add yourself.`
+	examples = `$ jira group add-user jira-admins "Jon Doe"
+
+$ jira group add-user jira-admins jon@example.com`
+)
+
+// NewCmdAddUser is a group add-user command.
+func NewCmdAddUser() *cobra.Command {
+	return &cobra.Command{
+		Use:     "add-user GROUP USER",
+		Short:   "Add a user to a group",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "GROUP\tName of the group\n" +
+				"USER\tDisplay name, email, account ID or username of the user",
+		},
+		Args: cobra.ExactArgs(2), //nolint:gomnd
+		Run:  addUser,
+	}
+}
+
+func addUser(cmd *cobra.Command, args []string) {
+	group, name := args[0], args[1]
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	user, err := func() (*jira.User, error) {
+		s := cmdutil.Info(fmt.Sprintf("Adding %q to group %q...", name, group))
+		defer s.Stop()
+
+		user, err := cmdcommon.FindUser(client, name)
+		if err != nil {
+			return nil, err
+		}
+		return user, api.ProxyAddGroupUser(client, group, user)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("User %q added to group %q", cmdcommon.UserLabel(user), group)
+}
@@ -0,0 +1,38 @@
+package group
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/group/adduser"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/group/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/group/members"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/group/removeuser"
+)
+
+const helpText = `Group manages groups and their members. See available commands below.
+
+Listing members needs the browse users permission, adding and removing them needs
+the site administration permission in Jira cloud and the administer permission in Jira server.`
+
+// NewCmdGroup is a group command.
+func NewCmdGroup() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "group",
+		Short:       "Group manages groups and their members",
+		Long:        helpText,
+		Aliases:     []string{"groups"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        group,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(), members.NewCmdMembers(),
+		adduser.NewCmdAddUser(), removeuser.NewCmdRemoveUser(),
+	)
+
+	return &cmd
+}
+
+func group(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
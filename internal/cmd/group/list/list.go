@@ -0,0 +1,96 @@
+package list
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	defaultLimit = 100
+
+	examples = `$ jira group list
+
+# List groups with jira in their name
+$ jira group list jira
+
+# List group names in a script
+$ jira group list -o json | jq -r '.[].name'`
+)
+
+// NewCmdList is a group list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list [QUERY]",
+		Short:   "List lists groups",
+		Long:    "List lists groups, or the groups whose name contains the query.",
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Annotations: map[string]string{
+			"help:args": "[QUERY]\tPart of the name of the groups",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  list,
+	}
+
+	cmd.Flags().Uint("limit", defaultLimit, "Maximum number of groups to return")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+
+	return &cmd
+}
+
+func list(cmd *cobra.Command, args []string) {
+	var query string
+	if len(args) > 0 {
+		query = args[0]
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	limit, err := cmd.Flags().GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	groups, err := func() ([]*jira.Group, error) {
+		s := cmdutil.Info("Fetching groups...")
+		defer s.Stop()
+
+		return api.Client(jira.Config{Debug: debug}).Groups(query, int(limit))
+	}()
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.NewGroup(groups).RenderJSON(os.Stdout))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	if len(groups) == 0 {
+		cmdutil.Failed("No groups found.")
+		return
+	}
+
+	v := view.NewGroup(groups, view.WithGroupDisplayFormat(view.DisplayFormat{
+		Plain:     plain,
+		NoHeaders: noHeaders,
+	}))
+
+	cmdutil.ExitIfError(v.Render())
+}
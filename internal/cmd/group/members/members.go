@@ -0,0 +1,110 @@
+package members
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	pageSize = 50
+
+	examples = `$ jira group members jira-admins
+
+# Include deactivated users
+$ jira group members jira-admins --inactive
+
+# Export members for an audit
+$ jira group members jira-admins -o json > jira-admins.json`
+)
+
+// NewCmdMembers is a group members command.
+func NewCmdMembers() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "members GROUP",
+		Short:   "Members lists users in a group",
+		Long:    "Members lists active users in a group, all users with --inactive.",
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "GROUP\tName of the group",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  members,
+	}
+
+	cmd.Flags().Bool("inactive", false, "Include inactive users")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+
+	return &cmd
+}
+
+func members(cmd *cobra.Command, args []string) {
+	group := args[0]
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	inactive, err := cmd.Flags().GetBool("inactive")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+	if output != "" && output != view.OutputJSON {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	users, err := func() ([]*jira.User, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching members of group %q...", group))
+		defer s.Stop()
+
+		return fetchAll(api.Client(jira.Config{Debug: debug}), group, inactive)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.NewUser(users).RenderJSON(os.Stdout))
+		return
+	}
+
+	if len(users) == 0 {
+		cmdutil.Failed("No members found in group %q.", group)
+		return
+	}
+
+	v := view.NewUser(users, view.WithUserDisplayFormat(view.DisplayFormat{
+		Plain:     plain,
+		NoHeaders: noHeaders,
+	}))
+
+	cmdutil.ExitIfError(v.Render())
+}
+
+// fetchAll fetches the members page by page, as audits need the complete list.
+func fetchAll(client *jira.Client, group string, inactive bool) ([]*jira.User, error) {
+	var users []*jira.User
+
+	for {
+		res, err := client.GroupMembers(group, inactive, len(users), pageSize)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, res.Users...)
+
+		if res.IsLast || len(res.Users) == 0 {
+			return users, nil
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package removeuser
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Remove-user removes a user from a group. The user is looked up by the display name, email,
+account ID or username in Jira server.`
+	examples = `$ jira group remove-user jira-admins "Jon Doe"
+
+$ jira group remove-user jira-admins jon@example.com`
+)
+
+// NewCmdRemoveUser is a group remove-user command.
+func NewCmdRemoveUser() *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove-user GROUP USER",
+		Short:   "Remove a user from a group",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "GROUP\tName of the group\n" +
+				"USER\tDisplay name, email, account ID or username of the user",
+		},
+		Args: cobra.ExactArgs(2), //nolint:gomnd
+		Run:  removeUser,
+	}
+}
+
+func removeUser(cmd *cobra.Command, args []string) {
+	group, name := args[0], args[1]
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	user, err := func() (*jira.User, error) {
+		s := cmdutil.Info(fmt.Sprintf("Removing %q from group %q...", name, group))
+		defer s.Stop()
+
+		user, err := cmdcommon.FindUser(client, name)
+		if err != nil {
+			return nil, err
+		}
+		return user, api.ProxyRemoveGroupUser(client, group, user)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("User %q removed from group %q", cmdcommon.UserLabel(user), group)
+}
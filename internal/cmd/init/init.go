@@ -9,21 +9,45 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
+const (
+	helpText = `Init initializes jira configuration required for the tool to work properly.
+
+Values passed with the flags are not prompted for. Pass the installation type, the server, the login
+and the project to generate the config without any prompts, eg: in containers and CI images. No default
+board is set in that case unless --board is passed as well. The token is read from JIRA_API_TOKEN env.`
+	examples = `$ jira init
+
+# Generate the config without prompts
+$ jira init --installation cloud --server https://company.atlassian.net --login jane@company.com \
+  --project PROJ --board "PROJ board" --force`
+)
+
 // NewCmdInit is an init command.
 func NewCmdInit() *cobra.Command {
 	cmd := cobra.Command{
 		Use:     "init",
 		Short:   "Init initializes jira config",
-		Long:    "Init initializes jira configuration required for the tool to work properly.",
+		Long:    helpText,
+		Example: examples,
 		Aliases: []string{"initialize", "configure", "config", "setup"},
 		Run:     initialize,
 	}
 
+	cmd.Flags().String("installation", "", "Installation type, accepts: Cloud, Local")
+	cmd.Flags().String("server", "", "Link to Jira server, eg: https://company.atlassian.net")
+	cmd.Flags().String("login", "", "Login email for Cloud installations, username for Local installations")
+	cmd.Flags().String("auth-type", "", "Authentication type, accepts: basic, bearer\n"+
+		"Detected for Local installations if not set")
+	cmd.Flags().String("board", "", "Default board name or id")
+	cmd.Flags().Bool("force", false, "Overwrite the existing config without asking")
+
 	cmd.Flags().Bool("insecure", false, `If set, the tool will skip TLS certificate verification.
-This can be useful if your server is using self-signed certificates.`)
+This can be useful if your server is using self-signed certificates.
+Prefer --ca-cert to trust the certificate of the server instead.`)
 
 	return &cmd
 }
@@ -32,7 +56,10 @@ func initialize(cmd *cobra.Command, _ []string) {
 	insecure, err := cmd.Flags().GetBool("insecure")
 	cmdutil.ExitIfError(err)
 
-	c := jiraConfig.NewJiraCLIConfig(jiraConfig.WithInsecureTLS(insecure))
+	preset, err := parsePreset(cmd.Flags())
+	cmdutil.ExitIfError(err)
+
+	c := jiraConfig.NewJiraCLIConfig(jiraConfig.WithInsecureTLS(insecure), jiraConfig.WithPreset(*preset))
 
 	if insecure {
 		cmdutil.Warn(`You are using --insecure option. In this mode, the client will NOT verify
@@ -62,3 +89,41 @@ server's certificate chain and host name in requests to the jira server.`)
 
 	cmdutil.Success("Configuration generated: %s", file)
 }
+
+func parsePreset(flags query.FlagParser) (*jiraConfig.Preset, error) {
+	var (
+		p   jiraConfig.Preset
+		err error
+	)
+
+	// Project is read from the global project flag.
+	strs := map[string]*string{
+		"installation": &p.Installation,
+		"server":       &p.Server,
+		"login":        &p.Login,
+		"project":      &p.Project,
+		"board":        &p.Board,
+	}
+	for name, v := range strs {
+		if *v, err = flags.GetString(name); err != nil {
+			return nil, err
+		}
+	}
+
+	authType, err := flags.GetString("auth-type")
+	if err != nil {
+		return nil, err
+	}
+	switch at := jira.AuthType(authType); at {
+	case "", jira.AuthTypeBasic, jira.AuthTypeBearer:
+		p.AuthType = at
+	default:
+		return nil, fmt.Errorf("invalid auth type %q, use basic or bearer", authType)
+	}
+
+	if p.Force, err = flags.GetBool("force"); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
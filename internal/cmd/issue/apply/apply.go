@@ -0,0 +1,197 @@
+package apply
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+)
+
+const (
+	helpText = `Apply updates an issue from a yaml file, eg: printed by 'jira issue view -o yaml'.
+
+The file is compared with the current state of the issue and only the changed fields are
+updated. Labels are added and removed individually, whereas components and fix versions
+are replaced as a whole. Type and status are informative only and are never updated.
+Clearing the summary, priority, description, components or fix versions is not supported.`
+	examples = `$ jira issue view ISSUE-1 -o yaml > issue.yaml
+$ vim issue.yaml
+$ jira issue apply -f issue.yaml
+
+# See what would be updated without updating the issue
+$ jira issue apply -f issue.yaml --dry-run
+
+# Read the file from stdin
+$ jira issue view ISSUE-1 -o yaml | sed 's/^priority: .*/priority: High/' | jira issue apply -f -`
+)
+
+// NewCmdApply is an apply command.
+func NewCmdApply() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "apply",
+		Short:   "Apply updates an issue from a yaml file",
+		Long:    helpText,
+		Example: examples,
+		Run:     apply,
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Yaml file with the issue, - to read it from stdin")
+	cmd.Flags().Bool("dry-run", false, "Display changes without updating the issue")
+
+	cmdutil.ExitIfError(cmd.MarkFlagRequired("file"))
+
+	return &cmd
+}
+
+func apply(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	file, err := cmd.Flags().GetString("file")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	b, err := cmdutil.ReadFile(file)
+	cmdutil.ExitIfError(err)
+
+	var local cmdcommon.IssueFile
+	if err := yaml.Unmarshal(b, &local); err != nil {
+		cmdutil.Failed("Unable to parse %s: %s", file, err)
+	}
+	if local.Key == "" {
+		cmdutil.Failed("Issue key is missing in %s", file)
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), local.Key)
+
+	iss, err := func() (*jira.Issue, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching issue %s...", key))
+		defer s.Stop()
+
+		return api.ProxyGetIssue(client, key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	changes := local.Diff(cmdcommon.NewIssueFile(iss))
+	if changes.IsEmpty() {
+		cmdutil.Success("Issue %s is up to date", key)
+		return
+	}
+	// The edit request can't clear fields, so clearing them is rejected as for components.
+	for _, f := range []struct {
+		name  string
+		value *string
+	}{
+		{"the summary", changes.Summary},
+		{"the priority", changes.Priority},
+		{"the description", changes.Description},
+	} {
+		if f.value != nil && *f.value == "" {
+			cmdutil.Failed("Removing %s is not supported", f.name)
+		}
+	}
+	if changes.Components != nil && len(changes.Components) == 0 {
+		cmdutil.Failed("Removing all components is not supported")
+	}
+	if changes.FixVersions != nil && len(changes.FixVersions) == 0 {
+		cmdutil.Failed("Removing all fix versions is not supported")
+	}
+
+	if dryRun {
+		printChanges(os.Stdout, changes)
+		return
+	}
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Updating issue %s...", key))
+		defer s.Stop()
+
+		return update(client, iss, changes)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Issue updated\n%s/browse/%s", viper.GetString("server"), key)
+}
+
+func update(client *jira.Client, iss *jira.Issue, changes *cmdcommon.IssueFileChanges) error {
+	var summary, body, priority string
+	if changes.Summary != nil {
+		summary = *changes.Summary
+	}
+	if changes.Description != nil {
+		body = *changes.Description
+		if _, ok := iss.Fields.Description.(*adf.ADF); ok {
+			body = md.ToJiraMD(body)
+		}
+	}
+	if changes.Priority != nil {
+		priority = *changes.Priority
+	}
+
+	project := cmdcommon.ProjectKey(iss.Key)
+	fixVersions, err := cmdcommon.ResolveVersions(client, project, changes.FixVersions, false)
+	if err != nil {
+		return err
+	}
+
+	if summary != "" || body != "" || priority != "" ||
+		len(changes.Components) > 0 || len(fixVersions) > 0 {
+		err := client.Edit(iss.Key, &jira.EditRequest{
+			Summary:     summary,
+			Body:        body,
+			Priority:    priority,
+			Components:  changes.Components,
+			FixVersions: fixVersions,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(changes.LabelsAdded) > 0 {
+		if err := client.UpdateLabels(iss.Key, jira.UpdateOpAdd, changes.LabelsAdded); err != nil {
+			return err
+		}
+	}
+	if len(changes.LabelsRemoved) > 0 {
+		return client.UpdateLabels(iss.Key, jira.UpdateOpRemove, changes.LabelsRemoved)
+	}
+	return nil
+}
+
+func printChanges(w io.Writer, ch *cmdcommon.IssueFileChanges) {
+	if ch.Summary != nil {
+		fmt.Fprintf(w, "summary: %s\n", *ch.Summary)
+	}
+	if ch.Priority != nil {
+		fmt.Fprintf(w, "priority: %s\n", *ch.Priority)
+	}
+	if ch.Description != nil {
+		fmt.Fprintln(w, "description: (changed)")
+	}
+	if len(ch.LabelsAdded) > 0 {
+		fmt.Fprintf(w, "labels added: %s\n", strings.Join(ch.LabelsAdded, ", "))
+	}
+	if len(ch.LabelsRemoved) > 0 {
+		fmt.Fprintf(w, "labels removed: %s\n", strings.Join(ch.LabelsRemoved, ", "))
+	}
+	if ch.Components != nil {
+		fmt.Fprintf(w, "components: %s\n", strings.Join(ch.Components, ", "))
+	}
+	if ch.FixVersions != nil {
+		fmt.Fprintf(w, "fix versions: %s\n", strings.Join(ch.FixVersions, ", "))
+	}
+}
@@ -0,0 +1,110 @@
+package archive
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Archive archives issues so that they don't clutter searches and boards.
+
+Issues are archived using the archive API in Jira Data Center. Jira Cloud doesn't support
+archiving in all plans, so the issues are labelled with --label and closed instead, and
+unarchive only removes the label there.
+
+ISSUE can be a single issue key, comma separated issue keys or "-" to read issue keys
+from the standard input, eg: from the output of the issue list command.`
+	examples = `$ jira issue archive ISSUE-1,ISSUE-2
+$ jira issue unarchive ISSUE-1
+
+# Archive issues that haven't been updated for a year
+$ jira issue list -q"updated < -52w" --plain --no-headers --columns key | jira issue archive -
+
+# Use a custom label on Jira Cloud
+$ jira issue archive ISSUE-1 --label stale`
+)
+
+// NewCmdArchive is an archive command.
+func NewCmdArchive() *cobra.Command {
+	return newCmd("archive", "Archive issues", true)
+}
+
+// NewCmdUnarchive is an unarchive command.
+func NewCmdUnarchive() *cobra.Command {
+	return newCmd("unarchive", "Restore archived issues", false)
+}
+
+func newCmd(use, short string, archive bool) *cobra.Command {
+	cmd := cobra.Command{
+		Use:     use + " ISSUE",
+		Short:   short,
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "ISSUE\tIssue key, comma separated issue keys or - to read them from stdin",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			run(cmd, args, archive)
+		},
+	}
+
+	cmd.Flags().String("label", api.ArchiveLabel, "Label that marks archived issues in Jira Cloud")
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to update at once")
+
+	return &cmd
+}
+
+func run(cmd *cobra.Command, args []string, archive bool) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	label, err := cmd.Flags().GetString("label")
+	cmdutil.ExitIfError(err)
+	if strings.TrimSpace(label) == "" {
+		cmdutil.Failed("--label can't be empty")
+	}
+
+	keys, err := cmdutil.GetJiraIssueKeys(viper.GetString("project.key"), args[0])
+	cmdutil.ExitIfError(err)
+	if len(keys) == 0 {
+		cmdutil.Failed("No issues to update")
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	concurrency := cmdcommon.GetConcurrency(cmd.Flags())
+
+	passed, err := func() (int, error) {
+		msg := "Archiving"
+		if !archive {
+			msg = "Restoring"
+		}
+		s := cmdutil.Info(fmt.Sprintf("%s %d issue(s)...", msg, len(keys)))
+		defer s.Stop()
+
+		return cmdcommon.UpdateIssues(keys, concurrency, func(key string) error {
+			if archive {
+				return api.ProxyArchiveIssue(client, key, label)
+			}
+			return api.ProxyUnarchiveIssue(client, key, label)
+		})
+	}()
+
+	if passed > 0 {
+		if archive {
+			cmdutil.Success("Archived %d of %d issue(s)", passed, len(keys))
+		} else {
+			cmdutil.Success("Restored %d of %d issue(s)", passed, len(keys))
+		}
+	}
+	cmdutil.ExitIfError(err)
+}
@@ -12,20 +12,25 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText = `Assign issue to a user.`
+	helpText = `Assign issue to a user.
+
+ASSIGNEE can be a display name, an email or an account id (username in local installation).
+You will be asked to pick the user if more than one user matches. Use "me" to assign the
+issue to yourself, "default" to assign it to the default assignee and "x" to unassign it.`
 	examples = `$ jira issue assign ISSUE-1 jon@domain.tld
 
 # Assignee name or email needs to be an exact match
 $ jira issue assign ISSUE-1 "Jon Doe"
 
 # Assign to self
-$ jira issue assign ISSUE-1 $(jira me)
+$ jira issue assign ISSUE-1 me
 
 # Assign to default assignee
 $ jira issue assign ISSUE-1 default
@@ -33,13 +38,15 @@ $ jira issue assign ISSUE-1 default
 # Unassign
 $ jira issue assign ISSUE-1 x`
 
-	maxResults = 100
-	lineBreak  = "----------"
+	lineBreak = "----------"
 
 	optionSearch  = "[Search...]"
 	optionDefault = "Default"
 	optionNone    = "No-one (Unassign)"
 	optionCancel  = "Cancel"
+
+	assigneeMe   = "me"
+	assigneeNone = "x"
 )
 
 // NewCmdAssign is an assign command.
@@ -52,9 +59,10 @@ func NewCmdAssign() *cobra.Command {
 		Aliases: []string{"asg"},
 		Annotations: map[string]string{
 			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1
-ASSIGNEE	Email or display name of the user to assign the issue to`,
+ASSIGNEE	Display name, email or account id of the user to assign the issue to`,
 		},
-		Run: assign,
+		ValidArgsFunction: cmdcommon.CompleteIssueKeys,
+		Run:               assign,
 	}
 }
 
@@ -67,15 +75,16 @@ func assign(cmd *cobra.Command, args []string) {
 		users:  nil,
 		params: params,
 	}
-	lu := strings.ToLower(ac.params.user)
 
 	cmdutil.ExitIfError(ac.setIssueKey(project))
 
-	if lu != strings.ToLower(optionNone) && lu != "x" && lu != jira.AssigneeDefault {
+	switch {
+	case isNone(ac.params.user), isDefault(ac.params.user):
+	case strings.EqualFold(ac.params.user, assigneeMe):
+		cmdutil.ExitIfError(ac.setMe())
+	default:
 		cmdutil.ExitIfError(ac.setAvailableUsers(project))
 		cmdutil.ExitIfError(ac.setAssignee(project))
-
-		lu = strings.ToLower(ac.params.user)
 	}
 
 	u, err := ac.verifyAssignee()
@@ -89,10 +98,10 @@ func assign(cmd *cobra.Command, args []string) {
 	switch {
 	case u != nil:
 		uname = u.Name
-	case lu == strings.ToLower(optionNone) || lu == "x":
+	case isNone(ac.params.user):
 		assignee = jira.AssigneeNone
 		uname = "unassigned"
-	case lu == strings.ToLower(optionDefault):
+	case isDefault(ac.params.user):
 		assignee = jira.AssigneeDefault
 		uname = assignee
 	}
@@ -118,6 +127,14 @@ func assign(cmd *cobra.Command, args []string) {
 	fmt.Printf("%s/browse/%s\n", viper.GetString("server"), ac.params.key)
 }
 
+func isNone(user string) bool {
+	return strings.EqualFold(user, optionNone) || strings.EqualFold(user, assigneeNone)
+}
+
+func isDefault(user string) bool {
+	return strings.EqualFold(user, optionDefault)
+}
+
 type assignParams struct {
 	key   string
 	user  string
@@ -148,6 +165,7 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *a
 type assignCmd struct {
 	client *jira.Client
 	users  []*jira.User
+	user   *jira.User
 	params *assignParams
 }
 
@@ -156,30 +174,38 @@ func (ac *assignCmd) setIssueKey(project string) error {
 		return nil
 	}
 
-	var ans string
-
-	qs := &survey.Question{
-		Name:     "key",
-		Prompt:   &survey.Input{Message: "Issue key"},
-		Validate: survey.Required,
-	}
-	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+	key, err := cmdcommon.PickIssue(ac.client, project)
+	if err != nil {
 		return err
 	}
-	ac.params.key = cmdutil.GetJiraIssueKey(project, ans)
+	ac.params.key = key
 
 	return nil
 }
 
-func (ac *assignCmd) setAssignee(project string) error {
-	if ac.params.user != "" && len(ac.users) == 1 {
-		ac.params.user = ac.users[0].Name
-		return nil
+func (ac *assignCmd) setMe() error {
+	s := cmdutil.Info("Fetching user details. Please wait...")
+	defer s.Stop()
+
+	me, err := cmdcommon.Me(ac.client)
+	if err != nil {
+		return err
 	}
+	ac.user = me
 
-	lu := strings.ToLower(ac.params.user)
-	if lu == strings.ToLower(optionNone) || lu == strings.ToLower(optionDefault) || lu == "x" {
-		return nil
+	return nil
+}
+
+func (ac *assignCmd) setAssignee(project string) error {
+	if ac.params.user != "" {
+		matches := cmdcommon.MatchUsers(ac.users, ac.params.user)
+		if len(matches) == 1 {
+			ac.user = matches[0]
+			return nil
+		}
+		if len(matches) > 1 {
+			return ac.disambiguate(matches)
+		}
 	}
 
 	var (
@@ -195,7 +221,7 @@ func (ac *assignCmd) setAssignee(project string) error {
 			Name: "user",
 			Prompt: &survey.Select{
 				Message: "Assign to user:",
-				Help:    "Can't find the user? Select search and look for a keyword or cancel to abort",
+				Help:    "Can't find the user? Select search and press tab to see the users matching a keyword",
 				Options: ac.getOptions(last),
 			},
 			Validate: func(val interface{}) error {
@@ -223,15 +249,54 @@ func (ac *assignCmd) setAssignee(project string) error {
 		if ans != optionSearch {
 			break
 		}
-		if err := ac.getSearchKeyword(); err != nil {
+
+		u, err := cmdcommon.AskUser(ac.client, project, "Search user:")
+		if err != nil {
 			return err
 		}
-		if err := ac.searchAndAssignUser(project); err != nil {
-			return err
+		if u != nil {
+			ac.user = u
+			ac.params.user = cmdcommon.UserLabel(u)
+			return nil
 		}
 		last = true
 	}
+
 	ac.params.user = ans
+	for _, u := range ac.users {
+		if cmdcommon.UserLabel(u) == ans {
+			ac.user = u
+		}
+	}
+
+	return nil
+}
+
+// disambiguate asks to pick one of the users matching the assignee.
+func (ac *assignCmd) disambiguate(users []*jira.User) error {
+	options := make([]string, 0, len(users)+1)
+	for _, u := range users {
+		options = append(options, cmdcommon.UserLabel(u))
+	}
+	options = append(options, optionCancel)
+
+	var ans int
+
+	qs := &survey.Question{
+		Name: "user",
+		Prompt: &survey.Select{
+			Message: fmt.Sprintf("Multiple users match %q, assign to:", ac.params.user),
+			Options: options,
+		},
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return err
+	}
+	if ans == len(users) {
+		cmdutil.Fail("Action aborted")
+		os.Exit(0)
+	}
+	ac.user = users[ans]
 
 	return nil
 }
@@ -241,7 +306,7 @@ func (ac *assignCmd) getOptions(last bool) []string {
 
 	for _, t := range ac.users {
 		if t.Active {
-			validUsers = append(validUsers, t.Name)
+			validUsers = append(validUsers, cmdcommon.UserLabel(t))
 		}
 	}
 	always := []string{optionDefault, optionNone, optionCancel}
@@ -260,44 +325,13 @@ func (ac *assignCmd) getOptions(last bool) []string {
 	return options
 }
 
-func (ac *assignCmd) getSearchKeyword() error {
-	qs := &survey.Question{
-		Name: "user",
-		Prompt: &survey.Input{
-			Message: "Search user:",
-			Help:    "Type user email or display name to search for a user",
-		},
-		Validate: func(val interface{}) error {
-			errInvalidKeyword := fmt.Errorf("enter atleast 3 characters to search")
-
-			str, ok := val.(string)
-			if !ok {
-				return errInvalidKeyword
-			}
-			if len(str) < 3 {
-				return errInvalidKeyword
-			}
-
-			return nil
-		},
-	}
-	return survey.Ask([]*survey.Question{qs}, &ac.params.user)
-}
-
 func (ac *assignCmd) searchAndAssignUser(project string) error {
-	q := ac.params.user
-	if q == "" {
-		q = "*"
-	}
-	u, err := api.ProxyUserSearch(ac.client, &jira.UserSearchOptions{
-		Query:      q,
-		Project:    project,
-		MaxResults: maxResults,
-	})
+	u, err := cmdcommon.SearchUsers(ac.client, project, ac.params.user)
 	if err != nil {
 		return err
 	}
 	ac.users = u
+
 	return nil
 }
 
@@ -309,25 +343,15 @@ func (ac *assignCmd) setAvailableUsers(project string) error {
 }
 
 func (ac *assignCmd) verifyAssignee() (*jira.User, error) {
-	u, d, n := strings.ToLower(ac.params.user), strings.ToLower(optionDefault), strings.ToLower(optionNone)
-	if u == d || u == n || u == "x" {
+	if isNone(ac.params.user) || isDefault(ac.params.user) {
 		return nil, nil
 	}
 
-	var user *jira.User
-
-	st := strings.ToLower(ac.params.user)
-	for _, u := range ac.users {
-		if strings.ToLower(u.Name) == st || strings.ToLower(u.Email) == st {
-			user = u
-		}
-	}
-
-	if user == nil {
+	if ac.user == nil {
 		return nil, fmt.Errorf("invalid assignee \"%s\"", ac.params.user)
 	}
-	if !user.Active {
-		return nil, fmt.Errorf("user \"%s\" is not active", user.Name)
+	if !ac.user.Active {
+		return nil, fmt.Errorf("user \"%s\" is not active", ac.user.Name)
 	}
-	return user, nil
+	return ac.user, nil
 }
@@ -0,0 +1,28 @@
+package attachment
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/attachment/download"
+)
+
+const helpText = `Attachment command helps you manage issue attachments. See available commands below.`
+
+// NewCmdAttachment is an attachment command.
+func NewCmdAttachment() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "attachment",
+		Short:   "Manage issue attachments",
+		Long:    helpText,
+		Aliases: []string{"attachments", "attach"},
+		RunE:    attachment,
+	}
+
+	cmd.AddCommand(download.NewCmdAttachmentDownload())
+
+	return &cmd
+}
+
+func attachment(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,218 @@
+package download
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Download downloads attachments of an issue.
+
+You will be asked to pick the attachments to download unless --all or --id is passed.
+Existing files are never overwritten, a number is added to the file name instead.`
+	examples = `# Pick attachments to download
+$ jira issue attachment download ISSUE-1
+
+# Download all attachments to the given directory
+$ jira issue attachment download ISSUE-1 --all --dir ./downloads
+
+# Download attachments by their id
+$ jira issue attachment download ISSUE-1 --id 10001 --id 10002`
+)
+
+// NewCmdAttachmentDownload is an attachment download command.
+func NewCmdAttachmentDownload() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "download ISSUE-KEY",
+		Short:   "Download attachments of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"dl", "get"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  download,
+	}
+
+	cmd.Flags().Bool("all", false, "Download all attachments of the issue")
+	cmd.Flags().StringArray("id", []string{}, "ID of the attachment to download. Can be used multiple times")
+	cmd.Flags().String("dir", ".", "Directory to save the attachments to")
+
+	return &cmd
+}
+
+func download(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args, viper.GetString("project.key"))
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	if params.all && len(params.ids) > 0 {
+		cmdutil.Failed("Use either --all or --id, not both")
+	}
+
+	if fi, err := os.Stat(params.dir); err != nil || !fi.IsDir() {
+		cmdutil.Failed("Directory %q doesn't exist", params.dir)
+	}
+
+	issue, err := func() (*jira.Issue, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching attachments of issue %s...", params.key))
+		defer s.Stop()
+
+		return api.ProxyGetIssue(client, params.key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(issue.Fields.Attachments) == 0 {
+		cmdutil.Failed("No attachments found in issue \"%s\"", params.key)
+	}
+
+	attachments, err := selectAttachments(issue.Fields.Attachments, params)
+	cmdutil.ExitIfError(err)
+
+	var (
+		failed strings.Builder
+		saved  []string
+	)
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Downloading %d attachment(s)...", len(attachments)))
+		defer s.Stop()
+
+		for _, a := range attachments {
+			path, err := save(client, a, params.dir)
+			if err != nil {
+				failed.WriteString(fmt.Sprintf("\n  - %s: %s", a.Filename, cmdutil.NormalizeJiraError(err.Error())))
+				continue
+			}
+			saved = append(saved, path)
+		}
+
+		if failed.Len() > 0 {
+			return &jira.ErrMultipleFailed{Msg: failed.String()}
+		}
+		return nil
+	}()
+
+	if len(saved) > 0 {
+		cmdutil.Success("Downloaded %d of %d attachment(s)", len(saved), len(attachments))
+		for _, path := range saved {
+			fmt.Println(path)
+		}
+	}
+	cmdutil.ExitIfError(err)
+}
+
+// save streams content of the attachment to a new file in the dir and returns path of the file.
+func save(client *jira.Client, a *jira.Attachment, dir string) (string, error) {
+	content, err := client.DownloadAttachment(a)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = content.Close() }()
+
+	f, err := cmdutil.CreateUniqueFile(dir, a.Filename)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = io.Copy(f, content)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
+func selectAttachments(all []*jira.Attachment, params *downloadParams) ([]*jira.Attachment, error) {
+	if params.all {
+		return all, nil
+	}
+
+	if len(params.ids) > 0 {
+		out := make([]*jira.Attachment, 0, len(params.ids))
+		for _, id := range params.ids {
+			var found *jira.Attachment
+			for _, a := range all {
+				if a.ID == id {
+					found = a
+					break
+				}
+			}
+			if found == nil {
+				return nil, fmt.Errorf("attachment %q not found in issue %q", id, params.key)
+			}
+			out = append(out, found)
+		}
+		return out, nil
+	}
+
+	options := make([]string, 0, len(all))
+	for _, a := range all {
+		options = append(options, fmt.Sprintf("%s (%s, id: %s)", a.Filename, cmdutil.FormatFileSize(a.Size), a.ID))
+	}
+
+	var ans []int
+
+	qs := &survey.Question{
+		Name: "attachments",
+		Prompt: &survey.MultiSelect{
+			Message: "Attachments to download:",
+			Options: options,
+		},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return nil, err
+	}
+
+	out := make([]*jira.Attachment, 0, len(ans))
+	for _, i := range ans {
+		out = append(out, all[i])
+	}
+	return out, nil
+}
+
+type downloadParams struct {
+	key   string
+	all   bool
+	ids   []string
+	dir   string
+	debug bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *downloadParams {
+	all, err := flags.GetBool("all")
+	cmdutil.ExitIfError(err)
+
+	ids, err := flags.GetStringArray("id")
+	cmdutil.ExitIfError(err)
+
+	dir, err := flags.GetString("dir")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &downloadParams{
+		key:   cmdutil.GetJiraIssueKey(project, args[0]),
+		all:   all,
+		ids:   ids,
+		dir:   dir,
+		debug: debug,
+	}
+}
@@ -0,0 +1,154 @@
+package branch
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/move"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/gitbranch"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	defaultState = "In Progress"
+
+	helpText = `Branch creates and checks out a git branch named after the issue in the current repository.
+The branch is checked out as is if it already exists.
+
+The name is built from a Go template set with --template or branch.template in the config,
+{{.Type}}/{{.Key}}-{{.SummarySlug}} by default. Available variables are Key, Project, Type,
+Summary and SummarySlug. Type is the issue type in lowercase, eg: bug, and SummarySlug is
+the summary in lowercase words separated by dashes.
+
+Pass --move to transition the issue to "In Progress", or to the given state, once the branch
+is checked out.`
+	examples = `$ jira issue branch ISSUE-1
+
+# Create the branch from main and start working on the issue
+$ jira issue branch ISSUE-1 --base main --move
+
+# Print the branch name without creating it
+$ jira issue branch ISSUE-1 --template "{{.Key}}-{{.SummarySlug}}" --print`
+)
+
+// NewCmdBranch is a branch command.
+func NewCmdBranch() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "branch ISSUE-KEY",
+		Short:   "Create a git branch named after the issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"br"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdcommon.CompleteIssueKeys,
+		Run:               branch,
+	}
+
+	cmd.Flags().String("template", "", "Go template of the branch name, "+gitbranch.DefaultTemplate+" by default")
+	cmd.Flags().String("base", "", "Branch or commit to create the branch from, the current HEAD by default")
+	cmd.Flags().String("move", "", "Transition the issue to the given state after checking out the branch")
+	cmd.Flags().Lookup("move").NoOptDefVal = defaultState
+	cmd.Flags().Bool("print", false, "Only print the branch name")
+
+	return &cmd
+}
+
+func branch(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	issue, err := func() (*jira.Issue, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching issue %s...", params.key))
+		defer s.Stop()
+
+		return api.ProxyGetIssue(client, params.key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	name, err := gitbranch.Name(
+		params.template, gitbranch.NewData(issue.Key, issue.Fields.IssueType.Name, issue.Fields.Summary),
+	)
+	cmdutil.ExitIfError(err)
+
+	if params.print {
+		fmt.Println(name)
+		return
+	}
+
+	wd, err := os.Getwd()
+	cmdutil.ExitIfError(err)
+
+	created, err := gitbranch.Checkout(wd, name, params.base)
+	cmdutil.ExitIfError(err)
+
+	if created {
+		cmdutil.Success("Created and checked out branch %s", name)
+	} else {
+		cmdutil.Success("Checked out existing branch %s", name)
+	}
+
+	if params.state == "" {
+		return
+	}
+
+	tr, err := func() (string, error) {
+		s := cmdutil.Info(fmt.Sprintf("Transitioning issue to \"%s\"...", params.state))
+		defer s.Stop()
+
+		return move.TransitionTo(client, issue.Key, params.state)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Issue %s transitioned with \"%s\"", issue.Key, tr)
+}
+
+type branchParams struct {
+	key      string
+	template string
+	base     string
+	state    string
+	print    bool
+	debug    bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *branchParams {
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	template, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+	if template == "" {
+		template = viper.GetString("branch.template")
+	}
+	if template == "" {
+		template = gitbranch.DefaultTemplate
+	}
+
+	base, err := flags.GetString("base")
+	cmdutil.ExitIfError(err)
+
+	state, err := flags.GetString("move")
+	cmdutil.ExitIfError(err)
+
+	printOnly, err := flags.GetBool("print")
+	cmdutil.ExitIfError(err)
+
+	return &branchParams{
+		key:      cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0]),
+		template: template,
+		base:     base,
+		state:    state,
+		print:    printOnly,
+		debug:    debug,
+	}
+}
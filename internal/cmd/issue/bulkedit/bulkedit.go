@@ -0,0 +1,281 @@
+package bulkedit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	defaultLimit = 500
+	helpText     = `Bulk edit applies the same changes to all issues matching a JQL query.
+
+Matching issues are fetched page by page up to --limit and listed for a preview before
+anything is written. Use --dry-run to only preview the issues and changes, and --yes to
+apply the changes without a confirmation prompt.
+
+ASSIGNEE can be a display name, an email or an account id (username in local installation).
+Use "me" to assign the issues to yourself and "x" to unassign them.`
+	examples = `$ jira issue bulk-edit --jql "project = FOO AND labels = old" --add-label new --remove-label old
+
+# Assign matching issues to yourself and raise their priority
+$ jira issue bulk-edit --jql "project = FOO AND status = Blocked" --assignee me --priority High
+
+# Preview the issues that would be updated
+$ jira issue bulk-edit --jql "project = FOO AND component = UI" --remove-component UI --dry-run`
+
+	assigneeNone = "x"
+)
+
+// NewCmdBulkEdit is a bulk edit command.
+func NewCmdBulkEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "bulk-edit",
+		Short:   "Apply the same changes to issues matching a JQL query",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"bulkedit"},
+		Args:    cobra.NoArgs,
+		Run:     bulkEdit,
+	}
+
+	cmd.Flags().StringP("jql", "q", "", "JQL query to find the issues to edit")
+	cmd.Flags().StringArray("add-label", []string{}, "Add label to the issues")
+	cmd.Flags().StringArray("remove-label", []string{}, "Remove label from the issues")
+	cmd.Flags().StringArray("add-component", []string{}, "Add component to the issues")
+	cmd.Flags().StringArray("remove-component", []string{}, "Remove component from the issues")
+	cmd.Flags().StringP("assignee", "a", "", "Assign the issues to the user, \"me\" or \"x\" to unassign")
+	cmd.Flags().StringP("priority", "y", "", "Set priority of the issues")
+	cmd.Flags().Uint("limit", defaultLimit, "Maximum number of issues to edit")
+	cmd.Flags().Bool("dry-run", false, "Preview the issues and changes without updating them")
+	cmd.Flags().Bool("yes", false, "Apply the changes without a confirmation prompt")
+
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to update at once")
+
+	return &cmd
+}
+
+func bulkEdit(cmd *cobra.Command, _ []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags())
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	update := params.update()
+	if len(update) == 0 && params.assignee == "" {
+		cmdutil.Failed("Nothing to update, pass at least one of --add-label, --remove-label, " +
+			"--add-component, --remove-component, --assignee or --priority")
+	}
+
+	var assignee *jira.User
+	if params.assignee != "" && params.assignee != assigneeNone {
+		var err error
+
+		assignee, err = cmdcommon.ResolveUser(client, project, params.assignee)
+		cmdutil.ExitIfError(err)
+	}
+
+	result, err := func() (*jira.SearchResult, error) {
+		s := cmdutil.Info("Fetching matching issues...")
+		defer s.Stop()
+
+		return api.ProxySearch(client, params.jql, params.limit)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(result.Issues) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No issues found for the query")
+		return
+	}
+
+	preview(result, params, assignee)
+
+	if params.dryRun {
+		return
+	}
+	if !params.yes {
+		confirmed := false
+		prompt := &survey.Confirm{Message: fmt.Sprintf("Update %d issue(s)?", len(result.Issues))}
+		cmdutil.ExitIfError(survey.AskOne(prompt, &confirmed))
+
+		if !confirmed {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
+	keys := make([]string, 0, len(result.Issues))
+	for _, iss := range result.Issues {
+		keys = append(keys, iss.Key)
+	}
+
+	passed, err := func() (int, error) {
+		s := cmdutil.Info(fmt.Sprintf("Updating %d issue(s)...", len(keys)))
+		defer s.Stop()
+
+		return cmdcommon.UpdateIssues(keys, params.concurrency, func(key string) error {
+			return apply(client, key, update, params.assignee, assignee)
+		})
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Updated %d of %d issue(s)", passed, len(result.Issues))
+	}
+	cmdutil.ExitIfError(err)
+}
+
+func apply(client *jira.Client, key string, update jira.IssueUpdate, assigneeVal string, assignee *jira.User) error {
+	if len(update) > 0 {
+		if err := client.UpdateIssue(key, update); err != nil {
+			return err
+		}
+	}
+	if assigneeVal != "" {
+		return api.ProxyAssignIssue(client, key, assignee, jira.AssigneeNone)
+	}
+	return nil
+}
+
+func preview(result *jira.SearchResult, params *bulkEditParams, assignee *jira.User) {
+	v := view.IssueList{
+		Data: result.Issues,
+		Display: view.DisplayFormat{
+			Plain:   true,
+			Columns: []string{"key", "summary", "status", "assignee", "priority"},
+		},
+	}
+	cmdutil.ExitIfError(v.Render())
+
+	if result.Total > len(result.Issues) {
+		cmdutil.Warn("\nOnly %d of %d matching issues will be updated, use --limit to update more", len(result.Issues), result.Total)
+	}
+
+	fmt.Println("\nChanges:")
+	for _, c := range params.changes(assignee) {
+		fmt.Printf("  - %s\n", c)
+	}
+	fmt.Println()
+}
+
+type bulkEditParams struct {
+	jql              string
+	addLabels        []string
+	removeLabels     []string
+	addComponents    []string
+	removeComponents []string
+	assignee         string
+	priority         string
+	limit            uint
+	concurrency      int
+	dryRun           bool
+	yes              bool
+	debug            bool
+}
+
+// update builds field update operations, the assignee is updated separately.
+func (p *bulkEditParams) update() jira.IssueUpdate {
+	u := jira.IssueUpdate{}.
+		Labels(jira.UpdateOpAdd, p.addLabels...).
+		Labels(jira.UpdateOpRemove, p.removeLabels...).
+		Components(jira.UpdateOpAdd, p.addComponents...).
+		Components(jira.UpdateOpRemove, p.removeComponents...)
+
+	if p.priority != "" {
+		u.Priority(p.priority)
+	}
+	return u
+}
+
+func (p *bulkEditParams) changes(assignee *jira.User) []string {
+	var out []string
+
+	if len(p.addLabels) > 0 {
+		out = append(out, "Add labels: "+strings.Join(p.addLabels, ", "))
+	}
+	if len(p.removeLabels) > 0 {
+		out = append(out, "Remove labels: "+strings.Join(p.removeLabels, ", "))
+	}
+	if len(p.addComponents) > 0 {
+		out = append(out, "Add components: "+strings.Join(p.addComponents, ", "))
+	}
+	if len(p.removeComponents) > 0 {
+		out = append(out, "Remove components: "+strings.Join(p.removeComponents, ", "))
+	}
+	if p.priority != "" {
+		out = append(out, "Set priority: "+p.priority)
+	}
+	switch {
+	case assignee != nil:
+		out = append(out, "Assign to: "+cmdcommon.UserLabel(assignee))
+	case p.assignee == assigneeNone:
+		out = append(out, "Unassign")
+	}
+	return out
+}
+
+func parseArgsAndFlags(flags query.FlagParser) *bulkEditParams {
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+	if strings.TrimSpace(jql) == "" {
+		cmdutil.Failed("--jql is required to find the issues to edit")
+	}
+
+	addLabels, err := flags.GetStringArray("add-label")
+	cmdutil.ExitIfError(err)
+
+	removeLabels, err := flags.GetStringArray("remove-label")
+	cmdutil.ExitIfError(err)
+
+	addComponents, err := flags.GetStringArray("add-component")
+	cmdutil.ExitIfError(err)
+
+	removeComponents, err := flags.GetStringArray("remove-component")
+	cmdutil.ExitIfError(err)
+
+	assignee, err := flags.GetString("assignee")
+	cmdutil.ExitIfError(err)
+
+	priority, err := flags.GetString("priority")
+	cmdutil.ExitIfError(err)
+
+	limit, err := flags.GetUint("limit")
+	cmdutil.ExitIfError(err)
+	if limit == 0 {
+		cmdutil.Failed("--limit must be greater than 0")
+	}
+
+	concurrency := cmdcommon.GetConcurrency(flags)
+
+	dryRun, err := flags.GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	yes, err := flags.GetBool("yes")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &bulkEditParams{
+		jql:              jql,
+		addLabels:        addLabels,
+		removeLabels:     removeLabels,
+		addComponents:    addComponents,
+		removeComponents: removeComponents,
+		assignee:         strings.TrimSpace(assignee),
+		priority:         priority,
+		limit:            limit,
+		concurrency:      concurrency,
+		dryRun:           dryRun,
+		yes:              yes,
+		debug:            debug,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/adf"
@@ -16,14 +17,24 @@ import (
 )
 
 const (
-	helpText = `Clone duplicates an issue and also allow you to override some of the metadata when doing so.`
+	helpText = `Clone duplicates an issue and also allow you to override some of the metadata when doing so.
+
+Summary, description, priority, labels, components and assignee are copied to the new issue.
+Use --with-links, --with-subtasks and --with-attachments to copy issue links, sub-tasks and
+attachments of the issue as well.`
 	examples = `$ jira issue clone ISSUE-1
 
 # Clone issue and modify the summary, priority and assignee
 $ jira issue clone ISSUE-1 -s"Modified summary" -yHigh -a$(jira me)
 
 # Clone issue and replace text from summary and description
-$ jira issue clone ISSUE-1 -H"find me:replace with me"`
+$ jira issue clone ISSUE-1 -H"find me:replace with me"
+
+# Clone issue to another project and prefix the summary
+$ jira issue clone ISSUE-1 --target-project PRJ --summary-prefix "CLONE - "
+
+# Clone issue along with its links, sub-tasks and attachments
+$ jira issue clone ISSUE-1 --with-links --with-subtasks --with-attachments`
 )
 
 // NewCmdClone is a clone command.
@@ -36,8 +47,9 @@ func NewCmdClone() *cobra.Command {
 		Annotations: map[string]string{
 			"help:args": "ISSUE-KEY\tKey of the issue to clone, eg: ISSUE-1",
 		},
-		Args: cobra.MinimumNArgs(1),
-		Run:  clone,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: cmdcommon.CompleteIssueKeys,
+		Run:               clone,
 	}
 
 	setFlags(&cmd)
@@ -71,12 +83,17 @@ func clone(cmd *cobra.Command, args []string) {
 
 	cp := cc.getActualCreateParams(issue)
 
+	target := project
+	if params.targetProject != "" {
+		target = params.targetProject
+	}
+
 	clonedIssueKey, err := func() (string, error) {
 		s := cmdutil.Info(fmt.Sprintf("Cloning %s...", key))
 		defer s.Stop()
 
 		cr := jira.CreateRequest{
-			Project:    project,
+			Project:    target,
 			IssueType:  issue.Fields.IssueType.Name,
 			Summary:    cp.summary,
 			Body:       cp.body,
@@ -115,7 +132,7 @@ func clone(cmd *cobra.Command, args []string) {
 
 			user, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
 				Query:   cp.assignee,
-				Project: project,
+				Project: target,
 			})
 			if err != nil || len(user) == 0 {
 				fmt.Println()
@@ -128,6 +145,19 @@ func clone(cmd *cobra.Command, args []string) {
 		}()
 	}
 
+	if params.withLinks || params.withSubtasks || params.withAttachments {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := cc.copyRelated(issue, clonedIssueKey, target); err != nil {
+				fmt.Println()
+				cmdutil.Fail("Unable to copy everything to the cloned issue:%s", err.Error())
+			}
+		}()
+	}
+
 	s := cmdutil.Info("Updating metadata...")
 	defer s.Stop()
 
@@ -156,7 +186,7 @@ type cloneCmd struct {
 func (cc *cloneCmd) getActualCreateParams(issue *jira.Issue) *createParams {
 	cp := createParams{}
 
-	cp.summary = issue.Fields.Summary
+	cp.summary = cc.params.summaryPrefix + issue.Fields.Summary
 	if cc.params.summary != "" {
 		cp.summary = cc.params.summary
 	}
@@ -221,14 +251,99 @@ func (cc *cloneCmd) getActualCreateParams(issue *jira.Issue) *createParams {
 	return &cp
 }
 
+// copyRelated copies links, sub-tasks and attachments of the issue to the cloned issue
+// as requested. It continues on failures and returns all of them at once.
+func (cc *cloneCmd) copyRelated(issue *jira.Issue, clonedKey, target string) error {
+	var failed strings.Builder
+
+	if cc.params.withLinks {
+		for _, link := range issue.Fields.IssueLinks {
+			var err error
+
+			switch {
+			case link.InwardIssue != nil:
+				err = cc.client.LinkIssue(link.InwardIssue.Key, clonedKey, link.LinkType.Name)
+			case link.OutwardIssue != nil:
+				err = cc.client.LinkIssue(clonedKey, link.OutwardIssue.Key, link.LinkType.Name)
+			default:
+				continue
+			}
+			if err != nil {
+				failed.WriteString(fmt.Sprintf("\n  - link %q: %s", link.LinkType.Name, cmdutil.NormalizeJiraError(err.Error())))
+			}
+		}
+	}
+
+	if cc.params.withSubtasks {
+		for _, st := range issue.Fields.Subtasks {
+			if err := cc.cloneSubtask(st.Key, clonedKey, target); err != nil {
+				failed.WriteString(fmt.Sprintf("\n  - sub-task %s: %s", st.Key, cmdutil.NormalizeJiraError(err.Error())))
+			}
+		}
+	}
+
+	if cc.params.withAttachments {
+		for _, a := range issue.Fields.Attachments {
+			if err := cc.copyAttachment(a, clonedKey); err != nil {
+				failed.WriteString(fmt.Sprintf("\n  - attachment %s: %s", a.Filename, cmdutil.NormalizeJiraError(err.Error())))
+			}
+		}
+	}
+
+	if failed.Len() > 0 {
+		return &jira.ErrMultipleFailed{Msg: failed.String()}
+	}
+	return nil
+}
+
+func (cc *cloneCmd) cloneSubtask(key, parentKey, target string) error {
+	st, err := api.ProxyGetIssue(cc.client, key)
+	if err != nil {
+		return err
+	}
+
+	cr := jira.CreateRequest{
+		Project:        target,
+		IssueType:      st.Fields.IssueType.Name,
+		ParentIssueKey: parentKey,
+		SubtaskField:   st.Fields.IssueType.Name,
+		Summary:        cc.params.summaryPrefix + st.Fields.Summary,
+		Body:           st.Fields.Description,
+		Priority:       st.Fields.Priority.Name,
+		Labels:         st.Fields.Labels,
+	}
+	if cr.Body == nil {
+		cr.Body = ""
+	}
+
+	_, err = api.ProxyCreate(cc.client, &cr)
+	return err
+}
+
+func (cc *cloneCmd) copyAttachment(a *jira.Attachment, key string) error {
+	content, err := cc.client.DownloadAttachment(a)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = content.Close() }()
+
+	_, err = cc.client.AddAttachment(key, a.Filename, content)
+	return err
+}
+
 type cloneParams struct {
-	summary    string
-	priority   string
-	assignee   string
-	labels     []string
-	components []string
-	replace    string
-	debug      bool
+	summary         string
+	summaryPrefix   string
+	priority        string
+	assignee        string
+	labels          []string
+	components      []string
+	replace         string
+	targetProject   string
+	withLinks       bool
+	withSubtasks    bool
+	withAttachments bool
+	debug           bool
 }
 
 func parseFlags(flags query.FlagParser) *cloneParams {
@@ -250,17 +365,37 @@ func parseFlags(flags query.FlagParser) *cloneParams {
 	replace, err := flags.GetString("replace")
 	cmdutil.ExitIfError(err)
 
+	summaryPrefix, err := flags.GetString("summary-prefix")
+	cmdutil.ExitIfError(err)
+
+	targetProject, err := flags.GetString("target-project")
+	cmdutil.ExitIfError(err)
+
+	withLinks, err := flags.GetBool("with-links")
+	cmdutil.ExitIfError(err)
+
+	withSubtasks, err := flags.GetBool("with-subtasks")
+	cmdutil.ExitIfError(err)
+
+	withAttachments, err := flags.GetBool("with-attachments")
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	return &cloneParams{
-		summary:    summary,
-		priority:   priority,
-		assignee:   assignee,
-		labels:     labels,
-		components: components,
-		replace:    replace,
-		debug:      debug,
+		summary:         summary,
+		summaryPrefix:   summaryPrefix,
+		priority:        priority,
+		assignee:        assignee,
+		labels:          labels,
+		components:      components,
+		replace:         replace,
+		targetProject:   targetProject,
+		withLinks:       withLinks,
+		withSubtasks:    withSubtasks,
+		withAttachments: withAttachments,
+		debug:           debug,
 	}
 }
 
@@ -273,5 +408,10 @@ func setFlags(cmd *cobra.Command) {
 	cmd.Flags().StringArrayP("label", "l", []string{}, "Issue labels")
 	cmd.Flags().StringArrayP("component", "C", []string{}, "Issue components")
 	cmd.Flags().StringP("replace", "H", "", "Replace strings in summary and body. Format <search>:<replace>, eg: \"find me:replace with me\"")
+	cmd.Flags().String("summary-prefix", "", "Prefix to add to the summary of the cloned issues, eg: \"CLONE - \"")
+	cmd.Flags().String("target-project", "", "Project to create the clone in (defaults to the current project)")
+	cmd.Flags().Bool("with-links", false, "Copy issue links to the cloned issue")
+	cmd.Flags().Bool("with-subtasks", false, "Clone sub-tasks of the issue under the cloned issue")
+	cmd.Flags().Bool("with-attachments", false, "Copy attachments to the cloned issue")
 	cmd.Flags().Bool("web", false, "Open in web browser after successful cloning")
 }
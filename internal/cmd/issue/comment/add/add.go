@@ -2,6 +2,7 @@ package add
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
@@ -11,12 +12,15 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/queue"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
 )
 
 const (
-	helpText = `Add adds comment to an issue.`
+	helpText = `Add adds comment to an issue.
+
+Use --internal to add a comment visible only to agents in a Jira Service Management project.`
 	examples = `$ jira issue comment add
 
 # Pass required parameters to skip prompt 
@@ -34,6 +38,12 @@ $ jira issue comment add ISSUE-1 --template -
 # Or, use pipe to read input directly from standard input
 $ echo "Comment from stdin" | jira issue comment add ISSUE-1
 
+# Template files can use variables like {{.IssueKey}}, {{.Date}}, {{.GitBranch}} and the ones passed via --var
+$ jira issue comment add ISSUE-1 --template /path/to/template.tmpl --var sprint=42
+
+# Add an internal comment hidden from customers of a service desk project
+$ jira issue comment add ISSUE-1 "Internal note" --internal
+
 # Positional argument takes precedence over the template flag
 # The example below will add "comment from arg" as a comment
 $ jira issue comment add ISSUE-1 "comment from arg" --template /path/to/template.tmpl`
@@ -50,11 +60,14 @@ func NewCmdCommentAdd() *cobra.Command {
 			"help:args": "ISSUE-KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
 				"COMMENT_BODY\tBody of the comment you want to add",
 		},
-		Run: add,
+		ValidArgsFunction: cmdcommon.CompleteIssueKeys,
+		Run:               add,
 	}
 
 	cmd.Flags().Bool("web", false, "Open issue in web browser after adding comment")
 	cmd.Flags().StringP("template", "T", "", "Path to a file to read comment body from")
+	cmd.Flags().StringArray("var", []string{}, "Template variable in key=value format, eg: sprint=42. Can be used multiple times")
+	cmd.Flags().Bool("internal", false, "Hide the comment from customers, works only in service desk projects")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
 
 	return &cmd
@@ -79,6 +92,13 @@ func add(cmd *cobra.Command, args []string) {
 
 	cmdutil.ExitIfError(ac.setIssueKey())
 
+	if params.internal {
+		// The project can't be verified while Jira is unreachable, the comment is queued instead.
+		if err := ac.verifyServiceDesk(); !api.IsUnreachable(err) {
+			cmdutil.ExitIfError(err)
+		}
+	}
+
 	qs := ac.getQuestions()
 	if len(qs) > 0 {
 		ans := struct{ IssueKey, Body string }{}
@@ -107,8 +127,16 @@ func add(cmd *cobra.Command, args []string) {
 		s := cmdutil.Info("Adding comment")
 		defer s.Stop()
 
-		return client.AddIssueComment(ac.params.issueKey, ac.params.body)
+		return client.AddIssueComment(ac.params.issueKey, ac.params.body, ac.params.internal)
 	}()
+	if api.IsUnreachable(err) {
+		cmdcommon.QueueOperation(&queue.Operation{
+			Kind:     queue.KindComment,
+			IssueKey: ac.params.issueKey,
+			Comment:  &queue.Comment{Body: ac.params.body, Internal: ac.params.internal},
+		})
+		return
+	}
 	cmdutil.ExitIfError(err)
 
 	server := viper.GetString("server")
@@ -126,6 +154,8 @@ type addParams struct {
 	issueKey string
 	body     string
 	template string
+	vars     []string
+	internal bool
 	noInput  bool
 	debug    bool
 }
@@ -147,6 +177,12 @@ func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
+	vars, err := flags.GetStringArray("var")
+	cmdutil.ExitIfError(err)
+
+	internal, err := flags.GetBool("internal")
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
@@ -154,6 +190,8 @@ func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
 		issueKey: issueKey,
 		body:     body,
 		template: template,
+		vars:     vars,
+		internal: internal,
 		noInput:  noInput,
 		debug:    debug,
 	}
@@ -170,18 +208,32 @@ func (ac *addCmd) setIssueKey() error {
 		return nil
 	}
 
-	var ans string
-
-	qs := &survey.Question{
-		Name:     "issueKey",
-		Prompt:   &survey.Input{Message: "Issue key"},
-		Validate: survey.Required,
-	}
-	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+	key, err := cmdcommon.PickIssue(ac.client, viper.GetString("project.key"))
+	if err != nil {
 		return err
 	}
-	ac.params.issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), ans)
+	ac.params.issueKey = key
+
+	return nil
+}
+
+// verifyServiceDesk makes sure the issue belongs to a service desk project
+// as internal comments are not supported in other projects.
+func (ac *addCmd) verifyServiceDesk() error {
+	project := strings.SplitN(ac.params.issueKey, "-", 2)[0] //nolint:gomnd
 
+	p, err := func() (*jira.Project, error) {
+		s := cmdutil.Info("Fetching project details...")
+		defer s.Stop()
+
+		return ac.client.GetProject(project)
+	}()
+	if err != nil {
+		return err
+	}
+	if p.ProjectTypeKey != jira.ProjectTypeServiceDesk {
+		return fmt.Errorf("internal comments are only supported in service desk projects, %q is not one", project)
+	}
 	return nil
 }
 
@@ -199,11 +251,11 @@ func (ac *addCmd) getQuestions() []*survey.Question {
 	var defaultBody string
 
 	if ac.params.template != "" || cmdutil.StdinHasData() {
-		b, err := cmdutil.ReadFile(ac.params.template)
+		body, err := cmdcommon.ReadTemplate(ac.params.template, ac.params.issueKey, ac.params.vars)
 		if err != nil {
 			cmdutil.Failed("Error: %s", err)
 		}
-		defaultBody = string(b)
+		defaultBody = body
 	}
 
 	if ac.params.noInput && ac.params.body == "" {
@@ -0,0 +1,165 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Delete deletes a comment of an issue.`
+	examples = `$ jira issue comment delete
+
+# Select the comment to delete from the list of your comments in the issue
+$ jira issue comment delete ISSUE-1
+
+# Delete a comment without a confirmation prompt
+$ jira issue comment delete ISSUE-1 10001 --yes`
+)
+
+// NewCmdCommentDelete is a comment delete command.
+func NewCmdCommentDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete [ISSUE-KEY] [COMMENT-ID]",
+		Short:   "Delete a comment of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key of the comment, eg: ISSUE-1\n" +
+				"COMMENT-ID\tID of the comment you want to delete",
+		},
+		Run: del,
+	}
+
+	cmd.Flags().BoolP("yes", "y", false, "Delete without a confirmation prompt")
+
+	return &cmd
+}
+
+func del(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+	client := api.Client(jira.Config{Debug: params.debug})
+	dc := deleteCmd{
+		client: client,
+		params: params,
+	}
+
+	cmdutil.ExitIfError(dc.setIssueKey())
+	cmdutil.ExitIfError(dc.setCommentID())
+
+	if !params.yes {
+		confirmed := false
+		prompt := &survey.Confirm{
+			Message: fmt.Sprintf("Delete comment %q of issue %q?", params.commentID, params.issueKey),
+		}
+		cmdutil.ExitIfError(survey.AskOne(prompt, &confirmed))
+
+		if !confirmed {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
+	err := func() error {
+		s := cmdutil.Info("Deleting comment")
+		defer s.Stop()
+
+		return client.DeleteIssueComment(params.issueKey, params.commentID)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Comment \"%s\" deleted from issue \"%s\"", params.commentID, params.issueKey)
+	fmt.Printf("%s/browse/%s\n", viper.GetString("server"), params.issueKey)
+}
+
+type deleteParams struct {
+	issueKey  string
+	commentID string
+	yes       bool
+	debug     bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *deleteParams {
+	var issueKey, commentID string
+
+	nargs := len(args)
+	if nargs >= 1 {
+		issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	}
+	if nargs >= 2 {
+		commentID = args[1]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	yes, err := flags.GetBool("yes")
+	cmdutil.ExitIfError(err)
+
+	return &deleteParams{
+		issueKey:  issueKey,
+		commentID: commentID,
+		yes:       yes,
+		debug:     debug,
+	}
+}
+
+type deleteCmd struct {
+	client *jira.Client
+	params *deleteParams
+}
+
+func (dc *deleteCmd) setIssueKey() error {
+	if dc.params.issueKey != "" {
+		return nil
+	}
+
+	var ans string
+
+	qs := &survey.Question{
+		Name:     "issueKey",
+		Prompt:   &survey.Input{Message: "Issue key"},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return err
+	}
+	dc.params.issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), ans)
+
+	return nil
+}
+
+func (dc *deleteCmd) setCommentID() error {
+	if dc.params.commentID != "" {
+		return nil
+	}
+
+	comments, err := func() ([]*jira.Comment, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching your comments in issue %s...", dc.params.issueKey))
+		defer s.Stop()
+
+		return cmdcommon.MyComments(dc.client, dc.params.issueKey)
+	}()
+	if err != nil {
+		return err
+	}
+	if len(comments) == 0 {
+		return fmt.Errorf("no comments by you found in issue %q", dc.params.issueKey)
+	}
+
+	c, err := cmdcommon.SelectComment(comments)
+	if err != nil {
+		return err
+	}
+	dc.params.commentID = c.ID
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package edit
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const (
+	helpText = `Edit edits a comment of an issue.
+
+The existing comment body is opened in your editor unless a new body is passed.`
+	examples = `$ jira issue comment edit
+
+# Select the comment to edit from the list of your comments in the issue
+$ jira issue comment edit ISSUE-1
+
+# Edit the comment in your editor
+$ jira issue comment edit ISSUE-1 10001
+
+# Pass required parameters to skip prompt
+$ jira issue comment edit ISSUE-1 10001 "Updated comment" --no-input
+
+# Load comment body from a template file
+$ jira issue comment edit ISSUE-1 10001 --template /path/to/template.tmpl
+
+# Get comment body from standard input
+$ echo "Comment from stdin" | jira issue comment edit ISSUE-1 10001`
+)
+
+// NewCmdCommentEdit is a comment edit command.
+func NewCmdCommentEdit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "edit [ISSUE-KEY] [COMMENT-ID] [COMMENT_BODY]",
+		Short:   "Edit a comment of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"update"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key of the comment, eg: ISSUE-1\n" +
+				"COMMENT-ID\tID of the comment you want to edit\n" +
+				"COMMENT_BODY\tNew body of the comment",
+		},
+		Run: edit,
+	}
+
+	cmd.Flags().Bool("web", false, "Open issue in web browser after editing comment")
+	cmd.Flags().StringP("template", "T", "", "Path to a file to read comment body from")
+	cmd.Flags().StringArray("var", []string{}, "Template variable in key=value format, eg: sprint=42. Can be used multiple times")
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+
+	return &cmd
+}
+
+func edit(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+	client := api.Client(jira.Config{Debug: params.debug})
+	ec := editCmd{
+		client: client,
+		params: params,
+	}
+
+	if ec.isNonInteractive() {
+		ec.params.noInput = true
+
+		if ec.isMandatoryParamsMissing() {
+			cmdutil.Failed("`ISSUE-KEY` and `COMMENT-ID` are mandatory when using a non-interactive mode")
+		}
+	}
+
+	cmdutil.ExitIfError(ec.setIssueKey())
+	cmdutil.ExitIfError(ec.setComment())
+
+	qs := ec.getQuestions()
+	if len(qs) > 0 {
+		ans := struct{ Body string }{}
+		err := survey.Ask(qs, &ans)
+		cmdutil.ExitIfError(err)
+
+		params.body = ans.Body
+	}
+
+	if params.body == "" {
+		cmdutil.Failed("Comment body cannot be empty")
+	}
+
+	if !params.noInput {
+		answer := struct{ Action string }{}
+		err := survey.Ask([]*survey.Question{ec.getNextAction()}, &answer)
+		cmdutil.ExitIfError(err)
+
+		if answer.Action == cmdcommon.ActionCancel {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
+	err := func() error {
+		s := cmdutil.Info("Updating comment")
+		defer s.Stop()
+
+		return client.UpdateIssueComment(params.issueKey, params.commentID, params.body)
+	}()
+	cmdutil.ExitIfError(err)
+
+	server := viper.GetString("server")
+
+	cmdutil.Success("Comment \"%s\" of issue \"%s\" updated", params.commentID, params.issueKey)
+	fmt.Printf("%s/browse/%s\n", server, params.issueKey)
+
+	if web, _ := cmd.Flags().GetBool("web"); web {
+		err := cmdutil.Navigate(server, params.issueKey)
+		cmdutil.ExitIfError(err)
+	}
+}
+
+type editParams struct {
+	issueKey  string
+	commentID string
+	body      string
+	template  string
+	vars      []string
+	noInput   bool
+	debug     bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *editParams {
+	var issueKey, commentID, body string
+
+	nargs := len(args)
+	if nargs >= 1 {
+		issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	}
+	if nargs >= 2 {
+		commentID = args[1]
+	}
+	if nargs >= 3 {
+		body = args[2]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	template, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+
+	vars, err := flags.GetStringArray("var")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	return &editParams{
+		issueKey:  issueKey,
+		commentID: commentID,
+		body:      body,
+		template:  template,
+		vars:      vars,
+		noInput:   noInput,
+		debug:     debug,
+	}
+}
+
+type editCmd struct {
+	client  *jira.Client
+	comment *jira.Comment
+	params  *editParams
+}
+
+func (ec *editCmd) setIssueKey() error {
+	if ec.params.issueKey != "" {
+		return nil
+	}
+
+	var ans string
+
+	qs := &survey.Question{
+		Name:     "issueKey",
+		Prompt:   &survey.Input{Message: "Issue key"},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return err
+	}
+	ec.params.issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), ans)
+
+	return nil
+}
+
+func (ec *editCmd) setComment() error {
+	if ec.params.commentID != "" {
+		// The existing body is only needed as a default in the editor.
+		if ec.params.body != "" || ec.params.noInput {
+			return nil
+		}
+
+		c, err := func() (*jira.Comment, error) {
+			s := cmdutil.Info("Fetching comment details...")
+			defer s.Stop()
+
+			return ec.client.GetIssueComment(ec.params.issueKey, ec.params.commentID)
+		}()
+		if err != nil {
+			return err
+		}
+		ec.comment = c
+
+		return nil
+	}
+
+	comments, err := func() ([]*jira.Comment, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching your comments in issue %s...", ec.params.issueKey))
+		defer s.Stop()
+
+		return cmdcommon.MyComments(ec.client, ec.params.issueKey)
+	}()
+	if err != nil {
+		return err
+	}
+	if len(comments) == 0 {
+		return fmt.Errorf("no comments by you found in issue %q", ec.params.issueKey)
+	}
+
+	c, err := cmdcommon.SelectComment(comments)
+	if err != nil {
+		return err
+	}
+	ec.comment = c
+	ec.params.commentID = c.ID
+
+	return nil
+}
+
+func (ec *editCmd) getQuestions() []*survey.Question {
+	if ec.params.body != "" {
+		return nil
+	}
+
+	if ec.params.template != "" || cmdutil.StdinHasData() {
+		body, err := cmdcommon.ReadTemplate(ec.params.template, ec.params.issueKey, ec.params.vars)
+		if err != nil {
+			cmdutil.Failed("Error: %s", err)
+		}
+		ec.params.body = body
+	}
+
+	if ec.params.noInput || ec.params.body != "" {
+		return nil
+	}
+
+	var defaultBody string
+	if ec.comment != nil {
+		defaultBody = md.FromJiraMD(ec.comment.Body)
+	}
+
+	return []*survey.Question{{
+		Name: "body",
+		Prompt: &surveyext.JiraEditor{
+			Editor: &survey.Editor{
+				Message:       "Comment body",
+				Default:       defaultBody,
+				HideDefault:   true,
+				AppendDefault: true,
+			},
+			BlankAllowed: false,
+		},
+	}}
+}
+
+func (ec *editCmd) getNextAction() *survey.Question {
+	return &survey.Question{
+		Name: "action",
+		Prompt: &survey.Select{
+			Message: "What's next?",
+			Options: []string{
+				cmdcommon.ActionSubmit,
+				cmdcommon.ActionCancel,
+			},
+		},
+		Validate: survey.Required,
+	}
+}
+
+func (ec *editCmd) isNonInteractive() bool {
+	return cmdutil.StdinHasData() || ec.params.template == "-"
+}
+
+func (ec *editCmd) isMandatoryParamsMissing() bool {
+	return ec.params.issueKey == "" || ec.params.commentID == ""
+}
@@ -0,0 +1,105 @@
+package list
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	defaultLimit = 20
+	helpText     = `List lists comments of an issue.
+
+Comments are displayed as a thread with the latest comment at the bottom. Only
+the latest comments are loaded at once, use --page to load older comments.`
+	examples = `$ jira issue comment list ISSUE-1
+
+# Load the next page of older comments
+$ jira issue comment list ISSUE-1 --page 2
+
+# List 50 comments per page in a plain view
+$ jira issue comment list ISSUE-1 --limit 50 --plain`
+)
+
+// NewCmdCommentList is a comment list command.
+func NewCmdCommentList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list ISSUE-KEY",
+		Short:   "List comments of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.MinimumNArgs(1),
+		Run:  list,
+	}
+
+	cmd.Flags().Uint("page", 1, "Page of comments to load, newest first")
+	cmd.Flags().Uint("limit", defaultLimit, "Number of comments per page")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+
+	return &cmd
+}
+
+func list(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	page, err := cmd.Flags().GetUint("page")
+	cmdutil.ExitIfError(err)
+
+	limit, err := cmd.Flags().GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	if page == 0 || limit == 0 {
+		cmdutil.Failed("Both --page and --limit must be greater than 0")
+	}
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	result, err := func() (*jira.CommentResult, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching comments of issue %s...", key))
+		defer s.Stop()
+
+		client := api.Client(jira.Config{Debug: debug})
+		return client.GetIssueComments(key, int((page-1)*limit), int(limit), jira.CommentOrderCreatedDesc)
+	}()
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, result))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	if len(result.Comments) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No comments found for issue \"%s\"", key)
+		return
+	}
+
+	v := view.Comments{
+		Server:  viper.GetString("server"),
+		Key:     key,
+		Data:    result,
+		Display: view.DisplayFormat{Plain: plain},
+	}
+	cmdutil.ExitIfError(v.Render())
+}
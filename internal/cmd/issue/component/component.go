@@ -0,0 +1,208 @@
+package component
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Component manages components of issues. See available commands below.
+
+ISSUE can be a single issue key, comma separated issue keys or "-" to read issue keys
+from the standard input. Components are validated against the components defined in
+the project of the issues, you will be asked to pick them if none is given.
+
+Use "jira project component list" to see the components of a project.`
+	examples = `$ jira issue component add ISSUE-1 Backend
+$ jira issue component remove ISSUE-1,ISSUE-2 Backend Frontend
+
+# Pick components interactively
+$ jira issue component add ISSUE-1`
+)
+
+// NewCmdComponent is a component command.
+func NewCmdComponent() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "component",
+		Short:   "Component manages components of issues",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"components"},
+		RunE:    component,
+	}
+
+	cmd.AddCommand(
+		newCmdComponentOp(jira.UpdateOpAdd, "Add components to issues"),
+		newCmdComponentOp(jira.UpdateOpRemove, "Remove components from issues"),
+	)
+
+	return &cmd
+}
+
+func component(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func newCmdComponentOp(op, short string) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   op + " ISSUE [COMPONENT...]",
+		Short: short,
+		Long:  helpText,
+		Args:  cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			"help:args": "ISSUE\tIssue key, comma separated issue keys or - to read them from stdin\n" +
+				"COMPONENT\tComponents to " + op,
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			update(cmd, args, op)
+		},
+	}
+
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to update at once")
+
+	return &cmd
+}
+
+func update(cmd *cobra.Command, args []string, op string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	keys, err := cmdutil.GetJiraIssueKeys(viper.GetString("project.key"), args[0])
+	cmdutil.ExitIfError(err)
+	if len(keys) == 0 {
+		cmdutil.Failed("No issues to update")
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	available, err := func() (map[string][]*jira.Component, error) {
+		s := cmdutil.Info("Fetching project components...")
+		defer s.Stop()
+
+		return projectComponents(client, keys)
+	}()
+	cmdutil.ExitIfError(err)
+
+	components := args[1:]
+	if len(components) == 0 {
+		components, err = askComponents(op, available)
+		cmdutil.ExitIfError(err)
+	}
+	if len(components) == 0 {
+		cmdutil.Fail("Action aborted")
+		os.Exit(0)
+	}
+
+	components, err = validate(components, available)
+	if err != nil {
+		cmdutil.Failed("Error: %s", err.Error())
+	}
+
+	concurrency := cmdcommon.GetConcurrency(cmd.Flags())
+
+	passed, err := func() (int, error) {
+		s := cmdutil.Info(fmt.Sprintf("Updating components of %d issue(s)...", len(keys)))
+		defer s.Stop()
+
+		return cmdcommon.UpdateIssues(keys, concurrency, func(key string) error {
+			return client.UpdateComponents(key, op, components)
+		})
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Components updated for %d of %d issue(s)", passed, len(keys))
+	}
+	cmdutil.ExitIfError(err)
+}
+
+// projectComponents fetches components of the projects the issues belong to.
+func projectComponents(client *jira.Client, keys []string) (map[string][]*jira.Component, error) {
+	out := make(map[string][]*jira.Component)
+
+	for _, key := range keys {
+		project := strings.SplitN(key, "-", 2)[0] //nolint:gomnd
+		if _, ok := out[project]; ok {
+			continue
+		}
+		cmps, err := client.ProjectComponents(project)
+		if err != nil {
+			return nil, err
+		}
+		out[project] = cmps
+	}
+
+	return out, nil
+}
+
+// validate makes sure that the components exist in all projects and
+// returns them with the case used in the projects.
+func validate(components []string, available map[string][]*jira.Component) ([]string, error) {
+	out := make([]string, 0, len(components))
+
+	for _, name := range components {
+		var actual string
+
+		for project, cmps := range available {
+			found := false
+			all := make([]string, 0, len(cmps))
+			for _, c := range cmps {
+				if strings.EqualFold(c.Name, name) {
+					found, actual = true, c.Name
+				}
+				all = append(all, fmt.Sprintf("'%s'", c.Name))
+			}
+			if !found {
+				return nil, fmt.Errorf(
+					"invalid component \"%s\" for project %s\nAvailable components are: %s",
+					name, project, strings.Join(all, ", "),
+				)
+			}
+		}
+		out = append(out, actual)
+	}
+
+	return out, nil
+}
+
+func askComponents(op string, available map[string][]*jira.Component) ([]string, error) {
+	var (
+		options []string
+		seen    = make(map[string]bool)
+	)
+	for _, cmps := range available {
+		for _, c := range cmps {
+			if !seen[c.Name] {
+				seen[c.Name] = true
+				options = append(options, c.Name)
+			}
+		}
+	}
+	if len(options) == 0 {
+		return nil, fmt.Errorf("no components defined in the project")
+	}
+
+	var ans []string
+
+	qs := &survey.Question{
+		Name: "components",
+		Prompt: &survey.MultiSelect{
+			Message: fmt.Sprintf("Components to %s:", op),
+			Options: options,
+		},
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
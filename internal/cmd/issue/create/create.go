@@ -26,6 +26,10 @@ $ jira issue create -tBug -s"New Bug" -yHigh -lbug -lurgent -b"Bug description"
 # Create issue in another project
 $ jira issue create -pPRJ -tBug -yHigh -s"New Bug" -b$'Bug description\n\nSome more text'
 
+# Create a sub-task, issue type is resolved automatically and components
+# and fix versions are inherited from the parent unless they are passed
+$ jira issue create -PISSUE-1 -s"Do the thing"
+
 # Load description from template file
 $ jira issue create --template /path/to/template.tmpl
 
@@ -35,6 +39,9 @@ $ jira issue create --template -
 # Or, use pipe to read input directly from standard input
 $ echo "Description from stdin" | jira issue create -s"Summary" -tTask
 
+# Set custom fields by their name or id, values are converted to the type of the field
+$ jira issue create -tStory -s"New story" --custom "Story Points=5" --custom Team=Platform --custom customfield_10020=BE,FE
+
 # For issue description, the flag --body/-b takes precedence over the --template flag
 # The example below will add "Body from flag" as an issue description
 $ jira issue create -tTask -sSummary -b"Body from flag" --template /path/to/template.tpl`
@@ -54,12 +61,14 @@ func NewCmdCreate() *cobra.Command {
 // SetFlags sets flags supported by create command.
 func SetFlags(cmd *cobra.Command) {
 	cmdcommon.SetCreateFlags(cmd, "Issue")
+
+	cmd.Flags().StringArray("custom", []string{}, `Custom field in name=value format, eg: "Story Points=5".
+Array values are comma separated. Can be used multiple times`)
 }
 
 func create(cmd *cobra.Command, _ []string) {
 	server := viper.GetString("server")
 	project := viper.GetString("project.key")
-	projectType := viper.GetString("project.type")
 
 	params := parseFlags(cmd.Flags())
 	client := api.Client(jira.Config{Debug: params.debug})
@@ -68,19 +77,26 @@ func create(cmd *cobra.Command, _ []string) {
 		params: params,
 	}
 
+	projectType, err := cmdcommon.ProjectType(client, project)
+	cmdutil.ExitIfError(err)
+
 	if cc.isNonInteractive() {
 		cc.params.noInput = true
 
 		if cc.isMandatoryParamsMissing() {
 			cmdutil.Failed(
-				"Params `--summary` and `--type` is mandatory when using a non-interactive mode",
+				"Params `--summary` and `--type` or `--parent` is mandatory when using a non-interactive mode",
 			)
 		}
 	}
 
 	cmdutil.ExitIfError(cc.setIssueTypes())
+	cmdutil.ExitIfError(cc.setSubtaskType(project))
 	cmdutil.ExitIfError(cc.askQuestions())
 
+	// Assignee picked in the prompt, params.assignee is looked up otherwise.
+	var assignee, reporter *jira.User
+
 	if !params.noInput {
 		answer := struct{ Action string }{}
 		for answer.Action != cmdcommon.ActionSubmit {
@@ -119,6 +135,15 @@ func create(cmd *cobra.Command, _ []string) {
 						params.fixVersions = strings.Split(ans.FixVersions, ",")
 					}
 				}
+				for _, m := range ans.Metadata {
+					switch m {
+					case cmdcommon.MetadataAssignee:
+						assignee, err = cmdcommon.AskUser(client, project, "Assignee")
+					case cmdcommon.MetadataReporter:
+						reporter, err = cmdcommon.AskUser(client, project, "Reporter")
+					}
+					cmdutil.ExitIfError(err)
+				}
 			}
 		}
 	}
@@ -127,21 +152,47 @@ func create(cmd *cobra.Command, _ []string) {
 		s := cmdutil.Info("Creating an issue...")
 		defer s.Stop()
 
+		customFields, err := cc.getCustomFields(project)
+		if err != nil {
+			return "", err
+		}
+		if params.parentIssueKey != "" && cc.isSubtask(params.issueType) {
+			parent, err := api.ProxyGetIssue(client, cmdutil.GetJiraIssueKey(project, params.parentIssueKey))
+			if err != nil {
+				return "", err
+			}
+			params.components, params.fixVersions = cmdcommon.InheritFromParent(
+				parent, params.components, params.fixVersions,
+			)
+		}
+		fixVersions, err := cmdcommon.ResolveVersions(client, project, params.fixVersions, false)
+		if err != nil {
+			return "", err
+		}
+		affectsVersions, err := cmdcommon.ResolveVersions(client, project, params.affectsVersions, false)
+		if err != nil {
+			return "", err
+		}
+
 		cr := jira.CreateRequest{
-			Project:        project,
-			IssueType:      params.issueType,
-			ParentIssueKey: params.parentIssueKey,
-			Summary:        params.summary,
-			Body:           params.body,
-			Priority:       params.priority,
-			Labels:         params.labels,
-			Components:     params.components,
-			FixVersions:    params.fixVersions,
-			EpicField:      viper.GetString("epic.link"),
+			Project:         project,
+			IssueType:       params.issueType,
+			ParentIssueKey:  params.parentIssueKey,
+			Summary:         params.summary,
+			Body:            params.body,
+			Priority:        params.priority,
+			Labels:          params.labels,
+			Components:      params.components,
+			FixVersions:     fixVersions,
+			AffectsVersions: affectsVersions,
+			EpicField:       viper.GetString("epic.link"),
+			CustomFields:    customFields,
 		}
 		cr.ForProjectType(projectType)
 
-		if strings.EqualFold(params.issueType, jira.IssueTypeSubTask) {
+		if cc.isSubtask(params.issueType) {
+			cr.SubtaskField = params.issueType
+		} else if strings.EqualFold(params.issueType, jira.IssueTypeSubTask) {
 			cr.SubtaskField = cmdutil.GetSubtaskHandle(cc.issueTypes)
 		}
 
@@ -155,7 +206,7 @@ func create(cmd *cobra.Command, _ []string) {
 
 	cmdutil.Success("Issue created\n%s/browse/%s", server, key)
 
-	if params.assignee != "" {
+	if assignee == nil && params.assignee != "" {
 		user, err := api.ProxyUserSearch(client, &jira.UserSearchOptions{
 			Query:   params.assignee,
 			Project: project,
@@ -163,10 +214,18 @@ func create(cmd *cobra.Command, _ []string) {
 		if err != nil || len(user) == 0 {
 			cmdutil.Failed("Unable to find assignee")
 		}
-		if err = api.ProxyAssignIssue(client, key, user[0], jira.AssigneeDefault); err != nil {
+		assignee = user[0]
+	}
+	if assignee != nil {
+		if err = api.ProxyAssignIssue(client, key, assignee, jira.AssigneeDefault); err != nil {
 			cmdutil.Failed("Unable to set assignee: %s", err.Error())
 		}
 	}
+	if reporter != nil {
+		if err = client.Edit(key, &jira.EditRequest{Reporter: reporter}); err != nil {
+			cmdutil.Failed("Unable to set reporter: %s", err.Error())
+		}
+	}
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, key)
@@ -205,6 +264,39 @@ func (cc *createCmd) setIssueTypes() error {
 	return nil
 }
 
+// setSubtaskType uses the subtask issue type of the project if
+// the parent issue is passed without an issue type.
+func (cc *createCmd) setSubtaskType(project string) error {
+	if cc.params.parentIssueKey == "" || cc.params.issueType != "" {
+		return nil
+	}
+
+	for _, t := range cc.issueTypes {
+		if t.Subtask {
+			cc.params.issueType = t.Name
+			return nil
+		}
+	}
+
+	it, err := cmdcommon.SubtaskIssueType(cc.client, project)
+	if err != nil {
+		return err
+	}
+	cc.params.issueType = it.Name
+
+	return nil
+}
+
+// isSubtask checks if the issue type is a subtask issue type.
+func (cc *createCmd) isSubtask(issueType string) bool {
+	for _, t := range cc.issueTypes {
+		if t.Subtask && (t.Name == issueType || (t.Handle != "" && t.Handle == issueType)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (cc *createCmd) getIssueType() *survey.Question {
 	var qs *survey.Question
 
@@ -283,16 +375,12 @@ func (cc *createCmd) askQuestions() error {
 func (cc *createCmd) getRemainingQuestions() []*survey.Question {
 	var qs []*survey.Question
 
-	if cc.params.parentIssueKey == "" {
-		for _, t := range cc.issueTypes {
-			if t.Subtask && (t.Name == cc.params.issueType || (t.Handle != "" && t.Handle == cc.params.issueType)) {
-				qs = append(qs, &survey.Question{
-					Name:     "parentIssueKey",
-					Prompt:   &survey.Input{Message: "Parent issue key"},
-					Validate: survey.Required,
-				})
-			}
-		}
+	if cc.params.parentIssueKey == "" && cc.isSubtask(cc.params.issueType) {
+		qs = append(qs, &survey.Question{
+			Name:     "parentIssueKey",
+			Prompt:   &survey.Input{Message: "Parent issue key"},
+			Validate: survey.Required,
+		})
 	}
 
 	if cc.params.summary == "" {
@@ -338,27 +426,42 @@ func (cc *createCmd) getRemainingQuestions() []*survey.Question {
 	return qs
 }
 
+// getCustomFields resolves custom fields set with the --custom flag
+// to field ids and values in the shape the fields expect.
+func (cc *createCmd) getCustomFields(project string) (map[string]interface{}, error) {
+	issueType := cc.params.issueType
+	for _, t := range cc.issueTypes {
+		if t.Handle != "" && t.Handle == issueType {
+			issueType = t.Name
+		}
+	}
+
+	return cmdcommon.GetCustomFields(cc.client, project, issueType, cc.params.customFields)
+}
+
 func (cc *createCmd) isNonInteractive() bool {
 	return cmdutil.StdinHasData() || cc.params.template == "-"
 }
 
 func (cc *createCmd) isMandatoryParamsMissing() bool {
-	return cc.params.summary == "" || cc.params.issueType == ""
+	return cc.params.summary == "" || (cc.params.issueType == "" && cc.params.parentIssueKey == "")
 }
 
 type createParams struct {
-	issueType      string
-	parentIssueKey string
-	summary        string
-	body           string
-	priority       string
-	assignee       string
-	labels         []string
-	components     []string
-	fixVersions    []string
-	template       string
-	noInput        bool
-	debug          bool
+	issueType       string
+	parentIssueKey  string
+	summary         string
+	body            string
+	priority        string
+	assignee        string
+	labels          []string
+	components      []string
+	fixVersions     []string
+	affectsVersions []string
+	customFields    []string
+	template        string
+	noInput         bool
+	debug           bool
 }
 
 func parseFlags(flags query.FlagParser) *createParams {
@@ -389,6 +492,12 @@ func parseFlags(flags query.FlagParser) *createParams {
 	fixVersions, err := flags.GetStringArray("fix-version")
 	cmdutil.ExitIfError(err)
 
+	affectsVersions, err := flags.GetStringArray("affects-version")
+	cmdutil.ExitIfError(err)
+
+	customFields, err := flags.GetStringArray("custom")
+	cmdutil.ExitIfError(err)
+
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
@@ -399,17 +508,19 @@ func parseFlags(flags query.FlagParser) *createParams {
 	cmdutil.ExitIfError(err)
 
 	return &createParams{
-		issueType:      issueType,
-		parentIssueKey: parentIssueKey,
-		summary:        summary,
-		body:           body,
-		priority:       priority,
-		assignee:       assignee,
-		labels:         labels,
-		components:     components,
-		fixVersions:    fixVersions,
-		template:       template,
-		noInput:        noInput,
-		debug:          debug,
+		issueType:       issueType,
+		parentIssueKey:  parentIssueKey,
+		summary:         summary,
+		body:            body,
+		priority:        priority,
+		assignee:        assignee,
+		labels:          labels,
+		components:      components,
+		fixVersions:     fixVersions,
+		affectsVersions: affectsVersions,
+		customFields:    customFields,
+		template:        template,
+		noInput:         noInput,
+		debug:           debug,
 	}
 }
@@ -0,0 +1,133 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Delete deletes an issue.
+
+You will be asked to type the issue key to confirm the deletion unless --yes is passed.
+Issues with subtasks can only be deleted along with their subtasks using --cascade.`
+	examples = `$ jira issue delete ISSUE-1
+
+# Delete an issue without a confirmation prompt
+$ jira issue delete ISSUE-1 --yes
+
+# Delete an issue along with its subtasks
+$ jira issue delete ISSUE-1 --cascade`
+)
+
+// NewCmdDelete is a delete command.
+func NewCmdDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete ISSUE-KEY",
+		Short:   "Delete an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm", "del"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tKey of the issue to delete, eg: ISSUE-1",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdcommon.CompleteIssueKeys,
+		Run:               del,
+	}
+
+	cmd.Flags().BoolP("yes", "y", false, "Delete without a confirmation prompt")
+	cmd.Flags().Bool("cascade", false, "Delete subtasks of the issue as well")
+
+	return &cmd
+}
+
+func del(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args, viper.GetString("project.key"))
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	issue, err := func() (*jira.Issue, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching issue %s...", params.key))
+		defer s.Stop()
+
+		return api.ProxyGetIssue(client, params.key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	subtasks := len(issue.Fields.Subtasks)
+	if subtasks > 0 && !params.cascade {
+		cmdutil.Failed(
+			"Issue \"%s\" has %d subtask(s), use --cascade to delete them along with the issue", params.key, subtasks,
+		)
+	}
+
+	if !params.yes {
+		msg := fmt.Sprintf("Type %s to delete issue %q", params.key, issue.Fields.Summary)
+		if subtasks > 0 {
+			msg += fmt.Sprintf(" and its %d subtask(s)", subtasks)
+		}
+
+		var ans string
+		cmdutil.ExitIfError(survey.AskOne(&survey.Input{Message: msg + ":"}, &ans))
+
+		if !strings.EqualFold(strings.TrimSpace(ans), params.key) {
+			cmdutil.Failed("Issue key didn't match, action aborted")
+		}
+	}
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Deleting issue %s...", params.key))
+		defer s.Stop()
+
+		return client.DeleteIssue(params.key, params.cascade)
+	}()
+	if errors.Is(err, jira.ErrNoDeletePermission) {
+		cmdutil.FailedWithCode(
+			cmdutil.ExitCodeAuth,
+			"You don't have permission to delete issue \"%s\", ask a project administrator for the Delete Issues permission",
+			params.key,
+		)
+	}
+	cmdutil.ExitIfError(err)
+
+	if subtasks > 0 {
+		cmdutil.Success("Issue \"%s\" and its %d subtask(s) deleted", params.key, subtasks)
+	} else {
+		cmdutil.Success("Issue \"%s\" deleted", params.key)
+	}
+}
+
+type deleteParams struct {
+	key     string
+	yes     bool
+	cascade bool
+	debug   bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *deleteParams {
+	yes, err := flags.GetBool("yes")
+	cmdutil.ExitIfError(err)
+
+	cascade, err := flags.GetBool("cascade")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &deleteParams{
+		key:     cmdutil.GetJiraIssueKey(project, args[0]),
+		yes:     yes,
+		cascade: cascade,
+		debug:   debug,
+	}
+}
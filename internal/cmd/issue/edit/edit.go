@@ -20,12 +20,19 @@ import (
 )
 
 const (
-	helpText = `Edit an issue in a given project with minimal information.`
+	helpText = `Edit an issue in a given project with minimal information.
+
+Current summary and description are used as defaults of the prompts, the description is
+opened in your $EDITOR. Labels are appended to the existing ones while components and
+fix versions replace them.`
 	examples = `$ jira issue edit ISSUE-1
 
 # Edit issue in the configured project
 $ jira issue edit ISSUE-1 -s"New Bug" -yHigh -lbug -lurgent -CBackend -b"Bug description"
 
+# Replace fix versions and set custom fields by their name or id
+$ jira issue edit ISSUE-1 --fix-version v2.0 --custom "Story Points=8" --custom Team=Platform --no-input
+
 # Use --no-input option to disable interactive prompt
 $ jira issue edit ISSUE-1 -s"New updated summary" --no-input`
 )
@@ -41,8 +48,9 @@ func NewCmdEdit() *cobra.Command {
 		Annotations: map[string]string{
 			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1`,
 		},
-		Args: cobra.MinimumNArgs(1),
-		Run:  edit,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: cmdcommon.CompleteIssueKeys,
+		Run:               edit,
 	}
 
 	setFlags(&cmd)
@@ -90,6 +98,9 @@ func edit(cmd *cobra.Command, args []string) {
 
 	cmdutil.ExitIfError(ec.askQuestions(issue, originalBody))
 
+	// Assignee picked in the prompt, params.assignee is looked up otherwise.
+	var assignee, reporter *jira.User
+
 	if !params.noInput {
 		answer := struct{ Action string }{}
 		for answer.Action != cmdcommon.ActionSubmit {
@@ -107,9 +118,10 @@ func edit(cmd *cobra.Command, args []string) {
 				if len(ans.Metadata) > 0 {
 					qs := getMetadataQuestions(ans.Metadata, issue)
 					ans := struct {
-						Priority   string
-						Labels     string
-						Components string
+						Priority    string
+						Labels      string
+						Components  string
+						FixVersions string
 					}{}
 					err := survey.Ask(qs, &ans)
 					cmdutil.ExitIfError(err)
@@ -123,6 +135,20 @@ func edit(cmd *cobra.Command, args []string) {
 					if len(ans.Components) > 0 {
 						params.components = strings.Split(ans.Components, ",")
 					}
+					if len(ans.FixVersions) > 0 {
+						params.fixVersions = strings.Split(ans.FixVersions, ",")
+					}
+				}
+				// The issue may not be in the project in the config.
+				issueProject := cmdcommon.ProjectKey(issue.Key)
+				for _, m := range ans.Metadata {
+					switch m {
+					case cmdcommon.MetadataAssignee:
+						assignee, err = cmdcommon.AskUser(client, issueProject, "Assignee")
+					case cmdcommon.MetadataReporter:
+						reporter, err = cmdcommon.AskUser(client, issueProject, "Reporter")
+					}
+					cmdutil.ExitIfError(err)
 				}
 			}
 		}
@@ -130,7 +156,9 @@ func edit(cmd *cobra.Command, args []string) {
 
 	var userAccountID string
 
-	if params.assignee != "" {
+	if assignee != nil {
+		userAccountID = assignee.AccountID
+	} else if params.assignee != "" {
 		err := func() error {
 			s := cmdutil.Info("Looking for assignee...")
 			defer s.Stop()
@@ -152,7 +180,40 @@ func edit(cmd *cobra.Command, args []string) {
 		cmdutil.ExitIfError(err)
 	}
 
-	if params.isEmpty() {
+	var customFields map[string]interface{}
+
+	if len(params.customFields) > 0 {
+		err := func() error {
+			s := cmdutil.Info("Resolving custom fields...")
+			defer s.Stop()
+
+			var err error
+
+			projectKey := cmdcommon.ProjectKey(issue.Key)
+			customFields, err = cmdcommon.GetCustomFields(client, projectKey, issue.Fields.IssueType.Name, params.customFields)
+			return err
+		}()
+		cmdutil.ExitIfError(err)
+	}
+
+	if len(params.fixVersions) > 0 || len(params.affectsVersions) > 0 {
+		err := func() error {
+			s := cmdutil.Info("Resolving versions...")
+			defer s.Stop()
+
+			var err error
+
+			projectKey := cmdcommon.ProjectKey(issue.Key)
+			if params.fixVersions, err = cmdcommon.ResolveVersions(client, projectKey, params.fixVersions, false); err != nil {
+				return err
+			}
+			params.affectsVersions, err = cmdcommon.ResolveVersions(client, projectKey, params.affectsVersions, false)
+			return err
+		}()
+		cmdutil.ExitIfError(err)
+	}
+
+	if params.isEmpty() && assignee == nil && reporter == nil {
 		fmt.Println()
 		cmdutil.Failed("Nothing to update")
 	}
@@ -174,12 +235,16 @@ func edit(cmd *cobra.Command, args []string) {
 		}
 
 		edr := jira.EditRequest{
-			Summary:    params.summary,
-			Body:       body,
-			Assignee:   userAccountID,
-			Priority:   params.priority,
-			Labels:     labels,
-			Components: params.components,
+			Summary:         params.summary,
+			Body:            body,
+			Assignee:        userAccountID,
+			Priority:        params.priority,
+			Labels:          labels,
+			Components:      params.components,
+			FixVersions:     params.fixVersions,
+			AffectsVersions: params.affectsVersions,
+			CustomFields:    customFields,
+			Reporter:        reporter,
 		}
 
 		return client.Edit(params.issueKey, &edr)
@@ -249,20 +314,24 @@ func (ec *editCmd) askQuestions(issue *jira.Issue, originalBody string) error {
 }
 
 type editParams struct {
-	issueKey   string
-	summary    string
-	body       string
-	priority   string
-	assignee   string
-	labels     []string
-	components []string
-	noInput    bool
-	debug      bool
+	issueKey        string
+	summary         string
+	body            string
+	priority        string
+	assignee        string
+	labels          []string
+	components      []string
+	fixVersions     []string
+	affectsVersions []string
+	customFields    []string
+	noInput         bool
+	debug           bool
 }
 
 func (ep editParams) isEmpty() bool {
-	return ep.summary == "" && ep.body == "" && ep.priority == "" &&
-		ep.assignee == "" && len(ep.labels) == 0 && len(ep.components) == 0
+	return ep.summary == "" && ep.body == "" && ep.priority == "" && ep.assignee == "" &&
+		len(ep.labels) == 0 && len(ep.components) == 0 && len(ep.fixVersions) == 0 &&
+		len(ep.affectsVersions) == 0 && len(ep.customFields) == 0
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *editParams {
@@ -284,6 +353,15 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *e
 	components, err := flags.GetStringArray("component")
 	cmdutil.ExitIfError(err)
 
+	fixVersions, err := flags.GetStringArray("fix-version")
+	cmdutil.ExitIfError(err)
+
+	affectsVersions, err := flags.GetStringArray("affects-version")
+	cmdutil.ExitIfError(err)
+
+	customFields, err := flags.GetStringArray("custom")
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
@@ -291,15 +369,18 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *e
 	cmdutil.ExitIfError(err)
 
 	return &editParams{
-		issueKey:   cmdutil.GetJiraIssueKey(project, args[0]),
-		summary:    summary,
-		body:       body,
-		priority:   priority,
-		assignee:   assignee,
-		labels:     labels,
-		components: components,
-		noInput:    noInput,
-		debug:      debug,
+		issueKey:        cmdutil.GetJiraIssueKey(project, args[0]),
+		summary:         summary,
+		body:            body,
+		priority:        priority,
+		assignee:        assignee,
+		labels:          labels,
+		components:      components,
+		fixVersions:     fixVersions,
+		affectsVersions: affectsVersions,
+		customFields:    customFields,
+		noInput:         noInput,
+		debug:           debug,
 	}
 }
 
@@ -330,6 +411,14 @@ func getMetadataQuestions(meta []string, issue *jira.Issue) []*survey.Question {
 					Default: strings.Join(issue.Fields.Labels, ","),
 				},
 			})
+		case "FixVersions":
+			qs = append(qs, &survey.Question{
+				Name: "fixversions",
+				Prompt: &survey.Input{
+					Message: "Fix Versions",
+					Help:    "Comma separated list of fixVersions. For eg: v1.0-beta,v2.0",
+				},
+			})
 		}
 	}
 
@@ -345,6 +434,10 @@ func setFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("assignee", "a", "", "Edit assignee (email or display name)")
 	cmd.Flags().StringArrayP("label", "l", []string{}, "Append labels")
 	cmd.Flags().StringArrayP("component", "C", []string{}, "Replace components")
+	cmd.Flags().StringArray("fix-version", []string{}, "Replace release info (fixVersions)")
+	cmd.Flags().StringArray("affects-version", []string{}, "Replace affected versions (versions)")
+	cmd.Flags().StringArray("custom", []string{}, `Custom field in name=value format, eg: "Story Points=5".
+Array values are comma separated. Can be used multiple times`)
 	cmd.Flags().Bool("web", false, "Open in web browser after successful update")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
 }
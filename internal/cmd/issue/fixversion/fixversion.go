@@ -0,0 +1,135 @@
+package fixversion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Fixversion manages release info (fixVersions) of issues. See available commands below.
+
+ISSUE can be a single issue key, comma separated issue keys or "-" to read issue keys
+from the standard input. Versions are validated against the versions of the project
+of the issues, use --create to create missing versions when adding them.`
+	examples = `$ jira issue fixversion add ISSUE-1 v2.0
+$ jira issue fixversion remove ISSUE-1,ISSUE-2 v2.0
+
+# Create the version if it doesn't exist yet
+$ jira issue fixversion add ISSUE-1 v2.1 --create
+
+# Update affected versions instead
+$ jira issue fixversion add ISSUE-1 v1.0 --affects`
+)
+
+// NewCmdFixVersion is a fixversion command.
+func NewCmdFixVersion() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "fixversion",
+		Short:   "Fixversion manages release info of issues",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"fixversions", "version"},
+		RunE:    fixversion,
+	}
+
+	add := newCmdVersionOp(jira.UpdateOpAdd, "Add fix versions to issues")
+	add.Flags().Bool("create", false, "Create versions that don't exist in the project")
+
+	cmd.AddCommand(add, newCmdVersionOp(jira.UpdateOpRemove, "Remove fix versions from issues"))
+
+	return &cmd
+}
+
+func fixversion(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func newCmdVersionOp(op, short string) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   op + " ISSUE VERSION...",
+		Short: short,
+		Long:  helpText,
+		Args:  cobra.MinimumNArgs(2), //nolint:gomnd
+		Annotations: map[string]string{
+			"help:args": "ISSUE\tIssue key, comma separated issue keys or - to read them from stdin\n" +
+				"VERSION\tVersions to " + op,
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			update(cmd, args, op)
+		},
+	}
+
+	cmd.Flags().Bool("affects", false, "Update affected versions instead of fix versions")
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to update at once")
+
+	return &cmd
+}
+
+func update(cmd *cobra.Command, args []string, op string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	affects, err := cmd.Flags().GetBool("affects")
+	cmdutil.ExitIfError(err)
+
+	// Missing versions are never created when removing them.
+	create, _ := cmd.Flags().GetBool("create")
+
+	keys, err := cmdutil.GetJiraIssueKeys(viper.GetString("project.key"), args[0])
+	cmdutil.ExitIfError(err)
+	if len(keys) == 0 {
+		cmdutil.Failed("No issues to update")
+	}
+
+	field := jira.VersionFieldFix
+	if affects {
+		field = jira.VersionFieldAffects
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	versions, err := func() (map[string][]string, error) {
+		s := cmdutil.Info("Resolving versions...")
+		defer s.Stop()
+
+		out := make(map[string][]string)
+		for _, key := range keys {
+			project := strings.SplitN(key, "-", 2)[0] //nolint:gomnd
+			if _, ok := out[project]; ok {
+				continue
+			}
+			v, err := cmdcommon.ResolveVersions(client, project, args[1:], create)
+			if err != nil {
+				return nil, err
+			}
+			out[project] = v
+		}
+		return out, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	concurrency := cmdcommon.GetConcurrency(cmd.Flags())
+
+	passed, err := func() (int, error) {
+		s := cmdutil.Info(fmt.Sprintf("Updating versions of %d issue(s)...", len(keys)))
+		defer s.Stop()
+
+		return cmdcommon.UpdateIssues(keys, concurrency, func(key string) error {
+			project := strings.SplitN(key, "-", 2)[0] //nolint:gomnd
+			return client.UpdateVersions(key, field, op, versions[project])
+		})
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Versions updated for %d of %d issue(s)", passed, len(keys))
+	}
+	cmdutil.ExitIfError(err)
+}
@@ -0,0 +1,109 @@
+package history
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	pageSize = 100
+	helpText = `History lists field changes of an issue in chronological order.
+
+The changelog is fetched page by page until all changes of the issue are retrieved.
+Use --field to only display changes of the given fields, eg: status or assignee.`
+	examples = `$ jira issue history ISSUE-1
+
+# Only display status and assignee changes
+$ jira issue history ISSUE-1 --field status --field assignee
+
+# Display full field values in a plain view
+$ jira issue history ISSUE-1 --plain --no-truncate`
+)
+
+// NewCmdHistory is a history command.
+func NewCmdHistory() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "history ISSUE-KEY",
+		Short:   "List field changes of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"changelog"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdcommon.CompleteIssueKeys,
+		Run:               history,
+	}
+
+	cmd.Flags().StringArray("field", []string{}, "Only display changes of the field")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers")
+	cmd.Flags().Bool("no-truncate", false, "Don't truncate field values")
+
+	return &cmd
+}
+
+func history(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	fields, err := cmd.Flags().GetStringArray("field")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	noTruncate, err := cmd.Flags().GetBool("no-truncate")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	histories, err := func() ([]*jira.ChangelogHistory, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching history of issue %s...", key))
+		defer s.Stop()
+
+		return api.ProxyChangelog(api.Client(jira.Config{Debug: debug}), key, pageSize)
+	}()
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, histories))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	v := view.NewHistory(
+		histories,
+		view.WithHistoryFields(fields...),
+		view.WithHistoryDisplayFormat(view.DisplayFormat{
+			Plain:      plain,
+			NoHeaders:  noHeaders,
+			NoTruncate: noTruncate,
+		}),
+	)
+
+	if v.Len() == 0 {
+		fmt.Println()
+		cmdutil.Failed("No changes found for issue \"%s\"", key)
+		return
+	}
+	cmdutil.ExitIfError(v.Render())
+}
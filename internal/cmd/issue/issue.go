@@ -3,15 +3,33 @@ package issue
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/apply"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/archive"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/assign"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/attachment"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/branch"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/bulkedit"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/clone"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/comment"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/component"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/create"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/delete"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/edit"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/fixversion"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/history"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/label"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/link"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/move"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/pr"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/rank"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/subtask"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/unlink"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/view"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/vote"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/watch"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/watchers"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/weblink"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog"
 )
 
@@ -33,7 +51,14 @@ func NewCmdIssue() *cobra.Command {
 
 	cmd.AddCommand(
 		lc, cc, edit.NewCmdEdit(), move.NewCmdMove(), view.NewCmdView(), assign.NewCmdAssign(),
-		link.NewCmdLink(), comment.NewCmdComment(), clone.NewCmdClone(), worklog.NewCmdWorklog(),
+		link.NewCmdLink(), unlink.NewCmdUnlink(), comment.NewCmdComment(), clone.NewCmdClone(), worklog.NewCmdWorklog(),
+		label.NewCmdLabel(), component.NewCmdComponent(), fixversion.NewCmdFixVersion(),
+		watch.NewCmdWatch(), watch.NewCmdUnwatch(), watchers.NewCmdWatchers(),
+		vote.NewCmdVote(), vote.NewCmdUnvote(), attachment.NewCmdAttachment(),
+		delete.NewCmdDelete(), subtask.NewCmdSubtask(), history.NewCmdHistory(),
+		weblink.NewCmdWebLink(), bulkedit.NewCmdBulkEdit(),
+		archive.NewCmdArchive(), archive.NewCmdUnarchive(), rank.NewCmdRank(), apply.NewCmdApply(),
+		branch.NewCmdBranch(), pr.NewCmdPR(),
 	)
 
 	list.SetFlags(lc)
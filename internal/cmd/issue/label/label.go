@@ -0,0 +1,105 @@
+package label
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Label manages labels of issues. See available commands below.
+
+ISSUE can be a single issue key, comma separated issue keys or "-" to read issue keys
+from the standard input, eg: from the output of the issue list command.`
+	examples = `$ jira issue label add ISSUE-1 backend urgent
+$ jira issue label remove ISSUE-1,ISSUE-2 urgent
+
+# Replace labels of the issues
+$ jira issue label set ISSUE-1 backend
+
+# Clear labels of the issue
+$ jira issue label set ISSUE-1
+
+# Label issues from the list output
+$ jira issue list -s"To Do" --plain --no-headers --columns key | jira issue label add - backlog`
+)
+
+// NewCmdLabel is a label command.
+func NewCmdLabel() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "label",
+		Short:   "Label manages labels of issues",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"labels"},
+		RunE:    label,
+	}
+
+	cmd.AddCommand(
+		newCmdLabelOp(jira.UpdateOpAdd, "Add labels to issues", 2),
+		newCmdLabelOp(jira.UpdateOpRemove, "Remove labels from issues", 2),
+		newCmdLabelOp(jira.UpdateOpSet, "Replace labels of issues", 1),
+	)
+
+	return &cmd
+}
+
+func label(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func newCmdLabelOp(op, short string, minArgs int) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   op + " ISSUE LABEL...",
+		Short: short,
+		Long:  helpText,
+		Args:  cobra.MinimumNArgs(minArgs),
+		Annotations: map[string]string{
+			"help:args": "ISSUE\tIssue key, comma separated issue keys or - to read them from stdin\n" +
+				"LABEL\tLabels to " + op,
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			update(cmd, args, op)
+		},
+	}
+
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to update at once")
+
+	return &cmd
+}
+
+func update(cmd *cobra.Command, args []string, op string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	keys, err := cmdutil.GetJiraIssueKeys(viper.GetString("project.key"), args[0])
+	cmdutil.ExitIfError(err)
+	if len(keys) == 0 {
+		cmdutil.Failed("No issues to update")
+	}
+
+	labels := args[1:]
+	client := api.Client(jira.Config{Debug: debug})
+
+	concurrency := cmdcommon.GetConcurrency(cmd.Flags())
+
+	passed, err := func() (int, error) {
+		s := cmdutil.Info(fmt.Sprintf("Updating labels of %d issue(s)...", len(keys)))
+		defer s.Stop()
+
+		return cmdcommon.UpdateIssues(keys, concurrency, func(key string) error {
+			return client.UpdateLabels(key, op, labels)
+		})
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Labels updated for %d of %d issue(s)", passed, len(keys))
+	}
+	cmdutil.ExitIfError(err)
+}
@@ -3,6 +3,7 @@ package link
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -10,31 +11,47 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText     = `Link connects two issues to a given link type.`
-	examples     = `$ jira issue link ISSUE-1 ISSUE-2 Duplicate`
+	helpText = `Link connects two issues to a given link type.
+
+The relationship can either be the name of a link type, eg: Blocks, or how the first issue
+relates to the second one as shown in Jira, eg: "blocks" or "is blocked by". Available link
+types are fetched from the server and you will be asked to pick one if it is omitted.`
+	examples = `$ jira issue link ISSUE-1 blocks ISSUE-2
+$ jira issue link ISSUE-1 "is duplicated by" ISSUE-2
+
+# Link type name after issue keys links issues in the outward direction of the type
+$ jira issue link ISSUE-1 ISSUE-2 Duplicate
+
+# Pick link type interactively
+$ jira issue link ISSUE-1 ISSUE-2`
 	optionCancel = "Cancel"
 )
 
+var issueKeyRegex = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_]*-)?[0-9]+$`)
+
 // NewCmdLink is a link command.
 func NewCmdLink() *cobra.Command {
 	cmd := cobra.Command{
-		Use:     "link INWARD_ISSUE_KEY OUTWARD_ISSUE_KEY ISSUE_LINK_TYPE",
+		Use:     "link ISSUE_KEY [RELATION] LINKED_ISSUE_KEY [ISSUE_LINK_TYPE]",
 		Short:   "Link connects two issues",
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"ln"},
 		Annotations: map[string]string{
-			"help:args": "INWARD_ISSUE_KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
-				"OUTWARD_ISSUE_KEY\tIssue key of the target issue, eg: ISSUE-2\n" +
+			"help:args": "ISSUE_KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
+				"RELATION\tHow the source issue relates to the linked issue, eg: blocks, is blocked by etc.\n" +
+				"LINKED_ISSUE_KEY\tIssue key of the target issue, eg: ISSUE-2\n" +
 				"ISSUE_LINK_TYPE\tRelationship between two issues, eg: Duplicates, Blocks etc.",
 		},
-		Run: link,
+		ValidArgsFunction: completeRelation,
+		Run:               link,
 	}
 
 	cmd.Flags().Bool("web", false, "Open inward issue in web browser after successful linking")
@@ -53,7 +70,6 @@ func link(cmd *cobra.Command, args []string) {
 	}
 
 	cmdutil.ExitIfError(lc.setInwardIssueKey(project))
-	cmdutil.ExitIfError(lc.setOutwardIssueKey(project))
 	cmdutil.ExitIfError(lc.setLinkTypes())
 	cmdutil.ExitIfError(lc.setDesiredLinkType())
 
@@ -62,24 +78,31 @@ func link(cmd *cobra.Command, args []string) {
 		os.Exit(0)
 	}
 
-	lt, err := lc.verifyIssueLinkType()
+	rel, err := lc.verifyIssueLinkType()
 	if err != nil {
 		fmt.Println()
 		cmdutil.Failed("Error: %s", err.Error())
 		return
 	}
 
+	cmdutil.ExitIfError(lc.setOutwardIssueKey(project))
+
+	inward, outward := lc.params.inwardIssueKey, lc.params.outwardIssueKey
+	if rel.reverse {
+		inward, outward = outward, inward
+	}
+
 	err = func() error {
 		s := cmdutil.Info("Linking issues")
 		defer s.Stop()
 
-		return client.LinkIssue(lc.params.inwardIssueKey, lc.params.outwardIssueKey, lt.Name)
+		return client.LinkIssue(inward, outward, rel.linkType.Name)
 	}()
 	cmdutil.ExitIfError(err)
 
 	server := viper.GetString("server")
 
-	cmdutil.Success("Issues linked as \"%s\"", lc.params.linkType)
+	cmdutil.Success("Issues linked: %s %s %s", lc.params.inwardIssueKey, rel.name, lc.params.outwardIssueKey)
 	fmt.Printf("%s/browse/%s\n", server, lc.params.inwardIssueKey)
 
 	if web, _ := cmd.Flags().GetBool("web"); web {
@@ -103,10 +126,18 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *l
 		inwardIssueKey = cmdutil.GetJiraIssueKey(project, args[0])
 	}
 	if nargs >= 2 {
-		outwardIssueKey = cmdutil.GetJiraIssueKey(project, args[1])
-	}
-	if nargs >= 3 {
-		linkType = args[2]
+		if isIssueKey(args[1]) {
+			// Legacy form: ISSUE_KEY LINKED_ISSUE_KEY [ISSUE_LINK_TYPE].
+			outwardIssueKey = cmdutil.GetJiraIssueKey(project, args[1])
+			if nargs >= 3 {
+				linkType = args[2]
+			}
+		} else {
+			linkType = args[1]
+			if nargs >= 3 {
+				outwardIssueKey = cmdutil.GetJiraIssueKey(project, args[2])
+			}
+		}
 	}
 
 	debug, err := flags.GetBool("debug")
@@ -120,6 +151,50 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *l
 	}
 }
 
+func isIssueKey(s string) bool {
+	return issueKeyRegex.MatchString(s)
+}
+
+// relation is a link type seen from the source issue.
+type relation struct {
+	name     string
+	linkType *jira.IssueLinkType
+	// reverse is set if the source issue is the outward issue of the link.
+	reverse bool
+}
+
+// relations lists both directions of the link types.
+func relations(types []*jira.IssueLinkType) []*relation {
+	out := make([]*relation, 0, len(types)*2)
+	for _, t := range types {
+		out = append(out, &relation{name: t.Outward, linkType: t})
+		if !strings.EqualFold(t.Inward, t.Outward) {
+			out = append(out, &relation{name: t.Inward, linkType: t, reverse: true})
+		}
+	}
+	return out
+}
+
+func completeRelation(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return cmdcommon.CompleteIssueKeys(cmd, args, toComplete)
+	}
+	if len(args) != 1 {
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+
+	types, err := api.Client(jira.Config{}).GetIssueLinkTypes()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	out := make([]string, 0, len(types)*2)
+	for _, r := range relations(types) {
+		out = append(out, fmt.Sprintf("%s\t%s", r.name, r.linkType.Name))
+	}
+	return out, cobra.ShellCompDirectiveNoFileComp
+}
+
 type linkCmd struct {
 	client    *jira.Client
 	linkTypes []*jira.IssueLinkType
@@ -135,7 +210,7 @@ func (lc *linkCmd) setInwardIssueKey(project string) error {
 
 	qs := &survey.Question{
 		Name:     "inwardIssueKey",
-		Prompt:   &survey.Input{Message: "Inward issue key"},
+		Prompt:   &survey.Input{Message: "Issue key"},
 		Validate: survey.Required,
 	}
 	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
@@ -155,7 +230,7 @@ func (lc *linkCmd) setOutwardIssueKey(project string) error {
 
 	qs := &survey.Question{
 		Name:     "outwardIssueKey",
-		Prompt:   &survey.Input{Message: "Outward issue key"},
+		Prompt:   &survey.Input{Message: "Linked issue key"},
 		Validate: survey.Required,
 	}
 	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
@@ -184,18 +259,19 @@ func (lc *linkCmd) setDesiredLinkType() error {
 		return nil
 	}
 
-	options := make([]string, 0, len(lc.linkTypes))
-	for _, t := range lc.linkTypes {
-		options = append(options, fmt.Sprintf("%s: %s", t.Name, t.Inward))
+	rels := relations(lc.linkTypes)
+	options := make([]string, 0, len(rels)+1)
+	for _, r := range rels {
+		options = append(options, fmt.Sprintf("%s (%s)", r.name, r.linkType.Name))
 	}
 	options = append(options, optionCancel)
 
-	var ans string
+	var ans int
 
 	qs := &survey.Question{
 		Name: "linkType",
 		Prompt: &survey.Select{
-			Message: "Link type:",
+			Message: fmt.Sprintf("%s:", lc.params.inwardIssueKey),
 			Options: options,
 		},
 		Validate: survey.Required,
@@ -203,28 +279,33 @@ func (lc *linkCmd) setDesiredLinkType() error {
 	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
 		return err
 	}
-	lc.params.linkType = strings.Split(ans, ":")[0]
+	if ans == len(rels) {
+		lc.params.linkType = optionCancel
+	} else {
+		lc.params.linkType = rels[ans].name
+	}
 
 	return nil
 }
 
-func (lc *linkCmd) verifyIssueLinkType() (*jira.IssueLinkType, error) {
-	var lt *jira.IssueLinkType
-
+func (lc *linkCmd) verifyIssueLinkType() (*relation, error) {
 	st := strings.ToLower(lc.params.linkType)
 	all := make([]string, 0, len(lc.linkTypes))
 	for _, t := range lc.linkTypes {
 		if strings.ToLower(t.Name) == st {
-			lt = t
+			return &relation{name: t.Outward, linkType: t}, nil
 		}
 		all = append(all, fmt.Sprintf("'%s'", t.Name))
 	}
-
-	if lt == nil {
-		return nil, fmt.Errorf(
-			"invalid issue link type \"%s\"\nAvailable issue link types are: %s",
-			lc.params.linkType, strings.Join(all, ", "),
-		)
+	for _, r := range relations(lc.linkTypes) {
+		if strings.ToLower(r.name) == st {
+			return r, nil
+		}
+		all = append(all, fmt.Sprintf("'%s'", r.name))
 	}
-	return lt, nil
+
+	return nil, fmt.Errorf(
+		"invalid issue link type \"%s\"\nAvailable issue link types are: %s",
+		lc.params.linkType, strings.Join(all, ", "),
+	)
 }
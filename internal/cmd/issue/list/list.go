@@ -2,12 +2,14 @@ package list
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
@@ -24,16 +26,37 @@ jira issue list -yHigh -s"In Progress" --created month -lbackend -l"high prio"
 
 Issues are displayed in an interactive list view by default. You can use a --plain flag
 to display output in a plain text mode. A --no-headers flag will hide the table headers
-in plain view. A --no-truncate flag will display all available fields in plain mode.`
+in plain view. A --no-truncate flag will display all available fields in plain mode.
+
+Use a --jql flag to run a raw JQL query in the project context. The query is appended to the
+query built from other flags with AND, or with OR if the query starts with OR. Results are
+fetched page by page until the --limit is reached, so the limit can go beyond the page size
+of the server.
+
+Use a --format flag to render each issue on its own line using a Go template, eg: to generate
+commit message prefixes or changelog lines. The template receives the issue as returned by the
+API and supports the same functions as the --template flag of the view command.
+
+Use a --filter flag to run the JQL of a saved filter, referenced by its id or name, in the
+project context. The ordering of the filter is ignored in favour of the --order-by flag.`
 
 	examples = `$ jira issue list
 
 # List issues in a plain table view without headers
 $ jira issue list --plain --no-headers
 
+# List all issues created this month, fetching as many pages as needed
+$ jira issue list --created month --paginate
+
+# List issues in sections grouped by the assignee
+$ jira issue list --group-by assignee
+
 # List some columns of the issue in a plain table view
 $ jira issue list --plain --columns key,assignee,status
 
+# Custom fields can be displayed by their name
+$ jira issue list --plain --columns "key,summary,story points"
+
 # List issues in a plain table view and show all fields
 $ jira issue list --plain --no-truncate
 
@@ -41,7 +64,22 @@ $ jira issue list --plain --no-truncate
 $ jira issue list -tEpic -sDone
 
 # List issues in status other than "Open" and is assigned to no one
-$ jira issue list -s~Open -ax`
+$ jira issue list -s~Open -ax
+
+# List issues using a raw JQL query
+$ jira issue list -q"summary ~ cli AND updated >= -2w" --limit 500
+
+# List issues in json format for scripts
+$ jira issue list -s"In Progress" -o json | jq -r '.[].key'
+
+# Export some columns of the issues in csv format
+$ jira issue list -o csv --columns key,summary,status,assignee > issues.csv
+
+# Print each issue using a Go template, eg: to generate changelog lines
+$ jira issue list -sDone --format '- {{.Key}}: {{.Fields.Summary}} ({{.Fields.Assignee.Name}})'
+
+# List issues using a saved filter
+$ jira issue list --filter "My open bugs"`
 
 	defaultLimit = 100
 )
@@ -54,15 +92,70 @@ func NewCmdList() *cobra.Command {
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"lists", "ls"},
-		Run:     List,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.ExitIfError(useDefaultJQL(cmd))
+			List(cmd, args)
+		},
 	}
 }
 
+// useDefaultJQL runs the JQL in the issue.jql key of the config, eg: set per project in
+// a .jira.yml file, unless a query or a saved filter is passed.
+func useDefaultJQL(cmd *cobra.Command) error {
+	q := viper.GetString("issue.jql")
+	if q == "" || cmd.Flags().Changed("jql") || cmd.Flags().Changed("filter") {
+		return nil
+	}
+	return cmd.Flags().Set("jql", q)
+}
+
 // List displays a list view.
 func List(cmd *cobra.Command, _ []string) {
+	cmdutil.ExitIfError(applySavedFilter(cmd))
 	loadList(cmd)
 }
 
+// applySavedFilter adds the JQL of the saved filter passed in --filter flag to the raw JQL query.
+func applySavedFilter(cmd *cobra.Command) error {
+	if cmd.Flags().Lookup("filter") == nil {
+		return nil
+	}
+
+	name, err := cmd.Flags().GetString("filter")
+	if err != nil || name == "" {
+		return err
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	if err != nil {
+		return err
+	}
+
+	filter, err := func() (*jira.SavedFilter, error) {
+		s := cmdutil.Info("Fetching saved filter...")
+		defer s.Stop()
+
+		return cmdcommon.FindSavedFilter(api.Client(jira.Config{Debug: debug}), name)
+	}()
+	if err != nil {
+		return err
+	}
+
+	q := cmdcommon.SavedFilterJQL(filter)
+	raw, err := cmd.Flags().GetString("jql")
+	if err != nil {
+		return err
+	}
+	switch {
+	case q == "":
+		q = raw
+	case raw != "":
+		q = fmt.Sprintf("(%s) AND (%s)", q, raw)
+	}
+
+	return cmd.Flags().Set("jql", q)
+}
+
 func loadList(cmd *cobra.Command) {
 	server := viper.GetString("server")
 	project := viper.GetString("project.key")
@@ -85,7 +178,9 @@ func loadList(cmd *cobra.Command) {
 			return nil, 0, err
 		}
 
-		resp, err := api.ProxySearch(api.Client(jira.Config{Debug: debug}), q.Get(), q.Params().Limit)
+		resp, err := api.ProxySearchWithProgress(
+			api.Client(jira.Config{Debug: debug}), q.Get(), q.Params().Limit, cmdutil.InfoProgress(s, "Fetching issues..."),
+		)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -94,6 +189,25 @@ func loadList(cmd *cobra.Command) {
 	}()
 	cmdutil.ExitIfError(err)
 
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, issues))
+		return
+	}
+	if output != "" && output != view.OutputCSV {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	format, err := cmd.Flags().GetString("format")
+	cmdutil.ExitIfError(err)
+
+	if format != "" {
+		cmdutil.ExitIfError(view.RenderIssuesTemplate(os.Stdout, server, format, issues))
+		return
+	}
+
 	if total == 0 {
 		fmt.Println()
 		cmdutil.Failed("No result found for given query in project \"%s\"", project)
@@ -109,7 +223,16 @@ func loadList(cmd *cobra.Command) {
 	noTruncate, err := cmd.Flags().GetBool("no-truncate")
 	cmdutil.ExitIfError(err)
 
-	columns, err := cmd.Flags().GetString("columns")
+	columnsFlag, err := cmd.Flags().GetString("columns")
+	cmdutil.ExitIfError(err)
+
+	columns, customColumns, err := cmdcommon.IssueColumns(api.Client(jira.Config{Debug: debug}), "issue", columnsFlag)
+	cmdutil.ExitIfError(err)
+
+	groupByFlag, err := cmd.Flags().GetString("group-by")
+	cmdutil.ExitIfError(err)
+
+	groupBy, err := cmdcommon.IssueGroupBy(groupByFlag)
 	cmdutil.ExitIfError(err)
 
 	v := view.IssueList{
@@ -120,19 +243,22 @@ func loadList(cmd *cobra.Command) {
 		Refresh: func() {
 			loadList(cmd)
 		},
+		Actions: cmdcommon.IssueActions(api.Client(jira.Config{Debug: debug}), project),
 		Display: view.DisplayFormat{
-			Plain:      plain,
-			NoHeaders:  noHeaders,
-			NoTruncate: noTruncate,
-			Columns: func() []string {
-				if columns != "" {
-					return strings.Split(columns, ",")
-				}
-				return []string{}
-			}(),
+			Plain:         plain,
+			NoHeaders:     noHeaders,
+			NoTruncate:    noTruncate,
+			Columns:       columns,
+			CustomColumns: customColumns,
+			GroupBy:       groupBy,
 		},
 	}
 
+	if output == view.OutputCSV {
+		cmdutil.ExitIfError(v.RenderCSV(os.Stdout))
+		return
+	}
+
 	cmdutil.ExitIfError(v.Render())
 }
 
@@ -163,16 +289,29 @@ func SetFlags(cmd *cobra.Command) {
 	cmd.Flags().String("updated-after", "", "Filter by issues updated after certain date")
 	cmd.Flags().String("created-before", "", "Filter by issues created before certain date")
 	cmd.Flags().String("updated-before", "", "Filter by issues updated before certain date")
-	cmd.Flags().StringP("jql", "q", "", "Run a raw JQL query in a given project context")
-	cmd.Flags().String("order-by", "created", "Field to order the list with")
+	cmd.Flags().StringP("jql", "q", "", "Run a raw JQL query in a given project context\n"+
+		"The query is appended to the query of the command with AND, start it with OR to append it with OR instead")
+	if cmd.HasParent() && cmd.Parent().Name() == "issue" {
+		cmd.Flags().String("filter", "", "Run the JQL of a saved filter in a given project context (id or name)")
+	}
+	cmd.Flags().String("order-by", "created", "Comma separated list of fields to order the list with, "+
+		"each with an optional direction, eg: 'priority desc, updated asc'")
 	cmd.Flags().Bool("reverse", false, "Reverse the display order (default \"DESC\")")
-	cmd.Flags().Uint("limit", defaultLimit, "Number of results to return")
+	cmd.Flags().Uint("limit", defaultLimit, "Number of results to return, fetched page by page if Jira caps the page size")
+	cmd.Flags().Bool("paginate", false, "Fetch all results, ignores --limit")
 	cmd.Flags().Bool("plain", false, "Display output in plain mode")
 	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
 	cmd.Flags().Bool("no-truncate", false, "Show all available columns in plain mode. Works only with --plain")
 
+	cmd.Flags().String("group-by", "", "Display issues in sections grouped by a field in the table and plain view.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(cmdcommon.IssueGroupByFields, ", ")))
+	cmd.Flags().String("format", "", "Render each issue using the Go template, eg: '{{.Key}}\\t{{.Fields.Summary}}'\n"+
+		"See 'jira issue view --help' for the available template functions")
+
 	if cmd.HasParent() && cmd.Parent().Name() != "sprint" {
-		cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode.\n"+
-			fmt.Sprintf("Accepts: %s", strings.Join(view.ValidIssueColumns(), ", ")))
+		cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode and csv output.\n"+
+			fmt.Sprintf("Accepts: %s, or the name of a custom field, eg: \"Story Points\"\n",
+				strings.Join(view.ValidIssueColumns(), ", "))+
+			fmt.Sprintf("Defaults to the columns set in the config, eg: columns.%s", cmd.Parent().Name()))
 	}
 }
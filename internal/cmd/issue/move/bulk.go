@@ -0,0 +1,165 @@
+package move
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/queue"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// bulkResult is the outcome of transitioning a single issue in bulk.
+type bulkResult struct {
+	key        string
+	transition string
+	err        error
+}
+
+// bulk transitions issues read from the standard input to the desired state.
+func (mc *moveCmd) bulk(installation string) {
+	if mc.params.targetProject != "" {
+		cmdutil.Failed("--bulk can't be used with --target-project")
+	}
+	if !cmdutil.StdinHasData() {
+		cmdutil.Failed("No issue keys found, pipe issue keys to the standard input to use --bulk")
+	}
+
+	keys, err := cmdutil.GetJiraIssueKeys(viper.GetString("project.key"), "-")
+	cmdutil.ExitIfError(err)
+	if len(keys) == 0 {
+		cmdutil.Failed("No issues to transition")
+	}
+
+	results := func() []*bulkResult {
+		s := cmdutil.Info(fmt.Sprintf("Transitioning %d issue(s) to \"%s\"...", len(keys), mc.params.state))
+		defer s.Stop()
+
+		return mc.bulkTransition(keys, installation, mc.params.concurrency)
+	}()
+
+	cmdutil.ExitIfError(printBulkResults(results, mc.params.state))
+}
+
+// bulkTransition transitions the issues to the given state, running at most
+// concurrency transitions at once. Results are in the same order as the keys.
+func (mc *moveCmd) bulkTransition(keys []string, installation string, concurrency int) []*bulkResult {
+	results := make([]*bulkResult, len(keys))
+
+	cmdcommon.RunConcurrently(len(keys), concurrency, func(i int) error {
+		name, err := mc.transitionIssue(keys[i], installation)
+		results[i] = &bulkResult{key: keys[i], transition: name, err: err}
+		return err
+	})
+
+	return results
+}
+
+// transitionIssue resolves the transition matching the desired state for the issue
+// and performs it without prompting. It returns the name of the performed transition.
+func (mc *moveCmd) transitionIssue(key, installation string) (string, error) {
+	trs, err := api.ProxyTransitions(mc.client, key)
+	if err != nil {
+		return "", err
+	}
+
+	tr := findTransition(trs, mc.params.state, installation)
+	if tr == nil {
+		all := make([]string, 0, len(trs))
+		for _, t := range trs {
+			all = append(all, fmt.Sprintf("'%s'", t.Name))
+		}
+		return "", fmt.Errorf("no transition to state %q, available states: %s", mc.params.state, strings.Join(all, ", "))
+	}
+
+	fields, err := mc.screenFields(tr, installation, false)
+	if err != nil {
+		return "", err
+	}
+
+	req := jira.TransitionRequest{
+		Transition: &jira.TransitionRequestData{ID: tr.ID.String(), Name: tr.Name},
+		Fields:     fields,
+	}
+	if mc.params.comment != "" {
+		req.AddComment(mc.params.comment)
+	}
+
+	if _, err := mc.client.Transition(key, &req); err != nil {
+		return "", err
+	}
+	return tr.Name, nil
+}
+
+// Replay transitions the issue as queued while Jira was unreachable, see queued.
+// Transition is resolved by its name or its target state like in bulk transitions.
+func Replay(client *jira.Client, key string, tr *queue.Transition) (string, error) {
+	mc := moveCmd{
+		client: client,
+		params: &moveParams{
+			state:      tr.State,
+			resolution: tr.Resolution,
+			fields:     tr.Fields,
+			comment:    tr.Comment,
+		},
+	}
+	return mc.transitionIssue(key, viper.GetString("installation"))
+}
+
+// TransitionTo transitions the issue to the state without prompting, see Replay.
+// It returns the name of the performed transition.
+func TransitionTo(client *jira.Client, key, state string) (string, error) {
+	return Replay(client, key, &queue.Transition{State: state})
+}
+
+// findTransition finds a transition by its name or, as workflows often name transitions
+// differently from their target states, by the name of the state it leads to.
+func findTransition(trs []*jira.Transition, state, installation string) *jira.Transition {
+	var byStatus *jira.Transition
+
+	for _, t := range trs {
+		// Jira API v2 doesn't return "isAvailable" field, see verifyTransition.
+		if installation == jira.InstallationTypeCloud && !t.IsAvailable {
+			continue
+		}
+		if strings.EqualFold(t.Name, state) {
+			return t
+		}
+		if byStatus == nil && t.To != nil && strings.EqualFold(t.To.Name, state) {
+			byStatus = t
+		}
+	}
+	return byStatus
+}
+
+// printBulkResults prints a per-issue summary of the bulk transition and
+// returns an error listing the failed issues, if any.
+func printBulkResults(results []*bulkResult, state string) error {
+	var (
+		failed strings.Builder
+		passed strings.Builder
+		n      int
+	)
+
+	for _, r := range results {
+		if r.err != nil {
+			failed.WriteString(fmt.Sprintf("\n  - %s: %s", r.key, cmdutil.NormalizeJiraError(r.err.Error())))
+			continue
+		}
+		passed.WriteString(fmt.Sprintf("  - %s: %s\n", r.key, r.transition))
+		n++
+	}
+
+	if n > 0 {
+		cmdutil.Success("Transitioned %d of %d issue(s) to state \"%s\"", n, len(results), state)
+		fmt.Print(passed.String())
+	}
+	if failed.Len() > 0 {
+		return &jira.ErrMultipleFailed{Msg: failed.String()}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package move
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	fieldComment    = "comment"
+	fieldResolution = "resolution"
+)
+
+// screenFields resolves values of the transition screen fields. Values passed with flags
+// are used as is and required fields without a value are prompted for unless prompt is false.
+func (mc *moveCmd) screenFields(tr *jira.Transition, installation string, prompt bool) (map[string]interface{}, error) {
+	screen := tr.ScreenFields()
+	fields := make(map[string]interface{})
+
+	values := make(map[string]string, len(mc.params.fields)+1)
+	for _, f := range mc.params.fields {
+		pieces := strings.SplitN(f, "=", 2) //nolint:gomnd
+		if len(pieces) != 2 || strings.TrimSpace(pieces[0]) == "" {
+			return nil, fmt.Errorf("invalid field %q, expected name=value", f)
+		}
+		values[strings.TrimSpace(pieces[0])] = strings.TrimSpace(pieces[1])
+	}
+	if mc.params.resolution != "" {
+		values[fieldResolution] = mc.params.resolution
+	}
+
+	for name, val := range values {
+		f := findScreenField(screen, name)
+		if f == nil {
+			return nil, fmt.Errorf("field %q is not available in the %q transition screen", name, tr.Name)
+		}
+		v, err := f.Value(val, installation)
+		if err != nil {
+			return nil, err
+		}
+		fields[f.ID] = v
+	}
+
+	for _, f := range screen {
+		if _, ok := fields[f.ID]; ok || !f.Required || f.ID == fieldComment {
+			continue
+		}
+		if !prompt {
+			return nil, fmt.Errorf("field %q is required by the %q transition, pass it with --field", f.Name, tr.Name)
+		}
+
+		val, err := askScreenField(f)
+		if err != nil {
+			return nil, err
+		}
+		v, err := f.Value(val, installation)
+		if err != nil {
+			return nil, err
+		}
+		fields[f.ID] = v
+	}
+
+	return fields, nil
+}
+
+func findScreenField(screen []*jira.TransitionField, name string) *jira.TransitionField {
+	for _, f := range screen {
+		if f.ID == name {
+			return f
+		}
+	}
+	for _, f := range screen {
+		if strings.EqualFold(f.Name, name) {
+			return f
+		}
+	}
+	return nil
+}
+
+func askScreenField(f *jira.TransitionField) (string, error) {
+	var (
+		prompt  survey.Prompt
+		message = fmt.Sprintf("%s:", f.Name)
+	)
+
+	opts := f.Options()
+
+	switch {
+	case len(opts) > 0 && f.Schema.Type == jira.FieldTypeArray:
+		var ans []string
+
+		qs := &survey.Question{
+			Name:     f.ID,
+			Prompt:   &survey.MultiSelect{Message: message, Options: opts},
+			Validate: survey.Required,
+		}
+		if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+			return "", err
+		}
+		return strings.Join(ans, ","), nil
+	case len(opts) > 0:
+		prompt = &survey.Select{Message: message, Options: opts}
+	default:
+		prompt = &survey.Input{Message: message}
+	}
+
+	var ans string
+
+	qs := &survey.Question{
+		Name:     f.ID,
+		Prompt:   prompt,
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return "", err
+	}
+	return ans, nil
+}
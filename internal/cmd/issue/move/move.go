@@ -10,15 +10,47 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/queue"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText = `Move transitions an issue from one state to another.`
+	helpText = `Move transitions an issue from one state to another.
+
+If the transition screen has required fields, eg: resolution, you will be asked to fill them.
+Use --resolution and --field to pass the values upfront and --comment to add a comment along
+with the transition.
+
+Use --target-project to move an issue to another project instead. The issue keeps its type and
+state if they exist in the target project, otherwise you will be asked to choose new ones.
+STATE, if given, is the state of the issue in the target project.
+
+If the server doesn't support moving issues, the issue is re-created in the target project,
+linked to the original issue and the original issue is closed.
+
+Use --bulk to transition issues piped to the standard input, one per line, eg: the output of
+issue list --plain. The transition is resolved per issue by its name or its target state and
+a summary of transitioned and failed issues is printed at the end.`
 	examples = `$ jira issue move ISSUE-1 "In Progress"
-$ jira issue move ISSUE-1 Done`
+$ jira issue move ISSUE-1 Done
+
+# Fill fields of the transition screen and add a comment
+$ jira issue move ISSUE-1 Done --resolution Fixed --field "Root cause=Code" --comment "Fixed in v1.2"
+
+# Move issue to another project
+$ jira issue move ISSUE-1 --target-project NEW
+
+# Move issue to another project as a story in "To Do" state
+$ jira issue move ISSUE-1 "To Do" --target-project NEW --type Story
+
+# Transition all issues in review to done
+$ jira issue list -s"In Review" --plain --no-headers | jira issue transition --bulk Done
+
+# Transition issues read from a file, 10 at a time
+$ jira issue transition --bulk Done --concurrency 10 < issues.txt`
 
 	optionCancel = "Cancel"
 )
@@ -27,18 +59,26 @@ $ jira issue move ISSUE-1 Done`
 func NewCmdMove() *cobra.Command {
 	cmd := cobra.Command{
 		Use:     "move ISSUE-KEY STATE",
-		Short:   "Transition an issue to a given state",
+		Short:   "Transition an issue to a given state or move it to another project",
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"transition", "mv"},
 		Annotations: map[string]string{
 			"help:args": `ISSUE-KEY	Issue key, eg: ISSUE-1
-STATE		State you want to transition the issue to`,
+STATE		State you want to transition the issue to, or its state in the target project`,
 		},
-		Run: move,
+		ValidArgsFunction: cmdcommon.CompleteIssueKeyAndTransition,
+		Run:               move,
 	}
 
 	cmd.Flags().Bool("web", false, "Open issue in web browser after successful transition")
+	cmd.Flags().StringP("resolution", "R", "", "Resolution of the issue if the transition screen asks for it")
+	cmd.Flags().StringArray("field", []string{}, "Field of the transition screen in name=value format. Can be used multiple times")
+	cmd.Flags().StringP("comment", "m", "", "Comment to add to the issue along with the transition")
+	cmd.Flags().String("target-project", "", "Move the issue to the given project")
+	cmd.Flags().StringP("type", "t", "", "Issue type in the target project, used with --target-project")
+	cmd.Flags().String("bulk", "", "Transition issues read from the standard input to the given state")
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to transition at once, used with --bulk")
 
 	return &cmd
 }
@@ -54,8 +94,41 @@ func move(cmd *cobra.Command, args []string) {
 		params:      params,
 	}
 
+	if mc.params.bulk {
+		mc.bulk(installation)
+		return
+	}
+
 	cmdutil.ExitIfError(mc.setIssueKey(project))
-	cmdutil.ExitIfError(mc.setAvailableTransitions())
+
+	server := viper.GetString("server")
+
+	if mc.params.targetProject != "" {
+		key, err := mc.moveToProject(installation)
+		if err != nil {
+			if key != "" {
+				fmt.Printf("%s/browse/%s\n", server, key)
+			}
+			cmdutil.ExitIfError(err)
+		}
+
+		cmdutil.Success("Issue %s moved to project %s", mc.params.key, mc.params.targetProject)
+		fmt.Printf("%s/browse/%s\n", server, key)
+
+		if mc.params.web {
+			cmdutil.ExitIfError(cmdutil.Navigate(server, key))
+		}
+		return
+	}
+
+	// Transitions can't be fetched while Jira is unreachable, so the transition to the
+	// given state is queued and resolved once it is sent with jira sync.
+	err := mc.setAvailableTransitions()
+	if api.IsUnreachable(err) && mc.params.state != "" {
+		cmdcommon.QueueOperation(mc.queued())
+		return
+	}
+	cmdutil.ExitIfError(err)
 	cmdutil.ExitIfError(mc.setDesiredState(installation))
 
 	if mc.params.state == optionCancel {
@@ -70,32 +143,51 @@ func move(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	fields, err := mc.screenFields(tr, installation, true)
+	cmdutil.ExitIfError(err)
+
+	req := jira.TransitionRequest{
+		Transition: &jira.TransitionRequestData{ID: tr.ID.String(), Name: tr.Name},
+		Fields:     fields,
+	}
+	if mc.params.comment != "" {
+		req.AddComment(mc.params.comment)
+	}
+
 	err = func() error {
 		s := cmdutil.Info(fmt.Sprintf("Transitioning issue to \"%s\"...", tr.Name))
 		defer s.Stop()
 
-		_, err := client.Transition(mc.params.key, &jira.TransitionRequest{
-			Transition: &jira.TransitionRequestData{ID: tr.ID.String(), Name: tr.Name},
-		})
+		_, err := client.Transition(mc.params.key, &req)
 		return err
 	}()
+	if api.IsUnreachable(err) {
+		cmdcommon.QueueOperation(mc.queued())
+		return
+	}
 	cmdutil.ExitIfError(err)
 
-	server := viper.GetString("server")
-
 	cmdutil.Success("Issue transitioned to state \"%s\"", tr.Name)
 	fmt.Printf("%s/browse/%s\n", server, mc.params.key)
 
-	if web, _ := cmd.Flags().GetBool("web"); web {
+	if mc.params.web {
 		err := cmdutil.Navigate(server, mc.params.key)
 		cmdutil.ExitIfError(err)
 	}
 }
 
 type moveParams struct {
-	key   string
-	state string
-	debug bool
+	key           string
+	state         string
+	resolution    string
+	fields        []string
+	comment       string
+	targetProject string
+	issueType     string
+	bulk          bool
+	concurrency   int
+	web           bool
+	debug         bool
 }
 
 func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *moveParams {
@@ -109,13 +201,48 @@ func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *m
 		state = args[1]
 	}
 
+	resolution, err := flags.GetString("resolution")
+	cmdutil.ExitIfError(err)
+
+	fields, err := flags.GetStringArray("field")
+	cmdutil.ExitIfError(err)
+
+	comment, err := flags.GetString("comment")
+	cmdutil.ExitIfError(err)
+
+	targetProject, err := flags.GetString("target-project")
+	cmdutil.ExitIfError(err)
+
+	issueType, err := flags.GetString("type")
+	cmdutil.ExitIfError(err)
+
+	bulkState, err := flags.GetString("bulk")
+	cmdutil.ExitIfError(err)
+	if bulkState != "" {
+		if nargs > 0 {
+			cmdutil.Failed("--bulk reads issue keys from the standard input and doesn't accept arguments")
+		}
+		state = bulkState
+	}
+
+	web, err := flags.GetBool("web")
+	cmdutil.ExitIfError(err)
+
 	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
 	return &moveParams{
-		key:   key,
-		state: state,
-		debug: debug,
+		key:           key,
+		state:         state,
+		resolution:    resolution,
+		fields:        fields,
+		comment:       comment,
+		targetProject: strings.ToUpper(targetProject),
+		issueType:     issueType,
+		bulk:          bulkState != "",
+		concurrency:   cmdcommon.GetConcurrency(flags),
+		web:           web,
+		debug:         debug,
 	}
 }
 
@@ -221,3 +348,17 @@ func (mc *moveCmd) verifyTransition(it string) (*jira.Transition, error) {
 	}
 	return tr, nil
 }
+
+// queued returns the transition of the issue to be queued while Jira is unreachable.
+func (mc *moveCmd) queued() *queue.Operation {
+	return &queue.Operation{
+		Kind:     queue.KindTransition,
+		IssueKey: mc.params.key,
+		Transition: &queue.Transition{
+			State:      mc.params.state,
+			Resolution: mc.params.resolution,
+			Fields:     mc.params.fields,
+			Comment:    mc.params.comment,
+		},
+	}
+}
@@ -0,0 +1,263 @@
+package move
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	taskPollInterval = time.Second
+	taskTimeout      = 2 * time.Minute
+
+	linkTypeClone    = "Cloners"
+	linkTypeRelates  = "Relates"
+	linkOutwardClone = "clones"
+)
+
+// moveToProject moves the issue to the target project. The move API is used if the
+// installation exposes it, otherwise the issue is re-created in the target project,
+// linked to the original issue and the original issue is closed.
+func (mc *moveCmd) moveToProject(installation string) (string, error) {
+	issue, err := func() (*jira.Issue, error) {
+		s := cmdutil.Info("Fetching issue details...")
+		defer s.Stop()
+
+		return api.ProxyGetIssue(mc.client, mc.params.key)
+	}()
+	if err != nil {
+		return "", err
+	}
+
+	if strings.EqualFold(cmdcommon.ProjectKey(issue.Key), mc.params.targetProject) {
+		return "", fmt.Errorf("issue %s already belongs to project %s", issue.Key, mc.params.targetProject)
+	}
+
+	types, err := func() ([]*jira.IssueTypeStatuses, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching issue types of project %s...", mc.params.targetProject))
+		defer s.Stop()
+
+		return mc.client.ProjectStatuses(mc.params.targetProject)
+	}()
+	if err != nil {
+		return "", err
+	}
+
+	it, err := mc.mapIssueType(issue, types)
+	if err != nil {
+		return "", err
+	}
+	st, err := mc.mapStatus(issue, it)
+	if err != nil {
+		return "", err
+	}
+
+	if installation != jira.InstallationTypeLocal {
+		key, err := mc.move(issue, it, st)
+		if !errors.Is(err, jira.ErrMoveNotSupported) {
+			return key, err
+		}
+		cmdutil.Warn("Move is not supported by the server, re-creating the issue in project %s", mc.params.targetProject)
+	}
+
+	return mc.recreate(issue, it, st)
+}
+
+func (mc *moveCmd) move(issue *jira.Issue, it *jira.IssueTypeStatuses, st *jira.Status) (string, error) {
+	s := cmdutil.Info(fmt.Sprintf("Moving issue to project %s...", mc.params.targetProject))
+	defer s.Stop()
+
+	id, err := mc.client.Move(&jira.MoveRequest{
+		Keys:        []string{issue.Key},
+		Project:     mc.params.targetProject,
+		IssueTypeID: it.ID,
+		StatusID:    st.ID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for start := time.Now(); time.Since(start) < taskTimeout; time.Sleep(taskPollInterval) {
+		task, err := mc.client.GetTask(id)
+		if err != nil {
+			return "", err
+		}
+		if !task.Done() {
+			continue
+		}
+		if task.Status != jira.TaskStatusComplete {
+			return "", fmt.Errorf("move task %s finished with status %s %s", id, task.Status, task.Message)
+		}
+
+		// Old issue key keeps pointing to the moved issue.
+		moved, err := api.ProxyGetIssue(mc.client, issue.Key)
+		if err != nil {
+			return "", err
+		}
+		return moved.Key, nil
+	}
+
+	return "", fmt.Errorf("timed out waiting for move task %s to finish", id)
+}
+
+func (mc *moveCmd) recreate(issue *jira.Issue, it *jira.IssueTypeStatuses, st *jira.Status) (string, error) {
+	s := cmdutil.Info(fmt.Sprintf("Re-creating issue in project %s...", mc.params.targetProject))
+	defer s.Stop()
+
+	body := issue.Fields.Description
+	if body == nil {
+		body = ""
+	}
+
+	resp, err := api.ProxyCreate(mc.client, &jira.CreateRequest{
+		Project:   mc.params.targetProject,
+		IssueType: it.Name,
+		Summary:   issue.Fields.Summary,
+		Body:      body,
+		Priority:  issue.Fields.Priority.Name,
+		Labels:    issue.Fields.Labels,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	linkType, err := mc.movedLinkType()
+	if err != nil {
+		return resp.Key, err
+	}
+	if linkType == "" {
+		cmdutil.Warn("No link type found to link issue %s to %s, please link them manually", resp.Key, issue.Key)
+	} else if err := mc.client.LinkIssue(issue.Key, resp.Key, linkType); err != nil {
+		return resp.Key, err
+	}
+	if err := mc.transitionTo(resp.Key, func(s *jira.Status) bool { return s.ID == st.ID }); err != nil {
+		return resp.Key, err
+	}
+
+	return resp.Key, mc.transitionTo(issue.Key, func(s *jira.Status) bool {
+		return s.StatusCategory.Key == jira.StatusCategoryDone
+	})
+}
+
+// movedLinkType finds the link type used to link the re-created issue to the original one.
+// The clone link type may be renamed in some instances, so it is also matched by its outward
+// description. The relates link type is used if there is no clone link type.
+func (mc *moveCmd) movedLinkType() (string, error) {
+	types, err := mc.client.GetIssueLinkTypes()
+	if err != nil {
+		return "", err
+	}
+
+	var relates string
+	for _, t := range types {
+		if strings.EqualFold(t.Name, linkTypeClone) || strings.EqualFold(t.Outward, linkOutwardClone) {
+			return t.Name, nil
+		}
+		if strings.EqualFold(t.Name, linkTypeRelates) {
+			relates = t.Name
+		}
+	}
+	return relates, nil
+}
+
+// transitionTo transitions the issue to the first status matching the given func.
+// A warning is displayed if the issue can't reach such status directly.
+func (mc *moveCmd) transitionTo(key string, match func(*jira.Status) bool) error {
+	err := api.TransitionTo(mc.client, key, match)
+	if errors.Is(err, api.ErrNoTransition) {
+		cmdutil.Warn("Unable to transition issue %s, please update its status manually", key)
+		return nil
+	}
+	return err
+}
+
+func (mc *moveCmd) mapIssueType(issue *jira.Issue, types []*jira.IssueTypeStatuses) (*jira.IssueTypeStatuses, error) {
+	name := issue.Fields.IssueType.Name
+	if mc.params.issueType != "" {
+		name = mc.params.issueType
+	}
+
+	options := make([]string, 0, len(types))
+	for _, t := range types {
+		if t.Subtask != issue.Fields.IssueType.Subtask {
+			continue
+		}
+		if strings.EqualFold(t.Name, name) {
+			return t, nil
+		}
+		options = append(options, t.Name)
+	}
+
+	if mc.params.issueType != "" || len(options) == 0 {
+		return nil, fmt.Errorf("issue type %q not found in project %s", name, mc.params.targetProject)
+	}
+
+	var ans string
+
+	qs := &survey.Question{
+		Name: "type",
+		Prompt: &survey.Select{
+			Message: fmt.Sprintf("Issue type %q doesn't exist in project %s, choose a new type:", name, mc.params.targetProject),
+			Options: options,
+		},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return nil, err
+	}
+
+	for _, t := range types {
+		if t.Name == ans {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("issue type %q not found in project %s", ans, mc.params.targetProject)
+}
+
+func (mc *moveCmd) mapStatus(issue *jira.Issue, it *jira.IssueTypeStatuses) (*jira.Status, error) {
+	name := issue.Fields.Status.Name
+	if mc.params.state != "" {
+		name = mc.params.state
+	}
+
+	options := make([]string, 0, len(it.Statuses))
+	for _, st := range it.Statuses {
+		if strings.EqualFold(st.Name, name) {
+			return st, nil
+		}
+		options = append(options, st.Name)
+	}
+
+	if mc.params.state != "" || len(options) == 0 {
+		return nil, fmt.Errorf("status %q not found for issue type %q in project %s", name, it.Name, mc.params.targetProject)
+	}
+
+	var ans string
+
+	qs := &survey.Question{
+		Name: "status",
+		Prompt: &survey.Select{
+			Message: fmt.Sprintf("Status %q doesn't exist for %s in project %s, choose a new status:", name, it.Name, mc.params.targetProject),
+			Options: options,
+		},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return nil, err
+	}
+
+	for _, st := range it.Statuses {
+		if st.Name == ans {
+			return st, nil
+		}
+	}
+	return nil, fmt.Errorf("status %q not found for issue type %q in project %s", ans, it.Name, mc.params.targetProject)
+}
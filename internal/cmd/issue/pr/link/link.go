@@ -0,0 +1,167 @@
+package link
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/gitbranch"
+	"github.com/ankitpokhrel/jira-cli/internal/pullrequest"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Link adds a pull request to an issue as a web link.
+
+The issue key is read from the name of the current git branch if it is omitted, eg: ISSUE-1
+for bug/ISSUE-1-fix-login. The pull request of the current branch is found with the GitHub CLI
+(gh) or the merge request with the GitLab CLI (glab) if the URL is omitted.
+
+Use --comment to also mention the pull request in a comment on the issue.`
+	examples = `$ jira issue pr link ISSUE-1 https://github.com/org/repo/pull/12
+
+# Link the pull request of the current branch to the issue in the branch name
+$ jira issue pr link
+
+# Link the pull request of the current branch and comment on the issue
+$ jira issue pr link ISSUE-1 --comment`
+)
+
+// NewCmdPRLink is a pr link command.
+func NewCmdPRLink() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "link [ISSUE-KEY] [PR-URL]",
+		Short:   "Link a pull request to an issue",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1, read from the current branch by default\n" +
+				"PR-URL\tURL of the pull request, detected with gh or glab by default",
+		},
+		Args: cobra.MaximumNArgs(2), //nolint:gomnd
+		Run:  link,
+	}
+
+	cmd.Flags().String("title", "", "Title of the pull request, used in the title of the link")
+	cmd.Flags().Bool("comment", false, "Also comment on the issue with the link to the pull request")
+
+	return &cmd
+}
+
+func link(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args)
+
+	wd, err := os.Getwd()
+	cmdutil.ExitIfError(err)
+
+	if params.key == "" {
+		branch, err := gitbranch.Current(wd)
+		cmdutil.ExitIfError(err)
+
+		if params.key = gitbranch.IssueKey(branch); params.key == "" {
+			cmdutil.Failed("No issue key found in branch %q, pass the issue key", branch)
+		}
+	}
+
+	var pr *pullrequest.PullRequest
+	if params.url != "" {
+		pr, err = pullrequest.FromURL(params.url)
+	} else {
+		pr, err = func() (*pullrequest.PullRequest, error) {
+			s := cmdutil.Info("Finding the pull request of the current branch...")
+			defer s.Stop()
+
+			return pullrequest.Detect(wd)
+		}()
+	}
+	cmdutil.ExitIfError(err)
+
+	if params.title != "" {
+		pr.Title = params.title
+	}
+
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	added, err := func() (bool, error) {
+		s := cmdutil.Info(fmt.Sprintf("Linking %s to issue %s...", pr.URL, params.key))
+		defer s.Stop()
+
+		links, err := client.GetRemoteLinks(params.key)
+		if err != nil {
+			return false, err
+		}
+		for _, l := range links {
+			if l.Object.URL == pr.URL {
+				return false, nil
+			}
+		}
+
+		if _, err := client.AddRemoteLink(params.key, pr.URL, pr.LinkTitle()); err != nil {
+			return false, err
+		}
+		if params.comment {
+			body := fmt.Sprintf("Pull request [%s](%s)", pr.LinkTitle(), pr.URL)
+			return true, client.AddIssueComment(params.key, body, false)
+		}
+		return true, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	if !added {
+		cmdutil.Success("Pull request is already linked to issue %s", params.key)
+		return
+	}
+
+	cmdutil.Success("Linked \"%s\" to issue %s", pr.LinkTitle(), params.key)
+	fmt.Printf("%s/browse/%s\n", viper.GetString("server"), params.key)
+}
+
+type linkParams struct {
+	key     string
+	url     string
+	title   string
+	comment bool
+	debug   bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *linkParams {
+	var key, url string
+
+	switch len(args) {
+	case 2: //nolint:gomnd
+		key, url = args[0], args[1]
+	case 1:
+		// A single argument is either the issue key or the URL of the pull request.
+		if strings.Contains(args[0], "://") {
+			url = args[0]
+		} else {
+			key = args[0]
+		}
+	}
+	if key != "" {
+		key = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), key)
+	}
+
+	title, err := flags.GetString("title")
+	cmdutil.ExitIfError(err)
+
+	comment, err := flags.GetBool("comment")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &linkParams{
+		key:     key,
+		url:     url,
+		title:   title,
+		comment: comment,
+		debug:   debug,
+	}
+}
@@ -0,0 +1,28 @@
+package pr
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/pr/link"
+)
+
+const helpText = `PR command helps you connect pull requests to issues. See available commands below.`
+
+// NewCmdPR is a pr command.
+func NewCmdPR() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "pr",
+		Short:   "Connect pull requests to issues",
+		Long:    helpText,
+		Aliases: []string{"mr"},
+		RunE:    pr,
+	}
+
+	cmd.AddCommand(link.NewCmdPRLink())
+
+	return &cmd
+}
+
+func pr(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,95 @@
+package rank
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	maxIssues = 50 // This is the maximum number of issues Jira API can rank at once.
+
+	helpText = `Rank moves issues above or below another issue in the backlog and on boards.
+
+ISSUE can be a single issue key, comma separated issue keys or "-" to read issue keys
+from the standard input. The issues keep their relative order and are placed right
+above or below the issue passed to --above or --below.`
+	examples = `$ jira issue rank ISSUE-1 --above ISSUE-2
+$ jira issue rank ISSUE-1,ISSUE-3 --below ISSUE-2
+
+# Move high priority bugs to the top of the backlog
+$ jira backlog list -tBug -yHigh --plain --no-headers --columns key | jira issue rank - --above ISSUE-2`
+)
+
+// NewCmdRank is a rank command.
+func NewCmdRank() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "rank ISSUE",
+		Short:   "Rank moves issues above or below another issue",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "ISSUE\tIssue key, comma separated issue keys or - to read them from stdin",
+		},
+		Run: rank,
+	}
+
+	cmd.Flags().String("above", "", "Issue key to rank the issues above")
+	cmd.Flags().String("below", "", "Issue key to rank the issues below")
+
+	return &cmd
+}
+
+func rank(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	above, err := cmd.Flags().GetString("above")
+	cmdutil.ExitIfError(err)
+
+	below, err := cmd.Flags().GetString("below")
+	cmdutil.ExitIfError(err)
+
+	if (above == "") == (below == "") {
+		cmdutil.Failed("Exactly one of --above or --below is required")
+	}
+
+	keys, err := cmdutil.GetJiraIssueKeys(project, args[0])
+	cmdutil.ExitIfError(err)
+	if len(keys) == 0 {
+		cmdutil.Failed("No issues to rank")
+	}
+	if len(keys) > maxIssues {
+		cmdutil.Failed("Only %d issues can be ranked at once", maxIssues)
+	}
+
+	req := jira.RankRequest{Issues: keys}
+	if above != "" {
+		req.RankBeforeIssue = cmdutil.GetJiraIssueKey(project, above)
+	} else {
+		req.RankAfterIssue = cmdutil.GetJiraIssueKey(project, below)
+	}
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Ranking %d issue(s)...", len(keys)))
+		defer s.Stop()
+
+		return api.Client(jira.Config{Debug: debug}).RankIssues(&req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if req.RankBeforeIssue != "" {
+		cmdutil.Success("Ranked %s above %s", strings.Join(keys, ", "), req.RankBeforeIssue)
+	} else {
+		cmdutil.Success("Ranked %s below %s", strings.Join(keys, ", "), req.RankAfterIssue)
+	}
+}
@@ -0,0 +1,270 @@
+package add
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const (
+	helpText = `Add creates a subtask of an issue.
+
+The subtask issue type of the parent's project is used. Components and fix versions
+are inherited from the parent issue unless they are passed.`
+	examples = `$ jira issue subtask add ISSUE-1 "Do the thing"
+
+# Override components inherited from the parent
+$ jira issue subtask add ISSUE-1 "Do the thing" -CBackend -yHigh
+
+# Assign the subtask to yourself
+$ jira issue subtask add ISSUE-1 "Do the thing" -a me
+
+# Get description from standard input
+$ echo "Description from stdin" | jira issue subtask add ISSUE-1 "Do the thing"`
+)
+
+// NewCmdSubtaskAdd is a subtask add command.
+func NewCmdSubtaskAdd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "add PARENT-KEY [SUMMARY]",
+		Short:   "Create a subtask of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"create"},
+		Annotations: map[string]string{
+			"help:args": "PARENT-KEY\tKey of the parent issue, eg: ISSUE-1\n" +
+				"SUMMARY\tSummary of the subtask",
+		},
+		Args: cobra.RangeArgs(1, 2), //nolint:gomnd
+		Run:  add,
+	}
+
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().StringP("body", "b", "", "Subtask description")
+	cmd.Flags().StringP("priority", "y", "", "Subtask priority")
+	cmd.Flags().StringP("assignee", "a", "", "Subtask assignee (email, display name or \"me\")")
+	cmd.Flags().StringArrayP("label", "l", []string{}, "Subtask labels")
+	cmd.Flags().StringArrayP("component", "C", []string{}, "Subtask components, defaults to the components of the parent")
+	cmd.Flags().StringArray("fix-version", []string{}, "Release info (fixVersions), defaults to the fix versions of the parent")
+	cmd.Flags().StringP("template", "T", "", "Path to a file to read description from")
+	cmd.Flags().Bool("web", false, "Open in web browser after successful creation")
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+
+	return &cmd
+}
+
+func add(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args, viper.GetString("project.key"))
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	if cmdutil.StdinHasData() || params.template == "-" {
+		params.noInput = true
+
+		if params.summary == "" {
+			cmdutil.Failed("`SUMMARY` is mandatory when using a non-interactive mode")
+		}
+	}
+
+	cmdutil.ExitIfError(askQuestions(params))
+
+	project := strings.SplitN(params.parentKey, "-", 2)[0] //nolint:gomnd
+
+	key, err := func() (string, error) {
+		s := cmdutil.Info(fmt.Sprintf("Creating a subtask of issue %s...", params.parentKey))
+		defer s.Stop()
+
+		parent, err := api.ProxyGetIssue(client, params.parentKey)
+		if err != nil {
+			return "", err
+		}
+		p, err := client.GetProject(project)
+		if err != nil {
+			return "", err
+		}
+		it, err := cmdcommon.SubtaskIssueType(client, project)
+		if err != nil {
+			return "", err
+		}
+
+		components, fixVersions := cmdcommon.InheritFromParent(parent, params.components, params.fixVersions)
+		fixVersions, err = cmdcommon.ResolveVersions(client, project, fixVersions, false)
+		if err != nil {
+			return "", err
+		}
+
+		cr := jira.CreateRequest{
+			Project:        project,
+			IssueType:      it.Name,
+			ParentIssueKey: parent.Key,
+			SubtaskField:   it.Name,
+			Summary:        params.summary,
+			Body:           params.body,
+			Priority:       params.priority,
+			Labels:         params.labels,
+			Components:     components,
+			FixVersions:    fixVersions,
+		}
+		cr.ForProjectType(p.Type)
+
+		resp, err := client.CreateV2(&cr)
+		if err != nil {
+			return "", err
+		}
+		return resp.Key, nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	server := viper.GetString("server")
+
+	cmdutil.Success("Subtask created\n%s/browse/%s", server, key)
+
+	if params.assignee != "" {
+		user, err := cmdcommon.ResolveUser(client, project, params.assignee)
+		if err == nil {
+			err = api.ProxyAssignIssue(client, key, user, jira.AssigneeDefault)
+		}
+		if err != nil {
+			cmdutil.Failed("Unable to set assignee: %s", err.Error())
+		}
+	}
+
+	if params.web {
+		err := cmdutil.Navigate(server, key)
+		cmdutil.ExitIfError(err)
+	}
+}
+
+func askQuestions(params *addParams) error {
+	var qs []*survey.Question
+
+	if params.summary == "" {
+		qs = append(qs, &survey.Question{
+			Name:     "summary",
+			Prompt:   &survey.Input{Message: "Summary"},
+			Validate: survey.Required,
+		})
+	}
+
+	var defaultBody string
+
+	if params.template != "" || cmdutil.StdinHasData() {
+		b, err := cmdutil.ReadFile(params.template)
+		if err != nil {
+			return err
+		}
+		defaultBody = string(b)
+	}
+
+	if params.body == "" && params.noInput {
+		params.body = defaultBody
+	}
+	if params.body == "" && !params.noInput {
+		qs = append(qs, &survey.Question{
+			Name: "body",
+			Prompt: &surveyext.JiraEditor{
+				Editor: &survey.Editor{
+					Message:       "Description",
+					Default:       defaultBody,
+					HideDefault:   true,
+					AppendDefault: true,
+				},
+				BlankAllowed: true,
+			},
+		})
+	}
+
+	if len(qs) == 0 {
+		return nil
+	}
+
+	ans := struct{ Summary, Body string }{}
+	if err := survey.Ask(qs, &ans); err != nil {
+		return err
+	}
+	if params.summary == "" {
+		params.summary = ans.Summary
+	}
+	if params.body == "" {
+		params.body = ans.Body
+	}
+
+	return nil
+}
+
+type addParams struct {
+	parentKey   string
+	summary     string
+	body        string
+	priority    string
+	assignee    string
+	labels      []string
+	components  []string
+	fixVersions []string
+	template    string
+	web         bool
+	noInput     bool
+	debug       bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *addParams {
+	var summary string
+	if len(args) >= 2 { //nolint:gomnd
+		summary = args[1]
+	}
+
+	body, err := flags.GetString("body")
+	cmdutil.ExitIfError(err)
+
+	priority, err := flags.GetString("priority")
+	cmdutil.ExitIfError(err)
+
+	assignee, err := flags.GetString("assignee")
+	cmdutil.ExitIfError(err)
+
+	labels, err := flags.GetStringArray("label")
+	cmdutil.ExitIfError(err)
+
+	components, err := flags.GetStringArray("component")
+	cmdutil.ExitIfError(err)
+
+	fixVersions, err := flags.GetStringArray("fix-version")
+	cmdutil.ExitIfError(err)
+
+	template, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+
+	web, err := flags.GetBool("web")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &addParams{
+		parentKey:   cmdutil.GetJiraIssueKey(project, args[0]),
+		summary:     summary,
+		body:        body,
+		priority:    priority,
+		assignee:    assignee,
+		labels:      labels,
+		components:  components,
+		fixVersions: fixVersions,
+		template:    template,
+		web:         web,
+		noInput:     noInput,
+		debug:       debug,
+	}
+}
@@ -0,0 +1,28 @@
+package subtask
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/subtask/add"
+)
+
+const helpText = `Subtask command helps you manage subtasks of an issue. See available commands below.`
+
+// NewCmdSubtask is a subtask command.
+func NewCmdSubtask() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "subtask",
+		Short:   "Manage subtasks of an issue",
+		Long:    helpText,
+		Aliases: []string{"subtasks", "sub"},
+		RunE:    subtask,
+	}
+
+	cmd.AddCommand(add.NewCmdSubtaskAdd())
+
+	return &cmd
+}
+
+func subtask(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
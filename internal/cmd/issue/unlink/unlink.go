@@ -0,0 +1,242 @@
+package unlink
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Unlink removes links between two issues.
+
+All links between the issues are removed unless a link type is given. You will be asked
+to pick a linked issue if it is omitted.`
+	examples = `$ jira issue unlink ISSUE-1 ISSUE-2
+
+# Only remove "Blocks" links between the issues
+$ jira issue unlink ISSUE-1 ISSUE-2 Blocks`
+	optionCancel = "Cancel"
+)
+
+// NewCmdUnlink is an unlink command.
+func NewCmdUnlink() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "unlink ISSUE_KEY LINKED_ISSUE_KEY [ISSUE_LINK_TYPE]",
+		Short:   "Unlink removes links between two issues",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"uln"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE_KEY\tIssue key of the source issue, eg: ISSUE-1\n" +
+				"LINKED_ISSUE_KEY\tIssue key of the linked issue, eg: ISSUE-2\n" +
+				"ISSUE_LINK_TYPE\tOnly remove links of the given type, eg: Duplicates, Blocks etc.",
+		},
+		Run: unlink,
+	}
+
+	cmd.Flags().Bool("web", false, "Open issue in web browser after successful unlinking")
+
+	return &cmd
+}
+
+func unlink(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags(), args, project)
+	client := api.Client(jira.Config{Debug: params.debug})
+	uc := unlinkCmd{
+		client: client,
+		params: params,
+	}
+
+	cmdutil.ExitIfError(uc.setIssueKey(project))
+	cmdutil.ExitIfError(uc.setLinks())
+	cmdutil.ExitIfError(uc.setLinkedIssueKey())
+
+	if uc.params.linkedIssueKey == optionCancel {
+		cmdutil.Fail("Action aborted")
+		os.Exit(0)
+	}
+
+	ids := uc.matchingLinks()
+	if len(ids) == 0 {
+		cmdutil.Failed("No links found between %s and %s", uc.params.issueKey, uc.params.linkedIssueKey)
+	}
+
+	err := func() error {
+		s := cmdutil.Info("Unlinking issues")
+		defer s.Stop()
+
+		for _, id := range ids {
+			if err := client.UnlinkIssue(id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	server := viper.GetString("server")
+
+	cmdutil.Success("Removed %d link(s) between %s and %s", len(ids), uc.params.issueKey, uc.params.linkedIssueKey)
+	fmt.Printf("%s/browse/%s\n", server, uc.params.issueKey)
+
+	if web, _ := cmd.Flags().GetBool("web"); web {
+		err := cmdutil.Navigate(server, uc.params.issueKey)
+		cmdutil.ExitIfError(err)
+	}
+}
+
+type unlinkParams struct {
+	issueKey       string
+	linkedIssueKey string
+	linkType       string
+	debug          bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *unlinkParams {
+	var issueKey, linkedIssueKey, linkType string
+
+	nargs := len(args)
+	if nargs >= 1 {
+		issueKey = cmdutil.GetJiraIssueKey(project, args[0])
+	}
+	if nargs >= 2 {
+		linkedIssueKey = cmdutil.GetJiraIssueKey(project, args[1])
+	}
+	if nargs >= 3 {
+		linkType = args[2]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &unlinkParams{
+		issueKey:       issueKey,
+		linkedIssueKey: linkedIssueKey,
+		linkType:       linkType,
+		debug:          debug,
+	}
+}
+
+// issueLink is a link of the source issue.
+type issueLink struct {
+	id        string
+	linkType  string
+	relation  string
+	linkedKey string
+}
+
+type unlinkCmd struct {
+	client *jira.Client
+	links  []*issueLink
+	params *unlinkParams
+}
+
+func (uc *unlinkCmd) setIssueKey(project string) error {
+	if uc.params.issueKey != "" {
+		return nil
+	}
+
+	var ans string
+
+	qs := &survey.Question{
+		Name:     "issueKey",
+		Prompt:   &survey.Input{Message: "Issue key"},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return err
+	}
+	uc.params.issueKey = cmdutil.GetJiraIssueKey(project, ans)
+
+	return nil
+}
+
+func (uc *unlinkCmd) setLinks() error {
+	s := cmdutil.Info("Fetching issue links. Please wait...")
+	defer s.Stop()
+
+	issue, err := api.ProxyGetIssue(uc.client, uc.params.issueKey)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range issue.Fields.IssueLinks {
+		switch {
+		case l.OutwardIssue != nil:
+			uc.links = append(uc.links, &issueLink{
+				id: l.ID, linkType: l.LinkType.Name, relation: l.LinkType.Outward, linkedKey: l.OutwardIssue.Key,
+			})
+		case l.InwardIssue != nil:
+			uc.links = append(uc.links, &issueLink{
+				id: l.ID, linkType: l.LinkType.Name, relation: l.LinkType.Inward, linkedKey: l.InwardIssue.Key,
+			})
+		}
+	}
+
+	return nil
+}
+
+func (uc *unlinkCmd) setLinkedIssueKey() error {
+	if uc.params.linkedIssueKey != "" {
+		return nil
+	}
+	if len(uc.links) == 0 {
+		return fmt.Errorf("issue %s doesn't have any links", uc.params.issueKey)
+	}
+
+	options := make([]string, 0, len(uc.links)+1)
+	for _, l := range uc.links {
+		options = append(options, fmt.Sprintf("%s %s", l.relation, l.linkedKey))
+	}
+	options = append(options, optionCancel)
+
+	var ans int
+
+	qs := &survey.Question{
+		Name: "linkedIssueKey",
+		Prompt: &survey.Select{
+			Message: fmt.Sprintf("%s:", uc.params.issueKey),
+			Options: options,
+		},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return err
+	}
+	if ans == len(uc.links) {
+		uc.params.linkedIssueKey = optionCancel
+		return nil
+	}
+
+	l := uc.links[ans]
+	uc.params.linkedIssueKey = l.linkedKey
+	uc.params.linkType = l.linkType
+
+	return nil
+}
+
+func (uc *unlinkCmd) matchingLinks() []string {
+	var ids []string
+
+	for _, l := range uc.links {
+		if !strings.EqualFold(l.linkedKey, uc.params.linkedIssueKey) {
+			continue
+		}
+		if uc.params.linkType != "" && !strings.EqualFold(l.linkType, uc.params.linkType) {
+			continue
+		}
+		ids = append(ids, l.id)
+	}
+
+	return ids
+}
@@ -1,10 +1,13 @@
 package view
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	tuiView "github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -12,11 +15,34 @@ import (
 )
 
 const (
-	helpText = `View displays contents of an issue.`
+	helpText = `View displays contents of an issue.
+
+Along with the issue details, the view shows the description rendered from Atlassian
+Document Format, time tracking info, linked issues and the most recent comments.
+
+Use --template to render the issue using a Go template instead, eg: for release notes or
+standup snippets. The template receives the issue as returned by the API, eg: {{.Key}} or
+{{.Fields.Summary}}, along with the following functions:
+
+  markdown  Convert a description or comment body to markdown, eg: {{markdown .Fields.Description}}
+  date      Format a date time field, eg: {{date "2006-01-02" .Fields.Created}}
+  url       Browse url of an issue, eg: {{url .Key}}
+  join      Join a list of strings, eg: {{join ", " .Fields.Labels}}
+  upper, lower and trim`
 	examples = `$ jira issue view ISSUE-1
 
 # Show 5 recent comments when viewing the issue
-$ jira issue view ISSUE-1 --comments 5`
+$ jira issue view ISSUE-1 --comments 5
+
+# Print the issue in json format for scripts
+$ jira issue view ISSUE-1 -o json | jq -r '.fields.status.name'
+
+# Print editable fields of the issue in yaml format, see 'jira issue apply'
+$ jira issue view ISSUE-1 -o yaml > issue.yaml
+
+# Render the issue using a template
+$ jira issue view ISSUE-1 --template release-note.tmpl
+$ echo '{{.Key}}: {{.Fields.Summary}}' | jira issue view ISSUE-1 --template -`
 )
 
 // NewCmdView is a view command.
@@ -30,12 +56,14 @@ func NewCmdView() *cobra.Command {
 		Annotations: map[string]string{
 			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
 		},
-		Args: cobra.MinimumNArgs(1),
-		Run:  view,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: cmdcommon.CompleteIssueKeys,
+		Run:               view,
 	}
 
 	cmd.Flags().Uint("comments", 1, "Show N comments")
 	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().String("template", "", "Render the issue using the Go template file, - to read it from stdin")
 
 	return &cmd
 }
@@ -47,6 +75,15 @@ func view(cmd *cobra.Command, args []string) {
 	comments, err := cmd.Flags().GetUint("comments")
 	cmdutil.ExitIfError(err)
 
+	tmplPath, err := cmd.Flags().GetString("template")
+	cmdutil.ExitIfError(err)
+
+	var tmpl []byte
+	if tmplPath != "" {
+		tmpl, err = cmdutil.ReadFile(tmplPath)
+		cmdutil.ExitIfError(err)
+	}
+
 	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
 	iss, err := func() (*jira.Issue, error) {
 		s := cmdutil.Info("Fetching issue details...")
@@ -57,6 +94,27 @@ func view(cmd *cobra.Command, args []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	switch output {
+	case tuiView.OutputJSON:
+		cmdutil.ExitIfError(tuiView.RenderJSON(os.Stdout, iss))
+		return
+	case tuiView.OutputYAML:
+		cmdutil.ExitIfError(tuiView.RenderYAML(os.Stdout, cmdcommon.NewIssueFile(iss)))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	if tmplPath != "" {
+		it := tuiView.IssueTemplate{Server: viper.GetString("server"), Data: iss}
+		cmdutil.ExitIfError(it.Render(os.Stdout, string(tmpl)))
+		return
+	}
+
 	plain, err := cmd.Flags().GetBool("plain")
 	cmdutil.ExitIfError(err)
 
@@ -0,0 +1,111 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	voteHelpText = `Vote casts your vote on issues.
+
+ISSUE can be a single issue key, comma separated issue keys or "-" to read issue keys
+from the standard input. You can't vote on issues reported by you.`
+	voteExamples = `$ jira issue vote ISSUE-1
+$ jira issue vote ISSUE-1,ISSUE-2`
+
+	unvoteHelpText = `Unvote removes your vote from issues.
+
+ISSUE can be a single issue key, comma separated issue keys or "-" to read issue keys
+from the standard input.`
+	unvoteExamples = `$ jira issue unvote ISSUE-1
+$ jira issue unvote ISSUE-1,ISSUE-2`
+)
+
+// NewCmdVote is a vote command.
+func NewCmdVote() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "vote ISSUE",
+		Short:   "Vote for issues",
+		Long:    voteHelpText,
+		Example: voteExamples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "ISSUE\tIssue key, comma separated issue keys or - to read them from stdin",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			run(cmd, args, true)
+		},
+	}
+
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to update at once")
+
+	return &cmd
+}
+
+// NewCmdUnvote is an unvote command.
+func NewCmdUnvote() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "unvote ISSUE",
+		Short:   "Remove your vote from issues",
+		Long:    unvoteHelpText,
+		Example: unvoteExamples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "ISSUE\tIssue key, comma separated issue keys or - to read them from stdin",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			run(cmd, args, false)
+		},
+	}
+
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to update at once")
+
+	return &cmd
+}
+
+func run(cmd *cobra.Command, args []string, vote bool) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	keys, err := cmdutil.GetJiraIssueKeys(viper.GetString("project.key"), args[0])
+	cmdutil.ExitIfError(err)
+	if len(keys) == 0 {
+		cmdutil.Failed("No issues to update")
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	concurrency := cmdcommon.GetConcurrency(cmd.Flags())
+
+	passed, err := func() (int, error) {
+		msg := "Voting for"
+		if !vote {
+			msg = "Removing vote from"
+		}
+		s := cmdutil.Info(fmt.Sprintf("%s %d issue(s)...", msg, len(keys)))
+		defer s.Stop()
+
+		return cmdcommon.UpdateIssues(keys, concurrency, func(key string) error {
+			if vote {
+				return client.Vote(key)
+			}
+			return client.Unvote(key)
+		})
+	}()
+
+	if passed > 0 {
+		if vote {
+			cmdutil.Success("Voted for %d of %d issue(s)", passed, len(keys))
+		} else {
+			cmdutil.Success("Removed vote from %d of %d issue(s)", passed, len(keys))
+		}
+	}
+	cmdutil.ExitIfError(err)
+}
@@ -0,0 +1,119 @@
+package watch
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	watchHelpText = `Watch starts watching issues as the authenticated user.
+
+ISSUE can be a single issue key, comma separated issue keys or "-" to read issue keys
+from the standard input.`
+	watchExamples = `$ jira issue watch ISSUE-1
+$ jira issue watch ISSUE-1,ISSUE-2`
+
+	unwatchHelpText = `Unwatch stops watching issues as the authenticated user.
+
+ISSUE can be a single issue key, comma separated issue keys or "-" to read issue keys
+from the standard input.`
+	unwatchExamples = `$ jira issue unwatch ISSUE-1
+$ jira issue unwatch ISSUE-1,ISSUE-2`
+)
+
+// NewCmdWatch is a watch command.
+func NewCmdWatch() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "watch ISSUE",
+		Short:   "Watch issues",
+		Long:    watchHelpText,
+		Example: watchExamples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "ISSUE\tIssue key, comma separated issue keys or - to read them from stdin",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			run(cmd, args, true)
+		},
+	}
+
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to update at once")
+
+	return &cmd
+}
+
+// NewCmdUnwatch is an unwatch command.
+func NewCmdUnwatch() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "unwatch ISSUE",
+		Short:   "Stop watching issues",
+		Long:    unwatchHelpText,
+		Example: unwatchExamples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "ISSUE\tIssue key, comma separated issue keys or - to read them from stdin",
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			run(cmd, args, false)
+		},
+	}
+
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to update at once")
+
+	return &cmd
+}
+
+func run(cmd *cobra.Command, args []string, watch bool) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	keys, err := cmdutil.GetJiraIssueKeys(viper.GetString("project.key"), args[0])
+	cmdutil.ExitIfError(err)
+	if len(keys) == 0 {
+		cmdutil.Failed("No issues to update")
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	me, err := func() (*jira.User, error) {
+		s := cmdutil.Info("Fetching user details. Please wait...")
+		defer s.Stop()
+
+		return cmdcommon.Me(client)
+	}()
+	cmdutil.ExitIfError(err)
+
+	concurrency := cmdcommon.GetConcurrency(cmd.Flags())
+
+	passed, err := func() (int, error) {
+		msg := "Watching"
+		if !watch {
+			msg = "Unwatching"
+		}
+		s := cmdutil.Info(fmt.Sprintf("%s %d issue(s)...", msg, len(keys)))
+		defer s.Stop()
+
+		return cmdcommon.UpdateIssues(keys, concurrency, func(key string) error {
+			if watch {
+				return api.ProxyAddWatcher(client, key, me)
+			}
+			return api.ProxyRemoveWatcher(client, key, me)
+		})
+	}()
+
+	if passed > 0 {
+		if watch {
+			cmdutil.Success("Watching %d of %d issue(s)", passed, len(keys))
+		} else {
+			cmdutil.Success("Stopped watching %d of %d issue(s)", passed, len(keys))
+		}
+	}
+	cmdutil.ExitIfError(err)
+}
@@ -0,0 +1,171 @@
+package watchers
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Watchers lists users watching an issue.
+
+Use --add and --remove to manage watches of other users, eg: when handing off the issue to
+another team. USER can be a display name, an email or an account id (username in local
+installation), or "me" for yourself. Users are looked up among the users that can be assigned
+the issue first, and among all users otherwise. Managing watches of other users requires the
+"Manage watcher list" permission.`
+	examples = `$ jira issue watchers ISSUE-1
+
+# Add users to the watchers
+$ jira issue watchers ISSUE-1 --add jon@domain.tld --add "Jane Doe"
+
+# Remove a user from the watchers
+$ jira issue watchers ISSUE-1 --remove jon@domain.tld`
+)
+
+// NewCmdWatchers is a watchers command.
+func NewCmdWatchers() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "watchers ISSUE-KEY",
+		Short:   "List and manage issue watchers",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"watcher"},
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		ValidArgsFunction: cmdcommon.CompleteIssueKeys,
+		Run:               watchers,
+	}
+
+	cmd.Flags().StringArray("add", []string{}, "Add user to the watchers")
+	cmd.Flags().StringArray("remove", []string{}, "Remove user from the watchers")
+
+	return &cmd
+}
+
+func watchers(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+	params := parseArgsAndFlags(cmd.Flags(), args, project)
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	if len(params.add) == 0 && len(params.remove) == 0 {
+		list(client, params.key, params.output)
+		return
+	}
+
+	project = strings.SplitN(params.key, "-", 2)[0] //nolint:gomnd
+
+	var (
+		failed strings.Builder
+		passed int
+		total  = len(params.add) + len(params.remove)
+	)
+
+	err := func() error {
+		s := cmdutil.Info(fmt.Sprintf("Updating watchers of issue %s...", params.key))
+		defer s.Stop()
+
+		update := func(val string, fn func(*jira.Client, string, *jira.User) error) {
+			u, err := cmdcommon.ResolveUser(client, project, val)
+
+			// Users of other teams may not be assignable issues of the project.
+			var notFound *cmdcommon.ErrUserNotFound
+			if errors.As(err, &notFound) {
+				u, err = cmdcommon.FindUser(client, val)
+			}
+			if err == nil {
+				err = fn(client, params.key, u)
+			}
+			if err != nil {
+				failed.WriteString(fmt.Sprintf("\n  - %s: %s", val, cmdutil.NormalizeJiraError(err.Error())))
+				return
+			}
+			passed++
+		}
+
+		for _, val := range params.add {
+			update(val, api.ProxyAddWatcher)
+		}
+		for _, val := range params.remove {
+			update(val, api.ProxyRemoveWatcher)
+		}
+
+		if failed.Len() > 0 {
+			return &jira.ErrMultipleFailed{Msg: failed.String()}
+		}
+		return nil
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Updated %d of %d watcher(s) of issue %s", passed, total, params.key)
+	}
+	cmdutil.ExitIfError(err)
+}
+
+func list(client *jira.Client, key, output string) {
+	users, err := func() ([]*jira.User, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching watchers of issue %s...", key))
+		defer s.Stop()
+
+		return client.Watchers(key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, users))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	if len(users) == 0 {
+		cmdutil.Failed("No one is watching issue %s.", key)
+		return
+	}
+
+	cmdutil.ExitIfError(view.NewWatchers(users).Render())
+}
+
+type watchersParams struct {
+	key    string
+	add    []string
+	remove []string
+	output string
+	debug  bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *watchersParams {
+	add, err := flags.GetStringArray("add")
+	cmdutil.ExitIfError(err)
+
+	remove, err := flags.GetStringArray("remove")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &watchersParams{
+		key:    cmdutil.GetJiraIssueKey(project, args[0]),
+		add:    add,
+		remove: remove,
+		output: output,
+		debug:  debug,
+	}
+}
@@ -0,0 +1,104 @@
+package add
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Add adds a web link to an issue.
+
+The URL is used as the title of the link if the title is omitted.`
+	examples = `$ jira issue weblink add ISSUE-1 https://docs.example.com "Design doc"
+
+# Add a link titled with its URL
+$ jira issue weblink add ISSUE-1 https://docs.example.com`
+)
+
+// NewCmdWebLinkAdd is a weblink add command.
+func NewCmdWebLinkAdd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "add ISSUE-KEY URL [TITLE]",
+		Short:   "Add a web link to an issue",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"URL\tURL of the web page, eg: https://docs.example.com\n" +
+				"TITLE\tTitle of the link, defaults to the URL",
+		},
+		Args: cobra.RangeArgs(2, 3), //nolint:gomnd
+		Run:  add,
+	}
+
+	cmd.Flags().Bool("web", false, "Open issue in web browser after adding the link")
+
+	return &cmd
+}
+
+func add(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args, viper.GetString("project.key"))
+
+	if u, err := url.ParseRequestURI(params.url); err != nil || u.Scheme == "" || u.Host == "" {
+		cmdutil.Failed("Invalid URL %q, the URL must be absolute, eg: https://docs.example.com", params.url)
+	}
+
+	link, err := func() (*jira.RemoteLink, error) {
+		s := cmdutil.Info(fmt.Sprintf("Adding web link to issue %s...", params.key))
+		defer s.Stop()
+
+		client := api.Client(jira.Config{Debug: params.debug})
+		return client.AddRemoteLink(params.key, params.url, params.title)
+	}()
+	cmdutil.ExitIfError(err)
+
+	server := viper.GetString("server")
+
+	cmdutil.Success("Web link \"%d\" added to issue \"%s\"", link.ID, params.key)
+	fmt.Printf("%s/browse/%s\n", server, params.key)
+
+	if params.web {
+		err := cmdutil.Navigate(server, params.key)
+		cmdutil.ExitIfError(err)
+	}
+}
+
+type addParams struct {
+	key   string
+	url   string
+	title string
+	web   bool
+	debug bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *addParams {
+	key := cmdutil.GetJiraIssueKey(project, args[0])
+	link := args[1]
+
+	title := link
+	if len(args) > 2 && args[2] != "" { //nolint:gomnd
+		title = args[2]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	web, err := flags.GetBool("web")
+	cmdutil.ExitIfError(err)
+
+	return &addParams{
+		key:   key,
+		url:   link,
+		title: title,
+		web:   web,
+		debug: debug,
+	}
+}
@@ -0,0 +1,68 @@
+package list
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `List lists web links of an issue.`
+	examples = `$ jira issue weblink list ISSUE-1`
+)
+
+// NewCmdWebLinkList is a weblink list command.
+func NewCmdWebLinkList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list ISSUE-KEY",
+		Short:   "List web links of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  list,
+	}
+}
+
+func list(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	links, err := func() ([]*jira.RemoteLink, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching web links of issue %s...", key))
+		defer s.Stop()
+
+		return api.Client(jira.Config{Debug: debug}).GetRemoteLinks(key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, links))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	if len(links) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No web links found for issue \"%s\"", key)
+		return
+	}
+	cmdutil.ExitIfError(view.NewWebLinks(links).Render())
+}
@@ -0,0 +1,155 @@
+package remove
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Remove removes web links from an issue.
+
+LINK can either be the id of the link or its URL, all links to the URL are removed
+in the latter case. You will be asked to pick a link if it is omitted.`
+	examples = `$ jira issue weblink remove ISSUE-1 10000
+
+# Remove all links to the URL
+$ jira issue weblink remove ISSUE-1 https://docs.example.com
+
+# Pick the link to remove interactively
+$ jira issue weblink remove ISSUE-1`
+	optionCancel = "Cancel"
+)
+
+// NewCmdWebLinkRemove is a weblink remove command.
+func NewCmdWebLinkRemove() *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove ISSUE-KEY [LINK]",
+		Short:   "Remove web links from an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"rm", "delete"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"LINK\tID or URL of the link to remove",
+		},
+		Args: cobra.RangeArgs(1, 2), //nolint:gomnd
+		Run:  remove,
+	}
+}
+
+func remove(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args, viper.GetString("project.key"))
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	links, err := func() ([]*jira.RemoteLink, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching web links of issue %s...", params.key))
+		defer s.Stop()
+
+		return client.GetRemoteLinks(params.key)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(links) == 0 {
+		cmdutil.Failed("Issue %s doesn't have any web links", params.key)
+	}
+
+	if params.link == "" {
+		params.link, err = selectLink(links)
+		cmdutil.ExitIfError(err)
+
+		if params.link == optionCancel {
+			cmdutil.Fail("Action aborted")
+			os.Exit(0)
+		}
+	}
+
+	ids := matchingLinks(links, params.link)
+	if len(ids) == 0 {
+		cmdutil.Failed("No web link %q found in issue %s", params.link, params.key)
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Removing web links")
+		defer s.Stop()
+
+		for _, id := range ids {
+			if err := client.DeleteRemoteLink(params.key, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Removed %d web link(s) from issue %s", len(ids), params.key)
+	fmt.Printf("%s/browse/%s\n", viper.GetString("server"), params.key)
+}
+
+type removeParams struct {
+	key   string
+	link  string
+	debug bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string, project string) *removeParams {
+	var link string
+
+	key := cmdutil.GetJiraIssueKey(project, args[0])
+	if len(args) > 1 {
+		link = args[1]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &removeParams{
+		key:   key,
+		link:  link,
+		debug: debug,
+	}
+}
+
+func selectLink(links []*jira.RemoteLink) (string, error) {
+	options := make([]string, 0, len(links)+1)
+	ids := make(map[string]string, len(links))
+	for _, l := range links {
+		opt := fmt.Sprintf("%s (%s)", l.Object.Title, l.Object.URL)
+		options = append(options, opt)
+		ids[opt] = strconv.Itoa(l.ID)
+	}
+	options = append(options, optionCancel)
+
+	var ans string
+
+	prompt := &survey.Select{
+		Message: "Web link to remove:",
+		Options: options,
+	}
+	if err := survey.AskOne(prompt, &ans); err != nil {
+		return "", err
+	}
+	if ans == optionCancel {
+		return optionCancel, nil
+	}
+	return ids[ans], nil
+}
+
+func matchingLinks(links []*jira.RemoteLink, link string) []int {
+	var ids []int
+	for _, l := range links {
+		if strconv.Itoa(l.ID) == link || l.Object.URL == link {
+			ids = append(ids, l.ID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,30 @@
+package weblink
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/weblink/add"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/weblink/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/weblink/remove"
+)
+
+const helpText = `Weblink command helps you manage links from an issue to external web pages. See available commands below.`
+
+// NewCmdWebLink is a weblink command.
+func NewCmdWebLink() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "weblink",
+		Short:   "Manage web links of an issue",
+		Long:    helpText,
+		Aliases: []string{"weblinks", "remotelink"},
+		RunE:    weblink,
+	}
+
+	cmd.AddCommand(add.NewCmdWebLinkAdd(), list.NewCmdWebLinkList(), remove.NewCmdWebLinkRemove())
+
+	return &cmd
+}
+
+func weblink(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -1,7 +1,10 @@
 package add
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -12,12 +15,22 @@ import (
 	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/queue"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
 )
 
 const (
-	helpText = `Add adds worklog to an issue.`
+	helpText = `Add adds worklog to an issue.
+
+Worklogs can be validated against a workday defined in the config. A warning is shown if
+the time spent is not a multiple of 'worklog.min_increment' or if it exceeds 'worklog.max_per_day'
+together with the time you already logged on the day. Set 'worklog.strict' to fail instead.
+
+  worklog:
+    max_per_day: 8h
+    min_increment: 15m`
 	examples = `$ jira issue worklog add
 
 # Pass required parameters to skip prompt 
@@ -35,6 +48,25 @@ $ jira issue worklog add ISSUE-1 --template -
 # Or, use pipe to read input directly from standard input
 $ echo "Worklog from stdin" | jira issue worklog add ISSUE-1
 
+# Keep the remaining estimate of the issue unchanged
+$ jira issue worklog add ISSUE-1 2h "My worklog" --adjust-estimate leave
+
+# Reduce the remaining estimate by 4 hours
+$ jira issue worklog add ISSUE-1 2h "My worklog" --adjust-estimate manual --reduce-by 4h
+
+# Restrict the worklog to the members of a project role or a group
+$ jira issue worklog add ISSUE-1 2h "My worklog" --visibility role:Developers
+$ jira issue worklog add ISSUE-1 2h "My worklog" --visibility group:engineering
+
+# Log work to Tempo with an account and a custom work attribute (requires worklog.provider: tempo)
+$ jira issue worklog add ISSUE-1 2h "My worklog" --account DEV --attribute _Role_=Developer
+
+# Print the created worklog as json, eg: to update or delete it later from a script
+$ jira issue worklog add ISSUE-1 2h "My worklog" --no-input --output json
+
+# Template files can use variables like {{.IssueKey}}, {{.Date}}, {{.GitBranch}} and the ones passed via --var
+$ jira issue worklog add ISSUE-1 --template /path/to/template.tmpl --var sprint=42
+
 # Positional argument takes precedence over the template flag
 # The example below will add "worklog from arg" as a worklog
 $ jira issue comment add ISSUE-1 "worklog from arg" --template /path/to/template.tmpl`
@@ -59,6 +91,17 @@ func NewCmdCWorklogAdd() *cobra.Command {
 
 	cmd.Flags().Bool("web", false, "Open issue in web browser after adding worklog")
 	cmd.Flags().StringP("template", "T", "", "Path to a file to read worklog body from")
+	cmd.Flags().StringArray("var", []string{}, "Template variable in key=value format, eg: sprint=42. Can be used multiple times")
+	cmd.Flags().String("adjust-estimate", "", "How to adjust the remaining estimate (default \"auto\").\n"+
+		fmt.Sprintf("Accepts: %s, %s, %s, %s", jira.AdjustEstimateAuto,
+			jira.AdjustEstimateLeave, jira.AdjustEstimateNew, jira.AdjustEstimateManual))
+	cmd.Flags().String("new-estimate", "", "New remaining estimate, eg: 2d. Works only with --adjust-estimate new")
+	cmd.Flags().String("reduce-by", "", "Amount to reduce the remaining estimate by, eg: 4h.\n"+
+		"Works only with --adjust-estimate manual")
+	cmd.Flags().String("timezone", "", "Timezone of the started date and time, eg: Europe/Berlin (defaults to local timezone)")
+	cmd.Flags().String("visibility", "", "Restrict worklog visibility to a project role or group, eg: role:Developers")
+	cmd.Flags().StringArray("attribute", []string{}, "Tempo work attribute in key=value format. Can be used multiple times")
+	cmd.Flags().String("account", "", "Tempo account key to log the work against")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
 
 	return &cmd
@@ -81,7 +124,22 @@ func add(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	// cmdutil.ExitIfError(ac.setIssueKey())
+	if params.output != "" && params.output != view.OutputJSON {
+		cmdutil.Failed("Invalid output format %q", params.output)
+	}
+
+	estimate, err := cmdcommon.GetAdjustEstimateValue(
+		params.adjustEstimate, params.newEstimate, params.reduceBy, "reduce-by",
+	)
+	cmdutil.ExitIfError(err)
+
+	visibility, err := cmdcommon.GetWorklogVisibility(params.visibility)
+	cmdutil.ExitIfError(err)
+
+	attrs, err := cmdcommon.GetWorklogAttributes(params.attributes, params.account)
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(ac.setIssueKey())
 
 	qs := ac.getQuestions()
 	if len(qs) > 0 {
@@ -116,14 +174,49 @@ func add(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	err := func() error {
+	loc, err := cmdcommon.WorklogLocation(params.timezone)
+	cmdutil.ExitIfError(err)
+
+	started, err := cmdcommon.FormatWorklogStarted(params.startedDate, params.startedTime, loc)
+	cmdutil.ExitIfError(err)
+
+	// Time logged on the day can't be fetched while Jira is unreachable, the worklog is queued instead.
+	if err := ac.validateWorkday(loc); !api.IsUnreachable(err) {
+		cmdutil.ExitIfError(err)
+	}
+
+	wl, err := func() (*jira.Worklog, error) {
 		s := cmdutil.Info("Adding worklog")
 		defer s.Stop()
 
-		return client.AddIssueWorklog(ac.params.issueKey, ac.params.comment, ac.params.startedDate+"T"+params.startedTime+":00.000+0100", ac.params.timeSpent)
+		return api.ProxyAddWorklog(
+			client, ac.params.issueKey, ac.params.comment, started,
+			ac.params.timeSpent, ac.params.adjustEstimate, estimate, visibility, attrs,
+		)
 	}()
+	if api.IsUnreachable(err) {
+		cmdcommon.QueueOperation(&queue.Operation{
+			Kind:     queue.KindWorklog,
+			IssueKey: ac.params.issueKey,
+			Worklog: &queue.Worklog{
+				Comment:        ac.params.comment,
+				Started:        started,
+				TimeSpent:      ac.params.timeSpent,
+				AdjustEstimate: ac.params.adjustEstimate,
+				Estimate:       estimate,
+				Visibility:     visibility,
+				Attributes:     attrs,
+			},
+		})
+		return
+	}
 	cmdutil.ExitIfError(err)
 
+	if params.output == view.OutputJSON {
+		cmdutil.ExitIfError(renderJSON(os.Stdout, ac.params.issueKey, wl))
+		return
+	}
+
 	server := viper.GetString("server")
 
 	cmdutil.Success("Worklog added to issue \"%s\"", ac.params.issueKey)
@@ -136,14 +229,23 @@ func add(cmd *cobra.Command, args []string) {
 }
 
 type addParams struct {
-	issueKey    string
-	comment     string
-	startedDate string
-	startedTime string
-	timeSpent   string
-	template    string
-	noInput     bool
-	debug       bool
+	issueKey       string
+	comment        string
+	startedDate    string
+	startedTime    string
+	timeSpent      string
+	template       string
+	vars           []string
+	adjustEstimate string
+	newEstimate    string
+	reduceBy       string
+	timezone       string
+	visibility     string
+	attributes     []string
+	account        string
+	output         string
+	noInput        bool
+	debug          bool
 }
 
 func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
@@ -176,18 +278,54 @@ func parseArgsAndFlags(args []string, flags query.FlagParser) *addParams {
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
+	vars, err := flags.GetStringArray("var")
+	cmdutil.ExitIfError(err)
+
+	adjustEstimate, err := flags.GetString("adjust-estimate")
+	cmdutil.ExitIfError(err)
+
+	newEstimate, err := flags.GetString("new-estimate")
+	cmdutil.ExitIfError(err)
+
+	reduceBy, err := flags.GetString("reduce-by")
+	cmdutil.ExitIfError(err)
+
+	timezone, err := flags.GetString("timezone")
+	cmdutil.ExitIfError(err)
+
+	visibility, err := flags.GetString("visibility")
+	cmdutil.ExitIfError(err)
+
+	attributes, err := flags.GetStringArray("attribute")
+	cmdutil.ExitIfError(err)
+
+	account, err := flags.GetString("account")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
 	return &addParams{
-		issueKey:    issueKey,
-		comment:     comment,
-		startedDate: startedDate,
-		startedTime: startedTime,
-		timeSpent:   timeSpent,
-		template:    template,
-		noInput:     noInput,
-		debug:       debug,
+		issueKey:       issueKey,
+		comment:        comment,
+		startedDate:    startedDate,
+		startedTime:    startedTime,
+		timeSpent:      timeSpent,
+		template:       template,
+		vars:           vars,
+		adjustEstimate: adjustEstimate,
+		newEstimate:    newEstimate,
+		reduceBy:       reduceBy,
+		timezone:       timezone,
+		visibility:     visibility,
+		attributes:     attributes,
+		account:        account,
+		output:         output,
+		noInput:        noInput,
+		debug:          debug,
 	}
 }
 
@@ -202,17 +340,11 @@ func (ac *addCmd) setIssueKey() error {
 		return nil
 	}
 
-	var ans string
-
-	qs := &survey.Question{
-		Name:     "issueKey",
-		Prompt:   &survey.Input{Message: "Issue key"},
-		Validate: survey.Required,
-	}
-	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+	key, err := cmdcommon.PickIssue(ac.client, viper.GetString("project.key"))
+	if err != nil {
 		return err
 	}
-	ac.params.issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), ans)
+	ac.params.issueKey = key
 
 	return nil
 }
@@ -244,11 +376,11 @@ func (ac *addCmd) getQuestions() []*survey.Question {
 	}
 
 	if ac.params.template != "" || cmdutil.StdinHasData() {
-		b, err := cmdutil.ReadFile(ac.params.template)
+		body, err := cmdcommon.ReadTemplate(ac.params.template, ac.params.issueKey, ac.params.vars)
 		if err != nil {
 			cmdutil.Failed("Error: %s", err)
 		}
-		defaultBody = string(b)
+		defaultBody = body
 	}
 
 	if ac.params.noInput && ac.params.comment == "" {
@@ -288,6 +420,41 @@ func (ac *addCmd) getQuestions() []*survey.Question {
 	return qs
 }
 
+// validateWorkday validates the worklog against the configured workday limits.
+// Violations are reported as warnings unless worklog.strict is set in the config.
+func (ac *addCmd) validateWorkday(loc *time.Location) error {
+	wd, err := cmdcommon.GetWorkday()
+	if err != nil || !wd.Enabled() {
+		return err
+	}
+
+	timeSpent, err := jira.ParseTimeSpent(ac.params.timeSpent)
+	if err != nil {
+		return err
+	}
+
+	logged := 0
+	if wd.NeedsLogged() {
+		logged, err = func() (int, error) {
+			s := cmdutil.Info(fmt.Sprintf("Fetching time logged on %s...", ac.params.startedDate))
+			defer s.Stop()
+
+			return cmdcommon.LoggedOn(ac.client, ac.params.startedDate, loc)
+		}()
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := wd.Validate(timeSpent, logged); err != nil {
+		if wd.Strict {
+			return err
+		}
+		cmdutil.Warn("Warning: %s", err)
+	}
+	return nil
+}
+
 func (ac *addCmd) getNextAction() *survey.Question {
 	return &survey.Question{
 		Name: "action",
@@ -309,3 +476,20 @@ func (ac *addCmd) isNonInteractive() bool {
 func (ac *addCmd) isMandatoryParamsMissing() bool {
 	return ac.params.issueKey == ""
 }
+
+func renderJSON(w io.Writer, key string, wl *jira.Worklog) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(struct {
+		ID      string `json:"id"`
+		Issue   string `json:"issue"`
+		Started string `json:"started"`
+		Seconds int    `json:"seconds"`
+	}{
+		ID:      wl.ID,
+		Issue:   key,
+		Started: wl.Started,
+		Seconds: wl.TimeSpentSeconds,
+	})
+}
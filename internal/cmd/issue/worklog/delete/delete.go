@@ -0,0 +1,219 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	pageSize = 100
+	helpText = `Delete deletes a worklog of an issue.
+
+By default, Jira reduces the remaining estimate automatically when a worklog is deleted.
+Use --adjust-estimate flag to control this behavior.`
+	examples = `$ jira issue worklog delete
+
+# Select the worklog to delete from the list of your worklogs in the issue
+$ jira issue worklog delete ISSUE-1
+
+# Delete a worklog without a confirmation prompt
+$ jira issue worklog delete ISSUE-1 10001 --yes
+
+# Delete a worklog and leave the remaining estimate unchanged
+$ jira issue worklog delete ISSUE-1 10001 --adjust-estimate leave
+
+# Delete a worklog and set the remaining estimate to 2 days
+$ jira issue worklog delete ISSUE-1 10001 --adjust-estimate new --new-estimate 2d
+
+# Delete a worklog and increase the remaining estimate by 4 hours
+$ jira issue worklog delete ISSUE-1 10001 --adjust-estimate manual --increase-by 4h`
+)
+
+// NewCmdWorklogDelete is a worklog delete command.
+func NewCmdWorklogDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete [ISSUE-KEY] [WORKLOG-ID]",
+		Short:   "Delete a worklog of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key of the worklog, eg: ISSUE-1\n" +
+				"WORKLOG-ID\tID of the worklog you want to delete",
+		},
+		Run: del,
+	}
+
+	cmd.Flags().BoolP("yes", "y", false, "Delete without a confirmation prompt")
+	cmd.Flags().String("adjust-estimate", "", "How to adjust the remaining estimate.\n"+
+		fmt.Sprintf("Accepts: %s, %s, %s, %s", jira.AdjustEstimateAuto,
+			jira.AdjustEstimateLeave, jira.AdjustEstimateNew, jira.AdjustEstimateManual))
+	cmd.Flags().String("new-estimate", "", "New remaining estimate, eg: 2d. Works only with --adjust-estimate new")
+	cmd.Flags().String("increase-by", "", "Amount to increase the remaining estimate by, eg: 4h.\n"+
+		"Works only with --adjust-estimate manual")
+
+	return &cmd
+}
+
+func del(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+	client := api.Client(jira.Config{Debug: params.debug})
+	dc := deleteCmd{
+		client: client,
+		params: params,
+	}
+
+	estimate, err := cmdcommon.GetAdjustEstimateValue(
+		params.adjustEstimate, params.newEstimate, params.increaseBy, "increase-by",
+	)
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(dc.setIssueKey())
+	cmdutil.ExitIfError(dc.setWorklogID())
+
+	if !params.yes {
+		confirmed := false
+		prompt := &survey.Confirm{
+			Message: fmt.Sprintf("Delete worklog %q of issue %q?", params.worklogID, params.issueKey),
+		}
+		cmdutil.ExitIfError(survey.AskOne(prompt, &confirmed))
+
+		if !confirmed {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Deleting worklog")
+		defer s.Stop()
+
+		return api.ProxyDeleteWorklog(client, params.issueKey, params.worklogID, params.adjustEstimate, estimate)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Worklog \"%s\" deleted from issue \"%s\"", params.worklogID, params.issueKey)
+	fmt.Printf("%s/browse/%s\n", viper.GetString("server"), params.issueKey)
+}
+
+type deleteParams struct {
+	issueKey       string
+	worklogID      string
+	adjustEstimate string
+	newEstimate    string
+	increaseBy     string
+	yes            bool
+	debug          bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *deleteParams {
+	var issueKey, worklogID string
+
+	nargs := len(args)
+	if nargs >= 1 {
+		issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	}
+	if nargs >= 2 {
+		worklogID = args[1]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	yes, err := flags.GetBool("yes")
+	cmdutil.ExitIfError(err)
+
+	adjustEstimate, err := flags.GetString("adjust-estimate")
+	cmdutil.ExitIfError(err)
+
+	newEstimate, err := flags.GetString("new-estimate")
+	cmdutil.ExitIfError(err)
+
+	increaseBy, err := flags.GetString("increase-by")
+	cmdutil.ExitIfError(err)
+
+	return &deleteParams{
+		issueKey:       issueKey,
+		worklogID:      worklogID,
+		adjustEstimate: adjustEstimate,
+		newEstimate:    newEstimate,
+		increaseBy:     increaseBy,
+		yes:            yes,
+		debug:          debug,
+	}
+}
+
+type deleteCmd struct {
+	client *jira.Client
+	params *deleteParams
+}
+
+func (dc *deleteCmd) setIssueKey() error {
+	if dc.params.issueKey != "" {
+		return nil
+	}
+
+	var ans string
+
+	qs := &survey.Question{
+		Name:     "issueKey",
+		Prompt:   &survey.Input{Message: "Issue key"},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return err
+	}
+	dc.params.issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), ans)
+
+	return nil
+}
+
+func (dc *deleteCmd) setWorklogID() error {
+	if dc.params.worklogID != "" {
+		return nil
+	}
+
+	worklogs, err := func() ([]*jira.Worklog, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching your worklogs in issue %s...", dc.params.issueKey))
+		defer s.Stop()
+
+		me, err := dc.client.Me()
+		if err != nil {
+			return nil, err
+		}
+		all, err := api.ProxyWorklogs(dc.client, dc.params.issueKey, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		var mine []*jira.Worklog
+		for _, wl := range all {
+			if wl.Author.Name == me.Name {
+				mine = append(mine, wl)
+			}
+		}
+		return mine, nil
+	}()
+	if err != nil {
+		return err
+	}
+	if len(worklogs) == 0 {
+		return fmt.Errorf("no worklogs by you found in issue %q", dc.params.issueKey)
+	}
+
+	wl, err := cmdcommon.SelectWorklog(worklogs)
+	if err != nil {
+		return err
+	}
+	dc.params.worklogID = wl.ID
+
+	return nil
+}
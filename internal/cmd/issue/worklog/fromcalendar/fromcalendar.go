@@ -0,0 +1,217 @@
+package fromcalendar
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/gitlog"
+	"github.com/ankitpokhrel/jira-cli/internal/timesync"
+	"github.com/ankitpokhrel/jira-cli/pkg/ics"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `From-calendar proposes worklogs from the events of an iCalendar (.ics) file.
+
+Each event of the given date becomes a worklog with the event length as the time spent
+and the event title as the comment. You will be asked to assign each event to an issue;
+an issue key found in the event title is suggested. Leave the issue key empty to skip
+the event. With --no-input, only events with an issue key in the title are logged.
+
+All-day events are skipped and recurring events are not expanded.`
+	examples = `$ jira issue worklog from-calendar meetings.ics
+
+# Log meetings of yesterday
+$ jira issue worklog from-calendar meetings.ics --date yesterday
+
+# See the proposed worklogs without adding them
+$ jira issue worklog from-calendar meetings.ics --date 2022-02-02 --dry-run`
+)
+
+// NewCmdWorklogFromCalendar is a worklog from-calendar command.
+func NewCmdWorklogFromCalendar() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "from-calendar FILE",
+		Short:   "Add worklogs from calendar events",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"calendar", "ics"},
+		Annotations: map[string]string{
+			"help:args": "FILE\tPath to the .ics file, use - to read from standard input",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  fromCalendar,
+	}
+
+	cmd.Flags().String("date", "today", "Date of the events: today, yesterday, a weekday or YYYY-MM-DD")
+	cmd.Flags().String("timezone", "", "Timezone of the events without one, eg: Europe/Berlin (defaults to local timezone)")
+	cmd.Flags().Bool("dry-run", false, "Display proposed worklogs without adding them")
+	cmd.Flags().Bool("no-input", false, "Log events with an issue key in the title without prompting")
+
+	return &cmd
+}
+
+type proposal struct {
+	event    *ics.Event
+	issueKey string
+}
+
+func fromCalendar(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	date, err := cmd.Flags().GetString("date")
+	cmdutil.ExitIfError(err)
+
+	timezone, err := cmd.Flags().GetString("timezone")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := cmd.Flags().GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	loc, err := cmdcommon.WorklogLocation(timezone)
+	cmdutil.ExitIfError(err)
+
+	day, err := timesync.ParseSince(date, time.Now().In(loc))
+	cmdutil.ExitIfError(err)
+
+	var r io.Reader = os.Stdin
+	if args[0] != "-" {
+		f, err := os.Open(args[0])
+		cmdutil.ExitIfError(err)
+		defer func() { _ = f.Close() }()
+
+		r = f
+	}
+
+	events, err := ics.Parse(r, loc)
+	cmdutil.ExitIfError(err)
+
+	var proposals []*proposal
+	for _, e := range events {
+		if e.AllDay || e.Start.Before(day) || !e.Start.Before(day.AddDate(0, 0, 1)) || e.Duration() <= 0 {
+			continue
+		}
+
+		var key string
+		if keys := gitlog.IssueKeys(e.Summary); len(keys) > 0 {
+			key = keys[0]
+		}
+		proposals = append(proposals, &proposal{event: e, issueKey: key})
+	}
+
+	if len(proposals) == 0 {
+		cmdutil.Failed("No events found on %s", day.Format("2006-01-02"))
+	}
+
+	if dryRun {
+		cmdutil.ExitIfError(render(os.Stdout, proposals, loc))
+		return
+	}
+
+	if !noInput {
+		for _, p := range proposals {
+			key, err := askIssueKey(p, loc)
+			cmdutil.ExitIfError(err)
+			p.issueKey = key
+		}
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	var (
+		failed        strings.Builder
+		passed, total int
+	)
+
+	err = func() error {
+		s := cmdutil.Info("Adding worklogs")
+		defer s.Stop()
+
+		for _, p := range proposals {
+			if p.issueKey == "" {
+				continue
+			}
+			total++
+
+			_, err := api.ProxyAddWorklog(
+				client, p.issueKey, p.event.Summary, p.event.Start.In(loc).Format(jira.RFC3339MilliLayout),
+				jira.FormatTimeSpent(p.event.Duration()), "", "", nil, nil,
+			)
+			if err != nil {
+				failed.WriteString(fmt.Sprintf(
+					"\n  - %s (%s): %s", p.event.Summary, p.issueKey, cmdutil.NormalizeJiraError(err.Error()),
+				))
+				continue
+			}
+			passed++
+		}
+
+		if failed.Len() > 0 {
+			return &jira.ErrMultipleFailed{Msg: failed.String()}
+		}
+		return nil
+	}()
+
+	if total == 0 {
+		cmdutil.Failed("No events were assigned to an issue")
+	}
+	if passed > 0 {
+		cmdutil.Success("Added %d of %d worklogs", passed, total)
+	}
+	cmdutil.ExitIfError(err)
+}
+
+func askIssueKey(p *proposal, loc *time.Location) (string, error) {
+	var ans string
+
+	prompt := &survey.Input{
+		Message: fmt.Sprintf(
+			"Issue key for %q (%s, %s)",
+			p.event.Summary, p.event.Start.In(loc).Format("15:04"), jira.FormatTimeSpent(p.event.Duration()),
+		),
+		Default: p.issueKey,
+		Help:    "Leave empty to skip the event",
+	}
+	if err := survey.AskOne(prompt, &ans); err != nil {
+		return "", err
+	}
+
+	ans = strings.TrimSpace(ans)
+	if ans == "" {
+		return "", nil
+	}
+	return cmdutil.GetJiraIssueKey(viper.GetString("project.key"), ans), nil
+}
+
+func render(w io.Writer, proposals []*proposal, loc *time.Location) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "ISSUE\tSTARTED\tTIME SPENT\tTITLE")
+	for _, p := range proposals {
+		key := p.issueKey
+		if key == "" {
+			key = "-"
+		}
+		fmt.Fprintf(
+			tw, "%s\t%s\t%s\t%s\n",
+			key, p.event.Start.In(loc).Format("2006-01-02 15:04"), jira.FormatTimeSpent(p.event.Duration()), p.event.Summary,
+		)
+	}
+
+	return tw.Flush()
+}
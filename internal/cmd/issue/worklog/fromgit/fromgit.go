@@ -0,0 +1,222 @@
+package fromgit
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/gitlog"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `From-git reconstructs worklogs from the commit history of the current git repository.
+
+Issue keys are extracted from commit messages. Commits without an issue key are credited to
+the issue key found in the current branch name, if any. Time spent on a commit is the time
+elapsed since your previous commit. If the gap is larger than --max-gap, the commit is
+considered the first one of a work session and is credited with --first-commit instead.
+
+Worklogs are estimated per issue and day. You will be asked to confirm or adjust the estimated
+time spent of each worklog unless --no-input is set. Leave the time spent empty to skip it.`
+	examples = `$ jira issue worklog from-git
+
+# Reconstruct worklogs of commits since yesterday
+$ jira issue worklog from-git --since yesterday
+
+# See what would be logged without adding worklogs
+$ jira issue worklog from-git --since "2 days ago" --dry-run
+
+# Add estimated worklogs without prompting
+$ jira issue worklog from-git --no-input`
+)
+
+// NewCmdWorklogFromGit is a worklog from-git command.
+func NewCmdWorklogFromGit() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "from-git",
+		Short:   "Add worklogs estimated from the git commit history",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"git"},
+		Run:     fromGit,
+	}
+
+	cmd.Flags().String("since", "midnight", "Scan commits more recent than the given date, eg: yesterday")
+	cmd.Flags().String("author", "", "Scan commits of the given author (defaults to git user.email)")
+	cmd.Flags().Duration("max-gap", 2*time.Hour, "Maximum gap between commits of the same work session")
+	cmd.Flags().Duration("first-commit", 30*time.Minute, "Time credited to the first commit of a work session")
+	cmd.Flags().Bool("dry-run", false, "Display estimated worklogs without adding them")
+	cmd.Flags().Bool("no-input", false, "Add estimated worklogs without prompting")
+
+	return &cmd
+}
+
+type params struct {
+	since       string
+	author      string
+	maxGap      time.Duration
+	firstCommit time.Duration
+	dryRun      bool
+	noInput     bool
+	debug       bool
+}
+
+func fromGit(cmd *cobra.Command, _ []string) {
+	p := parseFlags(cmd)
+
+	wd, err := os.Getwd()
+	cmdutil.ExitIfError(err)
+
+	if p.author == "" {
+		p.author, err = gitlog.UserEmail(wd)
+		cmdutil.ExitIfError(err)
+	}
+
+	commits, err := gitlog.Log(wd, p.since, p.author)
+	cmdutil.ExitIfError(err)
+
+	var branchKey string
+	if branch, err := gitlog.CurrentBranch(wd); err == nil {
+		if keys := gitlog.IssueKeys(strings.ToUpper(branch)); len(keys) > 0 {
+			branchKey = keys[0]
+		}
+	}
+
+	entries := gitlog.Estimate(commits, branchKey, p.maxGap, p.firstCommit)
+	if len(entries) == 0 {
+		cmdutil.Failed("No commits referencing an issue found since %q", p.since)
+	}
+
+	if p.dryRun {
+		cmdutil.ExitIfError(render(os.Stdout, entries))
+		return
+	}
+
+	timeSpent := make([]string, len(entries))
+	for i, e := range entries {
+		timeSpent[i] = jira.FormatTimeSpent(e.TimeSpent)
+	}
+
+	if !p.noInput {
+		for i, e := range entries {
+			ts, err := askTimeSpent(e, timeSpent[i])
+			cmdutil.ExitIfError(err)
+			timeSpent[i] = ts
+		}
+	}
+
+	client := api.Client(jira.Config{Debug: p.debug})
+
+	var (
+		failed        strings.Builder
+		passed, total int
+	)
+
+	err = func() error {
+		s := cmdutil.Info("Adding worklogs")
+		defer s.Stop()
+
+		for i, e := range entries {
+			ts := timeSpent[i]
+			if ts == "" {
+				continue
+			}
+			total++
+
+			_, err := api.ProxyAddWorklog(
+				client, e.IssueKey, e.Comment(), e.Started.Format(jira.RFC3339MilliLayout), ts, "", "", nil, nil,
+			)
+			if err != nil {
+				failed.WriteString(fmt.Sprintf(
+					"\n  - %s on %s: %s", e.IssueKey, e.Started.Format("2006-01-02"), cmdutil.NormalizeJiraError(err.Error()),
+				))
+			} else {
+				passed++
+			}
+		}
+
+		if failed.Len() > 0 {
+			return &jira.ErrMultipleFailed{Msg: failed.String()}
+		}
+		return nil
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Added %d of %d worklogs", passed, total)
+	}
+	cmdutil.ExitIfError(err)
+}
+
+func parseFlags(cmd *cobra.Command) *params {
+	flags := cmd.Flags()
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	since, err := flags.GetString("since")
+	cmdutil.ExitIfError(err)
+
+	author, err := flags.GetString("author")
+	cmdutil.ExitIfError(err)
+
+	maxGap, err := flags.GetDuration("max-gap")
+	cmdutil.ExitIfError(err)
+
+	firstCommit, err := flags.GetDuration("first-commit")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := flags.GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	return &params{
+		since:       since,
+		author:      author,
+		maxGap:      maxGap,
+		firstCommit: firstCommit,
+		dryRun:      dryRun,
+		noInput:     noInput,
+		debug:       debug,
+	}
+}
+
+func askTimeSpent(e *gitlog.Entry, def string) (string, error) {
+	var ans string
+
+	prompt := &survey.Input{
+		Message: fmt.Sprintf(
+			"Time spent on %s (%d commits since %s)", e.IssueKey, len(e.Commits), e.Started.Format("2006-01-02 15:04"),
+		),
+		Default: def,
+		Help:    e.Comment(),
+	}
+	if err := survey.AskOne(prompt, &ans); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(ans), nil
+}
+
+func render(w io.Writer, entries []*gitlog.Entry) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "ISSUE\tSTARTED\tTIME SPENT\tCOMMITS")
+	for _, e := range entries {
+		fmt.Fprintf(
+			tw, "%s\t%s\t%s\t%d\n",
+			e.IssueKey, e.Started.Format("2006-01-02 15:04"), jira.FormatTimeSpent(e.TimeSpent), len(e.Commits),
+		)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,315 @@
+package grid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/timesheet"
+	"github.com/ankitpokhrel/jira-cli/internal/timesync"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	maxLabelSize = 40
+
+	helpText = `Grid opens an interactive weekly timesheet of your issues.
+
+Days of the week are displayed as columns and issues as rows. Issues you logged work on
+during the week and issues assigned to you that are in progress are displayed by default,
+use --issue to add more rows.
+
+Move around with arrow keys and type a duration, eg: 1h 30m, into a cell to replace its
+value. Use DELETE to clear a cell, CTRL+S to save and ESC to quit without saving.
+
+On save, the timesheet is compared with your existing worklogs: a worklog is added to an
+empty cell, the latest worklog of a cell is adjusted when its total changes and worklogs
+of a cleared cell are deleted.`
+	examples = `$ jira issue worklog grid
+
+# Fill the timesheet of the last week
+$ jira issue worklog grid --week last
+
+# Fill the timesheet of the week of the given date with additional issues
+$ jira issue worklog grid --week 2022-02-02 --issue ISSUE-1 --issue ISSUE-2`
+	footerText = "Use arrow keys to navigate, type to edit, DELETE to clear, CTRL+S to save, ESC to quit"
+)
+
+// NewCmdWorklogGrid is a worklog grid command.
+func NewCmdWorklogGrid() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "grid",
+		Short:   "Edit worklogs of a week in an interactive timesheet",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"timesheet", "week"},
+		Run:     grid,
+	}
+
+	cmd.Flags().String("week", "today", "A date in the week: today, last, a weekday or YYYY-MM-DD")
+	cmd.Flags().StringArray("issue", []string{}, "Additional issue to display in the timesheet. Can be used multiple times")
+	cmd.Flags().String("timezone", "", "Timezone of the timesheet, eg: Europe/Berlin (defaults to local timezone)")
+
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to fetch worklogs of at once")
+
+	return &cmd
+}
+
+func grid(cmd *cobra.Command, _ []string) {
+	params := parseArgsAndFlags(cmd.Flags())
+
+	if api.IsTempoWorklogProvider() {
+		cmdutil.Failed("Timesheet grid is not supported with the %q worklog provider", api.WorklogProviderTempo)
+	}
+
+	loc, err := cmdcommon.WorklogLocation(params.timezone)
+	cmdutil.ExitIfError(err)
+
+	day, err := weekDay(params.week, time.Now().In(loc))
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: params.debug})
+	sheet := timesheet.New(day)
+
+	err = func() error {
+		s := cmdutil.Info("Fetching your worklogs of the week...")
+		defer s.Stop()
+
+		return cmdcommon.LoadTimesheet(client, sheet, params.issues, params.concurrency)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(sheet.Rows) == 0 {
+		cmdutil.Failed("No issues found for the week of %s, use --issue to add one", sheet.Start.Format("2006-01-02"))
+	}
+
+	view := tui.NewSheet(
+		tui.WithSheetFooterText(footerText),
+		tui.WithCellValidateFunc(func(val string) error {
+			_, err := parseCell(val)
+			return err
+		}),
+		tui.WithSheetSummaryFunc(func(data tui.TableData) string {
+			return summarize(sheet, data)
+		}),
+	)
+	cmdutil.ExitIfError(view.Paint(tableData(sheet)))
+
+	if !view.Saved() {
+		return
+	}
+
+	values, err := cellValues(sheet, view.Data())
+	cmdutil.ExitIfError(err)
+
+	changes := sheet.Diff(values)
+	if len(changes) == 0 {
+		cmdutil.Success("Timesheet is up to date")
+		return
+	}
+
+	var (
+		failed strings.Builder
+		passed int
+	)
+
+	err = func() error {
+		s := cmdutil.Info("Saving timesheet")
+		defer s.Stop()
+
+		for _, c := range changes {
+			if err := apply(client, c, loc); err != nil {
+				failed.WriteString(fmt.Sprintf("\n  - %s: %s", describe(c, loc), cmdutil.NormalizeJiraError(err.Error())))
+				continue
+			}
+			passed++
+		}
+
+		if failed.Len() > 0 {
+			return &jira.ErrMultipleFailed{Msg: failed.String()}
+		}
+		return nil
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Applied %d of %d worklog changes", passed, len(changes))
+	}
+	cmdutil.ExitIfError(err)
+}
+
+type gridParams struct {
+	week        string
+	issues      []string
+	timezone    string
+	concurrency int
+	debug       bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser) *gridParams {
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	week, err := flags.GetString("week")
+	cmdutil.ExitIfError(err)
+
+	issues, err := flags.GetStringArray("issue")
+	cmdutil.ExitIfError(err)
+
+	timezone, err := flags.GetString("timezone")
+	cmdutil.ExitIfError(err)
+
+	concurrency := cmdcommon.GetConcurrency(flags)
+
+	keys := make([]string, 0, len(issues))
+	for _, iss := range issues {
+		keys = append(keys, cmdutil.GetJiraIssueKey(viper.GetString("project.key"), iss))
+	}
+
+	return &gridParams{
+		week:        week,
+		issues:      keys,
+		timezone:    timezone,
+		concurrency: concurrency,
+		debug:       debug,
+	}
+}
+
+func weekDay(week string, now time.Time) (time.Time, error) {
+	if strings.EqualFold(week, "last") {
+		return now.AddDate(0, 0, -timesheet.DaysInWeek), nil
+	}
+	return timesync.ParseSince(week, now)
+}
+
+func tableData(sheet *timesheet.Sheet) tui.TableData {
+	header := []string{"ISSUE"}
+	for d := 0; d < timesheet.DaysInWeek; d++ {
+		header = append(header, sheet.Day(d).Format("Mon 02 Jan"))
+	}
+
+	data := tui.TableData{header}
+	for _, r := range sheet.Rows {
+		label := r.IssueKey
+		if r.Summary != "" {
+			label += " " + r.Summary
+		}
+		if len([]rune(label)) > maxLabelSize {
+			label = string([]rune(label)[:maxLabelSize-1]) + "…"
+		}
+
+		row := []string{label}
+		for d := 0; d < timesheet.DaysInWeek; d++ {
+			row = append(row, formatCell(r.Total(d)))
+		}
+		data = append(data, row)
+	}
+
+	return data
+}
+
+// cellValues converts edited cells to seconds. Untouched cells keep the logged
+// time as is, so that totals not rounded to a minute are not seen as a change.
+func cellValues(sheet *timesheet.Sheet, data tui.TableData) ([][]int, error) {
+	values := make([][]int, 0, len(sheet.Rows))
+
+	for i, r := range sheet.Rows {
+		row := data[i+1]
+		v := make([]int, 0, timesheet.DaysInWeek)
+		for d := 0; d < timesheet.DaysInWeek; d++ {
+			if row[d+1] == formatCell(r.Total(d)) {
+				v = append(v, r.Total(d))
+				continue
+			}
+			secs, err := parseCell(row[d+1])
+			if err != nil {
+				return nil, err
+			}
+			v = append(v, secs)
+		}
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
+func summarize(sheet *timesheet.Sheet, data tui.TableData) string {
+	var (
+		out   strings.Builder
+		total int
+	)
+
+	out.WriteString("Total:")
+	for d := 0; d < timesheet.DaysInWeek; d++ {
+		day := 0
+		for _, row := range data[1:] {
+			secs, _ := parseCell(row[d+1])
+			day += secs
+		}
+		total += day
+
+		if day > 0 {
+			out.WriteString(fmt.Sprintf("  %s %s", sheet.Day(d).Format("Mon"), formatCell(day)))
+		}
+	}
+	out.WriteString(fmt.Sprintf("  |  Week %s", formatCell(total)))
+
+	return out.String()
+}
+
+func apply(client *jira.Client, c *timesheet.Change, loc *time.Location) error {
+	timeSpent := formatCell(c.Seconds)
+
+	switch c.Action {
+	case timesheet.ActionCreate:
+		_, err := api.ProxyAddWorklog(
+			client, c.IssueKey, "", c.Started.In(loc).Format(jira.RFC3339MilliLayout), timeSpent, "", "", nil, nil,
+		)
+		return err
+	case timesheet.ActionUpdate:
+		started, err := time.Parse(jira.RFC3339, c.Worklog.Started)
+		if err != nil {
+			return err
+		}
+		return client.UpdateIssueWorklog(
+			c.IssueKey, c.Worklog.ID, c.Worklog.Comment, started.Format(jira.RFC3339MilliLayout), timeSpent, c.Worklog.Visibility,
+		)
+	case timesheet.ActionDelete:
+		return api.ProxyDeleteWorklog(client, c.IssueKey, c.Worklog.ID, "", "")
+	}
+
+	return fmt.Errorf("unknown worklog change")
+}
+
+func describe(c *timesheet.Change, loc *time.Location) string {
+	switch c.Action {
+	case timesheet.ActionCreate:
+		return fmt.Sprintf("add %s to %s on %s", formatCell(c.Seconds), c.IssueKey, c.Started.In(loc).Format("2006-01-02"))
+	case timesheet.ActionUpdate:
+		return fmt.Sprintf("update worklog %s of %s to %s", c.Worklog.ID, c.IssueKey, formatCell(c.Seconds))
+	default:
+		return fmt.Sprintf("delete worklog %s of %s", c.Worklog.ID, c.IssueKey)
+	}
+}
+
+func parseCell(val string) (int, error) {
+	val = strings.TrimSpace(val)
+	if val == "" || val == "0" {
+		return 0, nil
+	}
+	return jira.ParseTimeSpent(val)
+}
+
+func formatCell(secs int) string {
+	if secs <= 0 {
+		return ""
+	}
+	return jira.FormatTimeSpent(time.Duration(secs) * time.Second)
+}
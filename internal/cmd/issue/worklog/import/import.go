@@ -0,0 +1,221 @@
+package importcmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	numColumns = 5
+	helpText   = `Import adds worklogs in bulk from a CSV file.
+
+Each row of the file must contain the following columns in order:
+  ISSUE-KEY, DATE (YYYY-MM-DD), TIME (hh:mm), TIME_SPENT, COMMENT
+
+Started date and time are interpreted in the local timezone unless --timezone
+is set. Blank lines and lines starting with # are ignored. Rows that fail are
+reported at the end and the rest of the rows are still imported.`
+	examples = `$ jira issue worklog import --file worklogs.csv
+
+# Skip the header row of the file
+$ jira issue worklog import --file worklogs.csv --skip-header
+
+# Validate the file and see what would be imported without adding worklogs
+$ jira issue worklog import --file worklogs.csv --dry-run
+
+# Read rows from standard input
+$ cat worklogs.csv | jira issue worklog import --file -`
+)
+
+// NewCmdWorklogImport is a worklog import command.
+func NewCmdWorklogImport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "import",
+		Short:   "Import worklogs from a CSV file",
+		Long:    helpText,
+		Example: examples,
+		Run:     importWorklogs,
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Path to the CSV file to import, use - to read from standard input")
+	cmd.Flags().Bool("skip-header", false, "Skip the first row of the file")
+	cmd.Flags().Bool("dry-run", false, "Validate and display worklogs without adding them")
+	cmd.Flags().String("timezone", "", "Timezone of the started date and time, eg: Europe/Berlin (defaults to local timezone)")
+
+	_ = cmd.MarkFlagRequired("file")
+
+	return &cmd
+}
+
+type row struct {
+	line      int
+	issueKey  string
+	started   time.Time
+	timeSpent string
+	comment   string
+}
+
+func importWorklogs(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	file, err := cmd.Flags().GetString("file")
+	cmdutil.ExitIfError(err)
+
+	skipHeader, err := cmd.Flags().GetBool("skip-header")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	timezone, err := cmd.Flags().GetString("timezone")
+	cmdutil.ExitIfError(err)
+
+	loc, err := cmdcommon.WorklogLocation(timezone)
+	cmdutil.ExitIfError(err)
+
+	var r io.Reader = os.Stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		cmdutil.ExitIfError(err)
+		defer func() { _ = f.Close() }()
+
+		r = f
+	}
+
+	rows, err := parse(r, viper.GetString("project.key"), skipHeader, loc)
+	cmdutil.ExitIfError(err)
+
+	if len(rows) == 0 {
+		cmdutil.Failed("No worklogs found in the file")
+	}
+
+	if dryRun {
+		cmdutil.ExitIfError(render(os.Stdout, rows))
+		return
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	var (
+		failed strings.Builder
+		passed int
+	)
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Importing %d worklogs...", len(rows)))
+		defer s.Stop()
+
+		for _, rw := range rows {
+			_, err := api.ProxyAddWorklog(
+				client, rw.issueKey, rw.comment, rw.started.Format(jira.RFC3339MilliLayout), rw.timeSpent, "", "", nil, nil,
+			)
+			if err != nil {
+				msg := fmt.Sprintf("\n  - Row %d (%s): %s", rw.line, rw.issueKey, cmdutil.NormalizeJiraError(err.Error()))
+				failed.WriteString(msg)
+			} else {
+				passed++
+			}
+		}
+
+		if failed.Len() > 0 {
+			return &jira.ErrMultipleFailed{Msg: failed.String()}
+		}
+		return nil
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Imported %d of %d worklogs", passed, len(rows))
+	}
+	cmdutil.ExitIfError(err)
+}
+
+// parse reads and validates worklog rows. All invalid rows are reported at once.
+// Blank lines and lines starting with # are ignored.
+func parse(r io.Reader, project string, skipHeader bool, loc *time.Location) ([]*row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var (
+		rows    []*row
+		invalid strings.Builder
+	)
+
+	for {
+		rec, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if skipHeader {
+			skipHeader = false
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(rec) != numColumns {
+			invalid.WriteString(fmt.Sprintf("\n  - Row %d: expected %d columns, got %d", line, numColumns, len(rec)))
+			continue
+		}
+
+		started, err := time.ParseInLocation("2006-01-02 15:04", rec[1]+" "+rec[2], loc)
+		if err != nil {
+			invalid.WriteString(fmt.Sprintf("\n  - Row %d: invalid started date or time %q %q", line, rec[1], rec[2]))
+			continue
+		}
+		if rec[0] == "" || rec[3] == "" {
+			invalid.WriteString(fmt.Sprintf("\n  - Row %d: issue key and time spent are required", line))
+			continue
+		}
+		timeSpent := strings.TrimSpace(rec[3])
+		if secs, err := jira.ParseTimeSpent(timeSpent); err != nil || secs == 0 {
+			invalid.WriteString(fmt.Sprintf("\n  - Row %d: invalid time spent %q", line, rec[3]))
+			continue
+		}
+
+		rows = append(rows, &row{
+			line:      line,
+			issueKey:  cmdutil.GetJiraIssueKey(project, strings.TrimSpace(rec[0])),
+			started:   started,
+			timeSpent: timeSpent,
+			comment:   rec[4],
+		})
+	}
+
+	if invalid.Len() > 0 {
+		return nil, &jira.ErrMultipleFailed{Msg: invalid.String()}
+	}
+	return rows, nil
+}
+
+func render(w io.Writer, rows []*row) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "ROW\tISSUE\tSTARTED\tTIME SPENT\tCOMMENT")
+	for _, rw := range rows {
+		fmt.Fprintf(
+			tw, "%d\t%s\t%s\t%s\t%s\n",
+			rw.line, rw.issueKey, rw.started.Format("2006-01-02 15:04 -0700"), rw.timeSpent,
+			strings.Join(strings.Fields(rw.comment), " "),
+		)
+	}
+
+	return tw.Flush()
+}
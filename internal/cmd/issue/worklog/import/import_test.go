@@ -0,0 +1,80 @@
+package importcmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	started := func(s string) time.Time {
+		t, _ := time.ParseInLocation("2006-01-02 15:04", s, time.UTC)
+		return t
+	}
+
+	cases := []struct {
+		name       string
+		input      string
+		skipHeader bool
+		expected   []*row
+		err        string
+	}{
+		{
+			name:  "it parses valid rows",
+			input: "TEST-1,2022-02-02,09:30,1h 30m,Planning\n2,2022-02-03,14:00,2h,\"Review,\nand fixes\"\n",
+			expected: []*row{
+				{line: 1, issueKey: "TEST-1", started: started("2022-02-02 09:30"), timeSpent: "1h 30m", comment: "Planning"},
+				{line: 2, issueKey: "TEST-2", started: started("2022-02-03 14:00"), timeSpent: "2h", comment: "Review,\nand fixes"},
+			},
+		},
+		{
+			name:       "it skips the header row",
+			input:      "issue,date,time,spent,comment\nTEST-1,2022-02-02,09:30,45m,Planning\n",
+			skipHeader: true,
+			expected: []*row{
+				{line: 2, issueKey: "TEST-1", started: started("2022-02-02 09:30"), timeSpent: "45m", comment: "Planning"},
+			},
+		},
+		{
+			name:  "it ignores comments and blank lines",
+			input: "# Week 5\n\nTEST-1,2022-02-02,09:30,1d,Planning\n\n# Week 6\nTEST-2,2022-02-09,10:00,4h,Demo\n",
+			expected: []*row{
+				{line: 3, issueKey: "TEST-1", started: started("2022-02-02 09:30"), timeSpent: "1d", comment: "Planning"},
+				{line: 6, issueKey: "TEST-2", started: started("2022-02-09 10:00"), timeSpent: "4h", comment: "Demo"},
+			},
+		},
+		{
+			name:  "it reports the line of invalid rows",
+			input: "# Week 5\nTEST-1,2022-02-02,09:30,1h,Planning\n\nTEST-1,2022-02-02,09:30,1h\nTEST-2,02/02/2022,09:30,1h,Demo\n,2022-02-02,09:30,1h,Demo\n",
+			err: "\n  - Row 4: expected 5 columns, got 4" +
+				"\n  - Row 5: invalid started date or time \"02/02/2022\" \"09:30\"" +
+				"\n  - Row 6: issue key and time spent are required",
+		},
+		{
+			name:  "it validates time spent",
+			input: "TEST-1,2022-02-02,09:30,1h 30m,Planning\nTEST-1,2022-02-02,11:00,90 minutes,Demo\nTEST-1,2022-02-02,13:00,0h,Review\n",
+			err: "\n  - Row 2: invalid time spent \"90 minutes\"" +
+				"\n  - Row 3: invalid time spent \"0h\"",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rows, err := parse(strings.NewReader(tc.input), "TEST", tc.skipHeader, time.UTC)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, rows)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package list
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	pageSize = 100
+	helpText = `List lists worklogs of an issue.
+
+Worklogs are fetched page by page until all entries of the issue are retrieved.`
+	examples = `$ jira issue worklog list ISSUE-1
+
+# List worklogs in a plain table view without headers
+$ jira issue worklog list ISSUE-1 --plain --no-headers
+
+# List worklogs in a plain table view and show full comments
+$ jira issue worklog list ISSUE-1 --plain --no-truncate
+
+# Export worklogs in csv or json format
+$ jira issue worklog list ISSUE-1 --output csv > worklogs.csv
+$ jira issue worklog list ISSUE-1 --output json`
+)
+
+// NewCmdWorklogList is a worklog list command.
+func NewCmdWorklogList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list ISSUE-KEY",
+		Short:   "List worklogs of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.MinimumNArgs(1),
+		Run:  list,
+	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers")
+	cmd.Flags().Bool("no-truncate", false, "Don't truncate worklog comments")
+
+	return &cmd
+}
+
+func list(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	worklogs, err := func() ([]*jira.Worklog, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching worklogs of issue %s...", key))
+		defer s.Stop()
+
+		return api.ProxyWorklogs(api.Client(jira.Config{Debug: debug}), key, pageSize)
+	}()
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	noTruncate, err := cmd.Flags().GetBool("no-truncate")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	v := view.NewWorklog(
+		worklogs,
+		view.WithWorklogIssueKey(key),
+		view.WithWorklogDisplayFormat(view.DisplayFormat{
+			Plain:      plain,
+			NoHeaders:  noHeaders,
+			NoTruncate: noTruncate,
+		}),
+	)
+
+	switch output {
+	case "":
+		if len(worklogs) == 0 {
+			fmt.Println()
+			cmdutil.Failed("No worklogs found for issue \"%s\"", key)
+			return
+		}
+		cmdutil.ExitIfError(v.Render())
+	case view.OutputCSV:
+		cmdutil.ExitIfError(v.RenderCSV(os.Stdout))
+	case view.OutputJSON:
+		cmdutil.ExitIfError(v.RenderJSON(os.Stdout))
+	default:
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+}
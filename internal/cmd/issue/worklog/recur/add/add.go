@@ -0,0 +1,80 @@
+package add
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/recur"
+)
+
+const (
+	helpText = `Add schedules a recurring worklog.
+
+The worklog is submitted by 'jira issue worklog recur run' on each of the given days
+once the given time of the day has passed.`
+	examples = `$ jira issue worklog recur add ISSUE-1 30m "Daily standup" --days mon-fri --time 09:30
+
+# Log a weekly retro every Friday
+$ jira issue worklog recur add ISSUE-1 1h "Retro" --days fri --time 16:00`
+)
+
+// NewCmdRecurAdd is a recur add command.
+func NewCmdRecurAdd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "add ISSUE-KEY TIME_SPENT [WORKLOG_BODY]",
+		Short:   "Schedule a recurring worklog",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1\n" +
+				"TIME_SPENT\tTime spent in format '30m' or '4h 20m', etc.\n" +
+				"WORKLOG_BODY\tBody of the worklog",
+		},
+		Args: cobra.RangeArgs(2, 3),
+		Run:  add,
+	}
+
+	cmd.Flags().String("days", "mon-fri", "Days to log work on, eg: mon-fri, mon,wed,fri or daily")
+	cmd.Flags().String("time", "09:00", "Started time of the worklog in format 'hh:mm'")
+
+	return &cmd
+}
+
+func add(cmd *cobra.Command, args []string) {
+	days, err := cmd.Flags().GetString("days")
+	cmdutil.ExitIfError(err)
+
+	at, err := cmd.Flags().GetString("time")
+	cmdutil.ExitIfError(err)
+
+	weekdays, err := recur.ParseDays(days)
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(recur.ValidateClock(at))
+
+	sc := recur.Schedule{
+		IssueKey:  cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0]),
+		TimeSpent: args[1],
+		Days:      weekdays,
+		At:        at,
+		Created:   time.Now(),
+	}
+	if len(args) > 2 {
+		sc.Comment = args[2]
+	}
+
+	home, err := cmdutil.GetConfigHome()
+	cmdutil.ExitIfError(err)
+
+	store := recur.NewStore(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
+	cmdutil.ExitIfError(store.Add(&sc))
+
+	cmdutil.Success(
+		"Recurring worklog #%d of %s scheduled for issue \"%s\" (%s at %s)",
+		sc.ID, sc.TimeSpent, sc.IssueKey, sc.DaysString(), sc.At,
+	)
+}
@@ -0,0 +1,53 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/recur"
+)
+
+// NewCmdRecurList is a recur list command.
+func NewCmdRecurList() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List recurring worklogs",
+		Long:    "List lists scheduled recurring worklogs.",
+		Aliases: []string{"lists", "ls"},
+		Run:     list,
+	}
+}
+
+func list(_ *cobra.Command, _ []string) {
+	home, err := cmdutil.GetConfigHome()
+	cmdutil.ExitIfError(err)
+
+	schedules, err := recur.NewStore(fmt.Sprintf("%s/%s", home, jiraConfig.Dir)).All()
+	cmdutil.ExitIfError(err)
+
+	if len(schedules) == 0 {
+		cmdutil.Failed("No recurring worklogs found")
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "ID\tISSUE\tTIME SPENT\tDAYS\tAT\tLAST RUN\tCOMMENT")
+	for _, sc := range schedules {
+		lastRun := "-"
+		if !sc.LastRun.IsZero() {
+			lastRun = sc.LastRun.Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(
+			tw, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			sc.ID, sc.IssueKey, sc.TimeSpent, sc.DaysString(), sc.At, lastRun,
+			strings.Join(strings.Fields(sc.Comment), " "),
+		)
+	}
+	cmdutil.ExitIfError(tw.Flush())
+}
@@ -0,0 +1,37 @@
+package recur
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/recur/add"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/recur/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/recur/remove"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/recur/run"
+)
+
+const helpText = `Recur command helps you log routine work, eg: daily standups, on a schedule.
+
+Recurring worklogs are stored locally in the config directory. Schedule 'jira issue worklog recur run'
+with cron or a similar tool to submit the worklogs that are due.`
+
+// NewCmdWorklogRecur is a worklog recur command.
+func NewCmdWorklogRecur() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "recur",
+		Short:   "Manage recurring worklogs",
+		Long:    helpText,
+		Aliases: []string{"recurring"},
+		RunE:    recur,
+	}
+
+	cmd.AddCommand(
+		add.NewCmdRecurAdd(), list.NewCmdRecurList(),
+		remove.NewCmdRecurRemove(), run.NewCmdRecurRun(),
+	)
+
+	return &cmd
+}
+
+func recur(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,43 @@
+package remove
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/recur"
+)
+
+// NewCmdRecurRemove is a recur remove command.
+func NewCmdRecurRemove() *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove ID",
+		Short:   "Remove a recurring worklog",
+		Long:    "Remove removes a recurring worklog. Worklogs already submitted are not affected.",
+		Example: "$ jira issue worklog recur remove 1",
+		Aliases: []string{"rm", "delete"},
+		Annotations: map[string]string{
+			"help:args": "ID\tID of the recurring worklog, see 'jira issue worklog recur list'",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  remove,
+	}
+}
+
+func remove(_ *cobra.Command, args []string) {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		cmdutil.Failed("Invalid recurring worklog id %q", args[0])
+	}
+
+	home, err := cmdutil.GetConfigHome()
+	cmdutil.ExitIfError(err)
+
+	store := recur.NewStore(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
+	cmdutil.ExitIfError(store.Remove(id))
+
+	cmdutil.Success("Recurring worklog #%d removed", id)
+}
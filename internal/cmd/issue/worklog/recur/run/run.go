@@ -0,0 +1,141 @@
+package run
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/recur"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Run submits recurring worklogs that are due.
+
+Occurrences missed since the last run are caught up, so the command can be run at any
+interval, eg: every hour with cron. Each occurrence is submitted only once.`
+	examples = `$ jira issue worklog recur run
+
+# See what would be submitted without adding worklogs
+$ jira issue worklog recur run --dry-run
+
+# Crontab entry to submit due worklogs every hour
+0 * * * * jira issue worklog recur run`
+)
+
+// NewCmdRecurRun is a recur run command.
+func NewCmdRecurRun() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "run",
+		Short:   "Submit recurring worklogs that are due",
+		Long:    helpText,
+		Example: examples,
+		Run:     run,
+	}
+
+	cmd.Flags().Bool("dry-run", false, "Display due worklogs without adding them")
+
+	return &cmd
+}
+
+func run(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	home, err := cmdutil.GetConfigHome()
+	cmdutil.ExitIfError(err)
+
+	store := recur.NewStore(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
+
+	schedules, err := store.All()
+	cmdutil.ExitIfError(err)
+
+	now := time.Now()
+
+	due := make(map[int][]time.Time)
+	total := 0
+	for _, sc := range schedules {
+		if occ := sc.Due(now); len(occ) > 0 {
+			due[sc.ID] = occ
+			total += len(occ)
+		}
+	}
+
+	if total == 0 {
+		cmdutil.Success("No recurring worklogs are due")
+		return
+	}
+
+	if dryRun {
+		cmdutil.ExitIfError(render(schedules, due))
+		return
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	var (
+		failed strings.Builder
+		passed int
+	)
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Submitting %d recurring worklogs...", total))
+		defer s.Stop()
+
+		for _, sc := range schedules {
+			for _, occ := range due[sc.ID] {
+				_, err := api.ProxyAddWorklog(
+					client, sc.IssueKey, sc.Comment, occ.Format(jira.RFC3339MilliLayout), sc.TimeSpent, "", "", nil, nil,
+				)
+				if err != nil {
+					failed.WriteString(fmt.Sprintf(
+						"\n  - #%d %s on %s: %s",
+						sc.ID, sc.IssueKey, occ.Format("2006-01-02"), cmdutil.NormalizeJiraError(err.Error()),
+					))
+
+					// Stop at the first failure so that the occurrence is retried on the next run.
+					break
+				}
+				passed++
+
+				sc.LastRun = occ
+				if err := store.Update(sc); err != nil {
+					return err
+				}
+			}
+		}
+
+		if failed.Len() > 0 {
+			return &jira.ErrMultipleFailed{Msg: failed.String()}
+		}
+		return nil
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Submitted %d of %d recurring worklogs", passed, total)
+	}
+	cmdutil.ExitIfError(err)
+}
+
+func render(schedules []*recur.Schedule, due map[int][]time.Time) error {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "ID\tISSUE\tSTARTED\tTIME SPENT")
+	for _, sc := range schedules {
+		for _, occ := range due[sc.ID] {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", sc.ID, sc.IssueKey, occ.Format("2006-01-02 15:04"), sc.TimeSpent)
+		}
+	}
+
+	return tw.Flush()
+}
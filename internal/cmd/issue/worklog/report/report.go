@@ -0,0 +1,228 @@
+package report
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/timesheet"
+	"github.com/ankitpokhrel/jira-cli/internal/timesync"
+	"github.com/ankitpokhrel/jira-cli/pkg/chat"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	periodDay  = "day"
+	periodWeek = "week"
+
+	helpText = `Report summarizes the time you logged on each issue during a day or a week.
+
+The summary can be posted to a Slack or Microsoft Teams channel with --post, eg: from a
+cron job to share it with the team. Set the URL of the incoming webhook of the channel
+in the config, or in JIRA_SLACK_WEBHOOK and JIRA_TEAMS_WEBHOOK env:
+
+  worklog:
+    report:
+      slack: https://hooks.slack.com/services/T000/B000/XXXX
+      teams: https://example.webhook.office.com/webhookb2/XXXX`
+	examples = `$ jira issue worklog report
+
+# Summary of yesterday
+$ jira issue worklog report --date yesterday
+
+# Summary of the last week posted to Slack
+$ jira issue worklog report --period week --date last --post slack`
+)
+
+// NewCmdWorklogReport is a worklog report command.
+func NewCmdWorklogReport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "report",
+		Short:   "Summarize time logged during a day or a week",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"summary"},
+		Args:    cobra.NoArgs,
+		Run:     report,
+	}
+
+	cmd.Flags().String("period", periodDay, "Period to summarize: day or week")
+	cmd.Flags().String("date", "today", "A date in the period: today, yesterday, last (week), a weekday or YYYY-MM-DD")
+	cmd.Flags().String("post", "", "Post the summary to a chat: slack or teams")
+	cmd.Flags().String("timezone", "", "Timezone of the report, eg: Europe/Berlin (defaults to local timezone)")
+
+	cmdcommon.AddConcurrencyFlag(cmd.Flags(), "Number of issues to fetch worklogs of at once")
+
+	return &cmd
+}
+
+func report(cmd *cobra.Command, _ []string) {
+	params := parseFlags(cmd.Flags())
+
+	if api.IsTempoWorklogProvider() {
+		cmdutil.Failed("Worklog report is not supported with the %q worklog provider", api.WorklogProviderTempo)
+	}
+
+	var webhookURL string
+	if params.post != "" {
+		webhookURL = webhook(params.post)
+		if webhookURL == "" {
+			cmdutil.Failed(
+				"Missing webhook URL of %s, set worklog.report.%s in the config or JIRA_%s_WEBHOOK env",
+				params.post, params.post, strings.ToUpper(params.post),
+			)
+		}
+	}
+
+	loc, err := cmdcommon.WorklogLocation(params.timezone)
+	cmdutil.ExitIfError(err)
+
+	day, err := parseDate(params.date, params.period, time.Now().In(loc))
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: params.debug})
+	sheet := timesheet.New(day)
+
+	me, err := func() (*jira.Me, error) {
+		s := cmdutil.Info("Fetching your worklogs...")
+		defer s.Stop()
+
+		if err := cmdcommon.LoadTimesheet(client, sheet, nil, params.concurrency); err != nil {
+			return nil, err
+		}
+		return client.Me()
+	}()
+	cmdutil.ExitIfError(err)
+
+	msg := summarize(sheet, day, params.period, me.Name)
+
+	fmt.Println(msg.Title)
+	for _, l := range msg.Lines {
+		fmt.Printf("  - %s\n", l)
+	}
+
+	if params.post == "" {
+		return
+	}
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Posting the summary to %s...", params.post))
+		defer s.Stop()
+
+		return api.PostToChat(params.post, webhookURL, msg)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Summary posted to %s", params.post)
+}
+
+// summarize builds the summary of the time logged during the period the day falls in.
+func summarize(sheet *timesheet.Sheet, day time.Time, period, user string) *chat.Message {
+	first, last := 0, timesheet.DaysInWeek-1
+	if period == periodDay {
+		// Days are counted by their dates, as not all days are 24 hours long.
+		for first < last && !day.Before(sheet.Day(first+1)) {
+			first++
+		}
+		last = first
+	}
+
+	var (
+		lines []string
+		total int
+	)
+	for _, e := range sheet.Entries(first, last) {
+		label := e.IssueKey
+		if e.Summary != "" {
+			label += " " + e.Summary
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", label, formatSeconds(e.Seconds)))
+		total += e.Seconds
+	}
+
+	var title string
+	if period == periodDay {
+		title = fmt.Sprintf("Worklog of %s on %s", user, day.Format("Mon, 02 Jan 2006"))
+	} else {
+		title = fmt.Sprintf("Worklog of %s for the week of %s", user, sheet.Start.Format("02 Jan 2006"))
+	}
+	if total > 0 {
+		title += ": " + formatSeconds(total)
+	} else {
+		title += ": nothing logged"
+	}
+
+	return &chat.Message{Title: title, Lines: lines}
+}
+
+func formatSeconds(secs int) string {
+	return jira.FormatTimeSpent(time.Duration(secs) * time.Second)
+}
+
+// webhook returns the URL of the incoming webhook of the chat from the env or the config.
+func webhook(service string) string {
+	if u := os.Getenv(fmt.Sprintf("JIRA_%s_WEBHOOK", strings.ToUpper(service))); u != "" {
+		return u
+	}
+	return viper.GetString("worklog.report." + service)
+}
+
+func parseDate(date, period string, now time.Time) (time.Time, error) {
+	if strings.EqualFold(date, "last") {
+		if period == periodDay {
+			return time.Time{}, fmt.Errorf("--date last is only supported with --period week, use yesterday instead")
+		}
+		return timesync.ParseSince("today", now.AddDate(0, 0, -timesheet.DaysInWeek))
+	}
+	return timesync.ParseSince(date, now)
+}
+
+type reportParams struct {
+	period      string
+	date        string
+	post        string
+	timezone    string
+	concurrency int
+	debug       bool
+}
+
+func parseFlags(flags query.FlagParser) *reportParams {
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	period, err := flags.GetString("period")
+	cmdutil.ExitIfError(err)
+	if period != periodDay && period != periodWeek {
+		cmdutil.Failed("Invalid --period %q, expected %s or %s", period, periodDay, periodWeek)
+	}
+
+	date, err := flags.GetString("date")
+	cmdutil.ExitIfError(err)
+
+	post, err := flags.GetString("post")
+	cmdutil.ExitIfError(err)
+	post = strings.ToLower(post)
+	if post != "" && post != chat.Slack && post != chat.Teams {
+		cmdutil.Failed("Invalid --post %q, expected %s or %s", post, chat.Slack, chat.Teams)
+	}
+
+	timezone, err := flags.GetString("timezone")
+	cmdutil.ExitIfError(err)
+
+	return &reportParams{
+		period:      period,
+		date:        date,
+		post:        post,
+		timezone:    timezone,
+		concurrency: cmdcommon.GetConcurrency(flags),
+		debug:       debug,
+	}
+}
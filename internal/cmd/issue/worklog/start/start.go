@@ -0,0 +1,56 @@
+package start
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/timer"
+)
+
+const (
+	helpText = `Start starts a local worklog timer for an issue.
+
+The timer is persisted in the config directory, so it keeps running across
+terminal sessions and reboots. Use 'jira issue worklog stop' to log the elapsed time.`
+	examples = `$ jira issue worklog start ISSUE-1`
+)
+
+// NewCmdWorklogStart is a worklog start command.
+func NewCmdWorklogStart() *cobra.Command {
+	return &cobra.Command{
+		Use:     "start ISSUE-KEY",
+		Short:   "Start a worklog timer for an issue",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.MinimumNArgs(1),
+		Run:  start,
+	}
+}
+
+func start(_ *cobra.Command, args []string) {
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	home, err := cmdutil.GetConfigHome()
+	cmdutil.ExitIfError(err)
+
+	store := timer.NewStore(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
+
+	t, err := store.Start(key, time.Now())
+	if err == timer.ErrTimerExists {
+		t, err = store.Get(key)
+		cmdutil.ExitIfError(err)
+
+		cmdutil.Failed("Timer for issue \"%s\" is already running since %s", key, t.Started.Format("2006-01-02 15:04"))
+	}
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Timer started for issue \"%s\" at %s", key, t.Started.Format("15:04"))
+}
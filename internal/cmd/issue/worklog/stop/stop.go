@@ -0,0 +1,270 @@
+package stop
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/timer"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const (
+	// Timers running longer than this were most likely forgotten.
+	forgottenTimerThreshold = 12 * time.Hour
+
+	helpText = `Stop stops a running worklog timer and adds the elapsed time as a worklog to the issue.
+
+If the timer was running for more than 12 hours, you will be asked to confirm the time spent
+as the timer was most likely forgotten. Use --time-spent to override the elapsed time.`
+	examples = `$ jira issue worklog stop
+
+# Stop timer of an issue and add a worklog with the given comment
+$ jira issue worklog stop ISSUE-1 --comment "Implementation"
+
+# Override the time spent of a forgotten timer
+$ jira issue worklog stop ISSUE-1 --time-spent 2h --no-input
+
+# Discard the timer without adding a worklog
+$ jira issue worklog stop ISSUE-1 --discard`
+)
+
+// NewCmdWorklogStop is a worklog stop command.
+func NewCmdWorklogStop() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "stop [ISSUE-KEY]",
+		Short:   "Stop a worklog timer and log the elapsed time",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "[ISSUE-KEY]\tIssue key of the running timer, eg: ISSUE-1",
+		},
+		Run: stop,
+	}
+
+	cmd.Flags().StringP("comment", "m", "", "Body of the worklog")
+	cmd.Flags().String("time-spent", "", "Override elapsed time, eg: '30m' or '4h 20m'")
+	cmd.Flags().StringP("template", "T", "", "Path to a file to read worklog body from")
+	cmd.Flags().StringArray("var", []string{}, "Template variable in key=value format, eg: sprint=42. Can be used multiple times")
+	cmd.Flags().Bool("discard", false, "Discard the timer without adding a worklog")
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+
+	return &cmd
+}
+
+func stop(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(args, cmd.Flags())
+
+	home, err := cmdutil.GetConfigHome()
+	cmdutil.ExitIfError(err)
+
+	sc := stopCmd{
+		store:  timer.NewStore(fmt.Sprintf("%s/%s", home, jiraConfig.Dir)),
+		params: params,
+	}
+
+	t, err := sc.getTimer()
+	cmdutil.ExitIfError(err)
+
+	if params.discard {
+		cmdutil.ExitIfError(sc.store.Remove(t.IssueKey))
+		cmdutil.Success("Timer for issue \"%s\" discarded", t.IssueKey)
+		return
+	}
+
+	elapsed := t.Elapsed(time.Now())
+	if params.timeSpent == "" {
+		if elapsed > forgottenTimerThreshold {
+			cmdutil.Warn("Timer for issue \"%s\" is running since %s.", t.IssueKey, t.Started.Format("2006-01-02 15:04"))
+			if params.noInput {
+				cmdutil.Failed("Use --time-spent to confirm the time spent on a forgotten timer")
+			}
+		} else {
+			params.timeSpent = jira.FormatTimeSpent(elapsed)
+		}
+	}
+
+	if params.comment == "" && (params.template != "" || cmdutil.StdinHasData()) {
+		body, err := cmdcommon.ReadTemplate(params.template, t.IssueKey, params.vars)
+		cmdutil.ExitIfError(err)
+		params.comment = body
+	}
+
+	if qs := sc.getQuestions(elapsed); len(qs) > 0 {
+		ans := struct{ TimeSpent, Comment string }{}
+		err := survey.Ask(qs, &ans)
+		cmdutil.ExitIfError(err)
+
+		if params.timeSpent == "" {
+			params.timeSpent = ans.TimeSpent
+		}
+		if params.comment == "" {
+			params.comment = ans.Comment
+		}
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Adding worklog")
+		defer s.Stop()
+
+		client := api.Client(jira.Config{Debug: params.debug})
+		_, err := api.ProxyAddWorklog(
+			client, t.IssueKey, params.comment, t.Started.Format(jira.RFC3339MilliLayout), params.timeSpent, "", "", nil, nil,
+		)
+		return err
+	}()
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(sc.store.Remove(t.IssueKey))
+
+	cmdutil.Success("Worklog of %s added to issue \"%s\"", params.timeSpent, t.IssueKey)
+	fmt.Printf("%s/browse/%s\n", viper.GetString("server"), t.IssueKey)
+}
+
+type stopParams struct {
+	issueKey  string
+	comment   string
+	timeSpent string
+	template  string
+	vars      []string
+	discard   bool
+	noInput   bool
+	debug     bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *stopParams {
+	var issueKey string
+
+	if len(args) >= 1 {
+		issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	comment, err := flags.GetString("comment")
+	cmdutil.ExitIfError(err)
+
+	timeSpent, err := flags.GetString("time-spent")
+	cmdutil.ExitIfError(err)
+
+	template, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+
+	vars, err := flags.GetStringArray("var")
+	cmdutil.ExitIfError(err)
+
+	discard, err := flags.GetBool("discard")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	return &stopParams{
+		issueKey:  issueKey,
+		comment:   comment,
+		timeSpent: timeSpent,
+		template:  template,
+		vars:      vars,
+		discard:   discard,
+		noInput:   noInput,
+		debug:     debug,
+	}
+}
+
+type stopCmd struct {
+	store  *timer.Store
+	params *stopParams
+}
+
+func (sc *stopCmd) getTimer() (*timer.Timer, error) {
+	if sc.params.issueKey != "" {
+		t, err := sc.store.Get(sc.params.issueKey)
+		if err == timer.ErrNoTimer {
+			return nil, fmt.Errorf("no running timer found for issue %q", sc.params.issueKey)
+		}
+		return t, err
+	}
+
+	timers, err := sc.store.All()
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(timers) {
+	case 0:
+		return nil, timer.ErrNoTimer
+	case 1:
+		return timers[0], nil
+	}
+
+	if sc.params.noInput {
+		return nil, fmt.Errorf("multiple timers are running, please specify an issue key")
+	}
+
+	options := make([]string, 0, len(timers))
+	for _, t := range timers {
+		options = append(options, fmt.Sprintf("%s (since %s)", t.IssueKey, t.Started.Format("2006-01-02 15:04")))
+	}
+
+	var ans string
+
+	qs := &survey.Question{
+		Name: "timer",
+		Prompt: &survey.Select{
+			Message: "Timer:",
+			Options: options,
+		},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return nil, err
+	}
+
+	for i, opt := range options {
+		if opt == ans {
+			return timers[i], nil
+		}
+	}
+	return nil, timer.ErrNoTimer
+}
+
+func (sc *stopCmd) getQuestions(elapsed time.Duration) []*survey.Question {
+	var qs []*survey.Question
+
+	if sc.params.noInput {
+		return qs
+	}
+
+	if sc.params.timeSpent == "" {
+		qs = append(qs, &survey.Question{
+			Name:     "timeSpent",
+			Prompt:   &survey.Input{Message: "Worklog time spent", Default: jira.FormatTimeSpent(elapsed)},
+			Validate: survey.Required,
+		})
+	}
+
+	if sc.params.comment == "" {
+		qs = append(qs, &survey.Question{
+			Name: "comment",
+			Prompt: &surveyext.JiraEditor{
+				Editor: &survey.Editor{
+					Message:       "Worklog comment",
+					HideDefault:   true,
+					AppendDefault: true,
+				},
+				BlankAllowed: true,
+			},
+		})
+	}
+
+	return qs
+}
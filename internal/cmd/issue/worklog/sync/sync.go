@@ -0,0 +1,214 @@
+package synccmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/timesync"
+	"github.com/ankitpokhrel/jira-cli/pkg/clockify"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/toggl"
+)
+
+const (
+	clientTimeout = 15 * time.Second
+
+	helpText = `Sync imports time entries from Toggl Track or Clockify as worklogs.
+
+Time entries are matched to issues by the first issue key found in the entry description
+or tags. Entries without an issue key and entries that are still running are skipped.
+Synced entries are remembered, so running the command again doesn't create duplicates.
+
+The API token of the provider is read from the config or the env:
+  toggl.token (JIRA_TOGGL_TOKEN)
+  clockify.token (JIRA_CLOCKIFY_TOKEN), clockify.workspace (optional)`
+	examples = `$ jira issue worklog sync --provider toggl
+
+# Sync Clockify time entries since Monday
+$ jira issue worklog sync --provider clockify --since monday
+
+# See what would be synced without adding worklogs
+$ jira issue worklog sync --provider toggl --since 2022-02-01 --dry-run`
+)
+
+// NewCmdWorklogSync is a worklog sync command.
+func NewCmdWorklogSync() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "sync",
+		Short:   "Sync time entries from Toggl or Clockify as worklogs",
+		Long:    helpText,
+		Example: examples,
+		Run:     sync,
+	}
+
+	cmd.Flags().StringP("provider", "p", "", "Time tracker to sync from.\n"+
+		fmt.Sprintf("Accepts: %s, %s", timesync.ProviderToggl, timesync.ProviderClockify))
+	cmd.Flags().String("since", "today", "Sync entries started since today, yesterday, a weekday or YYYY-MM-DD")
+	cmd.Flags().Bool("dry-run", false, "Display entries to sync without adding worklogs")
+
+	_ = cmd.MarkFlagRequired("provider")
+
+	return &cmd
+}
+
+type match struct {
+	entry    *timesync.Entry
+	issueKey string
+}
+
+func sync(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	name, err := cmd.Flags().GetString("provider")
+	cmdutil.ExitIfError(err)
+
+	since, err := cmd.Flags().GetString("since")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	provider, err := getProvider(name, debug)
+	cmdutil.ExitIfError(err)
+
+	now := time.Now()
+
+	start, err := timesync.ParseSince(since, now)
+	cmdutil.ExitIfError(err)
+
+	home, err := cmdutil.GetConfigHome()
+	cmdutil.ExitIfError(err)
+
+	store := timesync.NewStore(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
+
+	entries, err := func() ([]*timesync.Entry, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching %s time entries since %s...", name, start.Format("2006-01-02")))
+		defer s.Stop()
+
+		return provider.Entries(start, now)
+	}()
+	cmdutil.ExitIfError(err)
+
+	var (
+		matches []*match
+		skipped int
+	)
+	for _, e := range entries {
+		synced, err := store.IsSynced(name, e.ID)
+		cmdutil.ExitIfError(err)
+		if synced {
+			continue
+		}
+
+		key := e.IssueKey()
+		if key == "" {
+			skipped++
+			continue
+		}
+		matches = append(matches, &match{entry: e, issueKey: key})
+	}
+
+	if skipped > 0 {
+		cmdutil.Warn("Skipped %d time entries without an issue key", skipped)
+	}
+	if len(matches) == 0 {
+		cmdutil.Success("No new time entries to sync")
+		return
+	}
+
+	if dryRun {
+		cmdutil.ExitIfError(render(os.Stdout, matches))
+		return
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	var (
+		failed strings.Builder
+		passed int
+	)
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Syncing %d time entries...", len(matches)))
+		defer s.Stop()
+
+		for _, m := range matches {
+			_, err := api.ProxyAddWorklog(
+				client, m.issueKey, m.entry.Description, m.entry.Start.Local().Format(jira.RFC3339MilliLayout),
+				jira.FormatTimeSpent(m.entry.Duration), "", "", nil, nil,
+			)
+			if err != nil {
+				failed.WriteString(fmt.Sprintf(
+					"\n  - %s (%s): %s", m.entry.ID, m.issueKey, cmdutil.NormalizeJiraError(err.Error()),
+				))
+				continue
+			}
+			passed++
+
+			if err := store.MarkSynced(name, m.entry.ID); err != nil {
+				return err
+			}
+		}
+
+		if failed.Len() > 0 {
+			return &jira.ErrMultipleFailed{Msg: failed.String()}
+		}
+		return nil
+	}()
+
+	if passed > 0 {
+		cmdutil.Success("Synced %d of %d time entries", passed, len(matches))
+	}
+	cmdutil.ExitIfError(err)
+}
+
+func getProvider(name string, debug bool) (timesync.Provider, error) {
+	switch name {
+	case timesync.ProviderToggl:
+		return timesync.NewToggl(toggl.NewClient(toggl.Config{
+			Server: viper.GetString("toggl.server"),
+			Token:  configToken("toggl.token", "JIRA_TOGGL_TOKEN"),
+			Debug:  debug,
+		}, toggl.WithTimeout(clientTimeout))), nil
+	case timesync.ProviderClockify:
+		return timesync.NewClockify(clockify.NewClient(clockify.Config{
+			Server: viper.GetString("clockify.server"),
+			Token:  configToken("clockify.token", "JIRA_CLOCKIFY_TOKEN"),
+			Debug:  debug,
+		}, clockify.WithTimeout(clientTimeout)), viper.GetString("clockify.workspace")), nil
+	}
+	return nil, fmt.Errorf("invalid provider %q", name)
+}
+
+func configToken(key, env string) string {
+	if token := viper.GetString(key); token != "" {
+		return token
+	}
+	return os.Getenv(env)
+}
+
+func render(w io.Writer, matches []*match) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "ENTRY\tISSUE\tSTARTED\tTIME SPENT\tDESCRIPTION")
+	for _, m := range matches {
+		fmt.Fprintf(
+			tw, "%s\t%s\t%s\t%s\t%s\n",
+			m.entry.ID, m.issueKey, m.entry.Start.Local().Format("2006-01-02 15:04"),
+			jira.FormatTimeSpent(m.entry.Duration), m.entry.Description,
+		)
+	}
+
+	return tw.Flush()
+}
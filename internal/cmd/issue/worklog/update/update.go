@@ -0,0 +1,391 @@
+package update
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/surveyext"
+)
+
+const (
+	pageSize = 100
+	helpText = `Update updates a worklog of an issue.`
+	examples = `$ jira issue worklog update
+
+# Select the worklog to update from the list of worklogs of the issue
+$ jira issue worklog update ISSUE-1
+
+# Pass required parameters to skip prompt
+$ jira issue worklog update ISSUE-1 10001 --time-spent 2h --no-input
+
+# Change started date and time of the worklog
+$ jira issue worklog update ISSUE-1 10001 --started-date 2022-02-02 --started-time 13:35
+
+# Restrict the worklog to the members of a group
+$ jira issue worklog update ISSUE-1 10001 --visibility group:engineering
+
+# Load worklog body from a template file
+$ jira issue worklog update ISSUE-1 10001 --template /path/to/template.tmpl`
+)
+
+// NewCmdWorklogUpdate is a worklog update command.
+func NewCmdWorklogUpdate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "update [ISSUE-KEY] [WORKLOG-ID]",
+		Short:   "Update a worklog of an issue",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"edit"},
+		Annotations: map[string]string{
+			"help:args": "ISSUE-KEY\tIssue key of the worklog, eg: ISSUE-1\n" +
+				"WORKLOG-ID\tID of the worklog you want to update",
+		},
+		Run: update,
+	}
+
+	cmd.Flags().String("time-spent", "", "Time spent in format '30m' or '4h 20m', etc.")
+	cmd.Flags().StringP("comment", "m", "", "Body of the worklog")
+	cmd.Flags().String("started-date", "", "Date in format '2022-05-15'")
+	cmd.Flags().String("started-time", "", "Time in format '15:55'")
+	cmd.Flags().String("timezone", "", "Timezone of the started date and time, eg: Europe/Berlin (defaults to local timezone)")
+	cmd.Flags().String("visibility", "", "Restrict worklog visibility to a project role or group, eg: role:Developers.\n"+
+		"Defaults to the current visibility of the worklog")
+	cmd.Flags().Bool("web", false, "Open issue in web browser after updating worklog")
+	cmd.Flags().StringP("template", "T", "", "Path to a file to read worklog body from")
+	cmd.Flags().StringArray("var", []string{}, "Template variable in key=value format, eg: sprint=42. Can be used multiple times")
+	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
+
+	return &cmd
+}
+
+func update(cmd *cobra.Command, args []string) {
+	if api.IsTempoWorklogProvider() {
+		cmdutil.Failed("Updating worklogs is not supported with the %q worklog provider", api.WorklogProviderTempo)
+	}
+
+	params := parseArgsAndFlags(args, cmd.Flags())
+	client := api.Client(jira.Config{Debug: params.debug})
+	uc := updateCmd{
+		client: client,
+		params: params,
+	}
+
+	if uc.isNonInteractive() {
+		uc.params.noInput = true
+
+		if uc.isMandatoryParamsMissing() {
+			cmdutil.Failed("`ISSUE-KEY` and `WORKLOG-ID` are mandatory when using a non-interactive mode")
+		}
+	}
+
+	loc, err := cmdcommon.WorklogLocation(params.timezone)
+	cmdutil.ExitIfError(err)
+	uc.location = loc
+
+	visibility, err := cmdcommon.GetWorklogVisibility(params.visibility)
+	cmdutil.ExitIfError(err)
+
+	cmdutil.ExitIfError(uc.setIssueKey())
+	cmdutil.ExitIfError(uc.setWorklog())
+
+	qs := uc.getQuestions()
+	if len(qs) > 0 {
+		ans := struct{ Comment, StartedDate, StartedTime, TimeSpent string }{}
+		err := survey.Ask(qs, &ans)
+		cmdutil.ExitIfError(err)
+
+		if params.comment == "" {
+			params.comment = ans.Comment
+		}
+		if params.startedDate == "" {
+			params.startedDate = ans.StartedDate
+		}
+		if params.startedTime == "" {
+			params.startedTime = ans.StartedTime
+		}
+		if params.timeSpent == "" {
+			params.timeSpent = ans.TimeSpent
+		}
+	}
+	uc.fillDefaults()
+
+	if !params.noInput {
+		answer := struct{ Action string }{}
+		err := survey.Ask([]*survey.Question{uc.getNextAction()}, &answer)
+		cmdutil.ExitIfError(err)
+
+		if answer.Action == cmdcommon.ActionCancel {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
+	started, err := cmdcommon.FormatWorklogStarted(params.startedDate, params.startedTime, uc.location)
+	cmdutil.ExitIfError(err)
+
+	if visibility == nil {
+		visibility = uc.worklog.Visibility
+	}
+
+	err = func() error {
+		s := cmdutil.Info("Updating worklog")
+		defer s.Stop()
+
+		return client.UpdateIssueWorklog(
+			params.issueKey, params.worklogID, params.comment, started, params.timeSpent, visibility,
+		)
+	}()
+	cmdutil.ExitIfError(err)
+
+	server := viper.GetString("server")
+
+	cmdutil.Success("Worklog \"%s\" of issue \"%s\" updated", params.worklogID, params.issueKey)
+	fmt.Printf("%s/browse/%s\n", server, params.issueKey)
+
+	if web, _ := cmd.Flags().GetBool("web"); web {
+		err := cmdutil.Navigate(server, params.issueKey)
+		cmdutil.ExitIfError(err)
+	}
+}
+
+type updateParams struct {
+	issueKey    string
+	worklogID   string
+	comment     string
+	startedDate string
+	startedTime string
+	timeSpent   string
+	template    string
+	vars        []string
+	timezone    string
+	visibility  string
+	noInput     bool
+	debug       bool
+}
+
+func parseArgsAndFlags(args []string, flags query.FlagParser) *updateParams {
+	var issueKey, worklogID string
+
+	nargs := len(args)
+	if nargs >= 1 {
+		issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+	}
+	if nargs >= 2 {
+		worklogID = args[1]
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	timeSpent, err := flags.GetString("time-spent")
+	cmdutil.ExitIfError(err)
+
+	comment, err := flags.GetString("comment")
+	cmdutil.ExitIfError(err)
+
+	startedDate, err := flags.GetString("started-date")
+	cmdutil.ExitIfError(err)
+
+	startedTime, err := flags.GetString("started-time")
+	cmdutil.ExitIfError(err)
+
+	template, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+
+	vars, err := flags.GetStringArray("var")
+	cmdutil.ExitIfError(err)
+
+	timezone, err := flags.GetString("timezone")
+	cmdutil.ExitIfError(err)
+
+	visibility, err := flags.GetString("visibility")
+	cmdutil.ExitIfError(err)
+
+	noInput, err := flags.GetBool("no-input")
+	cmdutil.ExitIfError(err)
+
+	return &updateParams{
+		issueKey:    issueKey,
+		worklogID:   worklogID,
+		comment:     comment,
+		startedDate: startedDate,
+		startedTime: startedTime,
+		timeSpent:   timeSpent,
+		template:    template,
+		vars:        vars,
+		timezone:    timezone,
+		visibility:  visibility,
+		noInput:     noInput,
+		debug:       debug,
+	}
+}
+
+type updateCmd struct {
+	client   *jira.Client
+	worklog  *jira.Worklog
+	location *time.Location
+	params   *updateParams
+}
+
+func (uc *updateCmd) setIssueKey() error {
+	if uc.params.issueKey != "" {
+		return nil
+	}
+
+	var ans string
+
+	qs := &survey.Question{
+		Name:     "issueKey",
+		Prompt:   &survey.Input{Message: "Issue key"},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return err
+	}
+	uc.params.issueKey = cmdutil.GetJiraIssueKey(viper.GetString("project.key"), ans)
+
+	return nil
+}
+
+func (uc *updateCmd) setWorklog() error {
+	if uc.params.worklogID != "" {
+		wl, err := func() (*jira.Worklog, error) {
+			s := cmdutil.Info("Fetching worklog details...")
+			defer s.Stop()
+
+			return uc.client.GetIssueWorklog(uc.params.issueKey, uc.params.worklogID)
+		}()
+		if err != nil {
+			return err
+		}
+		uc.worklog = wl
+
+		return nil
+	}
+
+	worklogs, err := func() ([]*jira.Worklog, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching worklogs of issue %s...", uc.params.issueKey))
+		defer s.Stop()
+
+		return uc.client.GetAllIssueWorklogs(uc.params.issueKey, pageSize)
+	}()
+	if err != nil {
+		return err
+	}
+	if len(worklogs) == 0 {
+		return fmt.Errorf("no worklogs found for issue %q", uc.params.issueKey)
+	}
+
+	wl, err := cmdcommon.SelectWorklog(worklogs)
+	if err != nil {
+		return err
+	}
+	uc.worklog = wl
+	uc.params.worklogID = wl.ID
+
+	return nil
+}
+
+func (uc *updateCmd) getQuestions() []*survey.Question {
+	var qs []*survey.Question
+
+	startedDate, startedTime := cmdcommon.SplitWorklogStarted(uc.worklog.Started, uc.location)
+
+	if uc.params.timeSpent == "" && !uc.params.noInput {
+		qs = append(qs, &survey.Question{
+			Name:   "timeSpent",
+			Prompt: &survey.Input{Message: "Worklog time spent", Default: uc.worklog.TimeSpent},
+		})
+	}
+
+	if uc.params.comment == "" && (uc.params.template != "" || cmdutil.StdinHasData()) {
+		body, err := cmdcommon.ReadTemplate(uc.params.template, uc.params.issueKey, uc.params.vars)
+		if err != nil {
+			cmdutil.Failed("Error: %s", err)
+		}
+		uc.params.comment = body
+	}
+
+	if uc.params.noInput {
+		return qs
+	}
+
+	if uc.params.comment == "" {
+		qs = append(qs, &survey.Question{
+			Name: "comment",
+			Prompt: &surveyext.JiraEditor{
+				Editor: &survey.Editor{
+					Message:       "Worklog comment",
+					Default:       uc.worklog.Comment,
+					HideDefault:   true,
+					AppendDefault: true,
+				},
+				BlankAllowed: true,
+			},
+		})
+	}
+
+	if uc.params.startedDate == "" {
+		qs = append(qs, &survey.Question{
+			Name:   "startedDate",
+			Prompt: &survey.Input{Message: "Worklog started date (YYYY-MM-DD)", Default: startedDate},
+		})
+	}
+
+	if uc.params.startedTime == "" {
+		qs = append(qs, &survey.Question{
+			Name:   "startedTime",
+			Prompt: &survey.Input{Message: "Worklog started time (hh:mm)", Default: startedTime},
+		})
+	}
+
+	return qs
+}
+
+// fillDefaults uses values from the existing worklog for the fields that were not changed.
+func (uc *updateCmd) fillDefaults() {
+	startedDate, startedTime := cmdcommon.SplitWorklogStarted(uc.worklog.Started, uc.location)
+
+	if uc.params.comment == "" {
+		uc.params.comment = uc.worklog.Comment
+	}
+	if uc.params.startedDate == "" {
+		uc.params.startedDate = startedDate
+	}
+	if uc.params.startedTime == "" {
+		uc.params.startedTime = startedTime
+	}
+	if uc.params.timeSpent == "" {
+		uc.params.timeSpent = uc.worklog.TimeSpent
+	}
+}
+
+func (uc *updateCmd) getNextAction() *survey.Question {
+	return &survey.Question{
+		Name: "action",
+		Prompt: &survey.Select{
+			Message: "What's next?",
+			Options: []string{
+				cmdcommon.ActionSubmit,
+				cmdcommon.ActionCancel,
+			},
+		},
+		Validate: survey.Required,
+	}
+}
+
+func (uc *updateCmd) isNonInteractive() bool {
+	return cmdutil.StdinHasData() || uc.params.template == "-"
+}
+
+func (uc *updateCmd) isMandatoryParamsMissing() bool {
+	return uc.params.issueKey == "" || uc.params.worklogID == ""
+}
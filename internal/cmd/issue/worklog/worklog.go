@@ -4,9 +4,25 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/add"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/fromcalendar"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/fromgit"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/grid"
+	importCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/import"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/recur"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/report"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/start"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/stop"
+	synccmd "github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/sync"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/worklog/update"
 )
 
-const helpText = `Worklog command helps you manage issue comments. See available commands below.`
+const helpText = `Worklog command helps you manage issue worklogs. See available commands below.
+
+Worklogs are managed in Jira by default. To log work in Tempo Timesheets instead, set
+'worklog.provider' to 'tempo' in the config along with the 'tempo.token' (or JIRA_TEMPO_TOKEN env)
+and optionally 'tempo.server' if you are not using the default Tempo cloud API.`
 
 // NewCmdWorklog is a worklog command.
 func NewCmdWorklog() *cobra.Command {
@@ -18,7 +34,15 @@ func NewCmdWorklog() *cobra.Command {
 		RunE:    worklog,
 	}
 
-	cmd.AddCommand(add.NewCmdCWorklogAdd())
+	cmd.AddCommand(
+		add.NewCmdCWorklogAdd(), list.NewCmdWorklogList(),
+		update.NewCmdWorklogUpdate(), delete.NewCmdWorklogDelete(),
+		start.NewCmdWorklogStart(), stop.NewCmdWorklogStop(),
+		importCmd.NewCmdWorklogImport(), fromgit.NewCmdWorklogFromGit(),
+		fromcalendar.NewCmdWorklogFromCalendar(), recur.NewCmdWorklogRecur(),
+		synccmd.NewCmdWorklogSync(), grid.NewCmdWorklogGrid(),
+		report.NewCmdWorklogReport(),
+	)
 
 	return &cmd
 }
@@ -0,0 +1,382 @@
+package jql
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/atotto/clipboard"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/jqlbuilder"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `JQL interactively builds a JQL query.
+
+Pick a field, an operator and the values for each condition of the query. Fields, operators
+and suggested values are fetched from Jira. The query is validated on the server once all
+conditions are added, and can then be run, copied to the clipboard or printed.`
+	examples = `$ jira jql
+
+# Show all fields of the issues found by the query
+$ jira jql --plain --no-truncate`
+
+	optionOther  = "[Other]"
+	optionNone   = "[None]"
+	actionAnd    = "Add an AND condition"
+	actionOr     = "Add an OR condition"
+	actionDone   = "Done"
+	actionRun    = "Run"
+	actionCopy   = "Copy to clipboard"
+	actionPrint  = "Print"
+	defaultLimit = 100
+)
+
+// NewCmdJQL is a jql command.
+func NewCmdJQL() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "jql",
+		Short:       "JQL interactively builds a JQL query",
+		Long:        helpText,
+		Example:     examples,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Run:         jql,
+	}
+
+	cmd.Flags().Uint("limit", defaultLimit, "Number of results to return when running the query")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode when running the query")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+	cmd.Flags().Bool("no-truncate", false, "Show all available columns in plain mode. Works only with --plain")
+
+	return &cmd
+}
+
+func jql(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	data, err := func() (*jira.JQLAutocompleteData, error) {
+		s := cmdutil.Info("Fetching fields...")
+		defer s.Stop()
+
+		return client.JQLAutocomplete()
+	}()
+	cmdutil.ExitIfError(err)
+
+	b := builder{client: client, data: data}
+	cmdutil.ExitIfError(b.build())
+
+	q := jqlbuilder.Build(b.clauses, b.orderBy, b.direction)
+
+	err = func() error {
+		s := cmdutil.Info("Validating query...")
+		defer s.Stop()
+
+		return client.ValidateJQL(q)
+	}()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "JQL: %s\n", q)
+	}
+	cmdutil.ExitIfError(err)
+
+	var action string
+	prompt := &survey.Select{
+		Message: fmt.Sprintf("JQL: %s\n  What's next?", q),
+		Options: []string{actionRun, actionCopy, actionPrint},
+	}
+	cmdutil.ExitIfError(survey.AskOne(prompt, &action))
+
+	switch action {
+	case actionRun:
+		run(cmd, client, q)
+	case actionCopy:
+		cmdutil.ExitIfError(clipboard.WriteAll(q))
+		cmdutil.Success("Query copied to the clipboard")
+	default:
+		fmt.Println(q)
+	}
+}
+
+func run(cmd *cobra.Command, client *jira.Client, q string) {
+	limit, err := cmd.Flags().GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	noTruncate, err := cmd.Flags().GetBool("no-truncate")
+	cmdutil.ExitIfError(err)
+
+	resp, err := func() (*jira.SearchResult, error) {
+		s := cmdutil.Info("Fetching issues...")
+		defer s.Stop()
+
+		return api.ProxySearch(client, q, limit)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if resp.Total == 0 {
+		fmt.Println()
+		cmdutil.Failed("No result found for the query")
+		return
+	}
+
+	v := view.IssueList{
+		Project: viper.GetString("project.key"),
+		Server:  viper.GetString("server"),
+		Total:   resp.Total,
+		Data:    resp.Issues,
+		Actions: cmdcommon.IssueActions(client, viper.GetString("project.key")),
+		Display: view.DisplayFormat{
+			Plain:      plain,
+			NoHeaders:  noHeaders,
+			NoTruncate: noTruncate,
+		},
+	}
+
+	cmdutil.ExitIfError(v.Render())
+}
+
+type builder struct {
+	client    *jira.Client
+	data      *jira.JQLAutocompleteData
+	clauses   []jqlbuilder.Clause
+	orderBy   string
+	direction string
+}
+
+func (b *builder) build() error {
+	conj := ""
+	for {
+		clause, err := b.askClause()
+		if err != nil {
+			return err
+		}
+		clause.Conjunction = conj
+		b.clauses = append(b.clauses, *clause)
+
+		var next string
+		prompt := &survey.Select{
+			Message: fmt.Sprintf("JQL: %s\n  Add another condition?", jqlbuilder.Build(b.clauses, "", "")),
+			Options: []string{actionAnd, actionOr, actionDone},
+		}
+		if err := survey.AskOne(prompt, &next); err != nil {
+			return err
+		}
+
+		switch next {
+		case actionAnd:
+			conj = jqlbuilder.ConjunctionAnd
+		case actionOr:
+			conj = jqlbuilder.ConjunctionOr
+		default:
+			return b.askOrderBy()
+		}
+	}
+}
+
+func (b *builder) askClause() (*jqlbuilder.Clause, error) {
+	fields := make([]*jira.JQLField, 0, len(b.data.VisibleFieldNames))
+	for _, f := range b.data.VisibleFieldNames {
+		if f.Searchable == "true" {
+			fields = append(fields, f)
+		}
+	}
+
+	field, err := selectField(fields, "Field:", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var op string
+	if err := survey.AskOne(&survey.Select{Message: "Operator:", Options: field.Operators}, &op); err != nil {
+		return nil, err
+	}
+
+	values, err := b.askValues(field, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jqlbuilder.Clause{Field: field.Value, Operator: op, Values: values}, nil
+}
+
+func (b *builder) askValues(field *jira.JQLField, op string) ([]string, error) {
+	switch {
+	case jqlbuilder.TakesNoValue(op):
+		return nil, nil
+	case jqlbuilder.TakesEmpty(op):
+		return []string{"EMPTY"}, nil
+	}
+
+	options := b.suggestions(field)
+	if !jqlbuilder.TakesList(op) {
+		options = append(options, b.functions(field)...)
+	}
+
+	if len(options) == 0 {
+		return askInput(op)
+	}
+	options = append(options, optionOther)
+
+	var values []string
+	if jqlbuilder.TakesList(op) {
+		if err := survey.AskOne(
+			&survey.MultiSelect{Message: "Values:", Options: options},
+			&values, survey.WithValidator(survey.Required),
+		); err != nil {
+			return nil, err
+		}
+	} else {
+		var value string
+		if err := survey.AskOne(&survey.Select{Message: "Value:", Options: options}, &value); err != nil {
+			return nil, err
+		}
+		values = []string{value}
+	}
+
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != optionOther {
+			out = append(out, v)
+			continue
+		}
+		other, err := askInput(op)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, other...)
+	}
+	return out, nil
+}
+
+// suggestions returns the values suggested by Jira for the field.
+// Fields without suggestions, eg: text fields, return no values.
+func (b *builder) suggestions(field *jira.JQLField) []string {
+	name := field.Value
+	if field.CFID != "" {
+		name = field.CFID
+	}
+
+	s := cmdutil.Info("Fetching suggestions...")
+	res, err := b.client.JQLSuggestions(name, "")
+	s.Stop()
+
+	if err != nil {
+		return nil
+	}
+
+	out := make([]string, 0, len(res))
+	for _, r := range res {
+		out = append(out, r.Value)
+	}
+	return out
+}
+
+// functions returns JQL functions that return a single value of the type of the field, eg: currentUser().
+func (b *builder) functions(field *jira.JQLField) []string {
+	var out []string
+
+	for _, fn := range b.data.VisibleFunctionNames {
+		if fn.IsList == "true" {
+			continue
+		}
+		for _, t := range fn.Types {
+			if contains(field.Types, t) {
+				out = append(out, fn.Value)
+				break
+			}
+		}
+	}
+	return out
+}
+
+func (b *builder) askOrderBy() error {
+	fields := make([]*jira.JQLField, 0, len(b.data.VisibleFieldNames))
+	for _, f := range b.data.VisibleFieldNames {
+		if f.Orderable == "true" {
+			fields = append(fields, f)
+		}
+	}
+
+	field, err := selectField(fields, "Order by:", optionNone)
+	if err != nil || field == nil {
+		return err
+	}
+	b.orderBy = field.Value
+
+	return survey.AskOne(&survey.Select{
+		Message: "Direction:",
+		Options: []string{"DESC", "ASC"},
+	}, &b.direction)
+}
+
+// selectField asks to pick one of the fields. The extra option is listed first and
+// returns a nil field if it is picked.
+func selectField(fields []*jira.JQLField, message, extra string) (*jira.JQLField, error) {
+	options := make([]string, 0, len(fields)+1)
+	if extra != "" {
+		options = append(options, extra)
+	}
+	for _, f := range fields {
+		options = append(options, f.DisplayName)
+	}
+
+	var idx int
+	if err := survey.AskOne(&survey.Select{Message: message, Options: options, PageSize: 15}, &idx); err != nil { //nolint:gomnd
+		return nil, err
+	}
+	if extra != "" {
+		if idx == 0 {
+			return nil, nil
+		}
+		idx--
+	}
+	return fields[idx], nil
+}
+
+func askInput(op string) ([]string, error) {
+	msg := "Value:"
+	if jqlbuilder.TakesList(op) {
+		msg = "Values (comma separated):"
+	}
+
+	var ans string
+	if err := survey.AskOne(&survey.Input{Message: msg}, &ans, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+	if !jqlbuilder.TakesList(op) {
+		return []string{strings.TrimSpace(ans)}, nil
+	}
+
+	var out []string
+	for _, v := range strings.Split(ans, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,204 @@
+package listen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/webhook"
+)
+
+const (
+	defaultPort  = 8080
+	maxQueued    = 100
+	secretEnvKey = "JIRA_WEBHOOK_SECRET"
+
+	helpText = `Listen receives Jira webhooks and runs a handler for each event.
+
+Events are normalized and printed as newline delimited json, or passed to the handler command
+in --exec on its standard input. The handler also gets JIRA_WEBHOOK_EVENT and JIRA_ISSUE_KEY env.
+Handlers are run one at a time in the order the events are received.
+
+Webhooks are verified with the secret in --secret or JIRA_WEBHOOK_SECRET env, either by the
+signature sent by Jira Cloud or by the secret query parameter in the webhook URL, eg:
+https://example.com/?secret=s3cr3t. The server only listens on localhost by default, use
+--host to expose it or forward the requests to it with a tunnel.`
+	examples = `$ JIRA_WEBHOOK_SECRET=s3cr3t jira listen --port 8080
+
+# Run a handler for each event
+$ jira listen --port 8080 --exec ./handler.sh
+
+# Notify on transitions of issues
+$ jira listen | jq --unbuffered -r 'select(.changes[]?.field == "status") | .issue.key' | xargs -L1 notify-send`
+)
+
+// NewCmdListen is a listen command.
+func NewCmdListen() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "listen",
+		Short:       "Listen receives Jira webhooks and runs a handler for each event",
+		Long:        helpText,
+		Example:     examples,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Args:        cobra.NoArgs,
+		Run:         listen,
+		// Webhooks are sent by Jira, so a token is not required.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+	}
+
+	cmd.Flags().Uint("port", defaultPort, "Port to listen on")
+	cmd.Flags().String("host", "127.0.0.1", "Address to listen on, eg: 0.0.0.0 to accept requests from anywhere")
+	cmd.Flags().String("path", "/", "Path of the webhook URL")
+	cmd.Flags().String("secret", "", "Secret of the webhook, can also be set with JIRA_WEBHOOK_SECRET env")
+	cmd.Flags().String("exec", "", "Command to run for each event, with the event in json on its standard input")
+	cmd.Flags().Bool("raw", false, "Pass the payload as sent by Jira instead of the normalized event")
+
+	return &cmd
+}
+
+type listenParams struct {
+	port    uint
+	host    string
+	path    string
+	secret  string
+	handler []string
+	raw     bool
+}
+
+func listen(cmd *cobra.Command, _ []string) {
+	params := parseFlags(cmd)
+
+	if params.secret == "" {
+		cmdutil.Warn("No secret is set, webhooks from anyone that can reach the server will be accepted")
+	}
+
+	events := make(chan *webhook.Event, maxQueued)
+	mux := http.NewServeMux()
+	mux.Handle(params.path, webhook.NewHandler(params.secret, func(ev *webhook.Event) {
+		select {
+		case events <- ev:
+		default:
+			cmdutil.Warn("Too many events are queued, dropping %s event", ev.Event)
+		}
+	}))
+
+	addr := net.JoinHostPort(params.host, fmt.Sprintf("%d", params.port))
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		cmdutil.Failed("Unable to start the server: %s", err)
+	}
+
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = srv.Serve(ln) }()
+
+	fmt.Fprintf(os.Stderr, "Listening for webhooks on http://%s%s\n", addr, params.path)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ev := range events {
+			handle(ev, params)
+		}
+	}()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	<-sig
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_ = srv.Shutdown(ctx)
+	close(events)
+	<-done
+}
+
+// handle prints the event or runs the handler with it.
+func handle(ev *webhook.Event, params *listenParams) {
+	b := []byte(ev.Raw)
+	if !params.raw {
+		var err error
+		if b, err = json.Marshal(ev); err != nil {
+			cmdutil.Fail("Unable to encode %s event: %s", ev.Event, err)
+			return
+		}
+	}
+
+	if len(params.handler) == 0 {
+		fmt.Printf("%s\n", b)
+		return
+	}
+
+	if err := runHandler(params.handler, ev, b); err != nil {
+		cmdutil.Fail("Handler failed for %s event: %s", ev.Event, err)
+	}
+}
+
+func runHandler(handler []string, ev *webhook.Event, b []byte) error {
+	var key string
+	if ev.Issue != nil {
+		key = ev.Issue.Key
+	}
+
+	c := exec.Command(handler[0], handler[1:]...)
+	c.Stdin = bytes.NewReader(b)
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	c.Env = append(os.Environ(), "JIRA_WEBHOOK_EVENT="+ev.Event, "JIRA_ISSUE_KEY="+key)
+
+	return c.Run()
+}
+
+func parseFlags(cmd *cobra.Command) *listenParams {
+	flags := cmd.Flags()
+
+	port, err := flags.GetUint("port")
+	cmdutil.ExitIfError(err)
+
+	host, err := flags.GetString("host")
+	cmdutil.ExitIfError(err)
+
+	path, err := flags.GetString("path")
+	cmdutil.ExitIfError(err)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	secret, err := flags.GetString("secret")
+	cmdutil.ExitIfError(err)
+	if secret == "" {
+		secret = os.Getenv(secretEnvKey)
+	}
+
+	exc, err := flags.GetString("exec")
+	cmdutil.ExitIfError(err)
+
+	handler, err := shlex.Split(exc)
+	if err != nil {
+		cmdutil.Failed("Invalid --exec command: %s", err)
+	}
+
+	raw, err := flags.GetBool("raw")
+	cmdutil.ExitIfError(err)
+
+	return &listenParams{
+		port:    port,
+		host:    host,
+		path:    path,
+		secret:  secret,
+		handler: handler,
+		raw:     raw,
+	}
+}
@@ -2,21 +2,107 @@ package me
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Displays configured jira user.
+
+Details of the user, ie: account ID, name, email, timezone and groups, are fetched from Jira
+with --details or --output json. As they are fetched with your credentials, the command exits
+with code 3 if the authentication fails, so it doubles as a check for scripts.`
+	examples = `$ jira me
+
+# Display details of the user
+$ jira me --details
+
+# Check if the authentication works
+$ jira me -o json >/dev/null && echo "Logged in"`
 )
 
 // NewCmdMe is a me command.
 func NewCmdMe() *cobra.Command {
-	return &cobra.Command{
-		Use:   "me",
-		Short: "Displays configured jira user",
-		Long:  "Displays configured jira user.",
-		Run:   me,
+	cmd := cobra.Command{
+		Use:     "me",
+		Short:   "Displays configured jira user",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     me,
 	}
+
+	cmd.Flags().Bool("details", false, "Display details of the user fetched from Jira")
+
+	return &cmd
 }
 
-func me(*cobra.Command, []string) {
-	fmt.Println(viper.GetString("login"))
+func me(cmd *cobra.Command, _ []string) {
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	details, err := cmd.Flags().GetBool("details")
+	cmdutil.ExitIfError(err)
+
+	if output != "" && output != view.OutputJSON {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+	if output == "" && !details {
+		fmt.Println(viper.GetString("login"))
+		return
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	u, err := api.Client(jira.Config{Debug: debug}).MeWithGroups()
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, u))
+		return
+	}
+	cmdutil.ExitIfError(render(os.Stdout, u))
+}
+
+func render(w io.Writer, u *jira.Me) error {
+	var groups []string
+	if u.Groups != nil {
+		for _, g := range u.Groups.Items {
+			groups = append(groups, g.Name)
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 1, '\t', 0)
+
+	// Account ID is only available in Jira cloud and username in Jira server.
+	if u.AccountID != "" {
+		fmt.Fprintf(tw, "Account ID\t%s\n", u.AccountID)
+	}
+	if u.Login != "" {
+		fmt.Fprintf(tw, "Username\t%s\n", u.Login)
+	}
+	fmt.Fprintf(tw, "Name\t%s\n", u.Name)
+	fmt.Fprintf(tw, "Email\t%s\n", orNone(u.Email))
+	fmt.Fprintf(tw, "Timezone\t%s\n", orNone(u.TimeZone))
+	fmt.Fprintf(tw, "Groups\t%s\n", orNone(strings.Join(groups, ", ")))
+
+	return tw.Flush()
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
 }
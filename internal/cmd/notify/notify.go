@@ -0,0 +1,161 @@
+package notify
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/watch"
+	"github.com/ankitpokhrel/jira-cli/pkg/desktop"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	defaultJQL      = "watcher = currentUser()"
+	defaultInterval = 2 * time.Minute
+	minInterval     = 30 * time.Second
+	maxIssues       = 50
+	maxComments     = 20
+
+	helpText = `Notify raises desktop notifications for new comments, transitions and assignments
+of the issues you watch, or of the issues matching --jql.
+
+Without --watch, the changes made in the last --interval are notified once, eg: from a cron job.
+With --watch, the issues are checked every --interval until the command is stopped. Changes made
+by you are not notified. Notifications are also printed, one per line.`
+	examples = `$ jira notify --watch --interval 2m
+
+# Notify on changes to the issues assigned to me
+$ jira notify --watch -q "assignee = currentUser() AND statusCategory != Done"
+
+# Notify on changes made in the last hour
+$ jira notify --interval 1h`
+)
+
+var reOrderBy = regexp.MustCompile(`(?i)\s+order\s+by\s+.*$`)
+
+// NewCmdNotify is a notify command.
+func NewCmdNotify() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "notify",
+		Short:       "Notify raises desktop notifications for changes to issues",
+		Long:        helpText,
+		Example:     examples,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Args:        cobra.NoArgs,
+		Run:         notify,
+	}
+
+	cmd.Flags().Bool("watch", false, "Keep checking the issues for changes until stopped")
+	cmd.Flags().Duration("interval", defaultInterval, "Time between the checks, or how far back to check without --watch")
+	cmd.Flags().StringP("jql", "q", "", "JQL query to find the issues to check, the issues you watch by default")
+
+	return &cmd
+}
+
+type notifyParams struct {
+	watch    bool
+	interval time.Duration
+	jql      string
+	debug    bool
+}
+
+func notify(cmd *cobra.Command, _ []string) {
+	params := parseFlags(cmd)
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	me, err := client.Me()
+	cmdutil.ExitIfError(err)
+
+	var (
+		last    = time.Now().Add(-params.interval)
+		watcher = watch.New(last, me)
+		warned  bool
+	)
+
+	for {
+		start := time.Now()
+
+		notifications, err := check(client, watcher, params.jql, last)
+
+		for _, n := range notifications {
+			fmt.Println(n)
+
+			if err := desktop.Notify(n.Title, n.Message); err != nil && !warned {
+				cmdutil.Warn("Unable to raise desktop notifications: %s", err)
+				warned = true
+			}
+		}
+
+		switch {
+		case err == nil:
+			last = start
+		case params.watch:
+			cmdutil.Warn("Unable to check the issues: %s", cmdutil.NormalizeJiraError(err.Error()))
+		default:
+			cmdutil.ExitIfError(err)
+		}
+
+		if !params.watch {
+			return
+		}
+		time.Sleep(params.interval)
+	}
+}
+
+// check returns the notifications for the issues matching the jql updated since the given time.
+// Changes are only marked as seen once all issues are fetched, so they are found again on error.
+func check(
+	client *jira.Client, watcher *watch.Watcher, jql string, since time.Time,
+) ([]*watch.Notification, error) {
+	res, err := api.ProxySearch(client, cmdcommon.UpdatedSinceJQL(jql, since, "ASC"), maxIssues)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := cmdcommon.FetchIssueActivity(client, res.Issues, maxComments, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*watch.Notification
+	for _, a := range activities {
+		out = append(out, watcher.Changes(a.Issue, a.Histories, a.Comments)...)
+	}
+
+	return out, nil
+}
+
+func parseFlags(cmd *cobra.Command) *notifyParams {
+	flags := cmd.Flags()
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	w, err := flags.GetBool("watch")
+	cmdutil.ExitIfError(err)
+
+	interval, err := flags.GetDuration("interval")
+	cmdutil.ExitIfError(err)
+	if interval < minInterval {
+		cmdutil.Failed("--interval must be at least %s", minInterval)
+	}
+
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+	if jql == "" {
+		jql = defaultJQL
+	}
+
+	return &notifyParams{
+		watch:    w,
+		interval: interval,
+		jql:      reOrderBy.ReplaceAllString(jql, ""),
+		debug:    debug,
+	}
+}
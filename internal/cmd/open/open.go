@@ -2,58 +2,153 @@ package open
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/pkg/browser"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
 const (
-	helpText = `Open opens issue in a browser. If the issue key is not given, it will open the project page.`
+	helpText = `Open opens issues in a browser. If no issue key is given, it will open the project page.
+
+Pass --board to open the board in the config or the board with the given ID, eg: --board=12,
+--sprint to open the issues of the active sprint of the board and --jql to open the search
+results of a JQL query. Multiple issues and destinations can be opened at once.`
 	examples = `$ jira open
-$ jira open ISSUE-1`
+$ jira open ISSUE-1
+
+# Open multiple issues
+$ jira open ISSUE-1 ISSUE-2 ISSUE-3
+
+# Open the board in the config, or another board
+$ jira open --board
+$ jira open --board=12
+
+# Open the active sprint and the issues assigned to me
+$ jira open --sprint --jql "assignee = currentUser() AND statusCategory != Done"`
 )
 
 // NewCmdOpen is an open command.
 func NewCmdOpen() *cobra.Command {
 	cmd := cobra.Command{
-		Use:     "open [ISSUE-KEY]",
-		Short:   "Open issue in a browser",
+		Use:     "open [ISSUE-KEY...]",
+		Short:   "Open issues in a browser",
 		Long:    helpText,
 		Example: examples,
 		Aliases: []string{"browse", "navigate"},
 		Annotations: map[string]string{
 			"cmd:main":  "true",
-			"help:args": "[ISSUE-KEY]\tIssue key, eg: ISSUE-1",
+			"help:args": "[ISSUE-KEY...]\tIssue keys, eg: ISSUE-1",
 		},
-		Run: open,
+		ValidArgsFunction: cmdcommon.CompleteIssueKeys,
+		Run:               open,
 	}
 
 	cmd.Flags().BoolP("no-browser", "n", false, `Skip opening destination URL in the browser`)
+	cmd.Flags().Int("board", 0, "Open the board with the given ID, the board in the config if no ID is given")
+	cmd.Flags().Lookup("board").NoOptDefVal = "0"
+	cmd.Flags().Bool("sprint", false, "Open the issues of the active sprint of the board")
+	cmd.Flags().StringP("jql", "q", "", "Open the search results of the JQL query")
 
 	return &cmd
 }
 
+type openParams struct {
+	keys      []string
+	board     bool
+	sprint    bool
+	jql       string
+	noBrowser bool
+	debug     bool
+}
+
 func open(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd, args)
 	server := viper.GetString("server")
 	project := viper.GetString("project.key")
 
-	var url string
+	var urls []string
+
+	for _, key := range params.keys {
+		urls = append(urls, fmt.Sprintf("%s/browse/%s", server, key))
+	}
+
+	if params.board || params.sprint {
+		boardID := viper.GetInt("board.id")
+		if boardID == 0 {
+			cmdutil.Failed("No board configured, use --board=ID or set a default board with 'jira init'")
+		}
+
+		if params.board {
+			urls = append(urls, fmt.Sprintf("%s/secure/RapidBoard.jspa?rapidView=%d", server, boardID))
+		}
+		if params.sprint {
+			sprint, err := func() (*jira.Sprint, error) {
+				s := cmdutil.Info("Fetching the active sprint...")
+				defer s.Stop()
+
+				return cmdcommon.BoardSprint(api.Client(jira.Config{Debug: params.debug}), boardID, jira.SprintStateActive)
+			}()
+			cmdutil.ExitIfError(err)
+
+			urls = append(urls, searchURL(server, fmt.Sprintf("sprint = %d ORDER BY rank", sprint.ID)))
+		}
+	}
+
+	if params.jql != "" {
+		urls = append(urls, searchURL(server, params.jql))
+	}
+
+	if len(urls) == 0 {
+		urls = append(urls, fmt.Sprintf("%s/browse/%s", server, project))
+	}
+
+	for _, u := range urls {
+		fmt.Println(u)
 
-	if len(args) == 0 {
-		url = fmt.Sprintf("%s/browse/%s", server, project)
-	} else {
-		url = fmt.Sprintf("%s/browse/%s", server, cmdutil.GetJiraIssueKey(project, args[0]))
+		if !params.noBrowser {
+			cmdutil.ExitIfError(browser.Browse(u))
+		}
 	}
+}
+
+func searchURL(server, jql string) string {
+	return fmt.Sprintf("%s/issues/?jql=%s", server, url.QueryEscape(jql))
+}
+
+func parseArgsAndFlags(cmd *cobra.Command, args []string) *openParams {
+	flags := cmd.Flags()
+	project := viper.GetString("project.key")
 
-	fmt.Println(url)
+	keys := make([]string, 0, len(args))
+	for _, a := range args {
+		keys = append(keys, cmdutil.GetJiraIssueKey(project, a))
+	}
+
+	sprint, err := flags.GetBool("sprint")
+	cmdutil.ExitIfError(err)
+
+	jql, err := flags.GetString("jql")
+	cmdutil.ExitIfError(err)
+
+	noBrowser, err := flags.GetBool("no-browser")
+	cmdutil.ExitIfError(err)
 
-	noBrowser, err := cmd.Flags().GetBool("no-browser")
+	debug, err := flags.GetBool("debug")
 	cmdutil.ExitIfError(err)
 
-	if !noBrowser {
-		cmdutil.ExitIfError(browser.Browse(url))
+	return &openParams{
+		keys:      keys,
+		board:     flags.Changed("board"),
+		sprint:    sprint,
+		jql:       jql,
+		noBrowser: noBrowser,
+		debug:     debug,
 	}
 }
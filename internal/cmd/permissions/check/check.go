@@ -0,0 +1,164 @@
+package check
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Check displays which permissions you hold on the issue, or in the project if the issue
+key is not given, to find out why a command was refused.
+
+The permissions used by the commands are checked by default. Pass --permission to check others,
+eg: MANAGE_WATCHERS. See the Jira documentation of the permission keys for the full list.`
+	examples = `$ jira permissions check ISSUE-1
+
+# Check permissions in a project
+$ jira permissions check --project FOO
+
+# Check a specific permission in a script
+$ jira permissions check ISSUE-1 --permission DELETE_ISSUES -o json | jq '.[0].havePermission'`
+)
+
+// defaultPermissions are the permissions needed by the commands of the tool.
+var defaultPermissions = []string{
+	"BROWSE_PROJECTS",
+	"CREATE_ISSUES",
+	"EDIT_ISSUES",
+	"TRANSITION_ISSUES",
+	"ASSIGN_ISSUES",
+	"DELETE_ISSUES",
+	"ADD_COMMENTS",
+	"WORK_ON_ISSUES",
+	"LINK_ISSUES",
+	"ADMINISTER_PROJECTS",
+	"ADMINISTER",
+}
+
+// NewCmdCheck is a permissions check command.
+func NewCmdCheck() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "check [ISSUE-KEY]",
+		Short:   "Check displays the permissions you hold on an issue or in a project",
+		Long:    helpText,
+		Example: examples,
+		Annotations: map[string]string{
+			"help:args": "[ISSUE-KEY]\tIssue key, eg: ISSUE-1",
+		},
+		Args: cobra.MaximumNArgs(1),
+		Run:  check,
+	}
+
+	cmd.Flags().StringArray("permission", []string{}, "Permission to check instead of the defaults, eg: EDIT_ISSUES")
+
+	return &cmd
+}
+
+type checkParams struct {
+	key         string
+	project     string
+	permissions []string
+	output      string
+	debug       bool
+}
+
+func check(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args)
+
+	opts := jira.MyPermissionsOptions{Permissions: params.permissions}
+	target := fmt.Sprintf("project %s", params.project)
+	if params.key != "" {
+		opts.IssueKey = params.key
+		target = fmt.Sprintf("issue %s", params.key)
+	} else {
+		opts.ProjectKey = params.project
+	}
+
+	perms, err := func() (map[string]*jira.Permission, error) {
+		s := cmdutil.Info(fmt.Sprintf("Checking your permissions on %s...", target))
+		defer s.Stop()
+
+		return api.Client(jira.Config{Debug: params.debug}).MyPermissions(&opts)
+	}()
+	cmdutil.ExitIfError(err)
+
+	// Permissions are displayed in the order they were asked for.
+	out := make([]*jira.Permission, 0, len(params.permissions))
+	for _, key := range params.permissions {
+		if p, ok := perms[key]; ok {
+			out = append(out, p)
+		}
+	}
+
+	if params.output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, out))
+		return
+	}
+	cmdutil.ExitIfError(render(os.Stdout, out))
+}
+
+func render(w io.Writer, perms []*jira.Permission) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "PERMISSION\tNAME\tALLOWED")
+	for _, p := range perms {
+		allowed := "no"
+		if p.HavePermission {
+			allowed = "yes"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", p.Key, p.Name, allowed)
+	}
+
+	return tw.Flush()
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *checkParams {
+	project := viper.GetString("project.key")
+
+	var key string
+	if len(args) > 0 {
+		key = cmdutil.GetJiraIssueKey(project, args[0])
+	}
+	if key == "" && project == "" {
+		cmdutil.Failed("Pass an issue key or a project with --project")
+	}
+
+	perms, err := flags.GetStringArray("permission")
+	cmdutil.ExitIfError(err)
+	if len(perms) == 0 {
+		perms = defaultPermissions
+	}
+	permissions := make([]string, 0, len(perms))
+	for _, p := range perms {
+		permissions = append(permissions, strings.ToUpper(strings.TrimSpace(p)))
+	}
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+	if output != "" && output != view.OutputJSON {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &checkParams{
+		key:         key,
+		project:     project,
+		permissions: permissions,
+		output:      output,
+		debug:       debug,
+	}
+}
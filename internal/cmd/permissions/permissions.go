@@ -0,0 +1,29 @@
+package permissions
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/permissions/check"
+)
+
+const helpText = `Permissions helps you inspect your permissions in Jira. See available commands below.`
+
+// NewCmdPermissions is a permissions command.
+func NewCmdPermissions() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "permissions",
+		Short:       "Permissions helps you inspect your permissions",
+		Long:        helpText,
+		Aliases:     []string{"permission", "perms"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        permissions,
+	}
+
+	cmd.AddCommand(check.NewCmdCheck())
+
+	return &cmd
+}
+
+func permissions(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
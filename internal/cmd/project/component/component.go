@@ -0,0 +1,36 @@
+package component
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/project/component/create"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/project/component/delete"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/project/component/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/project/component/update"
+)
+
+const helpText = `Component manages components of a project. See available commands below.`
+
+// NewCmdComponent is a component command.
+func NewCmdComponent() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "component",
+		Short:   "Component manages components of a project",
+		Long:    helpText,
+		Aliases: []string{"components"},
+		RunE:    component,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(),
+		create.NewCmdCreate(),
+		update.NewCmdUpdate(),
+		delete.NewCmdDelete(),
+	)
+
+	return &cmd
+}
+
+func component(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
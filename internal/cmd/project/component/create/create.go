@@ -0,0 +1,88 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create creates a component in a project.
+
+The lead can be referenced by display name, email, account id or username in local
+installation. Use "me" to make yourself the lead.`
+	examples = `$ jira project component create Backend
+
+# Create a component with a lead who gets its issues assigned by default
+$ jira project component create Backend --lead me --default-assignee component-lead`
+)
+
+// NewCmdCreate is a component create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create NAME",
+		Short:   "Create creates a component in a project",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the component, eg: Backend",
+		},
+		Run: create,
+	}
+
+	cmd.Flags().String("description", "", "Description of the component")
+	cmd.Flags().String("lead", "", "Lead of the component")
+	cmd.Flags().String("default-assignee", "", "Default assignee of issues in the component.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(cmdcommon.ComponentAssigneeTypes, ", ")))
+
+	return &cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	description, err := cmd.Flags().GetString("description")
+	cmdutil.ExitIfError(err)
+
+	lead, err := cmd.Flags().GetString("lead")
+	cmdutil.ExitIfError(err)
+
+	defaultAssignee, err := cmd.Flags().GetString("default-assignee")
+	cmdutil.ExitIfError(err)
+
+	assigneeType, err := cmdcommon.ComponentAssigneeType(defaultAssignee)
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	req := jira.ComponentRequest{
+		Name:         args[0],
+		Project:      project,
+		Description:  description,
+		AssigneeType: assigneeType,
+	}
+
+	component, err := func() (*jira.Component, error) {
+		s := cmdutil.Info(fmt.Sprintf("Creating component %s...", args[0]))
+		defer s.Stop()
+
+		if err := cmdcommon.SetComponentLead(client, project, lead, &req); err != nil {
+			return nil, err
+		}
+		return client.CreateComponent(&req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Component \"%s\" created with id %s", component.Name, component.ID)
+}
@@ -0,0 +1,104 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Delete deletes a component of a project.
+
+Issues of the component lose the component unless --move-issues-to is given.`
+	examples = `$ jira project component delete Backend
+
+# Move issues to another component and delete without a confirmation prompt
+$ jira project component delete Backend --move-issues-to Server --yes`
+)
+
+// NewCmdDelete is a component delete command.
+func NewCmdDelete() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "delete COMPONENT",
+		Short:   "Delete deletes a component",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"remove", "rm"},
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "COMPONENT\tName or id of the component, eg: Backend",
+		},
+		Run: del,
+	}
+
+	cmd.Flags().String("move-issues-to", "", "Name or id of the component to move issues of the deleted component to")
+	cmd.Flags().BoolP("yes", "y", false, "Delete without a confirmation prompt")
+
+	return &cmd
+}
+
+func del(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	moveTo, err := cmd.Flags().GetString("move-issues-to")
+	cmdutil.ExitIfError(err)
+
+	yes, err := cmd.Flags().GetBool("yes")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	component, target, err := func() (*jira.Component, *jira.Component, error) {
+		s := cmdutil.Info("Fetching components...")
+		defer s.Stop()
+
+		cmp, err := cmdcommon.FindComponent(client, project, args[0])
+		if err != nil || moveTo == "" {
+			return cmp, nil, err
+		}
+		target, err := cmdcommon.FindComponent(client, project, moveTo)
+		return cmp, target, err
+	}()
+	cmdutil.ExitIfError(err)
+
+	if target != nil && target.ID == component.ID {
+		cmdutil.Failed("Cannot move issues of component \"%s\" to itself", component.Name)
+	}
+
+	if !yes {
+		confirmed := false
+		prompt := &survey.Confirm{
+			Message: fmt.Sprintf("Delete component %q of project %q?", component.Name, project),
+		}
+		cmdutil.ExitIfError(survey.AskOne(prompt, &confirmed))
+
+		if !confirmed {
+			cmdutil.Failed("Action aborted")
+		}
+	}
+
+	var moveIssuesTo string
+	if target != nil {
+		moveIssuesTo = target.ID
+	}
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Deleting component %s...", component.Name))
+		defer s.Stop()
+
+		return client.DeleteComponent(component.ID, moveIssuesTo)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Component \"%s\" deleted", component.Name)
+}
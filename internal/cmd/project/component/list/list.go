@@ -0,0 +1,82 @@
+package list
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const examples = `$ jira project component list
+$ jira project component list -pPRJ
+
+# Find components without a lead in a script
+$ jira project component list -o json | jq -r '.[] | select(.lead.displayName == "") | .name'`
+
+// NewCmdList is a list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists components of a project",
+		Long:    "List lists components defined in the current project.",
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Run:     List,
+	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+
+	return &cmd
+}
+
+// List displays a list view.
+func List(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	project := viper.GetString("project.key")
+
+	components, err := func() ([]*jira.Component, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching components of project %s...", project))
+		defer s.Stop()
+
+		return api.Client(jira.Config{Debug: debug}).ProjectComponents(project)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.NewComponent(components).RenderJSON(os.Stdout))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	if len(components) == 0 {
+		cmdutil.Failed("No components found in project %s.", project)
+		return
+	}
+
+	v := view.NewComponent(components, view.WithComponentDisplayFormat(view.DisplayFormat{
+		Plain:     plain,
+		NoHeaders: noHeaders,
+	}))
+
+	cmdutil.ExitIfError(v.Render())
+}
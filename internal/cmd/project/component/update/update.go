@@ -0,0 +1,100 @@
+package update
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Update updates name, description, lead or default assignee of a component.
+Only the given fields are updated.`
+	examples = `$ jira project component update Backend --name Server
+
+# Change the lead of a component
+$ jira project component update Backend --lead "Jane Doe"
+
+# Stop assigning issues of a component to its lead
+$ jira project component update 10000 --default-assignee project-default`
+)
+
+// NewCmdUpdate is a component update command.
+func NewCmdUpdate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "update COMPONENT",
+		Short:   "Update updates a component",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"edit"},
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "COMPONENT\tName or id of the component, eg: Backend",
+		},
+		Run: update,
+	}
+
+	cmd.Flags().String("name", "", "New name of the component")
+	cmd.Flags().String("description", "", "Description of the component")
+	cmd.Flags().String("lead", "", "Lead of the component")
+	cmd.Flags().String("default-assignee", "", "Default assignee of issues in the component.\n"+
+		fmt.Sprintf("Accepts: %s", strings.Join(cmdcommon.ComponentAssigneeTypes, ", ")))
+
+	return &cmd
+}
+
+func update(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	name, err := cmd.Flags().GetString("name")
+	cmdutil.ExitIfError(err)
+
+	description, err := cmd.Flags().GetString("description")
+	cmdutil.ExitIfError(err)
+
+	lead, err := cmd.Flags().GetString("lead")
+	cmdutil.ExitIfError(err)
+
+	defaultAssignee, err := cmd.Flags().GetString("default-assignee")
+	cmdutil.ExitIfError(err)
+
+	assigneeType, err := cmdcommon.ComponentAssigneeType(defaultAssignee)
+	cmdutil.ExitIfError(err)
+
+	req := jira.ComponentRequest{
+		Name:         name,
+		Description:  description,
+		AssigneeType: assigneeType,
+	}
+	if req == (jira.ComponentRequest{}) && lead == "" {
+		cmdutil.Failed("Nothing to update, use --name, --description, --lead or --default-assignee")
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	component, err := func() (*jira.Component, error) {
+		s := cmdutil.Info(fmt.Sprintf("Updating component %s...", args[0]))
+		defer s.Stop()
+
+		cmp, err := cmdcommon.FindComponent(client, project, args[0])
+		if err != nil {
+			return nil, err
+		}
+		if err := cmdcommon.SetComponentLead(client, project, lead, &req); err != nil {
+			return nil, err
+		}
+		return client.UpdateComponent(cmp.ID, &req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Component \"%s\" updated", component.Name)
+}
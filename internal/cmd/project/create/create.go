@@ -0,0 +1,160 @@
+package create
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create creates a project. Creating projects requires Jira administrator permission.
+
+The project lead defaults to the current user. Use --lead to pass the account id of
+another user, or the username in local installation.
+
+Templates can be referenced by a short name of the project type, ie: scrum, kanban or
+basic for software projects and project-management, task-tracking or process-control
+for business projects, or by the full project template key.`
+	examples = `$ jira project create --key FOO --name "Foo"
+
+# Create a kanban software project with a description
+$ jira project create --key FOO --name "Foo" --template kanban --description "Foo service"
+
+# Create a business project and get its id in a script
+$ jira project create --key OPS --name "Ops" --type business --template task-tracking -o json | jq -r '.id'`
+)
+
+// NewCmdCreate is a project create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create",
+		Short:   "Create creates a Jira project",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.NoArgs,
+		Run:     create,
+	}
+
+	cmd.Flags().String("key", "", "Key of the project, eg: FOO")
+	cmd.Flags().String("name", "", "Name of the project")
+	cmd.Flags().String("type", jira.ProjectTypeSoftware, "Project type key, eg: software or business")
+	cmd.Flags().String("template", "scrum", "Project template name or key")
+	cmd.Flags().String("description", "", "Description of the project")
+	cmd.Flags().String("lead", "", "Account id, or username in local installation, of the project lead")
+
+	_ = cmd.MarkFlagRequired("key")
+	_ = cmd.MarkFlagRequired("name")
+
+	return &cmd
+}
+
+func create(cmd *cobra.Command, _ []string) {
+	server := viper.GetString("server")
+	params := parseFlags(cmd.Flags())
+
+	if params.output != "" && params.output != view.OutputJSON {
+		cmdutil.Failed("Invalid output format %q", params.output)
+	}
+
+	templateKey, err := cmdcommon.ProjectTemplateKey(params.projectType, params.template)
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	req := jira.ProjectCreateRequest{
+		Key:                strings.ToUpper(params.key),
+		Name:               params.name,
+		Description:        params.description,
+		ProjectTypeKey:     params.projectType,
+		ProjectTemplateKey: templateKey,
+	}
+
+	lead := params.lead
+	if lead == "" {
+		me, err := client.Me()
+		cmdutil.ExitIfError(err)
+
+		lead = me.AccountID
+		if viper.GetString("installation") == jira.InstallationTypeLocal {
+			lead = me.Login
+		}
+	}
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		req.Lead = lead
+	} else {
+		req.LeadAccountID = lead
+	}
+
+	project, err := func() (*jira.ProjectCreateResponse, error) {
+		s := cmdutil.Info(fmt.Sprintf("Creating project %s...", req.Key))
+		defer s.Stop()
+
+		return client.CreateProject(&req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if params.output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, project))
+		return
+	}
+
+	cmdutil.Success("Project \"%s\" created\n%s/browse/%s", project.Key, server, project.Key)
+}
+
+func parseFlags(flags query.FlagParser) *createParams {
+	key, err := flags.GetString("key")
+	cmdutil.ExitIfError(err)
+
+	name, err := flags.GetString("name")
+	cmdutil.ExitIfError(err)
+
+	projectType, err := flags.GetString("type")
+	cmdutil.ExitIfError(err)
+
+	template, err := flags.GetString("template")
+	cmdutil.ExitIfError(err)
+
+	description, err := flags.GetString("description")
+	cmdutil.ExitIfError(err)
+
+	lead, err := flags.GetString("lead")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &createParams{
+		key:         key,
+		name:        name,
+		projectType: strings.ToLower(projectType),
+		template:    template,
+		description: description,
+		lead:        lead,
+		output:      output,
+		debug:       debug,
+	}
+}
+
+type createParams struct {
+	key         string
+	name        string
+	projectType string
+	template    string
+	description string
+	lead        string
+	output      string
+	debug       bool
+}
@@ -1,6 +1,8 @@
 package list
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/api"
@@ -9,15 +11,29 @@ import (
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 )
 
+const examples = `$ jira project list
+
+# List projects in a plain table
+$ jira project list --plain --no-headers
+
+# Get keys of all software projects in a script
+$ jira project list -o json | jq -r '.[] | select(.projectTypeKey == "software") | .key'`
+
 // NewCmdList is a list command.
 func NewCmdList() *cobra.Command {
-	return &cobra.Command{
+	cmd := cobra.Command{
 		Use:     "list",
 		Short:   "List lists Jira projects",
 		Long:    "List lists Jira projects that a user has access to.",
+		Example: examples,
 		Aliases: []string{"lists", "ls"},
 		Run:     List,
 	}
+
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+
+	return &cmd
 }
 
 // List displays a list view.
@@ -25,6 +41,15 @@ func List(cmd *cobra.Command, _ []string) {
 	debug, err := cmd.Flags().GetBool("debug")
 	cmdutil.ExitIfError(err)
 
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
 	projects, total, err := func() ([]*jira.Project, int, error) {
 		s := cmdutil.Info("Fetching projects...")
 		defer s.Stop()
@@ -37,12 +62,23 @@ func List(cmd *cobra.Command, _ []string) {
 	}()
 	cmdutil.ExitIfError(err)
 
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.NewProject(projects).RenderJSON(os.Stdout))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
 	if total == 0 {
 		cmdutil.Failed("No projects found.")
 		return
 	}
 
-	v := view.NewProject(projects)
+	v := view.NewProject(projects, view.WithProjectDisplayFormat(view.DisplayFormat{
+		Plain:     plain,
+		NoHeaders: noHeaders,
+	}))
 
 	cmdutil.ExitIfError(v.Render())
 }
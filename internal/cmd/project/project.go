@@ -3,6 +3,8 @@ package project
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/project/component"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/project/create"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/project/list"
 )
 
@@ -19,7 +21,7 @@ func NewCmdProject() *cobra.Command {
 		RunE:        projects,
 	}
 
-	cmd.AddCommand(list.NewCmdList())
+	cmd.AddCommand(list.NewCmdList(), create.NewCmdCreate(), component.NewCmdComponent())
 
 	return &cmd
 }
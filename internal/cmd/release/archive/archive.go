@@ -0,0 +1,72 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Archive archives a version so that it is hidden from version pickers.`
+	examples = `$ jira release archive v1.0
+
+# Restore an archived version
+$ jira release archive v1.0 --undo`
+)
+
+// NewCmdArchive is a release archive command.
+func NewCmdArchive() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "archive VERSION",
+		Short:   "Archive archives a version",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "VERSION\tName or id of the version, eg: v1.0",
+		},
+		Run: archive,
+	}
+
+	cmd.Flags().Bool("undo", false, "Unarchive the version")
+
+	return &cmd
+}
+
+func archive(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	undo, err := cmd.Flags().GetBool("undo")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	version, err := func() (*jira.Version, error) {
+		s := cmdutil.Info(fmt.Sprintf("Updating version %s...", args[0]))
+		defer s.Stop()
+
+		v, err := cmdcommon.FindVersion(client, project, args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		archived := !undo
+		return client.UpdateVersion(v.ID, &jira.VersionRequest{Archived: &archived})
+	}()
+	cmdutil.ExitIfError(err)
+
+	if undo {
+		cmdutil.Success("Version \"%s\" unarchived", version.Name)
+	} else {
+		cmdutil.Success("Version \"%s\" archived", version.Name)
+	}
+}
@@ -0,0 +1,86 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Create creates a version in a project.`
+	examples = `$ jira release create v2.0
+
+# Create a version with a description and dates
+$ jira release create v2.0 --description "Importer" --start-date 2022-01-10 --release-date 2022-02-01`
+)
+
+// NewCmdCreate is a release create command.
+func NewCmdCreate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "create NAME",
+		Short:   "Create creates a version in a project",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "NAME\tName of the version, eg: v2.0",
+		},
+		Run: create,
+	}
+
+	cmd.Flags().String("description", "", "Description of the version")
+	cmd.Flags().String("start-date", "", "Start date of the version in YYYY-MM-DD format")
+	cmd.Flags().String("release-date", "", "Release date of the version in YYYY-MM-DD format")
+	cmd.Flags().Bool("released", false, "Mark the version as released")
+
+	return &cmd
+}
+
+func create(cmd *cobra.Command, args []string) {
+	server := viper.GetString("server")
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	description, err := cmd.Flags().GetString("description")
+	cmdutil.ExitIfError(err)
+
+	startDate, err := cmd.Flags().GetString("start-date")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdcommon.ValidateVersionDate(startDate))
+
+	releaseDate, err := cmd.Flags().GetString("release-date")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdcommon.ValidateVersionDate(releaseDate))
+
+	released, err := cmd.Flags().GetBool("released")
+	cmdutil.ExitIfError(err)
+
+	req := jira.VersionRequest{
+		Name:        args[0],
+		Project:     project,
+		Description: description,
+		StartDate:   startDate,
+		ReleaseDate: releaseDate,
+	}
+	if released {
+		req.Released = &released
+	}
+
+	version, err := func() (*jira.Version, error) {
+		s := cmdutil.Info(fmt.Sprintf("Creating version %s...", args[0]))
+		defer s.Stop()
+
+		return api.Client(jira.Config{Debug: debug}).CreateVersion(&req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Version \"%s\" created\n%s/projects/%s/versions/%s", version.Name, server, project, version.ID)
+}
@@ -0,0 +1,107 @@
+package list
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `List lists versions of a project. Archived versions are hidden unless --archived is passed.`
+	examples = `$ jira release list
+
+# List unreleased versions in a plain table
+$ jira release list --unreleased --plain
+
+# Get name of the next unreleased version in a script
+$ jira release list --unreleased -o json | jq -r '.[0].name'`
+)
+
+// NewCmdList is a release list command.
+func NewCmdList() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "list",
+		Short:   "List lists versions of a project",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"lists", "ls"},
+		Args:    cobra.NoArgs,
+		Run:     list,
+	}
+
+	cmd.Flags().Bool("unreleased", false, "List only unreleased versions")
+	cmd.Flags().Bool("archived", false, "Include archived versions")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+
+	return &cmd
+}
+
+func list(cmd *cobra.Command, _ []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	unreleased, err := cmd.Flags().GetBool("unreleased")
+	cmdutil.ExitIfError(err)
+
+	archived, err := cmd.Flags().GetBool("archived")
+	cmdutil.ExitIfError(err)
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	versions, err := func() ([]*jira.Version, error) {
+		s := cmdutil.Info(fmt.Sprintf("Fetching versions of project %s...", project))
+		defer s.Stop()
+
+		return api.Client(jira.Config{Debug: debug}).ProjectVersions(project)
+	}()
+	cmdutil.ExitIfError(err)
+
+	filtered := make([]*jira.Version, 0, len(versions))
+	for _, v := range versions {
+		if v.Archived && !archived {
+			continue
+		}
+		if v.Released && unreleased {
+			continue
+		}
+		filtered = append(filtered, v)
+	}
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.NewRelease(filtered).RenderJSON(os.Stdout))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	if len(filtered) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No versions found in project \"%s\"", project)
+		return
+	}
+
+	v := view.NewRelease(filtered, view.WithReleaseDisplayFormat(view.DisplayFormat{
+		Plain:     plain,
+		NoHeaders: noHeaders,
+	}))
+
+	cmdutil.ExitIfError(v.Render())
+}
@@ -0,0 +1,38 @@
+package release
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/archive"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/create"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/list"
+	releaseCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/release/release"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release/update"
+)
+
+const helpText = `Release manages versions of a project. See available commands below.
+
+Versions can be referenced by their name or id in all commands.`
+
+// NewCmdRelease is a release command.
+func NewCmdRelease() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "release",
+		Short:       "Release manages versions of a project",
+		Long:        helpText,
+		Aliases:     []string{"releases"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        release,
+	}
+
+	cmd.AddCommand(
+		list.NewCmdList(), create.NewCmdCreate(), update.NewCmdUpdate(),
+		releaseCmd.NewCmdRelease(), archive.NewCmdArchive(),
+	)
+
+	return &cmd
+}
+
+func release(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
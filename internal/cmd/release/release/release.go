@@ -0,0 +1,103 @@
+package releasecmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Release marks a version as released.
+
+Unresolved issues of the version stay in the version unless --move-unfixed-to is passed,
+in which case their fix version is changed to the given version.`
+	examples = `$ jira release release v2.0
+
+# Release a version on a given date and move unresolved issues to the next version
+$ jira release release v2.0 --date 2022-02-01 --move-unfixed-to v2.1`
+)
+
+// NewCmdRelease is a release release command.
+func NewCmdRelease() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "release VERSION",
+		Short:   "Release marks a version as released",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"ship"},
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "VERSION\tName or id of the version, eg: v2.0",
+		},
+		Run: release,
+	}
+
+	cmd.Flags().String("date", "", "Release date in YYYY-MM-DD format (defaults to today)")
+	cmd.Flags().String("move-unfixed-to", "", "Name or id of the version to move unresolved issues to")
+
+	return &cmd
+}
+
+func release(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	date, err := cmd.Flags().GetString("date")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdcommon.ValidateVersionDate(date))
+	if date == "" {
+		date = time.Now().Format(cmdcommon.VersionDateLayout)
+	}
+
+	moveTo, err := cmd.Flags().GetString("move-unfixed-to")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	version, err := func() (*jira.Version, error) {
+		s := cmdutil.Info(fmt.Sprintf("Releasing version %s...", args[0]))
+		defer s.Stop()
+
+		v, err := cmdcommon.FindVersion(client, project, args[0])
+		if err != nil {
+			return nil, err
+		}
+		if v.Released {
+			return nil, fmt.Errorf("version %q is already released", v.Name)
+		}
+
+		released := true
+		req := jira.VersionRequest{
+			ReleaseDate: date,
+			Released:    &released,
+		}
+		if moveTo != "" {
+			to, err := cmdcommon.FindVersion(client, project, moveTo)
+			if err != nil {
+				return nil, err
+			}
+			if to.ID == v.ID {
+				return nil, fmt.Errorf("can't move unresolved issues to the version being released")
+			}
+			req.MoveUnfixedIssuesTo = to.Self
+		}
+
+		return client.UpdateVersion(v.ID, &req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if moveTo != "" {
+		cmdutil.Success("Version \"%s\" released on %s, unresolved issues moved to \"%s\"", version.Name, date, moveTo)
+	} else {
+		cmdutil.Success("Version \"%s\" released on %s", version.Name, date)
+	}
+}
@@ -0,0 +1,91 @@
+package update
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Update updates name, description or dates of a version. Only the given fields are updated.`
+	examples = `$ jira release update v2.0 --name v2.0.0
+
+# Move the release date of a version
+$ jira release update v2.0 --release-date 2022-02-15`
+)
+
+// NewCmdUpdate is a release update command.
+func NewCmdUpdate() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "update VERSION",
+		Short:   "Update updates a version",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"edit"},
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			"help:args": "VERSION\tName or id of the version, eg: v2.0",
+		},
+		Run: update,
+	}
+
+	cmd.Flags().String("name", "", "New name of the version")
+	cmd.Flags().String("description", "", "Description of the version")
+	cmd.Flags().String("start-date", "", "Start date of the version in YYYY-MM-DD format")
+	cmd.Flags().String("release-date", "", "Release date of the version in YYYY-MM-DD format")
+
+	return &cmd
+}
+
+func update(cmd *cobra.Command, args []string) {
+	project := viper.GetString("project.key")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	name, err := cmd.Flags().GetString("name")
+	cmdutil.ExitIfError(err)
+
+	description, err := cmd.Flags().GetString("description")
+	cmdutil.ExitIfError(err)
+
+	startDate, err := cmd.Flags().GetString("start-date")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdcommon.ValidateVersionDate(startDate))
+
+	releaseDate, err := cmd.Flags().GetString("release-date")
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cmdcommon.ValidateVersionDate(releaseDate))
+
+	req := jira.VersionRequest{
+		Name:        name,
+		Description: description,
+		StartDate:   startDate,
+		ReleaseDate: releaseDate,
+	}
+	if req == (jira.VersionRequest{}) {
+		cmdutil.Failed("Nothing to update, use --name, --description, --start-date or --release-date")
+	}
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	version, err := func() (*jira.Version, error) {
+		s := cmdutil.Info(fmt.Sprintf("Updating version %s...", args[0]))
+		defer s.Stop()
+
+		v, err := cmdcommon.FindVersion(client, project, args[0])
+		if err != nil {
+			return nil, err
+		}
+		return client.UpdateVersion(v.ID, &req)
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Version \"%s\" updated", version.Name)
+}
@@ -1,59 +1,197 @@
 package root
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/netrc"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
+	"github.com/ankitpokhrel/jira-cli/api"
+	jiraAuth "github.com/ankitpokhrel/jira-cli/internal/auth"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/activity"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/alias"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/auth"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/backlog"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/board"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/cache"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/completion"
+	contextCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/context"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/epic"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/filter"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/git"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/group"
 	initCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/init"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/jql"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/listen"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/man"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/me"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/notify"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/open"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/permissions"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/project"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/release"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sync"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/user"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/version"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/contexts"
+	"github.com/ankitpokhrel/jira-cli/internal/extension"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/oauth"
 )
 
 const jiraAPITokenLink = "https://id.atlassian.com/manage-profile/security/api-tokens"
 
 var (
-	config string
-	debug  bool
+	config      string
+	contextName string
+	debug       bool
 )
 
 func init() {
 	cobra.OnInitialize(func() {
-		if config != "" {
-			viper.SetConfigFile(config)
-		} else {
-			home, err := cmdutil.GetConfigHome()
-			if err != nil {
-				cmdutil.Failed("Error: %s", err)
-				return
-			}
+		if err := readConfig(); err == nil && debug {
+			fmt.Printf("Using config file: %s\n", viper.ConfigFileUsed())
+		}
+	})
+}
 
-			viper.AddConfigPath(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
-			viper.SetConfigName(jiraConfig.FileName)
-			viper.SetConfigType(jiraConfig.FileType)
+// readConfig reads the config passed with the config flag or JIRA_CONFIG_FILE env, eg: set for
+// extensions, the config of the context in use or the default config.
+func readConfig() error {
+	file := config
+	if file == "" {
+		file = os.Getenv("JIRA_CONFIG_FILE")
+	}
+	if file == "" {
+		file = contextConfig()
+	}
+	if file != "" {
+		viper.SetConfigFile(file)
+	} else {
+		home, err := cmdutil.GetConfigHome()
+		if err != nil {
+			cmdutil.Failed("Error: %s", err)
+			return err
 		}
 
-		viper.AutomaticEnv()
-		viper.SetEnvPrefix("jira")
+		viper.AddConfigPath(fmt.Sprintf("%s/%s", home, jiraConfig.Dir))
+		viper.SetConfigName(jiraConfig.FileName)
+		viper.SetConfigType(jiraConfig.FileType)
+	}
 
-		if err := viper.ReadInConfig(); err == nil && debug {
-			fmt.Printf("Using config file: %s\n", viper.ConfigFileUsed())
+	viper.AutomaticEnv()
+	viper.SetEnvPrefix("jira")
+
+	return viper.ReadInConfig()
+}
+
+// ExpandAlias expands the user-defined alias the args start with, if any, after the global flags,
+// eg: jira --debug mine. Aliases are read before the flags are parsed, so they are looked up in the
+// config of the context in use or the default config. Commands take precedence over aliases with
+// the same name.
+func ExpandAlias(cmd *cobra.Command, args []string) ([]string, error) {
+	i := commandIndex(cmd.PersistentFlags(), args)
+	if i < 0 || cmdcommon.IsReservedName(cmd, args[i]) {
+		return args, nil
+	}
+
+	_ = readConfig()
+
+	expansion, ok := jiraConfig.Aliases()[strings.ToLower(args[i])]
+	if !ok {
+		return args, nil
+	}
+
+	expanded, err := jiraConfig.ExpandAlias(expansion, args[i+1:])
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]string{}, args[:i]...), expanded...), nil
+}
+
+// commandIndex returns the index of the first arg after the leading flags and their values,
+// eg: 2 for --project FOO mine. It returns -1 if there is none or a flag is unknown.
+func commandIndex(flags *pflag.FlagSet, args []string) int {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			return i
 		}
-	})
+
+		var (
+			f        *pflag.Flag
+			hasValue bool
+		)
+		switch {
+		case arg == "-" || arg == "--":
+			return -1
+		case strings.HasPrefix(arg, "--"):
+			name := arg[2:]
+			if j := strings.Index(name, "="); j >= 0 {
+				name, hasValue = name[:j], true
+			}
+			f = flags.Lookup(name)
+		default:
+			// Values can be attached to shorthands, eg: -pFOO.
+			f, hasValue = flags.ShorthandLookup(arg[1:2]), len(arg) > 2
+		}
+
+		if f == nil {
+			return -1
+		}
+		if !hasValue && f.NoOptDefVal == "" {
+			i++
+		}
+	}
+	return -1
+}
+
+// RunExtension runs the extension of the command the args start with, ie: an executable named
+// jira-<command> on the PATH, if the command is not a built-in one. The config and the credentials
+// are passed to the extension in env variables. It returns false if there is no such extension.
+func RunExtension(cmd *cobra.Command, args []string) (int, bool) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") || cmdcommon.IsReservedName(cmd, args[0]) {
+		return 0, false
+	}
+	path := extension.Find(args[0])
+	if path == "" {
+		return 0, false
+	}
+
+	_ = readConfig()
+
+	server, login := viper.GetString("server"), viper.GetString("login")
+	env := map[string]string{
+		"JIRA_CONFIG_FILE":  viper.ConfigFileUsed(),
+		"JIRA_INSTALLATION": viper.GetString("installation"),
+		"JIRA_SERVER":       server,
+		"JIRA_LOGIN":        login,
+		"JIRA_AUTH_TYPE":    viper.GetString("auth_type"),
+		"JIRA_PROJECT_KEY":  viper.GetString("project.key"),
+	}
+	// OAuth tokens are refreshed by the tool, so extensions need to call it to use them.
+	if viper.GetString("auth_type") != string(jira.AuthTypeOAuth) {
+		env["JIRA_API_TOKEN"] = api.APIToken(server, login)
+	}
+
+	code, err := extension.Run(path, args[1:], env)
+	if err != nil {
+		cmdutil.Failed("Unable to run extension %s: %s", path, err)
+	}
+	return code, true
 }
 
 // NewCmdRoot is a root command.
@@ -62,21 +200,70 @@ func NewCmdRoot() *cobra.Command {
 		Use:   "jira",
 		Short: "Interactive Jira CLI",
 		Long:  "Interactive Jira CLI.",
+		// Errors are printed by the caller so that they can be formatted as json.
+		SilenceErrors: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
 		},
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			UseJSONErrors(cmd)
+			usePlainMode(cmd)
+			local := useLocalConfig()
+
+			// Project type in the config belongs to the configured project, so
+			// it is resolved from the server when another project is passed.
+			if cmd.Flags().Changed("project") {
+				viper.Set("project.type", "")
+			}
+			// Default board of the project takes precedence over the board in the config.
+			if b := viper.GetStringMap(jiraConfig.BoardKey(viper.GetString("project.key"))); len(b) > 0 {
+				viper.Set("board", b)
+			}
+			// Certificates, the proxy and OAuth tokens are also needed to reach the server when generating the config.
+			useCertificates()
+			useProxy()
+			loggedIn := useOAuth()
+
 			subCmd := cmd.Name()
 			if !cmdRequireToken(subCmd) {
 				return
 			}
+			useCache(cmd)
 
-			checkForJiraToken(viper.GetString("server"), viper.GetString("login"))
+			if viper.GetString("auth_type") == string(jira.AuthTypeOAuth) {
+				if !loggedIn {
+					cmdutil.FailedWithCode(
+						cmdutil.ExitCodeAuth, "You are not logged in.\nRun 'jira auth login --oauth' to log in with OAuth.",
+					)
+				}
+			} else {
+				checkForJiraToken(viper.GetString("server"), viper.GetString("login"))
+			}
 
 			configFile := viper.ConfigFileUsed()
 			if !jiraConfig.Exists(configFile) {
 				cmdutil.Failed("Missing configuration file.\nRun 'jira init' to configure the tool.")
 			}
+			if viper.GetBool("insecure") {
+				cmdutil.Warn("TLS certificate verification is turned off by insecure in the config, " +
+					"set tls.ca_cert to trust the server certificate instead")
+			}
+
+			// Board passed via the --board flag takes precedence over the default board in the config,
+			// and the board in the per-directory config unless it is for another project.
+			// A board flag without an ID, eg: jira open --board, is for the default board.
+			var boardID int
+			if cmd.Flags().Changed("board") {
+				id, err := cmd.Flags().GetInt("board")
+				cmdutil.ExitIfError(err)
+				boardID = id
+			}
+			switch {
+			case boardID > 0:
+				useBoard(boardID)
+			case local != nil && local.Board > 0 && !cmd.Flags().Changed("project"):
+				useBoard(local.Board)
+			}
 		},
 	}
 
@@ -89,6 +276,10 @@ func NewCmdRoot() *cobra.Command {
 		&config, "config", "c", "",
 		fmt.Sprintf("Config file (default is %s/%s/%s.yml)", configHome, jiraConfig.Dir, jiraConfig.FileName),
 	)
+	cmd.PersistentFlags().StringVar(
+		&contextName, "context", "",
+		"Context to use instead of the one in use, see 'jira context'\nCan also be set with JIRA_CONTEXT env",
+	)
 	cmd.PersistentFlags().StringP(
 		"project", "p", "",
 		fmt.Sprintf(
@@ -96,13 +287,39 @@ func NewCmdRoot() *cobra.Command {
 			configHome, jiraConfig.Dir, jiraConfig.FileName,
 		),
 	)
+	cmd.PersistentFlags().StringP(
+		"output", "o", "",
+		fmt.Sprintf(
+			"Output format of listing and view commands for scripts, accepts: %s, %s, %s\n"+
+				"Format %s is only supported by issue, epic, backlog, and worklog list commands\n"+
+				"Format %s is only supported by issue view command",
+			view.OutputJSON, view.OutputCSV, view.OutputYAML, view.OutputCSV, view.OutputYAML,
+		),
+	)
+	cmd.PersistentFlags().Bool("plain", false, "Display output in plain mode without colors, spinners and prompts\n"+
+		"Turned on if the output is not a terminal, eg: piped to another command")
+	cmd.PersistentFlags().Bool("no-cache", false, "Fetch metadata from the server instead of the local cache")
+	cmd.PersistentFlags().Uint("max-retries", jira.DefaultMaxRetries, "Number of times to retry requests rate limited "+
+		"by Jira\nCan also be set with max_retries key in the config")
+	cmd.PersistentFlags().String("ca-cert", "", "CA certificate file to verify the server with, eg: a corporate root CA\n"+
+		"Can also be set with tls.ca_cert key in the config")
+	cmd.PersistentFlags().String("client-cert", "", "Client certificate file for servers that require mutual TLS\n"+
+		"Can also be set with tls.client_cert key in the config")
+	cmd.PersistentFlags().String("client-key", "", "Private key file of the client certificate\n"+
+		"Can also be set with tls.client_key key in the config")
 	cmd.PersistentFlags().BoolVar(&debug, "debug", false, "Turn on debug output")
 
 	cmd.SetHelpFunc(helpFunc)
 
+	_ = cmd.RegisterFlagCompletionFunc("project", cmdcommon.CompleteProjects)
+
 	_ = viper.BindPFlag("config", cmd.PersistentFlags().Lookup("config"))
 	_ = viper.BindPFlag("project.key", cmd.PersistentFlags().Lookup("project"))
 	_ = viper.BindPFlag("debug", cmd.PersistentFlags().Lookup("debug"))
+	_ = viper.BindPFlag("max_retries", cmd.PersistentFlags().Lookup("max-retries"))
+	_ = viper.BindPFlag("tls.ca_cert", cmd.PersistentFlags().Lookup("ca-cert"))
+	_ = viper.BindPFlag("tls.client_cert", cmd.PersistentFlags().Lookup("client-cert"))
+	_ = viper.BindPFlag("tls.client_key", cmd.PersistentFlags().Lookup("client-key"))
 
 	addChildCommands(&cmd)
 
@@ -112,11 +329,27 @@ func NewCmdRoot() *cobra.Command {
 func addChildCommands(cmd *cobra.Command) {
 	cmd.AddCommand(
 		initCmd.NewCmdInit(),
+		auth.NewCmdAuth(),
+		alias.NewCmdAlias(),
+		contextCmd.NewCmdContext(),
 		issue.NewCmdIssue(),
 		epic.NewCmdEpic(),
 		sprint.NewCmdSprint(),
+		backlog.NewCmdBacklog(),
 		board.NewCmdBoard(),
 		project.NewCmdProject(),
+		release.NewCmdRelease(),
+		filter.NewCmdFilter(),
+		user.NewCmdUser(),
+		group.NewCmdGroup(),
+		permissions.NewCmdPermissions(),
+		cache.NewCmdCache(),
+		sync.NewCmdSync(),
+		git.NewCmdGit(),
+		listen.NewCmdListen(),
+		notify.NewCmdNotify(),
+		activity.NewCmdActivity(),
+		jql.NewCmdJQL(),
 		open.NewCmdOpen(),
 		me.NewCmdMe(),
 		completion.NewCmdCompletion(),
@@ -125,6 +358,36 @@ func addChildCommands(cmd *cobra.Command) {
 	)
 }
 
+// contextConfig returns the config file of the context passed with the context flag or JIRA_CONTEXT env,
+// or of the context in use. It returns an empty string if no context is used.
+func contextConfig() string {
+	name := contextName
+	if name == "" {
+		name = os.Getenv("JIRA_CONTEXT")
+	}
+
+	store, err := jiraConfig.ContextStore()
+	cmdutil.ExitIfError(err)
+
+	if name == "" {
+		name, err = store.Current()
+		cmdutil.ExitIfError(err)
+	}
+	if name == "" {
+		return ""
+	}
+
+	ctx, err := store.Get(name)
+	if errors.Is(err, contexts.ErrNoContext) {
+		cmdutil.FailedWithCode(
+			cmdutil.ExitCodeNotFound, "Context %q not found, run 'jira context list' to see the available contexts", name,
+		)
+	}
+	cmdutil.ExitIfError(err)
+
+	return ctx.Config
+}
+
 func cmdRequireToken(cmd string) bool {
 	allowList := []string{
 		"init",
@@ -133,6 +396,12 @@ func cmdRequireToken(cmd string) bool {
 		"version",
 		"completion",
 		"man",
+		"cache",
+		"clear",
+		"auth",
+		"login",
+		"logout",
+		"set-token",
 	}
 
 	for _, item := range allowList {
@@ -144,6 +413,133 @@ func cmdRequireToken(cmd string) bool {
 	return true
 }
 
+// usePlainMode turns on the plain mode if the output is piped or redirected, unless the plain flag is
+// set explicitly. Plain mode disables colors and spinners, and prompts of commands with a no-input flag.
+// Commands that render tables define their own plain flag to print tab separated rows instead.
+func usePlainMode(cmd *cobra.Command) {
+	if !cmd.Flags().Changed("plain") && !cmdutil.StdoutIsTerminal() {
+		cmdutil.ExitIfError(cmd.Flags().Set("plain", "true"))
+	}
+	if plain, _ := cmd.Flags().GetBool("plain"); !plain {
+		return
+	}
+
+	color.NoColor = true
+	cmdutil.DisableSpinner()
+
+	if f := cmd.Flags().Lookup("no-input"); f != nil && !f.Changed {
+		cmdutil.ExitIfError(f.Value.Set("true"))
+	}
+}
+
+// useCache caches the responses of metadata endpoints, unless the no-cache flag is set.
+func useCache(cmd *cobra.Command) {
+	if noCache, _ := cmd.Flags().GetBool("no-cache"); noCache {
+		return
+	}
+
+	dir, err := jiraConfig.CacheDir()
+	if err != nil {
+		return
+	}
+	ttl, err := jiraConfig.CacheTTL()
+	if err != nil {
+		cmdutil.Failed("Invalid cache.ttl in the config: %s", err)
+	}
+	api.UseCache(dir, ttl)
+}
+
+func useCertificates() {
+	pool, err := jiraConfig.CACertPool()
+	if err != nil {
+		cmdutil.Failed("Unable to load the CA certificate: %s", err)
+	}
+	if pool != nil {
+		api.UseRootCAs(pool)
+	}
+
+	cert, err := jiraConfig.ClientCertificate()
+	if err != nil {
+		cmdutil.Failed("Unable to load the client certificate: %s", err)
+	}
+	if cert != nil {
+		api.UseClientCert(cert)
+	}
+}
+
+// useOAuth authorizes requests with the saved OAuth tokens if OAuth is the configured
+// authentication type. Refreshed tokens are saved as the refresh token is rotated.
+func useOAuth() bool {
+	if viper.GetString("auth_type") != string(jira.AuthTypeOAuth) {
+		return false
+	}
+
+	store, err := jiraConfig.AuthStore()
+	if err != nil {
+		return false
+	}
+	creds, err := store.Load()
+	if err != nil {
+		return false
+	}
+
+	source := oauth.NewTokenSource(creds.Config(api.HTTPClient()), &creds.Token, func(t *oauth.Token) error {
+		creds.Token = *t
+		return store.Save(creds)
+	})
+	api.UseOAuth(source, creds.APIURL())
+
+	return true
+}
+
+func useProxy() {
+	u, err := jiraConfig.ProxyURL()
+	if err != nil {
+		cmdutil.Failed("Invalid proxy in the config: %s", err)
+	}
+	if u != nil {
+		api.UseProxy(u)
+	}
+}
+
+// useLocalConfig merges the per-directory config found from the working directory upwards
+// into the config. The project type is reset if the config is for another project.
+func useLocalConfig() *jiraConfig.LocalConfig {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+
+	local, err := jiraConfig.ReadLocal(wd)
+	if err != nil {
+		cmdutil.Failed("Unable to read %s: %s", jiraConfig.LocalFileName, err)
+	}
+	if local == nil {
+		return nil
+	}
+	if debug {
+		fmt.Printf("Using local config file: %s\n", local.Path)
+	}
+
+	if local.Project != "" && !strings.EqualFold(local.Project, viper.GetString("project.key")) {
+		viper.Set("project.type", "")
+	}
+	cmdutil.ExitIfError(local.Merge())
+
+	return local
+}
+
+// useBoard replaces the board in the config with the given board. The board name is
+// only resolved by the commands that display it, see cmdcommon.BoardName.
+func useBoard(boardID int) {
+	if boardID == viper.GetInt("board.id") {
+		return
+	}
+
+	viper.Set("board.id", boardID)
+	viper.Set("board.name", "")
+}
+
 func checkForJiraToken(server string, login string) {
 	if os.Getenv("JIRA_API_TOKEN") != "" {
 		return
@@ -155,14 +551,26 @@ func checkForJiraToken(server string, login string) {
 		return
 	}
 
+	if token, _ := jiraAuth.Token(server, login); token != "" {
+		return
+	}
+
 	msg := fmt.Sprintf(`You need to define JIRA_API_TOKEN env for the tool to work. 
 
 You can generate a token using this link: %s
 
 After generating the token, export it to your shell and run 'jira init' if you haven't already.
 
-Alternatively, you might want to define JIRA server and user details in your .netrc and jira-cli will attempt to read them.`, jiraAPITokenLink)
+Alternatively, you might want to define JIRA server and user details in your .netrc and jira-cli will attempt to read them.
 
-	fmt.Fprintf(os.Stderr, "%s\n", msg)
-	os.Exit(1)
+You can also save the token in the keyring of your OS with 'jira auth set-token'.`, jiraAPITokenLink)
+
+	cmdutil.FailedWithCode(cmdutil.ExitCodeAuth, "%s", msg)
+}
+
+// UseJSONErrors prints the errors in json format if the json output is requested for the command.
+func UseJSONErrors(cmd *cobra.Command) {
+	if output, _ := cmd.Flags().GetString("output"); output == view.OutputJSON {
+		cmdutil.UseJSONErrors()
+	}
 }
@@ -0,0 +1,37 @@
+package root
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandIndex(t *testing.T) {
+	t.Parallel()
+
+	flags := pflag.NewFlagSet("jira", pflag.ContinueOnError)
+	flags.StringP("project", "p", "", "")
+	flags.Bool("debug", false, "")
+
+	cases := []struct {
+		args     []string
+		expected int
+	}{
+		{args: []string{"mine", "--debug"}, expected: 0},
+		{args: []string{"--debug", "mine"}, expected: 1},
+		{args: []string{"--project", "FOO", "mine"}, expected: 2},
+		{args: []string{"-p", "FOO", "--debug", "mine"}, expected: 3},
+		{args: []string{"--project=FOO", "mine"}, expected: 1},
+		{args: []string{"-pFOO", "mine"}, expected: 1},
+		{args: []string{"--debug"}, expected: -1},
+		{args: []string{"--project", "FOO"}, expected: -1},
+		{args: []string{"--unknown", "mine"}, expected: -1},
+		{args: []string{"--", "mine"}, expected: -1},
+		{args: nil, expected: -1},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, commandIndex(flags, tc.args), tc.args)
+	}
+}
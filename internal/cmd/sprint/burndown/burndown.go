@@ -0,0 +1,157 @@
+package burndown
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/burndown"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Burndown displays remaining estimate of a sprint per day in a chart.
+
+If SPRINT_ID is not given, the current active sprint of the board is used. Story points are
+read from the estimation field of the board and hours from the original time estimate of
+the issues. An issue counts toward the remaining estimate until it is resolved.`
+	examples = `$ jira sprint burndown
+
+# Burndown of a sprint in hours
+$ jira sprint burndown SPRINT_ID --hours
+
+# Use a taller chart
+$ jira sprint burndown --height 20`
+
+	defaultHeight = 10
+	secondsInHour = 3600
+)
+
+// NewCmdBurndown is a sprint burndown command.
+func NewCmdBurndown() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "burndown [SPRINT_ID]",
+		Short:   "Burndown displays a burndown chart of a sprint",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			"help:args": "[SPRINT_ID]\tID of the sprint, eg: 123",
+		},
+		Run: burndownChart,
+	}
+
+	cmd.Flags().Bool("points", false, "Burn down story points (default)")
+	cmd.Flags().Bool("hours", false, "Burn down original time estimate in hours")
+	cmd.Flags().Uint("height", defaultHeight, "Height of the chart in rows")
+
+	return &cmd
+}
+
+func burndownChart(cmd *cobra.Command, args []string) {
+	boardID := viper.GetInt("board.id")
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	points, err := cmd.Flags().GetBool("points")
+	cmdutil.ExitIfError(err)
+
+	hours, err := cmd.Flags().GetBool("hours")
+	cmdutil.ExitIfError(err)
+
+	if points && hours {
+		cmdutil.Failed("Only one of --points or --hours can be used")
+	}
+
+	height, err := cmd.Flags().GetUint("height")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Fetching sprint details...")
+		defer s.Stop()
+
+		if len(args) == 0 {
+			return cmdcommon.BoardSprint(client, boardID, jira.SprintStateActive)
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid sprint id %q", args[0])
+		}
+		return client.GetSprint(id)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if sprint.Status == jira.SprintStateFuture {
+		cmdutil.Failed("Sprint #%d \"%s\" is not started yet", sprint.ID, sprint.Name)
+	}
+	if boardID == 0 {
+		boardID = sprint.BoardID
+	}
+
+	field, unit, scale := jira.EstimateFieldOriginal, "h", float64(secondsInHour)
+
+	estimates, err := func() ([]*jira.IssueEstimate, error) {
+		s := cmdutil.Info("Fetching sprint issues...")
+		defer s.Stop()
+
+		if !hours {
+			conf, err := client.GetBoardConfiguration(boardID)
+			if err != nil {
+				return nil, err
+			}
+			if conf.Estimation.Field.FieldID == "" || conf.Estimation.Field.FieldID == jira.EstimateFieldOriginal {
+				return nil, fmt.Errorf("board #%d doesn't estimate in story points, use --hours instead", boardID)
+			}
+			field, unit, scale = conf.Estimation.Field.FieldID, "", 1
+		}
+
+		return client.SprintIssueEstimates(boardID, sprint.ID, field)
+	}()
+	cmdutil.ExitIfError(err)
+
+	start, err := time.Parse(time.RFC3339, sprint.StartDate)
+	cmdutil.ExitIfError(err)
+
+	end, err := time.Parse(time.RFC3339, sprint.EndDate)
+	cmdutil.ExitIfError(err)
+
+	if sprint.CompleteDate != "" {
+		if complete, err := time.Parse(time.RFC3339, sprint.CompleteDate); err == nil {
+			end = complete
+		}
+	}
+
+	issues := make([]burndown.Issue, 0, len(estimates))
+	for _, e := range estimates {
+		iss := burndown.Issue{Estimate: e.Estimate / scale}
+		if e.Resolved != "" {
+			resolved, err := time.Parse(jira.RFC3339MilliLayout, e.Resolved)
+			cmdutil.ExitIfError(err)
+			iss.Resolved = resolved
+		}
+		issues = append(issues, iss)
+	}
+
+	now := time.Now()
+	series := burndown.Compute(issues, start.In(now.Location()), end.In(now.Location()), now)
+
+	fmt.Printf("Sprint #%d ➤ %s (%s - %s)\n\n", sprint.ID, sprint.Name,
+		cmdutil.FormatDateTimeHuman(sprint.StartDate, time.RFC3339),
+		cmdutil.FormatDateTimeHuman(sprint.EndDate, time.RFC3339),
+	)
+	fmt.Print(burndown.Chart(series, int(height), unit))
+
+	if len(series) > 0 {
+		last := series[len(series)-1]
+		fmt.Printf("\nRemaining %.1f%s, ideal %.1f%s\n", last.Remaining, unit, last.Ideal, unit)
+	}
+}
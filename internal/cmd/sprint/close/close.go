@@ -0,0 +1,81 @@
+package closecmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Close completes an active sprint.
+
+If SPRINT_ID is not given, the current active sprint of the board is closed. Jira moves
+incomplete issues of the sprint to the backlog.`
+	examples = `$ jira sprint close
+
+# Close a sprint with the given id
+$ jira sprint close SPRINT_ID
+
+# Close the current active sprint of another board
+$ jira sprint close --board 12`
+)
+
+// NewCmdClose is a sprint close command.
+func NewCmdClose() *cobra.Command {
+	return &cobra.Command{
+		Use:     "close [SPRINT_ID]",
+		Short:   "Close an active sprint",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: []string{"complete"},
+		Annotations: map[string]string{
+			"help:args": "[SPRINT_ID]\tID of the sprint to close, eg: 123",
+		},
+		Run: closeSprint,
+	}
+}
+
+func closeSprint(cmd *cobra.Command, args []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Fetching sprint details...")
+		defer s.Stop()
+
+		if len(args) == 0 {
+			return cmdcommon.BoardSprint(client, viper.GetInt("board.id"), jira.SprintStateActive)
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid sprint id %q", args[0])
+		}
+		return client.GetSprint(id)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if sprint.Status != jira.SprintStateActive {
+		cmdutil.Failed("Sprint #%d \"%s\" is %s, only active sprints can be closed", sprint.ID, sprint.Name, sprint.Status)
+	}
+
+	err = func() error {
+		s := cmdutil.Info(fmt.Sprintf("Closing sprint \"%s\"...", sprint.Name))
+		defer s.Stop()
+
+		_, err := client.UpdateSprint(sprint.ID, &jira.SprintUpdateRequest{State: jira.SprintStateClosed})
+		return err
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success("Sprint #%d \"%s\" closed", sprint.ID, sprint.Name)
+}
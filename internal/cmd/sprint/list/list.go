@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
 	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
 	"github.com/ankitpokhrel/jira-cli/internal/query"
 	"github.com/ankitpokhrel/jira-cli/internal/view"
@@ -79,7 +81,10 @@ func sprintList(cmd *cobra.Command, args []string) {
 	client := api.Client(jira.Config{Debug: debug})
 
 	if len(args) == 0 {
-		sprintExplorerView(cmd.Flags(), boardID, project, server, client)
+		order, err := cmdcommon.ExplicitIssueOrder(cmd.Flags())
+		cmdutil.ExitIfError(err)
+
+		sprintExplorerView(cmd.Flags(), boardID, project, server, client, order)
 	} else {
 		sprintID, err := strconv.Atoi(args[0])
 		cmdutil.ExitIfError(err)
@@ -98,7 +103,11 @@ func singleSprintView(flags query.FlagParser, boardID, sprintID int, project, se
 			return nil, 0, err
 		}
 
-		resp, err := client.SprintIssues(boardID, sprintID, q.Get(), q.Params().Limit)
+		jql := q.Get()
+
+		resp, err := api.FetchPages(func(from, limit uint) (*jira.SearchResult, error) {
+			return client.SprintIssuesFrom(boardID, sprintID, jql, from, limit)
+		}, q.Params().Limit, cmdutil.InfoProgress(s, "Fetching sprint issues..."))
 		if err != nil {
 			return nil, 0, err
 		}
@@ -106,6 +115,25 @@ func singleSprintView(flags query.FlagParser, boardID, sprintID int, project, se
 	}()
 	cmdutil.ExitIfError(err)
 
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, issues))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	format, err := flags.GetString("format")
+	cmdutil.ExitIfError(err)
+
+	if format != "" {
+		cmdutil.ExitIfError(view.RenderIssuesTemplate(os.Stdout, server, format, issues))
+		return
+	}
+
 	if total == 0 {
 		fmt.Println()
 		cmdutil.Failed("No result found for given query in project \"%s\"", project)
@@ -121,7 +149,16 @@ func singleSprintView(flags query.FlagParser, boardID, sprintID int, project, se
 	noTruncate, err := flags.GetBool("no-truncate")
 	cmdutil.ExitIfError(err)
 
-	columns, err := flags.GetString("columns")
+	columnsFlag, err := flags.GetString("columns")
+	cmdutil.ExitIfError(err)
+
+	columns, customColumns, err := cmdcommon.IssueColumns(client, "sprint", columnsFlag)
+	cmdutil.ExitIfError(err)
+
+	groupByFlag, err := flags.GetString("group-by")
+	cmdutil.ExitIfError(err)
+
+	groupBy, err := cmdcommon.IssueGroupBy(groupByFlag)
 	cmdutil.ExitIfError(err)
 
 	var ft string
@@ -155,23 +192,23 @@ func singleSprintView(flags query.FlagParser, boardID, sprintID int, project, se
 		Refresh: func() {
 			singleSprintView(flags, boardID, sprintID, project, server, client, nil)
 		},
+		Actions: cmdcommon.IssueActions(client, project),
 		Display: view.DisplayFormat{
-			Plain:      plain,
-			NoHeaders:  noHeaders,
-			NoTruncate: noTruncate,
-			Columns: func() []string {
-				if columns != "" {
-					return strings.Split(columns, ",")
-				}
-				return []string{}
-			}(),
+			Plain:         plain,
+			NoHeaders:     noHeaders,
+			NoTruncate:    noTruncate,
+			Columns:       columns,
+			CustomColumns: customColumns,
+			GroupBy:       groupBy,
 		},
 	}
 
 	cmdutil.ExitIfError(v.Render())
 }
 
-func sprintExplorerView(flags query.FlagParser, boardID int, project, server string, client *jira.Client) {
+func sprintExplorerView(
+	flags query.FlagParser, boardID int, project, server string, client *jira.Client, order []query.OrderKey,
+) {
 	q, err := query.NewSprint(flags)
 	cmdutil.ExitIfError(err)
 
@@ -196,6 +233,17 @@ func sprintExplorerView(flags query.FlagParser, boardID int, project, server str
 		return
 	}
 
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+
+	if output == view.OutputJSON {
+		cmdutil.ExitIfError(view.RenderJSON(os.Stdout, sprints))
+		return
+	}
+	if output != "" {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
 	plain, err := flags.GetBool("plain")
 	cmdutil.ExitIfError(err)
 
@@ -207,14 +255,18 @@ func sprintExplorerView(flags query.FlagParser, boardID int, project, server str
 
 	v := view.SprintList{
 		Project: project,
-		Board:   viper.GetString("board.name"),
+		Board:   cmdcommon.BoardName(client),
 		Server:  server,
 		Data:    sprints,
 		Issues: func(boardID, sprintID int) []*jira.Issue {
-			resp, err := client.SprintIssues(boardID, sprintID, "", q.Params().Limit)
+			resp, err := api.FetchPages(func(from, limit uint) (*jira.SearchResult, error) {
+				return client.SprintIssuesFrom(boardID, sprintID, "", from, limit)
+			}, q.Params().Limit, nil)
 			if err != nil {
 				return []*jira.Issue{}
 			}
+			// Sprint issues are fetched without a JQL in the explorer, so they are ordered here.
+			cmdcommon.SortIssues(resp.Issues, order)
 			return resp.Issues
 		},
 		Display: view.DisplayFormat{
@@ -245,8 +297,10 @@ func setFlags(cmd *cobra.Command) {
 		`Defaults to "active,closed"`)
 	cmd.Flags().Bool("table", false, "Display sprints in a table view")
 	cmd.Flags().String("columns", "", "Comma separated list of columns to display in the plain mode.\n"+
-		fmt.Sprintf("Accepts (for sprint list): %s", strings.Join(view.ValidSprintColumns(), ", "))+
-		fmt.Sprintf("Accepts (for sprint issues): %s", strings.Join(view.ValidIssueColumns(), ", ")))
+		fmt.Sprintf("Accepts (for sprint list): %s\n", strings.Join(view.ValidSprintColumns(), ", "))+
+		fmt.Sprintf("Accepts (for sprint issues): %s, or the name of a custom field\n",
+			strings.Join(view.ValidIssueColumns(), ", "))+
+		"Columns of sprint issues default to the columns set in the config, eg: columns.sprint")
 	cmd.Flags().Bool("current", false, "List issues in current active sprint")
 	cmd.Flags().Bool("prev", false, "List issues in previous sprint")
 	cmd.Flags().Bool("next", false, "List issues in next planned sprint")
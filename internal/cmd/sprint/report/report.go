@@ -0,0 +1,109 @@
+package report
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Report displays velocity of recently closed sprints in a board.
+
+Committed is the estimate of the sprint at the time it was started and completed is the
+estimate of the issues done by the end of the sprint, as in the velocity chart of Jira.
+Jira reports velocity of up to the last 7 closed sprints.`
+	examples = `$ jira sprint report
+
+# Report velocity of the last 3 sprints in a plain table
+$ jira sprint report --last 3 --plain
+
+# Report velocity of another board in json format
+$ jira sprint report --board 12 -o json`
+
+	defaultLast = 5
+)
+
+// NewCmdReport is a sprint report command.
+func NewCmdReport() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "report",
+		Short:   "Report displays velocity of closed sprints",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"velocity"},
+		Args:    cobra.NoArgs,
+		Run:     report,
+	}
+
+	cmd.Flags().Uint("last", defaultLast, "Number of recently closed sprints to report")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+
+	return &cmd
+}
+
+func report(cmd *cobra.Command, _ []string) {
+	boardID := viper.GetInt("board.id")
+	if boardID == 0 {
+		cmdutil.Failed("No board configured, use --board or set a default board with 'jira init'")
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	last, err := cmd.Flags().GetUint("last")
+	cmdutil.ExitIfError(err)
+	if last == 0 {
+		cmdutil.Failed("--last must be greater than 0")
+	}
+
+	plain, err := cmd.Flags().GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := cmd.Flags().GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	output, err := cmd.Flags().GetString("output")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	velocity, err := func() ([]*jira.SprintVelocity, error) {
+		s := cmdutil.Info("Fetching sprint velocity...")
+		defer s.Stop()
+
+		return client.BoardVelocity(boardID)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if len(velocity) == 0 {
+		fmt.Println()
+		cmdutil.Failed("No closed sprints found in board \"%s\"", cmdcommon.BoardName(client))
+		return
+	}
+	if uint(len(velocity)) > last {
+		velocity = velocity[:last]
+	}
+
+	v := view.NewVelocity(velocity, view.WithVelocityDisplayFormat(view.DisplayFormat{
+		Plain:     plain,
+		NoHeaders: noHeaders,
+	}))
+
+	switch output {
+	case "":
+		cmdutil.ExitIfError(v.Render())
+	case view.OutputJSON:
+		cmdutil.ExitIfError(v.RenderJSON(os.Stdout))
+	default:
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/add"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/burndown"
+	closeCmd "github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/close"
 	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/list"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/report"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/sprint/start"
 )
 
 const helpText = `Sprint manage sprints in a project board. See available commands below.`
@@ -23,7 +27,12 @@ func NewCmdSprint() *cobra.Command {
 	lc := list.NewCmdList()
 	ac := add.NewCmdAdd()
 
-	cmd.AddCommand(lc, ac)
+	cmd.AddCommand(
+		lc, ac, start.NewCmdStart(), closeCmd.NewCmdClose(), report.NewCmdReport(),
+		burndown.NewCmdBurndown(),
+	)
+
+	cmd.PersistentFlags().Int("board", 0, "ID of the board to look into (defaults to the board in the config)")
 
 	list.SetFlags(lc)
 
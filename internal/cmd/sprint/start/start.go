@@ -0,0 +1,160 @@
+package start
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	helpText = `Start starts a future sprint.
+
+If SPRINT_ID is not given, the next planned sprint of the board is started. The sprint
+starts now and runs for --weeks weeks unless --start or --end is given.`
+	examples = `$ jira sprint start
+
+# Start a sprint with the given id
+$ jira sprint start SPRINT_ID
+
+# Start the next planned sprint of another board
+$ jira sprint start --board 12
+
+# Start a sprint with custom dates and a goal
+$ jira sprint start SPRINT_ID --start 2022-01-10 --end 2022-01-21 --goal "Ship the importer"`
+)
+
+// NewCmdStart is a sprint start command.
+func NewCmdStart() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "start [SPRINT_ID]",
+		Short:   "Start a sprint",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			"help:args": "[SPRINT_ID]\tID of the sprint to start, eg: 123",
+		},
+		Run: start,
+	}
+
+	cmd.Flags().String("start", "", "Start date of the sprint in YYYY-MM-DD format (defaults to now)")
+	cmd.Flags().String("end", "", "End date of the sprint in YYYY-MM-DD format")
+	cmd.Flags().Uint("weeks", 2, "Length of the sprint in weeks, ignored if --end is given") //nolint:gomnd
+	cmd.Flags().String("goal", "", "Sprint goal")
+
+	return &cmd
+}
+
+func start(cmd *cobra.Command, args []string) {
+	params := parseFlags(cmd.Flags())
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	sprint, err := func() (*jira.Sprint, error) {
+		s := cmdutil.Info("Fetching sprint details...")
+		defer s.Stop()
+
+		if len(args) == 0 {
+			return cmdcommon.BoardSprint(client, viper.GetInt("board.id"), jira.SprintStateFuture)
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid sprint id %q", args[0])
+		}
+		return client.GetSprint(id)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if sprint.Status != jira.SprintStateFuture {
+		cmdutil.Failed("Sprint #%d \"%s\" is already %s", sprint.ID, sprint.Name, sprint.Status)
+	}
+
+	startDate, endDate, err := sprintDates(params, time.Now())
+	cmdutil.ExitIfError(err)
+
+	sprint, err = func() (*jira.Sprint, error) {
+		s := cmdutil.Info(fmt.Sprintf("Starting sprint \"%s\"...", sprint.Name))
+		defer s.Stop()
+
+		return client.UpdateSprint(sprint.ID, &jira.SprintUpdateRequest{
+			State:     jira.SprintStateActive,
+			StartDate: startDate.Format(time.RFC3339),
+			EndDate:   endDate.Format(time.RFC3339),
+			Goal:      params.goal,
+		})
+	}()
+	cmdutil.ExitIfError(err)
+
+	cmdutil.Success(
+		"Sprint #%d \"%s\" started, ends on %s",
+		sprint.ID, sprint.Name, cmdutil.FormatDateTimeHuman(sprint.EndDate, time.RFC3339),
+	)
+}
+
+func sprintDates(params *startParams, now time.Time) (time.Time, time.Time, error) {
+	startDate := now
+	if params.start != "" {
+		t, err := time.ParseInLocation(dateLayout, params.start, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid start date %q, expected YYYY-MM-DD", params.start)
+		}
+		startDate = t
+	}
+
+	endDate := startDate.AddDate(0, 0, 7*int(params.weeks)) //nolint:gomnd
+	if params.end != "" {
+		t, err := time.ParseInLocation(dateLayout, params.end, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end date %q, expected YYYY-MM-DD", params.end)
+		}
+		endDate = t
+	}
+	if !endDate.After(startDate) {
+		return time.Time{}, time.Time{}, fmt.Errorf("end date must be after the start date")
+	}
+
+	return startDate, endDate, nil
+}
+
+func parseFlags(flags query.FlagParser) *startParams {
+	startDate, err := flags.GetString("start")
+	cmdutil.ExitIfError(err)
+
+	endDate, err := flags.GetString("end")
+	cmdutil.ExitIfError(err)
+
+	weeks, err := flags.GetUint("weeks")
+	cmdutil.ExitIfError(err)
+
+	goal, err := flags.GetString("goal")
+	cmdutil.ExitIfError(err)
+
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	return &startParams{
+		start: startDate,
+		end:   endDate,
+		weeks: weeks,
+		goal:  goal,
+		debug: debug,
+	}
+}
+
+type startParams struct {
+	start string
+	end   string
+	weeks uint
+	goal  string
+	debug bool
+}
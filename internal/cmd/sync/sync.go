@@ -0,0 +1,170 @@
+package sync
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/move"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdcommon"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/queue"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Sync sends the operations queued while Jira was unreachable, in the order they were queued.
+
+Worklogs, comments and transitions are queued by worklog add, comment add and issue move
+if Jira can't be reached, eg: while traveling. Operations rejected by Jira stay in the queue,
+so that you can fix them in Jira and sync again, or drop them with --discard.
+
+Only the operations queued for the server and user in the config are sent. Operations queued
+with another config, eg: in another context, stay in the queue until you sync with that config.`
+	examples = `$ jira sync
+
+# List queued operations
+$ jira sync --list
+
+# Drop all queued operations without sending them
+$ jira sync --discard`
+)
+
+// NewCmdSync is a sync command.
+func NewCmdSync() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "sync",
+		Short:       "Sync sends the operations queued while Jira was unreachable",
+		Long:        helpText,
+		Example:     examples,
+		Annotations: map[string]string{"cmd:main": "true"},
+		Args:        cobra.NoArgs,
+		Run:         syncQueue,
+	}
+
+	cmd.Flags().Bool("list", false, "List queued operations without sending them")
+	cmd.Flags().Bool("discard", false, "Drop all queued operations without sending them")
+
+	return &cmd
+}
+
+func syncQueue(cmd *cobra.Command, _ []string) {
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	list, err := cmd.Flags().GetBool("list")
+	cmdutil.ExitIfError(err)
+
+	discard, err := cmd.Flags().GetBool("discard")
+	cmdutil.ExitIfError(err)
+
+	store, err := cmdcommon.QueueStore()
+	cmdutil.ExitIfError(err)
+
+	ops, err := store.All()
+	cmdutil.ExitIfError(err)
+
+	if len(ops) == 0 {
+		cmdutil.Success("Nothing to sync, the queue is empty")
+		return
+	}
+
+	switch {
+	case list:
+		cmdutil.ExitIfError(printQueue(ops))
+		return
+	case discard:
+		cmdutil.ExitIfError(store.Clear())
+		cmdutil.Success("Discarded %d queued operation(s)", len(ops))
+		return
+	}
+
+	var (
+		server = viper.GetString("server")
+		login  = viper.GetString("login")
+		mine   = make([]*queue.Operation, 0, len(ops))
+	)
+	for _, op := range ops {
+		if op.IsFor(server, login) {
+			mine = append(mine, op)
+		}
+	}
+	if skipped := len(ops) - len(mine); skipped > 0 {
+		cmdutil.Warn(
+			"Skipping %d operation(s) queued for another server or user, use 'jira sync --list' to see them",
+			skipped,
+		)
+	}
+	if len(mine) == 0 {
+		return
+	}
+	ops = mine
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	failed := 0
+	for i, op := range ops {
+		err := func() error {
+			s := cmdutil.Info(fmt.Sprintf("%s...", op))
+			defer s.Stop()
+
+			return replay(client, op)
+		}()
+		if api.IsUnreachable(err) {
+			cmdutil.Failed("Jira is still unreachable, %d operation(s) remain queued", len(ops)-i+failed)
+		}
+		if err != nil {
+			failed++
+			cmdutil.Fail("%s: %s", op, cmdutil.NormalizeJiraError(err.Error()))
+			continue
+		}
+
+		cmdutil.ExitIfError(store.Remove(op.ID))
+		cmdutil.Success("%s", op)
+	}
+
+	if failed > 0 {
+		cmdutil.Failed("%d operation(s) failed and remain queued", failed)
+	}
+}
+
+func replay(client *jira.Client, op *queue.Operation) error {
+	switch op.Kind {
+	case queue.KindWorklog:
+		w := op.Worklog
+		_, err := api.ProxyAddWorklog(
+			client, op.IssueKey, w.Comment, w.Started, w.TimeSpent, w.AdjustEstimate, w.Estimate, w.Visibility, w.Attributes,
+		)
+		return err
+	case queue.KindComment:
+		return client.AddIssueComment(op.IssueKey, op.Comment.Body, op.Comment.Internal)
+	case queue.KindTransition:
+		_, err := move.Replay(client, op.IssueKey, op.Transition)
+		return err
+	}
+	return fmt.Errorf("unknown operation %q", op.Kind)
+}
+
+func printQueue(ops []*queue.Operation) error {
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+
+	fmt.Fprintln(tw, "ID\tQUEUED\tSERVER\tLOGIN\tOPERATION")
+	for _, op := range ops {
+		fmt.Fprintf(
+			tw, "%d\t%s\t%s\t%s\t%s\n",
+			op.ID, op.Queued.Format("2006-01-02 15:04"), orNone(op.Server), orNone(op.Login), op,
+		)
+	}
+	return tw.Flush()
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,142 @@
+package search
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	defaultLimit = 50
+
+	helpText = `Search finds users by their name or email, or by their username in Jira server.
+
+The ID column is the account ID of the user in Jira cloud and the username in Jira server,
+which is what commands like 'jira issue assign' expect. Pass --assignable to only find the
+users that can be assigned issues of the project.`
+	examples = `$ jira user search "ann"
+
+# Find users that can be assigned issues of the FOO project
+$ jira user search "ann" --assignable --project FOO
+
+# Get account ID of a user in a script
+$ jira user search "ann@example.com" -o json | jq -r '.[0].accountId'`
+)
+
+// NewCmdSearch is a user search command.
+func NewCmdSearch() *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "search QUERY",
+		Short:   "Search finds users by their name or email",
+		Long:    helpText,
+		Example: examples,
+		Aliases: []string{"find"},
+		Annotations: map[string]string{
+			"help:args": "QUERY\tName, email or username of the user, or the start of it",
+		},
+		Args: cobra.ExactArgs(1),
+		Run:  search,
+	}
+
+	cmd.Flags().Bool("assignable", false, "Only find users that can be assigned issues of the project")
+	cmd.Flags().Uint("limit", defaultLimit, "Maximum number of users to return")
+	cmd.Flags().Bool("plain", false, "Display output in plain mode")
+	cmd.Flags().Bool("no-headers", false, "Don't display table headers in plain mode. Works only with --plain")
+
+	return &cmd
+}
+
+func search(cmd *cobra.Command, args []string) {
+	params := parseArgsAndFlags(cmd.Flags(), args)
+	client := api.Client(jira.Config{Debug: params.debug})
+
+	users, err := func() ([]*jira.User, error) {
+		s := cmdutil.Info(fmt.Sprintf("Searching users matching %q...", params.query))
+		defer s.Stop()
+
+		if params.assignable {
+			return api.ProxyUserSearch(client, &jira.UserSearchOptions{
+				Query:      params.query,
+				Project:    params.project,
+				MaxResults: params.limit,
+			})
+		}
+		return api.ProxyFindUsers(client, params.query, params.limit)
+	}()
+	cmdutil.ExitIfError(err)
+
+	if params.output == view.OutputJSON {
+		cmdutil.ExitIfError(view.NewUser(users).RenderJSON(os.Stdout))
+		return
+	}
+
+	if len(users) == 0 {
+		cmdutil.FailedWithCode(cmdutil.ExitCodeNotFound, "No users found matching %q", params.query)
+		return
+	}
+
+	v := view.NewUser(users, view.WithUserDisplayFormat(view.DisplayFormat{
+		Plain:     params.plain,
+		NoHeaders: params.noHeaders,
+	}))
+
+	cmdutil.ExitIfError(v.Render())
+}
+
+type searchParams struct {
+	query      string
+	project    string
+	assignable bool
+	limit      int
+	plain      bool
+	noHeaders  bool
+	output     string
+	debug      bool
+}
+
+func parseArgsAndFlags(flags query.FlagParser, args []string) *searchParams {
+	debug, err := flags.GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	assignable, err := flags.GetBool("assignable")
+	cmdutil.ExitIfError(err)
+
+	project := viper.GetString("project.key")
+	if assignable && project == "" {
+		cmdutil.Failed("Project is required with --assignable, use --project or set a default project with 'jira init'")
+	}
+
+	limit, err := flags.GetUint("limit")
+	cmdutil.ExitIfError(err)
+
+	plain, err := flags.GetBool("plain")
+	cmdutil.ExitIfError(err)
+
+	noHeaders, err := flags.GetBool("no-headers")
+	cmdutil.ExitIfError(err)
+
+	output, err := flags.GetString("output")
+	cmdutil.ExitIfError(err)
+	if output != "" && output != view.OutputJSON {
+		cmdutil.Failed("Invalid output format %q", output)
+	}
+
+	return &searchParams{
+		query:      args[0],
+		project:    project,
+		assignable: assignable,
+		limit:      int(limit),
+		plain:      plain,
+		noHeaders:  noHeaders,
+		output:     output,
+		debug:      debug,
+	}
+}
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/user/search"
+)
+
+const helpText = `User helps you find Jira users. See available commands below.`
+
+// NewCmdUser is a user command.
+func NewCmdUser() *cobra.Command {
+	cmd := cobra.Command{
+		Use:         "user",
+		Short:       "User helps you find Jira users",
+		Long:        helpText,
+		Aliases:     []string{"users"},
+		Annotations: map[string]string{"cmd:main": "true"},
+		RunE:        user,
+	}
+
+	cmd.AddCommand(search.NewCmdSearch())
+
+	return &cmd
+}
+
+func user(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
@@ -0,0 +1,106 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// unassign is the input of the assign action to unassign issues.
+const unassign = "x"
+
+// IssueActions returns the actions that can be applied at once to the issues marked in the
+// interactive issue list, ie: transition, label, assign and add to sprint.
+func IssueActions(client *jira.Client, project string) []view.IssueAction {
+	return []view.IssueAction{
+		{
+			Name:   "Transition",
+			Prompt: "Transition to status",
+			Run: func(key, status string) error {
+				return api.TransitionTo(client, key, func(s *jira.Status) bool {
+					return strings.EqualFold(s.Name, status)
+				})
+			},
+		},
+		{
+			Name:   "Label",
+			Prompt: "Add labels (comma separated)",
+			Run: func(key, input string) error {
+				return addLabels(client, key, strings.Split(input, ","))
+			},
+		},
+		{
+			Name:   "Assign",
+			Prompt: fmt.Sprintf("Assign to user (%q for yourself, %q to unassign)", UserMe, unassign),
+			Run:    assignAction(client, project),
+		},
+		{
+			Name:   "Sprint",
+			Prompt: "Add to sprint ID",
+			Run: func(key, input string) error {
+				if _, err := strconv.Atoi(input); err != nil {
+					return fmt.Errorf("invalid sprint id %q", input)
+				}
+				return client.SprintIssuesAdd(input, key)
+			},
+		},
+	}
+}
+
+// addLabels adds the labels to the issue, keeping the labels it already has.
+func addLabels(client *jira.Client, key string, labels []string) error {
+	iss, err := api.ProxyGetIssue(client, key)
+	if err != nil {
+		return err
+	}
+
+	out := iss.Fields.Labels
+	for _, l := range labels {
+		l = strings.TrimSpace(l)
+		if l == "" || containsLabel(out, l) {
+			continue
+		}
+		out = append(out, l)
+	}
+	if len(out) == len(iss.Fields.Labels) {
+		return nil
+	}
+
+	return client.Edit(key, &jira.EditRequest{Labels: out})
+}
+
+func containsLabel(labels []string, label string) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// assignAction returns an action that assigns issues to the user. The user is
+// resolved once for all issues the action is applied to with the same input.
+func assignAction(client *jira.Client, project string) func(key, input string) error {
+	var (
+		resolved string
+		user     *jira.User
+	)
+
+	return func(key, input string) error {
+		if strings.EqualFold(input, unassign) {
+			return api.ProxyAssignIssue(client, key, nil, jira.AssigneeNone)
+		}
+		if user == nil || resolved != input {
+			u, err := ResolveUser(client, project, input)
+			if err != nil {
+				return err
+			}
+			user, resolved = u, input
+		}
+		return api.ProxyAssignIssue(client, key, user, jira.AssigneeDefault)
+	}
+}
@@ -0,0 +1,66 @@
+package cmdcommon
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestAddLabels(t *testing.T) {
+	var edited []interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			assert.Equal(t, "/rest/api/3/issue/TEST-1", r.URL.Path)
+			_, _ = w.Write([]byte(`{"key": "TEST-1", "fields": {"labels": ["backend", "api"]}}`))
+		case http.MethodPut:
+			assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+
+			body, _ := io.ReadAll(r.Body)
+
+			var req struct {
+				Update struct {
+					Labels []struct {
+						Set []interface{} `json:"set"`
+					} `json:"labels"`
+				} `json:"update"`
+			}
+			assert.NoError(t, json.Unmarshal(body, &req))
+			edited = req.Update.Labels[0].Set
+
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	assert.NoError(t, addLabels(client, "TEST-1", []string{"api", " triage ", ""}))
+	assert.Equal(t, []interface{}{"backend", "api", "triage"}, edited)
+
+	edited = nil
+	assert.NoError(t, addLabels(client, "TEST-1", []string{"backend"}))
+	assert.Nil(t, edited)
+}
+
+func TestIssueActions(t *testing.T) {
+	actions := IssueActions(nil, "TEST")
+
+	names := make([]string, 0, len(actions))
+	for _, a := range actions {
+		names = append(names, a.Name)
+	}
+	assert.Equal(t, []string{"Transition", "Label", "Assign", "Sprint"}, names)
+
+	err := actions[3].Run("TEST-1", "next")
+	assert.Error(t, err)
+	assert.Equal(t, `invalid sprint id "next"`, err.Error())
+}
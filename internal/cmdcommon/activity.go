@@ -0,0 +1,69 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const activityPageSize = 100
+
+// IssueActivity is an issue with its changelog, latest comments and worklogs.
+type IssueActivity struct {
+	Issue     *jira.Issue
+	Histories []*jira.ChangelogHistory
+	Comments  []*jira.Comment
+	Worklogs  []*jira.Worklog
+}
+
+// UpdatedSinceJQL restricts the query to the issues updated since the given time,
+// ordered by the updated time in the given order, eg: ASC.
+func UpdatedSinceJQL(jql string, since time.Time, order string) string {
+	// Relative dates don't depend on the timezone of the user in Jira. A minute is added as
+	// updated times are compared in minutes, older changes are filtered out by the callers.
+	minutes := int(math.Ceil(time.Since(since).Minutes())) + 1
+
+	return fmt.Sprintf("(%s) AND updated >= -%dm ORDER BY updated %s", jql, minutes, order)
+}
+
+// FetchIssueActivity fetches the changelog and the latest comments of the issues, and their
+// worklogs if withWorklogs is set, with at most DefaultConcurrency issues fetched at once.
+// Activities are in the same order as the issues, the first error in that order is returned.
+func FetchIssueActivity(
+	c *jira.Client, issues []*jira.Issue, maxComments int, withWorklogs bool,
+) ([]*IssueActivity, error) {
+	out := make([]*IssueActivity, len(issues))
+
+	errs := RunConcurrently(len(issues), DefaultConcurrency, func(i int) error {
+		a := IssueActivity{Issue: issues[i]}
+
+		var err error
+		if a.Histories, err = api.ProxyChangelog(c, a.Issue.Key, activityPageSize); err != nil {
+			return err
+		}
+		comments, err := c.GetIssueComments(a.Issue.Key, 0, maxComments, jira.CommentOrderCreatedDesc)
+		if err != nil {
+			return err
+		}
+		a.Comments = comments.Comments
+
+		if withWorklogs {
+			if a.Worklogs, err = api.ProxyWorklogs(c, a.Issue.Key, activityPageSize); err != nil {
+				return err
+			}
+		}
+
+		out[i] = &a
+		return nil
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
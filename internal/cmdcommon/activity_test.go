@@ -0,0 +1,20 @@
+package cmdcommon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdatedSinceJQL(t *testing.T) {
+	t.Parallel()
+
+	since := time.Now().Add(-90*time.Minute - 30*time.Second)
+
+	assert.Equal(
+		t,
+		`(project = "TEST") AND updated >= -92m ORDER BY updated DESC`,
+		UpdatedSinceJQL(`project = "TEST"`, since, "DESC"),
+	)
+}
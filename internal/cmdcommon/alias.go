@@ -0,0 +1,20 @@
+package cmdcommon
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// IsReservedName tells if the name is taken by a command, so it can't be used as an alias.
+func IsReservedName(cmd *cobra.Command, name string) bool {
+	if name == "help" || strings.HasPrefix(name, "__") {
+		return true
+	}
+	for _, c := range cmd.Root().Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/view"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// IssueColumns returns the columns of the issue list of a command, eg: issue, epic, sprint
+// or backlog. Columns passed via the --columns flag take precedence over the columns set
+// for the command in the config, eg:
+//
+//	columns:
+//	  issue: key,summary,status,story points
+//	  backlog: [key, summary, sprint]
+//
+// Columns other than the standard ones are looked up in the fields of the server by name
+// or id. They are returned as a map of the column in upper case to the custom field id.
+func IssueColumns(c *jira.Client, command, flag string) ([]string, map[string]string, error) {
+	columns := splitColumns(flag)
+	if len(columns) == 0 {
+		columns = configColumns(command)
+	}
+
+	standard := make(map[string]struct{})
+	for _, col := range view.ValidIssueColumns() {
+		standard[col] = struct{}{}
+	}
+
+	var unknown []string
+	for _, col := range columns {
+		if _, ok := standard[strings.ToUpper(col)]; !ok {
+			unknown = append(unknown, col)
+		}
+	}
+	if len(unknown) == 0 {
+		return columns, nil, nil
+	}
+
+	fields, err := c.Fields()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	custom := make(map[string]string, len(unknown))
+	for _, col := range unknown {
+		f := findField(fields, col)
+		if f == nil {
+			return nil, nil, fmt.Errorf("unknown column %q, use one of %s or a field name", col,
+				strings.ToLower(strings.Join(view.ValidIssueColumns(), ", ")))
+		}
+		custom[strings.ToUpper(col)] = f.ID
+	}
+
+	return columns, custom, nil
+}
+
+func configColumns(command string) []string {
+	switch v := viper.Get("columns." + command).(type) {
+	case string:
+		return splitColumns(v)
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, c := range v {
+			if s := strings.TrimSpace(fmt.Sprint(c)); s != "" {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func splitColumns(columns string) []string {
+	var out []string
+	for _, c := range strings.Split(columns, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func findField(fields []*jira.Field, name string) *jira.Field {
+	for _, f := range fields {
+		if f.ID == name || strings.EqualFold(f.Name, name) {
+			return f
+		}
+	}
+	return nil
+}
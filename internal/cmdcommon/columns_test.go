@@ -0,0 +1,71 @@
+package cmdcommon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestIssueColumns(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/field", r.URL.Path)
+		requests++
+
+		_, _ = w.Write([]byte(`[
+			{"id": "summary", "name": "Summary", "custom": false},
+			{"id": "customfield_10001", "name": "Story Points", "custom": true, "schema": {"type": "number"}}
+		]`))
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	columns, custom, err := IssueColumns(client, "issue", "key, status")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key", "status"}, columns)
+	assert.Nil(t, custom)
+	assert.Equal(t, 0, requests)
+
+	columns, custom, err = IssueColumns(client, "issue", "key,story points,customfield_10001")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key", "story points", "customfield_10001"}, columns)
+	assert.Equal(t, map[string]string{
+		"STORY POINTS":      "customfield_10001",
+		"CUSTOMFIELD_10001": "customfield_10001",
+	}, custom)
+
+	_, _, err = IssueColumns(client, "issue", "key,velocity")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown column "velocity"`)
+}
+
+func TestIssueColumnsFromConfig(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set("columns.issue", "key,summary")
+	viper.Set("columns.backlog", []interface{}{"key", "priority"})
+
+	columns, _, err := IssueColumns(nil, "issue", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key", "summary"}, columns)
+
+	columns, _, err = IssueColumns(nil, "backlog", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key", "priority"}, columns)
+
+	columns, _, err = IssueColumns(nil, "backlog", "status")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"status"}, columns)
+
+	columns, _, err = IssueColumns(nil, "epic", "")
+	assert.NoError(t, err)
+	assert.Empty(t, columns)
+}
@@ -0,0 +1,77 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	commentPageSize   = 100
+	commentSnippetLen = 50
+)
+
+// SelectComment prompts user to select a comment from the given list.
+func SelectComment(comments []*jira.Comment) (*jira.Comment, error) {
+	if len(comments) == 0 {
+		return nil, fmt.Errorf("no comments to select from")
+	}
+
+	options := make([]string, 0, len(comments))
+	for _, c := range comments {
+		options = append(options, commentOption(c))
+	}
+
+	var ans int
+
+	qs := &survey.Question{
+		Name: "comment",
+		Prompt: &survey.Select{
+			Message: "Comment:",
+			Options: options,
+		},
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return nil, err
+	}
+	return comments[ans], nil
+}
+
+// commentOption is a label of the comment with the first line of its body.
+func commentOption(c *jira.Comment) string {
+	body := strings.TrimSpace(c.Body)
+	if i := strings.IndexByte(body, '\n'); i >= 0 {
+		body = strings.TrimSpace(body[:i]) + "..."
+	}
+	if r := []rune(body); len(r) > commentSnippetLen {
+		body = string(r[:commentSnippetLen-3]) + "..."
+	}
+	return fmt.Sprintf(
+		"%s: %s by %s: %s",
+		c.ID, cmdutil.FormatDateTimeHuman(c.Created, jira.RFC3339), c.Author.Name, body,
+	)
+}
+
+// MyComments returns comments of the issue authored by the current user.
+func MyComments(c *jira.Client, key string) ([]*jira.Comment, error) {
+	me, err := c.Me()
+	if err != nil {
+		return nil, err
+	}
+	all, err := c.GetAllIssueComments(key, commentPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var mine []*jira.Comment
+	for _, cm := range all {
+		if cm.Author.AccountID == me.AccountID && cm.Author.Login == me.Login {
+			mine = append(mine, cm)
+		}
+	}
+	return mine, nil
+}
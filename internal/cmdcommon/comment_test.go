@@ -0,0 +1,46 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestCommentOption(t *testing.T) {
+	cases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "it uses the body as is",
+			body:     "Short comment",
+			expected: "10001: Tue, 23 Nov 21 by Person A: Short comment",
+		},
+		{
+			name:     "it uses the first line of the body",
+			body:     "First line\nSecond line",
+			expected: "10001: Tue, 23 Nov 21 by Person A: First line...",
+		},
+		{
+			name:     "it shortens long body",
+			body:     "This comment is definitely longer than fifty characters in total",
+			expected: "10001: Tue, 23 Nov 21 by Person A: This comment is definitely longer than fifty ch...",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			c := &jira.Comment{
+				ID:      "10001",
+				Author:  jira.User{Name: "Person A"},
+				Body:    tc.body,
+				Created: "2021-11-23T10:00:00.000+0100",
+			}
+			assert.Equal(t, tc.expected, commentOption(c))
+		})
+	}
+}
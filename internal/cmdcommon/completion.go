@@ -0,0 +1,133 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cache"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	// completionTTL is the duration completions fetched from the server are cached for.
+	// It is kept short as issues and their transitions change often.
+	completionTTL = 5 * time.Minute
+
+	completionLimit = 50
+
+	// recentIssuesJQL finds issues assigned to the user and issues the user viewed recently.
+	recentIssuesJQL = "assignee = currentUser() OR issuekey IN issueHistory() ORDER BY updated DESC"
+)
+
+// CompleteIssueKeys completes the issue key argument with the issues assigned to
+// the user and the issues the user viewed recently.
+func CompleteIssueKeys(_ *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	keys, err := cachedCompletions("issues", func() ([]string, error) {
+		res, err := api.ProxySearch(api.Client(jira.Config{}), recentIssuesJQL, completionLimit)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(res.Issues))
+		for _, iss := range res.Issues {
+			out = append(out, fmt.Sprintf("%s\t%s", iss.Key, iss.Fields.Summary))
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return filterCompletions(keys, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// CompleteIssueKeyAndTransition completes the issue key argument like CompleteIssueKeys,
+// and the state argument with the transitions available for the issue.
+func CompleteIssueKeyAndTransition(
+	cmd *cobra.Command, args []string, toComplete string,
+) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 1 {
+		return CompleteIssueKeys(cmd, args, toComplete)
+	}
+
+	key := cmdutil.GetJiraIssueKey(viper.GetString("project.key"), args[0])
+
+	names, err := cachedCompletions("transitions:"+key, func() ([]string, error) {
+		trs, err := api.ProxyTransitions(api.Client(jira.Config{}), key)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(trs))
+		for _, t := range trs {
+			if t.To != nil && t.To.Name != "" {
+				out = append(out, fmt.Sprintf("%s\tto %s", t.Name, t.To.Name))
+			} else {
+				out = append(out, t.Name)
+			}
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return filterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// CompleteProjects completes the project flag with the keys of the projects.
+func CompleteProjects(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	projects, err := api.Client(jira.Config{}).Project()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	out := make([]string, 0, len(projects))
+	for _, p := range projects {
+		out = append(out, fmt.Sprintf("%s\t%s", p.Key, p.Name))
+	}
+	return filterCompletions(out, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// cachedCompletions returns the completions cached under the key for the server and the
+// user, or fetches and caches them. Projects are not cached here as their responses are.
+func cachedCompletions(key string, fetch func() ([]string, error)) ([]string, error) {
+	key = fmt.Sprintf("completion:%s:%s:%s", viper.GetString("server"), viper.GetString("login"), key)
+
+	dir, err := jiraConfig.CacheDir()
+	if err != nil {
+		return fetch()
+	}
+
+	var out []string
+	if cache.Get(dir, key, completionTTL, &out) {
+		return out, nil
+	}
+
+	out, err = fetch()
+	if err != nil {
+		return nil, err
+	}
+	_ = cache.Set(dir, key, out)
+
+	return out, nil
+}
+
+// filterCompletions returns the completions, in value<TAB>description format,
+// whose values start with the text being completed, ignoring the case.
+func filterCompletions(all []string, toComplete string) []string {
+	out := make([]string, 0, len(all))
+	for _, c := range all {
+		if strings.HasPrefix(strings.ToLower(c), strings.ToLower(toComplete)) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
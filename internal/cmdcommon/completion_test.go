@@ -0,0 +1,18 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterCompletions(t *testing.T) {
+	t.Parallel()
+
+	all := []string{"TEST-1\tFix login", "TEST-12\tAdd logout", "OTHER-1\tUpdate docs"}
+
+	assert.Equal(t, all, filterCompletions(all, ""))
+	assert.Equal(t, []string{"TEST-1\tFix login", "TEST-12\tAdd logout"}, filterCompletions(all, "test-1"))
+	assert.Equal(t, []string{"TEST-12\tAdd logout"}, filterCompletions(all, "TEST-12"))
+	assert.Empty(t, filterCompletions(all, "NONE"))
+}
@@ -0,0 +1,77 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// ComponentAssigneeTypes are the default assignee options of a component accepted by the commands.
+var ComponentAssigneeTypes = []string{"project-default", "component-lead", "project-lead", "unassigned"}
+
+// FindComponent finds a component of the project by its id or name.
+func FindComponent(c *jira.Client, project, nameOrID string) (*jira.Component, error) {
+	components, err := c.ProjectComponents(project)
+	if err != nil {
+		return nil, err
+	}
+	if cmp := findComponent(components, nameOrID); cmp != nil {
+		return cmp, nil
+	}
+	return nil, fmt.Errorf("component %q not found in project %s", nameOrID, project)
+}
+
+// ComponentAssigneeType converts a default assignee option, eg: component-lead,
+// to the assignee type of the component, eg: COMPONENT_LEAD.
+func ComponentAssigneeType(val string) (string, error) {
+	if val == "" {
+		return "", nil
+	}
+	for _, t := range ComponentAssigneeTypes {
+		if strings.EqualFold(t, val) {
+			return strings.ToUpper(strings.ReplaceAll(t, "-", "_")), nil
+		}
+	}
+	return "", fmt.Errorf(
+		"invalid default assignee %q, accepts: %s", val, strings.Join(ComponentAssigneeTypes, ", "),
+	)
+}
+
+// SetComponentLead resolves the user and sets it as the lead of the component request,
+// identifying the user based on the installation type.
+func SetComponentLead(c *jira.Client, project, lead string, req *jira.ComponentRequest) error {
+	if lead == "" {
+		return nil
+	}
+
+	u, err := ResolveUser(c, project, lead)
+	if err != nil {
+		return err
+	}
+
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		req.LeadUserName = u.Login
+	} else {
+		req.LeadAccountID = u.AccountID
+	}
+	return nil
+}
+
+func findComponent(components []*jira.Component, nameOrID string) *jira.Component {
+	nameOrID = strings.TrimSpace(nameOrID)
+
+	for _, cmp := range components {
+		if cmp.ID == nameOrID {
+			return cmp
+		}
+	}
+	for _, cmp := range components {
+		if strings.EqualFold(cmp.Name, nameOrID) {
+			return cmp
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestFindComponent(t *testing.T) {
+	components := []*jira.Component{
+		{ID: "10000", Name: "Backend"},
+		{ID: "10001", Name: "10000"},
+		{ID: "10002", Name: "Web UI"},
+	}
+
+	assert.Equal(t, components[0], findComponent(components, "10000"))
+	assert.Equal(t, components[2], findComponent(components, " web ui"))
+	assert.Equal(t, components[1], findComponent(components, "10001"))
+	assert.Nil(t, findComponent(components, "Mobile"))
+}
+
+func TestComponentAssigneeType(t *testing.T) {
+	cases := map[string]string{
+		"":                "",
+		"project-default": jira.ComponentAssigneeProjectDefault,
+		"Component-Lead":  jira.ComponentAssigneeComponentLead,
+		"project-lead":    jira.ComponentAssigneeProjectLead,
+		"unassigned":      jira.ComponentAssigneeUnassigned,
+	}
+	for val, expected := range cases {
+		actual, err := ComponentAssigneeType(val)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+
+	_, err := ComponentAssigneeType("lead")
+	assert.EqualError(t, err, "invalid default assignee \"lead\", accepts: project-default, component-lead, project-lead, unassigned")
+}
@@ -0,0 +1,84 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/spf13/pflag"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// DefaultConcurrency is the number of requests sent at once by commands that send a request per issue.
+const DefaultConcurrency = 5
+
+// AddConcurrencyFlag adds the concurrency flag to commands that send a request per issue.
+func AddConcurrencyFlag(flags *pflag.FlagSet, usage string) {
+	flags.Uint("concurrency", DefaultConcurrency, usage)
+}
+
+// GetConcurrency returns the value of the concurrency flag. It fails if the value is 0.
+func GetConcurrency(flags query.FlagParser) int {
+	concurrency, err := flags.GetUint("concurrency")
+	cmdutil.ExitIfError(err)
+
+	if concurrency == 0 {
+		cmdutil.Failed("--concurrency must be greater than 0")
+	}
+	return int(concurrency)
+}
+
+// RunConcurrently calls fn for each of the n items with at most concurrency calls at once.
+// Errors are returned in the order of the items, nil for the items fn succeeded for.
+func RunConcurrently(n, concurrency int, fn func(i int) error) []error {
+	var (
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, concurrency)
+		errs = make([]error, n)
+	)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// UpdateIssues calls fn for each issue with at most concurrency calls at once. It returns
+// the number of issues updated and an error listing the issues that failed, if any.
+func UpdateIssues(keys []string, concurrency int, fn func(key string) error) (int, error) {
+	errs := RunConcurrently(len(keys), concurrency, func(i int) error {
+		return fn(keys[i])
+	})
+
+	var (
+		failed strings.Builder
+		passed int
+	)
+	for i, err := range errs {
+		if err != nil {
+			failed.WriteString(fmt.Sprintf("\n  - %s: %s", keys[i], cmdutil.NormalizeJiraError(err.Error())))
+			continue
+		}
+		passed++
+	}
+
+	if failed.Len() > 0 {
+		return passed, &jira.ErrMultipleFailed{Msg: failed.String()}
+	}
+	return passed, nil
+}
@@ -0,0 +1,69 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		running int
+		peak    int
+	)
+
+	errs := RunConcurrently(10, 3, func(i int) error {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		if i%4 == 0 {
+			return fmt.Errorf("failed %d", i)
+		}
+		return nil
+	})
+
+	assert.Len(t, errs, 10)
+	assert.LessOrEqual(t, peak, 3)
+	for i, err := range errs {
+		if i%4 == 0 {
+			assert.EqualError(t, err, fmt.Sprintf("failed %d", i))
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestUpdateIssues(t *testing.T) {
+	t.Parallel()
+
+	passed, err := UpdateIssues([]string{"TEST-1", "TEST-2", "TEST-3"}, 2, func(key string) error {
+		if key == "TEST-2" {
+			return fmt.Errorf("not allowed")
+		}
+		return nil
+	})
+
+	assert.Equal(t, 2, passed)
+	assert.EqualError(t, err, "\n  - TEST-2: not allowed")
+
+	passed, err = UpdateIssues([]string{"TEST-1"}, 1, func(string) error { return nil })
+
+	assert.Equal(t, 1, passed)
+	assert.NoError(t, err)
+}
@@ -12,6 +12,10 @@ const (
 	ActionCancel = "Cancel"
 	// ActionMetadata is an add metadata action.
 	ActionMetadata = "Add metadata"
+	// MetadataAssignee is the metadata to set the assignee.
+	MetadataAssignee = "Assignee"
+	// MetadataReporter is the metadata to set the reporter.
+	MetadataReporter = "Reporter"
 )
 
 // SetCreateFlags sets flags supported by create command.
@@ -32,6 +36,7 @@ And, this field is mandatory when creating a sub-task.`)
 	cmd.Flags().StringArrayP("label", "l", []string{}, prefix+" labels")
 	cmd.Flags().StringArrayP("component", "C", []string{}, prefix+" components")
 	cmd.Flags().StringArray("fix-version", []string{}, "Release info (fixVersions)")
+	cmd.Flags().StringArray("affects-version", []string{}, "Affected versions (versions)")
 	cmd.Flags().StringP("template", "T", "", "Path to a file to read body/description from")
 	cmd.Flags().Bool("web", false, "Open in web browser after successful creation")
 	cmd.Flags().Bool("no-input", false, "Disable prompt for non-required fields")
@@ -60,13 +65,16 @@ func GetMetadata() []*survey.Question {
 			Name: "metadata",
 			Prompt: &survey.MultiSelect{
 				Message: "What would you like to add?",
-				Options: []string{"Priority", "Components", "Labels", "FixVersions"},
+				Options: []string{
+					"Priority", MetadataAssignee, MetadataReporter, "Components", "Labels", "FixVersions",
+				},
 			},
 		},
 	}
 }
 
-// GetMetadataQuestions prepares metadata question to input from user.
+// GetMetadataQuestions prepares metadata question to input from user. Assignee and
+// reporter are asked separately with AskUser, as they are looked up while typing.
 func GetMetadataQuestions(cat []string) []*survey.Question {
 	var qs []*survey.Question
 
@@ -0,0 +1,54 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// GetCustomFields resolves custom fields in name=value format to field ids and values in the
+// shape the fields expect, using create metadata of the issue type in the given project.
+func GetCustomFields(c *jira.Client, project, issueType string, custom []string) (map[string]interface{}, error) {
+	if len(custom) == 0 {
+		return nil, nil
+	}
+
+	meta, err := c.GetCreateMeta(&jira.CreateMetaRequest{
+		Projects:       project,
+		IssueTypeNames: url.QueryEscape(issueType),
+		Expand:         "projects.issuetypes.fields",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(meta.Projects) == 0 || len(meta.Projects[0].IssueTypes) == 0 {
+		return nil, fmt.Errorf("unable to fetch fields of issue type %q in project %q", issueType, project)
+	}
+	it := meta.Projects[0].IssueTypes[0]
+
+	installation := viper.GetString("installation")
+	fields := make(map[string]interface{}, len(custom))
+
+	for _, cf := range custom {
+		pieces := strings.SplitN(cf, "=", 2) //nolint:gomnd
+		if len(pieces) != 2 || strings.TrimSpace(pieces[0]) == "" {
+			return nil, fmt.Errorf("invalid custom field %q, expected name=value", cf)
+		}
+
+		field, err := it.FindField(strings.TrimSpace(pieces[0]))
+		if err != nil {
+			return nil, err
+		}
+		val, err := field.Value(strings.TrimSpace(pieces[1]), installation)
+		if err != nil {
+			return nil, err
+		}
+		fields[field.ID] = val
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,54 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const filterSearchMaxResults = 50
+
+var orderByRegex = regexp.MustCompile(`(?i)\s*\border\s+by\b.*$`)
+
+// FindSavedFilter finds a saved filter by its id or name. Names are matched case-insensitively
+// against the favourite filters of the user first and then against all filters visible to the
+// user. Filters that are not favourite can only be found by name in the cloud.
+func FindSavedFilter(c *jira.Client, idOrName string) (*jira.SavedFilter, error) {
+	idOrName = strings.TrimSpace(idOrName)
+	if _, err := strconv.Atoi(idOrName); err == nil {
+		return c.GetSavedFilter(idOrName)
+	}
+
+	favourites, err := c.FavouriteFilters()
+	if err != nil {
+		return nil, err
+	}
+	if f := findSavedFilter(favourites, idOrName); f != nil {
+		return f, nil
+	}
+
+	if res, err := c.SearchSavedFilters(idOrName, filterSearchMaxResults); err == nil {
+		if f := findSavedFilter(res.Values, idOrName); f != nil {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("filter %q not found", idOrName)
+}
+
+// SavedFilterJQL returns the JQL of the saved filter without the ORDER BY clause,
+// so that it can be combined with other conditions.
+func SavedFilterJQL(f *jira.SavedFilter) string {
+	return strings.TrimSpace(orderByRegex.ReplaceAllString(f.JQL, ""))
+}
+
+func findSavedFilter(filters []*jira.SavedFilter, name string) *jira.SavedFilter {
+	for _, f := range filters {
+		if strings.EqualFold(f.Name, name) {
+			return f
+		}
+	}
+	return nil
+}
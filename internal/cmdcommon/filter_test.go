@@ -0,0 +1,63 @@
+package cmdcommon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestFindSavedFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/filter/10042":
+			_, _ = w.Write([]byte(`{"id": "10042", "name": "My open bugs", "jql": "type = Bug"}`))
+		case "/rest/api/2/filter/favourite":
+			_, _ = w.Write([]byte(`[{"id": "10042", "name": "My open bugs", "jql": "type = Bug"}]`))
+		case "/rest/api/2/filter/search":
+			_, _ = w.Write([]byte(`{"values": [
+				{"id": "10050", "name": "Team bugs (old)", "jql": "type = Bug"},
+				{"id": "10051", "name": "Team bugs", "jql": "type = Bug AND labels = team"}
+			]}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	f, err := FindSavedFilter(client, "10042")
+	assert.NoError(t, err)
+	assert.Equal(t, "My open bugs", f.Name)
+
+	f, err = FindSavedFilter(client, "my open BUGS")
+	assert.NoError(t, err)
+	assert.Equal(t, "10042", f.ID)
+
+	f, err = FindSavedFilter(client, "Team bugs")
+	assert.NoError(t, err)
+	assert.Equal(t, "10051", f.ID)
+
+	_, err = FindSavedFilter(client, "Unknown")
+	assert.EqualError(t, err, "filter \"Unknown\" not found")
+
+	_, err = FindSavedFilter(client, "10099")
+	assert.Error(t, err)
+}
+
+func TestSavedFilterJQL(t *testing.T) {
+	cases := map[string]string{
+		"project = TEST ORDER BY Rank ASC":                "project = TEST",
+		"type = Bug AND status = Open order  by priority": "type = Bug AND status = Open",
+		"ORDER BY created DESC":                           "",
+		"summary ~ border":                                "summary ~ border",
+	}
+	for jql, expected := range cases {
+		assert.Equal(t, expected, SavedFilterJQL(&jira.SavedFilter{JQL: jql}))
+	}
+}
@@ -0,0 +1,51 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// IssueGroupByFields are the fields issues can be grouped by in list commands.
+var IssueGroupByFields = []string{"status", "assignee", "epic"}
+
+// IssueGroupBy returns a func that returns the group of an issue for the given field,
+// ie: status, assignee or epic. It returns nil if the field is empty.
+func IssueGroupBy(field string) (func(*jira.Issue) string, error) {
+	switch field {
+	case "":
+		return nil, nil
+	case "status":
+		return func(iss *jira.Issue) string {
+			return iss.Fields.Status.Name
+		}, nil
+	case "assignee":
+		return func(iss *jira.Issue) string {
+			if iss.Fields.Assignee.Name == "" {
+				return "Unassigned"
+			}
+			return iss.Fields.Assignee.Name
+		}, nil
+	case "epic":
+		return issueEpic, nil
+	}
+	return nil, fmt.Errorf("invalid group-by %q, use one of %s", field, strings.Join(IssueGroupByFields, ", "))
+}
+
+// issueEpic returns the epic of an issue. Issues are linked to epics with the parent field
+// in team-managed projects and in recent Jira cloud versions, and with the epic link field
+// in company-managed projects. Parents of subtasks are not epics.
+func issueEpic(iss *jira.Issue) string {
+	if p := iss.Fields.Parent; p != nil && !iss.Fields.IssueType.Subtask {
+		return fmt.Sprintf("%s %s", p.Key, p.Fields.Summary)
+	}
+	if link := viper.GetString("epic.link"); link != "" {
+		if key, ok := iss.Fields.CustomFields[link].(string); ok && key != "" {
+			return key
+		}
+	}
+	return "No epic"
+}
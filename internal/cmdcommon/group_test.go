@@ -0,0 +1,51 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestIssueGroupBy(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set("epic.link", "customfield_10014")
+
+	fn, err := IssueGroupBy("")
+	assert.NoError(t, err)
+	assert.Nil(t, fn)
+
+	_, err = IssueGroupBy("priority")
+	assert.Error(t, err)
+
+	var iss jira.Issue
+	iss.Fields.Status.Name = "In Progress"
+
+	fn, err = IssueGroupBy("status")
+	assert.NoError(t, err)
+	assert.Equal(t, "In Progress", fn(&iss))
+
+	fn, err = IssueGroupBy("assignee")
+	assert.NoError(t, err)
+	assert.Equal(t, "Unassigned", fn(&iss))
+
+	iss.Fields.Assignee.Name = "Person A"
+	assert.Equal(t, "Person A", fn(&iss))
+
+	fn, err = IssueGroupBy("epic")
+	assert.NoError(t, err)
+	assert.Equal(t, "No epic", fn(&iss))
+
+	iss.Fields.CustomFields = map[string]interface{}{"customfield_10014": "TEST-1"}
+	assert.Equal(t, "TEST-1", fn(&iss))
+
+	iss.Fields.Parent = &jira.Issue{Key: "TEST-2"}
+	iss.Fields.Parent.Fields.Summary = "Epic summary"
+	assert.Equal(t, "TEST-2 Epic summary", fn(&iss))
+
+	iss.Fields.IssueType.Subtask = true
+	assert.Equal(t, "TEST-1", fn(&iss))
+}
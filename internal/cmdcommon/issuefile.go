@@ -0,0 +1,137 @@
+package cmdcommon
+
+import (
+	"sort"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/adf"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// IssueFile is an editable representation of an issue. It is printed by the yaml
+// output of issue view and read back by issue apply. Type and status are only
+// informative and are not updated when the file is applied.
+type IssueFile struct {
+	Key         string   `yaml:"key"`
+	Type        string   `yaml:"type"`
+	Status      string   `yaml:"status"`
+	Summary     string   `yaml:"summary"`
+	Priority    string   `yaml:"priority"`
+	Labels      []string `yaml:"labels"`
+	Components  []string `yaml:"components"`
+	FixVersions []string `yaml:"fixVersions"`
+	Description string   `yaml:"description"`
+}
+
+// NewIssueFile builds an issue file from the issue. Description in Atlassian
+// Document Format is translated to markdown as in issue edit.
+func NewIssueFile(iss *jira.Issue) *IssueFile {
+	f := IssueFile{
+		Key:         iss.Key,
+		Type:        iss.Fields.IssueType.Name,
+		Status:      iss.Fields.Status.Name,
+		Summary:     iss.Fields.Summary,
+		Priority:    iss.Fields.Priority.Name,
+		Labels:      iss.Fields.Labels,
+		Components:  make([]string, 0, len(iss.Fields.Components)),
+		FixVersions: make([]string, 0, len(iss.Fields.FixVersions)),
+	}
+	if f.Labels == nil {
+		f.Labels = []string{}
+	}
+	for _, c := range iss.Fields.Components {
+		f.Components = append(f.Components, c.Name)
+	}
+	for _, v := range iss.Fields.FixVersions {
+		f.FixVersions = append(f.FixVersions, v.Name)
+	}
+
+	switch desc := iss.Fields.Description.(type) {
+	case *adf.ADF:
+		f.Description = adf.NewTranslator(desc, adf.NewJiraMarkdownTranslator()).Translate()
+	case string:
+		f.Description = desc
+	}
+
+	return &f
+}
+
+// IssueFileChanges holds the fields of an issue file that differ from the server state.
+// Nil values denote unchanged fields, pointers to empty strings cleared fields.
+type IssueFileChanges struct {
+	Summary       *string
+	Priority      *string
+	Description   *string
+	LabelsAdded   []string
+	LabelsRemoved []string
+	Components    []string
+	FixVersions   []string
+}
+
+// Diff compares the issue file with the server state of the issue and returns the
+// changed fields. Labels are compared as sets, components and versions are replaced
+// as a whole if they differ.
+func (f *IssueFile) Diff(server *IssueFile) *IssueFileChanges {
+	var ch IssueFileChanges
+
+	if f.Summary != server.Summary {
+		ch.Summary = &f.Summary
+	}
+	if f.Priority != server.Priority {
+		ch.Priority = &f.Priority
+	}
+	if f.Description != server.Description {
+		ch.Description = &f.Description
+	}
+	ch.LabelsAdded = missingIn(server.Labels, f.Labels)
+	ch.LabelsRemoved = missingIn(f.Labels, server.Labels)
+	if !sameSet(f.Components, server.Components) {
+		ch.Components = f.Components
+	}
+	if !sameSet(f.FixVersions, server.FixVersions) {
+		ch.FixVersions = f.FixVersions
+	}
+
+	return &ch
+}
+
+// IsEmpty checks if there are no changes.
+func (ch *IssueFileChanges) IsEmpty() bool {
+	return ch.Summary == nil && ch.Priority == nil && ch.Description == nil &&
+		len(ch.LabelsAdded) == 0 && len(ch.LabelsRemoved) == 0 &&
+		ch.Components == nil && ch.FixVersions == nil
+}
+
+// missingIn returns the values of b that are not in a.
+func missingIn(a, b []string) []string {
+	seen := make(map[string]struct{}, len(a))
+	for _, v := range a {
+		seen[v] = struct{}{}
+	}
+
+	var out []string
+	for _, v := range b {
+		if _, ok := seen[v]; !ok {
+			out = append(out, v)
+			seen[v] = struct{}{}
+		}
+	}
+	return out
+}
+
+func sameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	x := append([]string{}, a...)
+	y := append([]string{}, b...)
+	sort.Strings(x)
+	sort.Strings(y)
+
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package cmdcommon
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestNewIssueFile(t *testing.T) {
+	var iss jira.Issue
+
+	iss.Key = "TEST-1"
+	iss.Fields.Summary = "Summary"
+	iss.Fields.Description = "Description"
+	iss.Fields.IssueType.Name = "Bug"
+	iss.Fields.Status.Name = "To Do"
+	iss.Fields.Priority.Name = "High"
+	iss.Fields.Components = []struct {
+		Name string `json:"name"`
+	}{{Name: "BE"}}
+	iss.Fields.FixVersions = []*jira.Version{{Name: "v1.0"}}
+
+	assert.Equal(t, &IssueFile{
+		Key:         "TEST-1",
+		Type:        "Bug",
+		Status:      "To Do",
+		Summary:     "Summary",
+		Priority:    "High",
+		Labels:      []string{},
+		Components:  []string{"BE"},
+		FixVersions: []string{"v1.0"},
+		Description: "Description",
+	}, NewIssueFile(&iss))
+}
+
+func TestIssueFileDiff(t *testing.T) {
+	server := &IssueFile{
+		Key:         "TEST-1",
+		Summary:     "Summary",
+		Priority:    "High",
+		Labels:      []string{"cli", "bug"},
+		Components:  []string{"BE", "FE"},
+		FixVersions: []string{"v1.0"},
+		Description: "Description",
+	}
+
+	var (
+		newSummary     = "New summary"
+		newPriority    = "Low"
+		newDescription = "New description"
+		cleared        = ""
+	)
+
+	cases := []struct {
+		name     string
+		local    IssueFile
+		expected *IssueFileChanges
+	}{
+		{
+			name:     "it returns no changes for the same file",
+			local:    *server,
+			expected: &IssueFileChanges{},
+		},
+		{
+			name: "it ignores the order of components and versions",
+			local: IssueFile{
+				Summary: "Summary", Priority: "High", Labels: []string{"bug", "cli"},
+				Components: []string{"FE", "BE"}, FixVersions: []string{"v1.0"}, Description: "Description",
+			},
+			expected: &IssueFileChanges{},
+		},
+		{
+			name: "it returns changed fields",
+			local: IssueFile{
+				Summary: "New summary", Priority: "Low", Labels: []string{"cli", "urgent"},
+				Components: []string{"BE"}, FixVersions: []string{"v1.0", "v1.1"}, Description: "New description",
+			},
+			expected: &IssueFileChanges{
+				Summary:       &newSummary,
+				Priority:      &newPriority,
+				Description:   &newDescription,
+				LabelsAdded:   []string{"urgent"},
+				LabelsRemoved: []string{"bug"},
+				Components:    []string{"BE"},
+				FixVersions:   []string{"v1.0", "v1.1"},
+			},
+		},
+		{
+			name: "it returns cleared fields",
+			local: IssueFile{
+				Summary: "Summary", Labels: []string{"cli", "bug"},
+				Components: []string{"BE", "FE"}, FixVersions: []string{"v1.0"},
+			},
+			expected: &IssueFileChanges{
+				Priority:    &cleared,
+				Description: &cleared,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := tc.local.Diff(server)
+			assert.Equal(t, tc.expected, actual)
+			assert.Equal(t, reflect.DeepEqual(tc.expected, &IssueFileChanges{}), actual.IsEmpty())
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	// pickerJQL fetches the unresolved issues assigned to the user along with the issues they viewed recently.
+	pickerJQL        = "(assignee = currentUser() AND resolution = EMPTY) OR issue in issueHistory() ORDER BY updated DESC"
+	pickerMaxResults = 50
+	pickerPageSize   = 10
+	pickerEnterKey   = "Enter an issue key"
+)
+
+// PickIssue asks the user to pick an issue from the unresolved issues assigned to them and the
+// issues they viewed recently. Issues are fuzzy searched as the user types, ie: "lgnbug" matches
+// "TEST-12 Fix login bug". The key can also be typed in if the issue is not listed, and it is
+// always asked for if there are no such issues.
+func PickIssue(c *jira.Client, project string) (string, error) {
+	res, err := func() (*jira.SearchResult, error) {
+		s := cmdutil.Info("Fetching your recent issues...")
+		defer s.Stop()
+
+		return api.ProxySearch(c, pickerJQL, pickerMaxResults)
+	}()
+	if err != nil || len(res.Issues) == 0 {
+		return askIssueKey(project)
+	}
+
+	options := make([]string, 0, len(res.Issues)+1)
+	for _, iss := range res.Issues {
+		options = append(options, fmt.Sprintf("%s %s", iss.Key, iss.Fields.Summary))
+	}
+	options = append(options, pickerEnterKey)
+
+	var ans string
+
+	qs := &survey.Question{
+		Name: "key",
+		Prompt: &survey.Select{
+			Message:  "Issue:",
+			Help:     "Type to search your assigned and recently viewed issues, or pick the last option to enter a key",
+			Options:  options,
+			PageSize: pickerPageSize,
+			Filter: func(filter, option string, _ int) bool {
+				return option == pickerEnterKey || FuzzyMatch(filter, option)
+			},
+		},
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return "", err
+	}
+	if ans == pickerEnterKey {
+		return askIssueKey(project)
+	}
+
+	return strings.SplitN(ans, " ", 2)[0], nil //nolint:gomnd
+}
+
+func askIssueKey(project string) (string, error) {
+	var ans string
+
+	qs := &survey.Question{
+		Name:     "key",
+		Prompt:   &survey.Input{Message: "Issue key"},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return "", err
+	}
+
+	return cmdutil.GetJiraIssueKey(project, ans), nil
+}
+
+// FuzzyMatch tells if all characters of the query appear in the value in the same
+// order, ignoring the case and the spaces in the query, eg: "t12" matches "TEST-12".
+func FuzzyMatch(query, value string) bool {
+	v := []rune(strings.ToLower(value))
+
+	i := 0
+	for _, r := range strings.ToLower(query) {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		for i < len(v) && v[i] != r {
+			i++
+		}
+		if i == len(v) {
+			return false
+		}
+		i++
+	}
+	return true
+}
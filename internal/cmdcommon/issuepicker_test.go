@@ -0,0 +1,30 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	cases := []struct {
+		name     string
+		query    string
+		value    string
+		expected bool
+	}{
+		{name: "it matches empty query", query: "", value: "TEST-12 Fix login bug", expected: true},
+		{name: "it matches key", query: "test-12", value: "TEST-12 Fix login bug", expected: true},
+		{name: "it matches characters in order", query: "t12", value: "TEST-12 Fix login bug", expected: true},
+		{name: "it ignores spaces in query", query: "lgn bug", value: "TEST-12 Fix login bug", expected: true},
+		{name: "it doesn't match characters out of order", query: "bug login", value: "TEST-12 Fix login bug"},
+		{name: "it doesn't match missing characters", query: "logout", value: "TEST-12 Fix login bug"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, FuzzyMatch(tc.query, tc.value))
+		})
+	}
+}
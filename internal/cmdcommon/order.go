@@ -0,0 +1,130 @@
+package cmdcommon
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
+)
+
+// priorityRank ranks the default priorities of Jira, so
+// that they are not ordered alphabetically by their name.
+var priorityRank = map[string]int{
+	"highest": 5,
+	"high":    4,
+	"medium":  3,
+	"low":     2,
+	"lowest":  1,
+}
+
+// SortIssues orders the issues client-side, eg: for issues fetched without a JQL.
+// It supports the fields displayed in the issue list, ie: key, summary, type, status,
+// priority, assignee, reporter, resolution, created and updated. Other fields are
+// ignored and the issues keep their order for them.
+func SortIssues(issues []*jira.Issue, order []query.OrderKey) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		for _, k := range order {
+			c := compareIssues(issues[i], issues[j], strings.ToLower(k.Field))
+			if c == 0 {
+				continue
+			}
+			if k.Direction == jql.DirectionDescending {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+}
+
+func compareIssues(a, b *jira.Issue, field string) int {
+	switch field {
+	case "key", "issuekey":
+		return compareKeys(a.Key, b.Key)
+	case "summary":
+		return strings.Compare(a.Fields.Summary, b.Fields.Summary)
+	case "type", "issuetype":
+		return strings.Compare(a.Fields.IssueType.Name, b.Fields.IssueType.Name)
+	case "status":
+		return strings.Compare(a.Fields.Status.Name, b.Fields.Status.Name)
+	case "priority":
+		return comparePriorities(a.Fields.Priority.Name, b.Fields.Priority.Name)
+	case "assignee":
+		return strings.Compare(a.Fields.Assignee.Name, b.Fields.Assignee.Name)
+	case "reporter":
+		return strings.Compare(a.Fields.Reporter.Name, b.Fields.Reporter.Name)
+	case "resolution":
+		return strings.Compare(a.Fields.Resolution.Name, b.Fields.Resolution.Name)
+	case "created":
+		return compareDates(a.Fields.Created, b.Fields.Created)
+	case "updated":
+		return compareDates(a.Fields.Updated, b.Fields.Updated)
+	}
+	return 0
+}
+
+// compareKeys compares issue keys by their project and then numerically by their
+// number, so that TEST-10 comes after TEST-9.
+func compareKeys(a, b string) int {
+	ap, an := splitKey(a)
+	bp, bn := splitKey(b)
+	if c := strings.Compare(ap, bp); c != 0 {
+		return c
+	}
+	return an - bn
+}
+
+func splitKey(key string) (string, int) {
+	i := strings.LastIndex(key, "-")
+	if i == -1 {
+		return key, 0
+	}
+	n, _ := strconv.Atoi(key[i+1:])
+	return key[:i], n
+}
+
+func comparePriorities(a, b string) int {
+	if c := priorityRank[strings.ToLower(a)] - priorityRank[strings.ToLower(b)]; c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+func compareDates(a, b string) int {
+	at, aErr := time.Parse(jira.RFC3339, a)
+	bt, bErr := time.Parse(jira.RFC3339, b)
+	if aErr != nil || bErr != nil {
+		return strings.Compare(a, b)
+	}
+	switch {
+	case at.Before(bt):
+		return -1
+	case at.After(bt):
+		return 1
+	}
+	return 0
+}
+
+// ExplicitIssueOrder returns the order passed via the order-by and reverse flags. It returns
+// nil if none of the flags is set, so that the issues keep the order returned by the server.
+func ExplicitIssueOrder(flags *pflag.FlagSet) ([]query.OrderKey, error) {
+	if !flags.Changed("order-by") && !flags.Changed("reverse") {
+		return nil, nil
+	}
+
+	orderBy, err := flags.GetString("order-by")
+	if err != nil {
+		return nil, err
+	}
+	reverse, err := flags.GetBool("reverse")
+	if err != nil {
+		return nil, err
+	}
+	return query.ParseOrderBy(orderBy, reverse)
+}
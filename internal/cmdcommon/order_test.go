@@ -0,0 +1,45 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/query"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestSortIssues(t *testing.T) {
+	newIssue := func(key, priority, updated string) *jira.Issue {
+		iss := jira.Issue{Key: key}
+		iss.Fields.Priority.Name = priority
+		iss.Fields.Updated = updated
+		return &iss
+	}
+	keys := func(issues []*jira.Issue) []string {
+		out := make([]string, 0, len(issues))
+		for _, iss := range issues {
+			out = append(out, iss.Key)
+		}
+		return out
+	}
+
+	issues := []*jira.Issue{
+		newIssue("TEST-9", "Low", "2022-01-02T10:00:00.000+0000"),
+		newIssue("TEST-10", "Highest", "2022-01-01T10:00:00.000+0000"),
+		newIssue("TEST-2", "Low", "2022-01-01T10:00:00.000+0000"),
+		newIssue("TEST-1", "Medium", "2022-01-03T10:00:00.000+0000"),
+	}
+
+	SortIssues(issues, []query.OrderKey{
+		{Field: "priority", Direction: "DESC"},
+		{Field: "updated", Direction: "ASC"},
+	})
+	assert.Equal(t, []string{"TEST-10", "TEST-1", "TEST-2", "TEST-9"}, keys(issues))
+
+	SortIssues(issues, []query.OrderKey{{Field: "Key", Direction: "ASC"}})
+	assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-9", "TEST-10"}, keys(issues))
+
+	SortIssues(issues, []query.OrderKey{{Field: "rank", Direction: "DESC"}})
+	assert.Equal(t, []string{"TEST-1", "TEST-2", "TEST-9", "TEST-10"}, keys(issues))
+}
@@ -0,0 +1,63 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// ProjectType returns the type of the project, ie: classic (company-managed) or next-gen
+// (team-managed). The type saved in the config is only valid for the configured project,
+// so the type of other projects is fetched from the server.
+func ProjectType(c *jira.Client, project string) (string, error) {
+	if t := viper.GetString("project.type"); t != "" && strings.EqualFold(project, viper.GetString("project.key")) {
+		return t, nil
+	}
+
+	p, err := c.GetProject(project)
+	if err != nil {
+		return "", err
+	}
+	if p.Type == "" {
+		// Local installations don't have team-managed projects.
+		return jira.ProjectTypeClassic, nil
+	}
+	return p.Type, nil
+}
+
+// ProjectKey returns the project key of the issue key, eg: PROJ for PROJ-1.
+func ProjectKey(issueKey string) string {
+	return strings.ToUpper(strings.SplitN(issueKey, "-", 2)[0]) //nolint:gomnd
+}
+
+// ProjectTemplateKey resolves the short name of a project template, eg: scrum, to the template
+// key of the project type. Full template keys, eg: com.pyxis.greenhopper.jira:gh-simplified-basic,
+// are returned as is.
+func ProjectTemplateKey(projectType, template string) (string, error) {
+	if template == "" || strings.Contains(template, ":") {
+		return template, nil
+	}
+
+	templates, ok := jira.ProjectTemplates[projectType]
+	if !ok {
+		return "", fmt.Errorf("no templates known for project type %q, pass a full template key instead", projectType)
+	}
+	if key, ok := templates[strings.ToLower(template)]; ok {
+		return key, nil
+	}
+
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, fmt.Sprintf("'%s'", name))
+	}
+	sort.Strings(names)
+
+	return "", fmt.Errorf(
+		"invalid template %q for project type %s\nAvailable templates are: %s",
+		template, projectType, strings.Join(names, ", "),
+	)
+}
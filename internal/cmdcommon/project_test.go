@@ -0,0 +1,82 @@
+package cmdcommon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestProjectType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/project/TEAM":
+			_, _ = w.Write([]byte(`{"key": "TEAM", "style": "next-gen"}`))
+		case "/rest/api/2/project/LOCAL":
+			_, _ = w.Write([]byte(`{"key": "LOCAL"}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	viper.Set("project.key", "TEST")
+	viper.Set("project.type", jira.ProjectTypeClassic)
+	defer func() {
+		viper.Set("project.key", "")
+		viper.Set("project.type", "")
+	}()
+
+	pt, err := ProjectType(client, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, jira.ProjectTypeClassic, pt)
+
+	pt, err = ProjectType(client, "TEAM")
+	assert.NoError(t, err)
+	assert.Equal(t, jira.ProjectTypeNextGen, pt)
+
+	pt, err = ProjectType(client, "LOCAL")
+	assert.NoError(t, err)
+	assert.Equal(t, jira.ProjectTypeClassic, pt)
+
+	_, err = ProjectType(client, "UNKNOWN")
+	assert.Error(t, err)
+}
+
+func TestProjectKey(t *testing.T) {
+	assert.Equal(t, "TEST", ProjectKey("TEST-1"))
+	assert.Equal(t, "TEST", ProjectKey("test-12"))
+	assert.Equal(t, "TEST", ProjectKey("TEST"))
+}
+
+func TestProjectTemplateKey(t *testing.T) {
+	key, err := ProjectTemplateKey(jira.ProjectTypeSoftware, "Scrum")
+	assert.NoError(t, err)
+	assert.Equal(t, "com.pyxis.greenhopper.jira:gh-simplified-scrum-classic", key)
+
+	key, err = ProjectTemplateKey(jira.ProjectTypeBusiness, "task-tracking")
+	assert.NoError(t, err)
+	assert.Equal(t, "com.atlassian.jira-core-project-templates:jira-core-simplified-task-tracking", key)
+
+	key, err = ProjectTemplateKey("service_desk", "com.atlassian.servicedesk:simplified-it-service-management")
+	assert.NoError(t, err)
+	assert.Equal(t, "com.atlassian.servicedesk:simplified-it-service-management", key)
+
+	key, err = ProjectTemplateKey(jira.ProjectTypeSoftware, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "", key)
+
+	_, err = ProjectTemplateKey(jira.ProjectTypeSoftware, "waterfall")
+	assert.EqualError(t, err, "invalid template \"waterfall\" for project type software\n"+
+		"Available templates are: 'basic', 'kanban', 'scrum'")
+
+	_, err = ProjectTemplateKey("service_desk", "itsm")
+	assert.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package cmdcommon
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	jiraConfig "github.com/ankitpokhrel/jira-cli/internal/config"
+	"github.com/ankitpokhrel/jira-cli/internal/queue"
+)
+
+// QueueStore returns the store of operations queued while Jira was unreachable.
+func QueueStore() (*queue.Store, error) {
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return nil, err
+	}
+	return queue.NewStore(fmt.Sprintf("%s/%s", home, jiraConfig.Dir)), nil
+}
+
+// QueueOperation queues the operation to be sent to Jira later with jira sync.
+// It is used when Jira is unreachable, eg: to log time while traveling.
+func QueueOperation(op *queue.Operation) {
+	// Operations are only replayed against the server and user they were queued for,
+	// the config in use may differ when syncing, eg: after switching the context.
+	op.Server, op.Login = viper.GetString("server"), viper.GetString("login")
+
+	store, err := QueueStore()
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(store.Add(op))
+
+	cmdutil.Warn("Jira is unreachable, the operation is queued. Run 'jira sync' to send it once you are online.")
+	cmdutil.Success("%s queued", op)
+}
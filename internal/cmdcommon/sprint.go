@@ -0,0 +1,49 @@
+package cmdcommon
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// BoardSprint returns the sprint in the given state that is up next on the board,
+// ie: the earliest future sprint or the oldest active sprint.
+func BoardSprint(c *jira.Client, boardID int, state string) (*jira.Sprint, error) {
+	if boardID == 0 {
+		return nil, fmt.Errorf("no board configured, use --board or set a default board with 'jira init'")
+	}
+
+	// Sprints are returned in ascending order, so the first one is up next.
+	res, err := c.Sprints(boardID, fmt.Sprintf("state=%s", state), 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Sprints) == 0 {
+		return nil, fmt.Errorf("no %s sprint found in board %d", state, boardID)
+	}
+
+	sprint := res.Sprints[0]
+	sprint.BoardID = boardID
+
+	return sprint, nil
+}
+
+// BoardName returns the name of the board in the config. It is fetched from the server if
+// the board was replaced, eg: with --board or in the per-directory config, and falls back
+// to the board id if it can't be fetched, as it is only displayed.
+func BoardName(c *jira.Client) string {
+	if name := viper.GetString("board.name"); name != "" {
+		return name
+	}
+
+	id := viper.GetInt("board.id")
+	conf, err := c.GetBoardConfiguration(id)
+	if err != nil {
+		return fmt.Sprintf("#%d", id)
+	}
+	viper.Set("board.name", conf.Name)
+
+	return conf.Name
+}
@@ -0,0 +1,49 @@
+package cmdcommon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestBoardSprint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/2/sprint", r.URL.Path)
+
+		switch r.URL.Query().Get("state") {
+		case jira.SprintStateClosed:
+			w.WriteHeader(400)
+			_, _ = w.Write([]byte(`{"errorMessages": ["Board does not support sprints"]}`))
+		case jira.SprintStateFuture:
+			_, _ = w.Write([]byte(`{"isLast": true, "values": [
+				{"id": 3, "name": "Sprint 3", "state": "future"},
+				{"id": 4, "name": "Sprint 4", "state": "future"}
+			]}`))
+		default:
+			_, _ = w.Write([]byte(`{"isLast": true, "values": []}`))
+		}
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	sprint, err := BoardSprint(client, 2, jira.SprintStateFuture)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, sprint.ID)
+	assert.Equal(t, 2, sprint.BoardID)
+
+	_, err = BoardSprint(client, 2, jira.SprintStateActive)
+	assert.Error(t, err)
+
+	_, err = BoardSprint(client, 2, jira.SprintStateClosed)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Board does not support sprints")
+
+	_, err = BoardSprint(client, 0, jira.SprintStateActive)
+	assert.Error(t, err)
+}
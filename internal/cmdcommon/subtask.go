@@ -0,0 +1,41 @@
+package cmdcommon
+
+import (
+	"fmt"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// SubtaskIssueType fetches the subtask issue type of the project.
+func SubtaskIssueType(c *jira.Client, project string) (*jira.IssueType, error) {
+	meta, err := c.GetCreateMeta(&jira.CreateMetaRequest{Projects: project})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range meta.Projects {
+		for _, it := range p.IssueTypes {
+			if it.Subtask {
+				t := it.IssueType
+				return &t, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("project %q doesn't have a subtask issue type", project)
+}
+
+// InheritFromParent returns components and fix versions of the parent issue for the
+// ones that are not set, so that subtasks are released along with their parent.
+func InheritFromParent(parent *jira.Issue, components, fixVersions []string) ([]string, []string) {
+	if len(components) == 0 {
+		for _, c := range parent.Fields.Components {
+			components = append(components, c.Name)
+		}
+	}
+	if len(fixVersions) == 0 {
+		for _, v := range parent.Fields.FixVersions {
+			fixVersions = append(fixVersions, v.Name)
+		}
+	}
+	return components, fixVersions
+}
@@ -0,0 +1,33 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestInheritFromParent(t *testing.T) {
+	parent := &jira.Issue{Key: "TEST-1"}
+	parent.Fields.Components = []struct {
+		Name string `json:"name"`
+	}{{Name: "BE"}, {Name: "FE"}}
+	parent.Fields.FixVersions = []*jira.Version{{Name: "v1.0"}}
+
+	components, fixVersions := InheritFromParent(parent, nil, nil)
+	assert.Equal(t, []string{"BE", "FE"}, components)
+	assert.Equal(t, []string{"v1.0"}, fixVersions)
+
+	components, fixVersions = InheritFromParent(parent, []string{"API"}, nil)
+	assert.Equal(t, []string{"API"}, components)
+	assert.Equal(t, []string{"v1.0"}, fixVersions)
+
+	components, fixVersions = InheritFromParent(parent, nil, []string{"v2.0"})
+	assert.Equal(t, []string{"BE", "FE"}, components)
+	assert.Equal(t, []string{"v2.0"}, fixVersions)
+
+	components, fixVersions = InheritFromParent(&jira.Issue{}, nil, nil)
+	assert.Empty(t, components)
+	assert.Empty(t, fixVersions)
+}
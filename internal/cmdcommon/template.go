@@ -0,0 +1,74 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/gitlog"
+)
+
+// ReadTemplate reads body from the given template file, or standard input if the
+// path is empty or -, and expands variables in it. Body piped to the standard input
+// without the template flag is returned as is.
+//
+// Available variables are {{.IssueKey}}, {{.Date}}, {{.GitBranch}} and the ones
+// given in key=value format via vars, eg: --var sprint=42 becomes {{.sprint}}.
+func ReadTemplate(path, issueKey string, vars []string) (string, error) {
+	b, err := cmdutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	if path == "" {
+		return string(b), nil
+	}
+
+	data, err := TemplateVars(issueKey, vars)
+	if err != nil {
+		return "", err
+	}
+	return ExpandTemplate(string(b), data)
+}
+
+// TemplateVars prepares built-in and user defined template variables.
+func TemplateVars(issueKey string, vars []string) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"IssueKey":  issueKey,
+		"Date":      time.Now().Format("2006-01-02"),
+		"GitBranch": "",
+	}
+
+	if wd, err := os.Getwd(); err == nil {
+		if branch, err := gitlog.CurrentBranch(wd); err == nil {
+			data["GitBranch"] = branch
+		}
+	}
+
+	for _, v := range vars {
+		pieces := strings.SplitN(v, "=", 2)
+		if len(pieces) != 2 || strings.TrimSpace(pieces[0]) == "" {
+			return nil, fmt.Errorf("invalid template variable %q, expected format key=value", v)
+		}
+		data[strings.TrimSpace(pieces[0])] = pieces[1]
+	}
+
+	return data, nil
+}
+
+// ExpandTemplate executes body as a Go template with the given variables.
+// Referencing an undefined variable is an error.
+func ExpandTemplate(body string, data map[string]interface{}) (string, error) {
+	tmpl, err := template.New("body").Option("missingkey=error").Parse(body)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+	return out.String(), nil
+}
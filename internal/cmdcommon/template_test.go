@@ -0,0 +1,31 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandTemplate(t *testing.T) {
+	t.Parallel()
+
+	data, err := TemplateVars("TEST-1", []string{"sprint=42", "note=a=b"})
+	assert.NoError(t, err)
+
+	actual, err := ExpandTemplate("{{.IssueKey}} in sprint {{.sprint}}: {{.note}}", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-1 in sprint 42: a=b", actual)
+
+	actual, err = ExpandTemplate("Plain body", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "Plain body", actual)
+
+	_, err = ExpandTemplate("{{.unknown}}", data)
+	assert.Error(t, err)
+
+	_, err = ExpandTemplate("{{.IssueKey", data)
+	assert.Error(t, err)
+
+	_, err = TemplateVars("TEST-1", []string{"sprint"})
+	assert.EqualError(t, err, `invalid template variable "sprint", expected format key=value`)
+}
@@ -0,0 +1,62 @@
+package cmdcommon
+
+import (
+	"fmt"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/timesheet"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const timesheetPageSize = 100
+
+// LoadTimesheet adds the issues you logged work on during the week of the sheet, the issues assigned
+// to you that are in progress and the given issues to the sheet, along with your worklogs of the week.
+func LoadTimesheet(client *jira.Client, sheet *timesheet.Sheet, issues []string, concurrency int) error {
+	me, err := client.Me()
+	if err != nil {
+		return err
+	}
+
+	jql := fmt.Sprintf(
+		`(worklogAuthor = currentUser() AND worklogDate >= "%s" AND worklogDate < "%s") OR `+
+			`(assignee = currentUser() AND statusCategory = "In Progress") ORDER BY key ASC`,
+		sheet.Start.Format("2006-01-02"), sheet.End().Format("2006-01-02"),
+	)
+
+	res, err := api.ProxySearch(client, jql, timesheetPageSize)
+	if err != nil {
+		return err
+	}
+
+	for _, iss := range res.Issues {
+		sheet.AddIssue(iss.Key, iss.Fields.Summary)
+	}
+	for _, key := range issues {
+		sheet.AddIssue(key, "")
+	}
+
+	keys := make([]string, 0, len(sheet.Rows))
+	for _, r := range sheet.Rows {
+		keys = append(keys, r.IssueKey)
+	}
+
+	all, err := FetchWorklogs(client, keys, timesheetPageSize, concurrency)
+	if err != nil {
+		return err
+	}
+
+	// Worklogs are added in order as the sheet is not safe for concurrent use.
+	for i, key := range keys {
+		for _, wl := range all[i] {
+			if !IsWorklogAuthor(wl, me) {
+				continue
+			}
+			if _, err := sheet.AddWorklog(key, wl); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
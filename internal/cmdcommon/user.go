@@ -0,0 +1,219 @@
+package cmdcommon
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// UserMe is a shortcut for the authenticated user.
+const UserMe = "me"
+
+const userSearchMaxResults = 100
+
+// ErrUserNotFound is returned when no user matches the value.
+type ErrUserNotFound struct {
+	Value string
+}
+
+func (e *ErrUserNotFound) Error() string {
+	return fmt.Sprintf("user %q not found", e.Value)
+}
+
+// Me returns the authenticated user.
+func Me(c *jira.Client) (*jira.User, error) {
+	me, err := c.Me()
+	if err != nil {
+		return nil, err
+	}
+	return &jira.User{
+		AccountID: me.AccountID,
+		Login:     me.Login,
+		Email:     me.Email,
+		Name:      me.Name,
+		Active:    true,
+	}, nil
+}
+
+// ResolveUser finds a user in the project whose display name, email, account id or
+// username (in local installation) is the given value. Use "me" for the authenticated user.
+func ResolveUser(c *jira.Client, project, val string) (*jira.User, error) {
+	if strings.EqualFold(val, UserMe) {
+		return Me(c)
+	}
+
+	users, err := SearchUsers(c, project, val)
+	if err != nil {
+		return nil, err
+	}
+	return pickUser(users, val)
+}
+
+// FindUser finds an active user whose display name, email, account id or username (in local
+// installation) is the given value, whether or not the user can be assigned issues. Use "me"
+// for the authenticated user.
+func FindUser(c *jira.Client, val string) (*jira.User, error) {
+	if strings.EqualFold(val, UserMe) {
+		return Me(c)
+	}
+
+	users, err := api.ProxyFindUsers(c, val, userSearchMaxResults)
+	if err != nil {
+		return nil, err
+	}
+	if len(MatchUsers(users, val)) == 0 && viper.GetString("installation") != jira.InstallationTypeLocal {
+		// The query doesn't match account ids in Jira cloud.
+		more, err := c.FindUsers(&jira.UserSearchOptions{AccountID: val})
+		var e *jira.ErrUnexpectedResponse
+		if err != nil && !(errors.As(err, &e) && e.StatusCode == http.StatusBadRequest) {
+			return nil, err
+		}
+		users = append(users, more...)
+	}
+	return pickUser(users, val)
+}
+
+func pickUser(users []*jira.User, val string) (*jira.User, error) {
+	matches := MatchUsers(users, val)
+	switch len(matches) {
+	case 0:
+		return nil, &ErrUserNotFound{Value: val}
+	case 1:
+		return matches[0], nil
+	}
+
+	names := make([]string, 0, len(matches))
+	for _, u := range matches {
+		names = append(names, UserLabel(u))
+	}
+	return nil, fmt.Errorf("multiple users match %q: %s", val, strings.Join(names, ", "))
+}
+
+// SearchUsers searches assignable users of the project by the given value. The search
+// query doesn't match account ids and usernames, so they are looked up separately if
+// none of the users found matches the value. All users are returned if value is empty.
+func SearchUsers(c *jira.Client, project, val string) ([]*jira.User, error) {
+	q := val
+	if q == "" {
+		q = "*"
+	}
+
+	users, err := api.ProxyUserSearch(c, &jira.UserSearchOptions{
+		Query:      q,
+		Project:    project,
+		MaxResults: userSearchMaxResults,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if val == "" || len(MatchUsers(users, val)) > 0 {
+		return users, nil
+	}
+
+	opts := jira.UserSearchOptions{Project: project, MaxResults: userSearchMaxResults}
+	if viper.GetString("installation") == jira.InstallationTypeLocal {
+		opts.Username = val
+	} else {
+		opts.AccountID = val
+	}
+
+	more, err := api.ProxyUserSearch(c, &opts)
+	if err != nil {
+		// Server rejects values that are not valid account ids or usernames.
+		var e *jira.ErrUnexpectedResponse
+		if errors.As(err, &e) && (e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusNotFound) {
+			return users, nil
+		}
+		return nil, err
+	}
+
+	return append(users, more...), nil
+}
+
+// AskUser asks for a user that can be assigned issues of the project. Pressing tab suggests
+// the users matching the typed text, and the answer is looked up before it is accepted, so
+// a mistyped name is caught by the prompt. It returns nil if the answer is left empty.
+func AskUser(c *jira.Client, project, message string) (*jira.User, error) {
+	// Users are remembered by their labels, so that suggestions are accepted as is.
+	found := make(map[string]*jira.User)
+
+	qs := &survey.Question{
+		Name: "user",
+		Prompt: &survey.Input{
+			Message: message,
+			Help:    "Type a name or email and press tab to see matching users, leave empty to skip",
+			Suggest: func(toComplete string) []string {
+				users, err := SearchUsers(c, project, strings.TrimSpace(toComplete))
+				if err != nil {
+					return nil
+				}
+
+				labels := make([]string, 0, len(users))
+				for _, u := range users {
+					if u.Active {
+						label := UserLabel(u)
+						found[label] = u
+						labels = append(labels, label)
+					}
+				}
+				return labels
+			},
+		},
+		Validate: func(val interface{}) error {
+			str, _ := val.(string)
+			if str = strings.TrimSpace(str); str == "" || found[str] != nil {
+				return nil
+			}
+
+			u, err := ResolveUser(c, project, str)
+			if err != nil {
+				return err
+			}
+			found[str] = u
+
+			return nil
+		},
+	}
+
+	var ans string
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return nil, err
+	}
+	return found[strings.TrimSpace(ans)], nil
+}
+
+// UserLabel is a display name of the user that is distinguishable from users with the same name.
+func UserLabel(u *jira.User) string {
+	switch {
+	case u.Email != "":
+		return fmt.Sprintf("%s (%s)", u.Name, u.Email)
+	case u.Login != "":
+		return fmt.Sprintf("%s (%s)", u.Name, u.Login)
+	case u.AccountID != "":
+		return fmt.Sprintf("%s (%s)", u.Name, u.AccountID)
+	}
+	return u.Name
+}
+
+// MatchUsers returns users whose display name, email, account id or username is the given value.
+func MatchUsers(users []*jira.User, val string) []*jira.User {
+	var out []*jira.User
+
+	for _, u := range users {
+		for _, v := range []string{u.Name, u.Email, u.AccountID, u.Login} {
+			if v != "" && strings.EqualFold(v, val) {
+				out = append(out, u)
+				break
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,151 @@
+package cmdcommon
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestMatchUsers(t *testing.T) {
+	jon := &jira.User{AccountID: "a-1", Name: "Jon Doe", Email: "jon@domain.tld"}
+	jane := &jira.User{AccountID: "a-2", Name: "Jane Doe", Login: "jane"}
+	other := &jira.User{AccountID: "a-3", Name: "Jon Doe"}
+
+	users := []*jira.User{jon, jane, other}
+
+	cases := []struct {
+		name     string
+		val      string
+		expected []*jira.User
+	}{
+		{name: "it matches email", val: "JON@domain.tld", expected: []*jira.User{jon}},
+		{name: "it matches display name", val: "Jane Doe", expected: []*jira.User{jane}},
+		{name: "it matches account id", val: "A-1", expected: []*jira.User{jon}},
+		{name: "it matches username", val: "jane", expected: []*jira.User{jane}},
+		{name: "it matches username case insensitively", val: "JANE", expected: []*jira.User{jane}},
+		{name: "it returns all users with same name", val: "jon doe", expected: []*jira.User{jon, other}},
+		{name: "it doesn't match partial values", val: "Doe", expected: nil},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, MatchUsers(users, tc.val))
+		})
+	}
+}
+
+func TestUserLabel(t *testing.T) {
+	cases := []struct {
+		name     string
+		user     *jira.User
+		expected string
+	}{
+		{
+			name:     "it prefers email",
+			user:     &jira.User{Name: "Jon Doe", Email: "jon@domain.tld", Login: "jon", AccountID: "a-1"},
+			expected: "Jon Doe (jon@domain.tld)",
+		},
+		{
+			name:     "it uses username without email",
+			user:     &jira.User{Name: "Jane Doe", Login: "jane", AccountID: "a-2"},
+			expected: "Jane Doe (jane)",
+		},
+		{
+			name:     "it uses account id without email and username",
+			user:     &jira.User{Name: "Jon Doe", AccountID: "a-1"},
+			expected: "Jon Doe (a-1)",
+		},
+		{
+			name:     "it uses name only",
+			user:     &jira.User{Name: "Jon Doe"},
+			expected: "Jon Doe",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, UserLabel(tc.user))
+		})
+	}
+}
+
+func TestSearchUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/user/assignable/search", r.URL.Path)
+		assert.Equal(t, "TEST", r.URL.Query().Get("project"))
+
+		q := r.URL.Query()
+		switch {
+		case q.Get("query") == "*":
+			_, _ = w.Write([]byte(`[{"accountId": "a-1", "displayName": "Jon Doe", "active": true}]`))
+		case q.Get("query") != "":
+			_, _ = w.Write([]byte(`[]`))
+		case q.Get("accountId") == "a-2":
+			_, _ = w.Write([]byte(`[{"accountId": "a-2", "displayName": "Jane Doe", "active": true}]`))
+		case q.Get("accountId") == "invalid":
+			w.WriteHeader(400)
+		default:
+			w.WriteHeader(500)
+		}
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	users, err := SearchUsers(client, "TEST", "")
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "a-1", users[0].AccountID)
+
+	users, err = SearchUsers(client, "TEST", "a-2")
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "Jane Doe", users[0].Name)
+
+	users, err = SearchUsers(client, "TEST", "invalid")
+	assert.NoError(t, err)
+	assert.Empty(t, users)
+
+	_, err = SearchUsers(client, "TEST", "a-3")
+	assert.Error(t, err)
+}
+
+func TestFindUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/user/search", r.URL.Path)
+
+		q := r.URL.Query()
+		switch {
+		case q.Get("query") == "jon@domain.tld":
+			_, _ = w.Write([]byte(`[{"accountId": "a-1", "displayName": "Jon Doe", "emailAddress": "jon@domain.tld"}]`))
+		case q.Get("query") != "":
+			_, _ = w.Write([]byte(`[]`))
+		case q.Get("accountId") == "a-2":
+			_, _ = w.Write([]byte(`[{"accountId": "a-2", "displayName": "Jane Doe"}]`))
+		default:
+			w.WriteHeader(400)
+		}
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(jira.Config{Server: server.URL}, jira.WithTimeout(3*time.Second))
+
+	user, err := FindUser(client, "jon@domain.tld")
+	assert.NoError(t, err)
+	assert.Equal(t, "a-1", user.AccountID)
+
+	user, err = FindUser(client, "a-2")
+	assert.NoError(t, err)
+	assert.Equal(t, "Jane Doe", user.Name)
+
+	_, err = FindUser(client, "unknown")
+	assert.EqualError(t, err, `user "unknown" not found`)
+	assert.ErrorAs(t, err, new(*ErrUserNotFound))
+}
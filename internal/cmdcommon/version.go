@@ -0,0 +1,121 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// ResolveVersions resolves version names against the versions of the project. Names are matched
+// case-insensitively and replaced with the names used in the project. Versions missing in the
+// project are created if create is set, otherwise an error listing available versions is returned.
+func ResolveVersions(c *jira.Client, project string, names []string, create bool) ([]string, error) {
+	if len(names) == 0 {
+		return names, nil
+	}
+
+	versions, err := c.ProjectVersions(project)
+	if err != nil {
+		return nil, err
+	}
+
+	out, missing := matchVersions(versions, names)
+	if len(missing) == 0 {
+		return out, nil
+	}
+
+	if !create {
+		all := make([]string, 0, len(versions))
+		for _, v := range versions {
+			if !v.Archived {
+				all = append(all, fmt.Sprintf("'%s'", v.Name))
+			}
+		}
+		return nil, fmt.Errorf(
+			"version %q not found in project %s\nAvailable versions are: %s",
+			missing[0], project, strings.Join(all, ", "),
+		)
+	}
+
+	for _, name := range missing {
+		v, err := c.CreateVersion(&jira.VersionRequest{Name: name, Project: project})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v.Name)
+	}
+
+	return out, nil
+}
+
+// matchVersions returns project names of the versions found and the names not found in the versions.
+func matchVersions(versions []*jira.Version, names []string) ([]string, []string) {
+	var found, missing []string
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		var match string
+		for _, v := range versions {
+			if strings.EqualFold(v.Name, name) {
+				match = v.Name
+				break
+			}
+		}
+
+		if match == "" {
+			missing = append(missing, name)
+		} else {
+			found = append(found, match)
+		}
+	}
+
+	return found, missing
+}
+
+// VersionDateLayout is the layout of start and release dates of a version.
+const VersionDateLayout = "2006-01-02"
+
+// ValidateVersionDate validates that the date, if set, is in the format of VersionDateLayout.
+func ValidateVersionDate(date string) error {
+	if date == "" {
+		return nil
+	}
+	if _, err := time.Parse(VersionDateLayout, date); err != nil {
+		return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+	return nil
+}
+
+// FindVersion finds a version of the project by its id or name. Names are matched case-insensitively.
+func FindVersion(c *jira.Client, project, nameOrID string) (*jira.Version, error) {
+	versions, err := c.ProjectVersions(project)
+	if err != nil {
+		return nil, err
+	}
+	if v := findVersion(versions, nameOrID); v != nil {
+		return v, nil
+	}
+	return nil, fmt.Errorf("version %q not found in project %s", nameOrID, project)
+}
+
+func findVersion(versions []*jira.Version, nameOrID string) *jira.Version {
+	nameOrID = strings.TrimSpace(nameOrID)
+
+	for _, v := range versions {
+		if v.ID == nameOrID {
+			return v
+		}
+	}
+	for _, v := range versions {
+		if strings.EqualFold(v.Name, nameOrID) {
+			return v
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestMatchVersions(t *testing.T) {
+	versions := []*jira.Version{
+		{ID: "1", Name: "v1.0"},
+		{ID: "2", Name: "V2.0-Beta"},
+	}
+
+	found, missing := matchVersions(versions, []string{"v2.0-beta", " v1.0 ", "", "v3.0"})
+	assert.Equal(t, []string{"V2.0-Beta", "v1.0"}, found)
+	assert.Equal(t, []string{"v3.0"}, missing)
+
+	found, missing = matchVersions(nil, []string{"v1.0"})
+	assert.Empty(t, found)
+	assert.Equal(t, []string{"v1.0"}, missing)
+}
+
+func TestFindVersion(t *testing.T) {
+	versions := []*jira.Version{
+		{ID: "10000", Name: "v1.0"},
+		{ID: "10001", Name: "10000"},
+		{ID: "10002", Name: "V2.0-Beta"},
+	}
+
+	assert.Equal(t, versions[0], findVersion(versions, "10000"))
+	assert.Equal(t, versions[2], findVersion(versions, " v2.0-beta"))
+	assert.Equal(t, versions[1], findVersion(versions, "10001"))
+	assert.Nil(t, findVersion(versions, "v3.0"))
+}
+
+func TestValidateVersionDate(t *testing.T) {
+	assert.NoError(t, ValidateVersionDate(""))
+	assert.NoError(t, ValidateVersionDate("2022-01-31"))
+	assert.Error(t, ValidateVersionDate("2022-31-01"))
+	assert.Error(t, ValidateVersionDate("31/01/2022"))
+}
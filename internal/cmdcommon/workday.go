@@ -0,0 +1,150 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const workdayPageSize = 100
+
+// Workday holds workday limits configured under the worklog key, eg:
+//
+//	worklog:
+//	  max_per_day: 8h
+//	  min_increment: 15m
+//	  strict: true
+//
+// Limits are given in seconds and a zero value means there is no limit.
+type Workday struct {
+	MaxPerDay    int
+	MinIncrement int
+	Strict       bool
+}
+
+// GetWorkday reads workday limits from the config.
+func GetWorkday() (*Workday, error) {
+	var (
+		wd  Workday
+		err error
+	)
+
+	if v := viper.GetString("worklog.max_per_day"); v != "" {
+		if wd.MaxPerDay, err = jira.ParseTimeSpent(v); err != nil {
+			return nil, fmt.Errorf("invalid worklog.max_per_day config %q", v)
+		}
+	}
+	if v := viper.GetString("worklog.min_increment"); v != "" {
+		if wd.MinIncrement, err = jira.ParseTimeSpent(v); err != nil {
+			return nil, fmt.Errorf("invalid worklog.min_increment config %q", v)
+		}
+	}
+	wd.Strict = viper.GetBool("worklog.strict")
+
+	return &wd, nil
+}
+
+// Enabled checks if any workday limit is configured.
+func (w *Workday) Enabled() bool {
+	return w.MaxPerDay > 0 || w.MinIncrement > 0
+}
+
+// NeedsLogged checks if the time already logged on the day is required to validate a worklog.
+func (w *Workday) NeedsLogged() bool {
+	return w.MaxPerDay > 0
+}
+
+// Validate validates time spent of a new worklog given time already logged on the day.
+// All values are in seconds.
+func (w *Workday) Validate(timeSpent, logged int) error {
+	secs := func(s int) string {
+		return jira.FormatTimeSpent(time.Duration(s) * time.Second)
+	}
+
+	if w.MinIncrement > 0 && timeSpent%w.MinIncrement != 0 {
+		return fmt.Errorf(
+			"time spent %s is not a multiple of the minimum increment %s",
+			secs(timeSpent), secs(w.MinIncrement),
+		)
+	}
+	if w.MaxPerDay > 0 && logged+timeSpent > w.MaxPerDay {
+		if logged == 0 {
+			return fmt.Errorf("time spent %s exceeds the daily budget of %s", secs(timeSpent), secs(w.MaxPerDay))
+		}
+		return fmt.Errorf(
+			"time spent %s exceeds the daily budget of %s, %s already logged on the day",
+			secs(timeSpent), secs(w.MaxPerDay), secs(logged),
+		)
+	}
+	return nil
+}
+
+// LoggedOn sums up time the current user logged on the given date in the given timezone.
+func LoggedOn(c *jira.Client, date string, loc *time.Location) (int, error) {
+	me, err := c.Me()
+	if err != nil {
+		return 0, err
+	}
+
+	jql := fmt.Sprintf(`worklogAuthor = currentUser() AND worklogDate = "%s"`, date)
+
+	res, err := api.ProxySearch(c, jql, workdayPageSize)
+	if err != nil {
+		return 0, err
+	}
+
+	keys := make([]string, 0, len(res.Issues))
+	for _, iss := range res.Issues {
+		keys = append(keys, iss.Key)
+	}
+
+	all, err := FetchWorklogs(c, keys, workdayPageSize, DefaultConcurrency)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, worklogs := range all {
+		for _, wl := range worklogs {
+			if !IsWorklogAuthor(wl, me) {
+				continue
+			}
+			if d, _ := SplitWorklogStarted(wl.Started, loc); d == date {
+				total += wl.TimeSpentSeconds
+			}
+		}
+	}
+
+	return total, nil
+}
+
+// FetchWorklogs fetches worklogs of the issues with at most concurrency requests at once.
+// Worklogs are in the same order as the keys, the first error in that order is returned.
+func FetchWorklogs(c *jira.Client, keys []string, pageSize, concurrency int) ([][]*jira.Worklog, error) {
+	out := make([][]*jira.Worklog, len(keys))
+
+	errs := RunConcurrently(len(keys), concurrency, func(i int) error {
+		worklogs, err := api.ProxyWorklogs(c, keys[i], pageSize)
+		out[i] = worklogs
+		return err
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+// IsWorklogAuthor tells if the worklog was logged by the given user.
+func IsWorklogAuthor(wl *jira.Worklog, me *jira.Me) bool {
+	if me.AccountID != "" && wl.Author.AccountID != "" {
+		return wl.Author.AccountID == me.AccountID
+	}
+	return wl.Author.Name == me.Name
+}
@@ -0,0 +1,35 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkdayValidate(t *testing.T) {
+	t.Parallel()
+
+	wd := Workday{MaxPerDay: 8 * 3600, MinIncrement: 15 * 60}
+
+	assert.True(t, wd.Enabled())
+	assert.NoError(t, wd.Validate(30*60, 0))
+	assert.NoError(t, wd.Validate(2*3600, 6*3600))
+
+	assert.EqualError(
+		t, wd.Validate(20*60, 0),
+		"time spent 20m is not a multiple of the minimum increment 15m",
+	)
+	assert.EqualError(
+		t, wd.Validate(90*60, 7*3600),
+		"time spent 1h 30m exceeds the daily budget of 8h, 7h already logged on the day",
+	)
+	assert.EqualError(
+		t, wd.Validate(9*3600, 0),
+		"time spent 9h exceeds the daily budget of 8h",
+	)
+
+	wd = Workday{}
+
+	assert.False(t, wd.Enabled())
+	assert.NoError(t, wd.Validate(20*60, 10*3600))
+}
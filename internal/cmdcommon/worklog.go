@@ -0,0 +1,148 @@
+package cmdcommon
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tempo"
+)
+
+// SelectWorklog prompts user to select a worklog from the given list.
+func SelectWorklog(worklogs []*jira.Worklog) (*jira.Worklog, error) {
+	if len(worklogs) == 0 {
+		return nil, fmt.Errorf("no worklogs to select from")
+	}
+
+	options := make([]string, 0, len(worklogs))
+	for _, wl := range worklogs {
+		options = append(options, worklogOption(wl))
+	}
+
+	var ans string
+
+	qs := &survey.Question{
+		Name: "worklog",
+		Prompt: &survey.Select{
+			Message: "Worklog:",
+			Options: options,
+		},
+		Validate: survey.Required,
+	}
+	if err := survey.Ask([]*survey.Question{qs}, &ans); err != nil {
+		return nil, err
+	}
+
+	for i, opt := range options {
+		if opt == ans {
+			return worklogs[i], nil
+		}
+	}
+	return nil, fmt.Errorf("invalid worklog selected")
+}
+
+// WorklogLocation returns the timezone worklog started date and time are interpreted in.
+// It uses the given timezone, eg: Europe/Berlin, or the one set in the config file and
+// fallbacks to the local timezone if none is set.
+func WorklogLocation(tz string) (*time.Location, error) {
+	if tz == "" {
+		tz = viper.GetString("timezone")
+	}
+	if tz == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(tz)
+}
+
+// FormatWorklogStarted converts date and time in the given timezone to a jira worklog started timestamp.
+func FormatWorklogStarted(date, tm string, loc *time.Location) (string, error) {
+	t, err := time.ParseInLocation("2006-01-02 15:04", date+" "+tm, loc)
+	if err != nil {
+		return "", fmt.Errorf("invalid started date or time %q %q", date, tm)
+	}
+	return t.Format(jira.RFC3339MilliLayout), nil
+}
+
+// SplitWorklogStarted splits jira worklog started timestamp to date and time in the given timezone.
+func SplitWorklogStarted(started string, loc *time.Location) (string, string) {
+	t, err := time.Parse(jira.RFC3339, started)
+	if err != nil {
+		return "", ""
+	}
+	t = t.In(loc)
+	return t.Format("2006-01-02"), t.Format("15:04")
+}
+
+// GetAdjustEstimateValue validates remaining estimate adjustment options and returns
+// the estimate to send along with the adjustment type. manualFlag is the name of the
+// flag that holds the estimate for the manual adjustment, eg: reduce-by.
+func GetAdjustEstimateValue(adjustEstimate, newEstimate, manualEstimate, manualFlag string) (string, error) {
+	switch adjustEstimate {
+	case "", jira.AdjustEstimateAuto, jira.AdjustEstimateLeave:
+		return "", nil
+	case jira.AdjustEstimateNew:
+		if newEstimate == "" {
+			return "", fmt.Errorf("--new-estimate is required when using --adjust-estimate %s", jira.AdjustEstimateNew)
+		}
+		return newEstimate, nil
+	case jira.AdjustEstimateManual:
+		if manualEstimate == "" {
+			return "", fmt.Errorf("--%s is required when using --adjust-estimate %s", manualFlag, jira.AdjustEstimateManual)
+		}
+		return manualEstimate, nil
+	}
+	return "", fmt.Errorf("invalid value %q for --adjust-estimate", adjustEstimate)
+}
+
+// GetWorklogVisibility parses worklog visibility given in type:value format,
+// eg: role:Developers or group:engineering. It returns nil if visibility is empty.
+func GetWorklogVisibility(visibility string) (*jira.WorklogVisibility, error) {
+	if visibility == "" {
+		return nil, nil
+	}
+
+	pieces := strings.SplitN(visibility, ":", 2)
+	if len(pieces) != 2 || strings.TrimSpace(pieces[1]) == "" {
+		return nil, fmt.Errorf("invalid visibility %q, expected format role:NAME or group:NAME", visibility)
+	}
+
+	typ := strings.ToLower(strings.TrimSpace(pieces[0]))
+	if typ != jira.VisibilityTypeRole && typ != jira.VisibilityTypeGroup {
+		return nil, fmt.Errorf(
+			"invalid visibility type %q, expected %s or %s", pieces[0], jira.VisibilityTypeRole, jira.VisibilityTypeGroup,
+		)
+	}
+
+	return &jira.WorklogVisibility{Type: typ, Value: strings.TrimSpace(pieces[1])}, nil
+}
+
+// GetWorklogAttributes parses Tempo work attributes given in key=value format.
+// The account, if set, is added as the Tempo account attribute.
+func GetWorklogAttributes(attrs []string, account string) ([]tempo.Attribute, error) {
+	out := make([]tempo.Attribute, 0, len(attrs)+1)
+
+	for _, attr := range attrs {
+		pieces := strings.SplitN(attr, "=", 2)
+		if len(pieces) != 2 || strings.TrimSpace(pieces[0]) == "" {
+			return nil, fmt.Errorf("invalid attribute %q, expected format key=value", attr)
+		}
+		out = append(out, tempo.Attribute{
+			Key:   strings.TrimSpace(pieces[0]),
+			Value: strings.TrimSpace(pieces[1]),
+		})
+	}
+	if account != "" {
+		out = append(out, tempo.Attribute{Key: tempo.AttributeAccount, Value: account})
+	}
+
+	return out, nil
+}
+
+func worklogOption(wl *jira.Worklog) string {
+	date, tm := SplitWorklogStarted(wl.Started, time.Local)
+	return fmt.Sprintf("%s: %s %s, %s by %s", wl.ID, date, tm, wl.TimeSpent, wl.Author.Name)
+}
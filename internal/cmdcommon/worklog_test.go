@@ -0,0 +1,130 @@
+package cmdcommon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tempo"
+)
+
+func TestFormatWorklogStarted(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	started, err := FormatWorklogStarted("2022-02-02", "13:35", berlin)
+	assert.NoError(t, err)
+	assert.Equal(t, "2022-02-02T13:35:00.000+0100", started)
+
+	// Daylight saving time is taken into account.
+	started, err = FormatWorklogStarted("2022-07-02", "13:35", berlin)
+	assert.NoError(t, err)
+	assert.Equal(t, "2022-07-02T13:35:00.000+0200", started)
+
+	started, err = FormatWorklogStarted("2022-02-02", "13:35", time.UTC)
+	assert.NoError(t, err)
+	assert.Equal(t, "2022-02-02T13:35:00.000+0000", started)
+
+	_, err = FormatWorklogStarted("02/02/2022", "13:35", time.UTC)
+	assert.Error(t, err)
+}
+
+func TestSplitWorklogStarted(t *testing.T) {
+	kathmandu, err := time.LoadLocation("Asia/Kathmandu")
+	assert.NoError(t, err)
+
+	date, tm := SplitWorklogStarted("2022-02-02T13:35:00.000+0100", time.UTC)
+	assert.Equal(t, "2022-02-02", date)
+	assert.Equal(t, "12:35", tm)
+
+	date, tm = SplitWorklogStarted("2022-02-02T23:35:00.000+0100", kathmandu)
+	assert.Equal(t, "2022-02-03", date)
+	assert.Equal(t, "04:20", tm)
+
+	date, tm = SplitWorklogStarted("invalid", time.UTC)
+	assert.Equal(t, "", date)
+	assert.Equal(t, "", tm)
+}
+
+func TestGetAdjustEstimateValue(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		adjust   string
+		expected string
+		err      bool
+	}{
+		{name: "it returns empty estimate by default", adjust: "", expected: ""},
+		{name: "it returns empty estimate for auto", adjust: "auto", expected: ""},
+		{name: "it returns empty estimate for leave", adjust: "leave", expected: ""},
+		{name: "it returns new estimate for new", adjust: "new", expected: "2d"},
+		{name: "it returns manual estimate for manual", adjust: "manual", expected: "4h"},
+		{name: "it returns error for invalid type", adjust: "invalid", err: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := GetAdjustEstimateValue(tc.adjust, "2d", "4h", "reduce-by")
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+
+	_, err := GetAdjustEstimateValue("new", "", "4h", "reduce-by")
+	assert.EqualError(t, err, "--new-estimate is required when using --adjust-estimate new")
+
+	_, err = GetAdjustEstimateValue("manual", "2d", "", "reduce-by")
+	assert.EqualError(t, err, "--reduce-by is required when using --adjust-estimate manual")
+}
+
+func TestGetWorklogVisibility(t *testing.T) {
+	t.Parallel()
+
+	actual, err := GetWorklogVisibility("")
+	assert.NoError(t, err)
+	assert.Nil(t, actual)
+
+	actual, err = GetWorklogVisibility("role:Developers")
+	assert.NoError(t, err)
+	assert.Equal(t, &jira.WorklogVisibility{Type: jira.VisibilityTypeRole, Value: "Developers"}, actual)
+
+	actual, err = GetWorklogVisibility("Group: engineering team")
+	assert.NoError(t, err)
+	assert.Equal(t, &jira.WorklogVisibility{Type: jira.VisibilityTypeGroup, Value: "engineering team"}, actual)
+
+	_, err = GetWorklogVisibility("Developers")
+	assert.EqualError(t, err, `invalid visibility "Developers", expected format role:NAME or group:NAME`)
+
+	_, err = GetWorklogVisibility("user:john")
+	assert.EqualError(t, err, `invalid visibility type "user", expected role or group`)
+}
+
+func TestGetWorklogAttributes(t *testing.T) {
+	t.Parallel()
+
+	actual, err := GetWorklogAttributes([]string{"_Role_=Developer", " _Billable_ = yes"}, "DEV")
+	assert.NoError(t, err)
+	assert.Equal(t, []tempo.Attribute{
+		{Key: "_Role_", Value: "Developer"},
+		{Key: "_Billable_", Value: "yes"},
+		{Key: tempo.AttributeAccount, Value: "DEV"},
+	}, actual)
+
+	actual, err = GetWorklogAttributes(nil, "")
+	assert.NoError(t, err)
+	assert.Empty(t, actual)
+
+	_, err = GetWorklogAttributes([]string{"_Role_"}, "")
+	assert.EqualError(t, err, `invalid attribute "_Role_", expected format key=value`)
+}
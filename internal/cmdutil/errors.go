@@ -0,0 +1,121 @@
+package cmdutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Exit codes of the tool, so that scripts can branch on the type of the failure.
+const (
+	// ExitCodeError is the exit code of failures that are not of any other type.
+	ExitCodeError = 1
+	// ExitCodeValidation is the exit code when the input is invalid, eg: an unknown flag
+	// or a field rejected by Jira.
+	ExitCodeValidation = 2
+	// ExitCodeAuth is the exit code when the user is not authenticated or not allowed to do the action.
+	ExitCodeAuth = 3
+	// ExitCodeNotFound is the exit code when the resource, eg: an issue, doesn't exist.
+	ExitCodeNotFound = 4
+	// ExitCodeNetwork is the exit code when Jira can't be reached or is unavailable.
+	ExitCodeNetwork = 5
+)
+
+var errorTypes = map[int]string{
+	ExitCodeError:      "error",
+	ExitCodeValidation: "validation",
+	ExitCodeAuth:       "auth",
+	ExitCodeNotFound:   "not_found",
+	ExitCodeNetwork:    "network",
+}
+
+// jsonErrors prints the errors in json format.
+var jsonErrors bool
+
+// UseJSONErrors prints the errors in json format, eg: when the output format is json.
+func UseJSONErrors() {
+	jsonErrors = true
+}
+
+// UsageError is an error in the usage of a command, eg: an unknown flag or a missing argument.
+type UsageError struct {
+	Err error
+}
+
+func (e *UsageError) Error() string {
+	return e.Err.Error()
+}
+
+// ExitCode returns the exit code of the tool for the error.
+func ExitCode(err error) int {
+	var (
+		usageErr *UsageError
+		respErr  *jira.ErrUnexpectedResponse
+		netErr   net.Error
+	)
+
+	switch {
+	case errors.As(err, &usageErr):
+		return ExitCodeValidation
+	case errors.Is(err, jira.ErrNoDeletePermission):
+		return ExitCodeAuth
+	case errors.As(err, &respErr):
+		switch code := respErr.StatusCode; {
+		case code == http.StatusUnauthorized || code == http.StatusForbidden:
+			return ExitCodeAuth
+		case code == http.StatusNotFound:
+			return ExitCodeNotFound
+		case code == http.StatusBadRequest || code == http.StatusUnprocessableEntity:
+			return ExitCodeValidation
+		case code == http.StatusTooManyRequests || code >= http.StatusInternalServerError:
+			return ExitCodeNetwork
+		}
+	case errors.As(err, &netErr), errors.Is(err, io.EOF), errors.Is(err, context.DeadlineExceeded):
+		return ExitCodeNetwork
+	}
+	return ExitCodeError
+}
+
+// jsonError is the error printed in json format.
+type jsonError struct {
+	Type     string            `json:"type"`
+	ExitCode int               `json:"exitCode"`
+	Message  string            `json:"message"`
+	Status   int               `json:"status,omitempty"`
+	Errors   map[string]string `json:"errors,omitempty"`
+}
+
+// formatJSONError formats the error in json format, eg:
+//
+//	{"error":{"type":"not_found","exitCode":4,"message":"Issue does not exist","status":404}}
+func formatJSONError(code int, msg string, err error) []byte {
+	je := jsonError{Type: errorTypes[code], ExitCode: code, Message: msg}
+
+	var respErr *jira.ErrUnexpectedResponse
+	if errors.As(err, &respErr) {
+		je.Status = respErr.StatusCode
+		if len(respErr.Body.Errors) > 0 {
+			je.Errors = respErr.Body.Errors
+		}
+		if je.Message == "" {
+			je.Message = respErr.Status
+		}
+	}
+
+	b, _ := json.Marshal(struct {
+		Error jsonError `json:"error"`
+	}{je})
+
+	return b
+}
+
+func printJSONError(code int, msg string, err error) {
+	fmt.Fprintf(os.Stderr, "%s\n", formatJSONError(code, msg, err))
+}
@@ -0,0 +1,117 @@
+package cmdutil
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{
+			name:     "it returns validation exit code for usage errors",
+			err:      &UsageError{Err: fmt.Errorf("unknown flag: --foo")},
+			expected: ExitCodeValidation,
+		},
+		{
+			name:     "it returns auth exit code for unauthorized response",
+			err:      &jira.ErrUnexpectedResponse{StatusCode: 401},
+			expected: ExitCodeAuth,
+		},
+		{
+			name:     "it returns auth exit code for forbidden response",
+			err:      &jira.ErrUnexpectedResponse{StatusCode: 403},
+			expected: ExitCodeAuth,
+		},
+		{
+			name:     "it returns auth exit code if the user can't delete the issue",
+			err:      fmt.Errorf("delete TEST-1: %w", jira.ErrNoDeletePermission),
+			expected: ExitCodeAuth,
+		},
+		{
+			name:     "it returns not found exit code for not found response",
+			err:      &jira.ErrUnexpectedResponse{StatusCode: 404},
+			expected: ExitCodeNotFound,
+		},
+		{
+			name:     "it returns validation exit code for bad request response",
+			err:      &jira.ErrUnexpectedResponse{StatusCode: 400},
+			expected: ExitCodeValidation,
+		},
+		{
+			name:     "it returns network exit code for server errors",
+			err:      &jira.ErrUnexpectedResponse{StatusCode: 503},
+			expected: ExitCodeNetwork,
+		},
+		{
+			name: "it returns network exit code if the server can't be reached",
+			err: &url.Error{
+				Op:  "Get",
+				URL: "https://jira.example.com",
+				Err: &net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")},
+			},
+			expected: ExitCodeNetwork,
+		},
+		{
+			name:     "it returns error exit code for other responses",
+			err:      &jira.ErrUnexpectedResponse{StatusCode: 409},
+			expected: ExitCodeError,
+		},
+		{
+			name:     "it returns error exit code for other errors",
+			err:      fmt.Errorf("something went wrong"),
+			expected: ExitCodeError,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, ExitCode(tc.err))
+		})
+	}
+}
+
+func TestFormatJSONError(t *testing.T) {
+	t.Parallel()
+
+	err := &jira.ErrUnexpectedResponse{
+		Body: jira.Errors{
+			Errors: map[string]string{"summary": "You must specify a summary of the issue."},
+		},
+		Status:     "400 Bad Request",
+		StatusCode: 400,
+	}
+	assert.JSONEq(
+		t,
+		`{"error":{"type":"validation","exitCode":2,"message":"Invalid input","status":400,`+
+			`"errors":{"summary":"You must specify a summary of the issue."}}}`,
+		string(formatJSONError(ExitCodeValidation, "Invalid input", err)),
+	)
+
+	assert.JSONEq(
+		t,
+		`{"error":{"type":"not_found","exitCode":4,"message":"404 Not Found","status":404}}`,
+		string(formatJSONError(ExitCodeNotFound, "", &jira.ErrUnexpectedResponse{Status: "404 Not Found", StatusCode: 404})),
+	)
+
+	assert.JSONEq(
+		t,
+		`{"error":{"type":"auth","exitCode":3,"message":"You are not logged in."}}`,
+		string(formatJSONError(ExitCodeAuth, "You are not logged in.", nil)),
+	)
+}
@@ -1,9 +1,13 @@
 package cmdutil
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -22,6 +26,16 @@ func ExitIfError(err error) {
 		return
 	}
 
+	code := ExitCode(err)
+	if jsonErrors {
+		msg := strings.TrimSpace(err.Error())
+		if _, ok := err.(*jira.ErrUnexpectedResponse); ok {
+			msg = NormalizeJiraError(msg)
+		}
+		printJSONError(code, msg, err)
+		os.Exit(code)
+	}
+
 	var msg string
 
 	if e, ok := err.(*jira.ErrUnexpectedResponse); ok {
@@ -47,7 +61,15 @@ func ExitIfError(err error) {
 	}
 
 	fmt.Fprintf(os.Stderr, "%s\n", msg)
-	os.Exit(1)
+	os.Exit(code)
+}
+
+// noSpinner disables the spinners displayed by Info.
+var noSpinner bool
+
+// DisableSpinner disables the spinners displayed by Info, eg: in plain mode.
+func DisableSpinner() {
+	noSpinner = true
 }
 
 // Info displays spinner.
@@ -61,11 +83,24 @@ func Info(msg string) *spinner.Spinner {
 		spinner.WithHiddenCursor(true),
 		spinner.WithWriter(color.Error),
 	)
-	s.Start()
+	if !noSpinner {
+		s.Start()
+	}
 
 	return s
 }
 
+// InfoProgress returns a func that appends the progress of a task to the message of the
+// spinner, eg: Fetching issues... 100/250. It is safe to be called concurrently.
+func InfoProgress(s *spinner.Spinner, msg string) func(done, total int) {
+	return func(done, total int) {
+		s.Lock()
+		defer s.Unlock()
+
+		s.Suffix = fmt.Sprintf(" %s %d/%d", msg, done, total)
+	}
+}
+
 // Success prints success message in stdout.
 func Success(msg string, args ...interface{}) {
 	fmt.Fprintf(os.Stdout, fmt.Sprintf("\n\u001B[0;32m✓\u001B[0m %s\n", msg), args...)
@@ -83,8 +118,17 @@ func Fail(msg string, args ...interface{}) {
 
 // Failed prints failure message in stderr and exits.
 func Failed(msg string, args ...interface{}) {
-	Fail(msg, args...)
-	os.Exit(1)
+	FailedWithCode(ExitCodeError, msg, args...)
+}
+
+// FailedWithCode prints failure message in stderr and exits with the given exit code.
+func FailedWithCode(code int, msg string, args ...interface{}) {
+	if jsonErrors {
+		printJSONError(code, fmt.Sprintf(msg, args...), nil)
+	} else {
+		Fail(msg, args...)
+	}
+	os.Exit(code)
 }
 
 // Navigate navigates to jira issue.
@@ -127,6 +171,15 @@ func StdinHasData() bool {
 	return true
 }
 
+// StdoutIsTerminal checks if standard output is a terminal, ie: not piped or redirected.
+func StdoutIsTerminal() bool {
+	fi, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
 // ReadFile reads contents of the given file.
 func ReadFile(filePath string) ([]byte, error) {
 	if filePath != "-" && filePath != "" {
@@ -140,6 +193,47 @@ func ReadFile(filePath string) ([]byte, error) {
 	return []byte(""), nil
 }
 
+// CreateUniqueFile creates a new file with the given name in the dir. A number is added
+// to the name, eg: report (1).pdf, if the file already exists so that no file is overwritten.
+// Any directory part of the name is ignored.
+func CreateUniqueFile(dir, name string) (*os.File, error) {
+	name = filepath.Base(filepath.Clean("/" + name))
+	if name == "/" || name == "." {
+		return nil, fmt.Errorf("invalid file name")
+	}
+
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+
+	for i := 0; ; i++ {
+		fn := name
+		if i > 0 {
+			fn = fmt.Sprintf("%s (%d)%s", base, i, ext)
+		}
+		f, err := os.OpenFile(filepath.Join(dir, fn), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o644)
+		if os.IsExist(err) {
+			continue
+		}
+		return f, err
+	}
+}
+
+// FormatFileSize formats size in bytes to a human readable format, eg: 1.5 MB.
+func FormatFileSize(size int) string {
+	const unit = 1024
+
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := unit, 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
 // GetJiraIssueKey constructs actual issue key based on given key.
 func GetJiraIssueKey(project, key string) string {
 	if project == "" {
@@ -151,6 +245,53 @@ func GetJiraIssueKey(project, key string) string {
 	return fmt.Sprintf("%s-%s", project, key)
 }
 
+var issueKeyRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[0-9]+$`)
+
+// GetJiraIssueKeys constructs actual issue keys from comma separated keys. Keys are read from
+// the standard input if the value is "-", so that the output of issue list can be piped.
+func GetJiraIssueKeys(project, keys string) ([]string, error) {
+	if keys == "-" {
+		defer func() { _ = os.Stdin.Close() }()
+		return readJiraIssueKeys(project, os.Stdin)
+	}
+
+	var out []string
+	for _, k := range strings.Split(keys, ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			out = append(out, GetJiraIssueKey(project, k))
+		}
+	}
+	return out, nil
+}
+
+// readJiraIssueKeys reads issue keys from the first column of each line. If the first column
+// isn't an issue key, the first full issue key in the line is used instead so that the default
+// output of issue list, where the key follows the issue type, can be piped as is. Lines without
+// an issue key, eg: table header, are skipped.
+func readJiraIssueKeys(project string, r io.Reader) ([]string, error) {
+	var out []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if key := GetJiraIssueKey(project, fields[0]); issueKeyRegex.MatchString(key) {
+			out = append(out, key)
+			continue
+		}
+		for _, f := range fields[1:] {
+			if issueKeyRegex.MatchString(f) {
+				out = append(out, f)
+				break
+			}
+		}
+	}
+
+	return out, scanner.Err()
+}
+
 // NormalizeJiraError normalizes error message we receive from jira.
 func NormalizeJiraError(msg string) string {
 	msg = strings.TrimSpace(strings.Replace(msg, "Error:\n", "", 1))
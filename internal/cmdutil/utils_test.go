@@ -2,6 +2,8 @@ package cmdutil
 
 import (
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -168,6 +170,26 @@ func TestNormalizeJiraError(t *testing.T) {
 	}
 }
 
+func TestGetJiraIssueKeys(t *testing.T) {
+	t.Parallel()
+
+	keys, err := GetJiraIssueKeys("ANK", "ANK-1, 2,,POK-3")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ANK-1", "ANK-2", "POK-3"}, keys)
+
+	input := "KEY\tSUMMARY\nANK-1\tFirst issue\n\n  POK-2  Second issue\n3\nnot a key\n"
+
+	keys, err = readJiraIssueKeys("ANK", strings.NewReader(input))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ANK-1", "POK-2", "ANK-3"}, keys)
+
+	input = "TYPE\tKEY\tSUMMARY\tSTATUS\nBug\tANK-1\tFix ANK-5\tTo Do\nNew Feature\tANK-2\tSecond issue\tDone\n"
+
+	keys, err = readJiraIssueKeys("ANK", strings.NewReader(input))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ANK-1", "ANK-2"}, keys)
+}
+
 func TestGetSubtaskHandle(t *testing.T) {
 	t.Parallel()
 
@@ -235,3 +257,43 @@ func TestGetSubtaskHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateUniqueFile(t *testing.T) {
+	dir := t.TempDir()
+
+	names := []string{"report.pdf", "report.pdf", "../report.pdf", "report.pdf"}
+	expected := []string{"report.pdf", "report (1).pdf", "report (2).pdf", "report (3).pdf"}
+
+	for i, name := range names {
+		f, err := CreateUniqueFile(dir, name)
+		assert.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, expected[i]), f.Name())
+		assert.NoError(t, f.Close())
+	}
+
+	f, err := CreateUniqueFile(dir, "README")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "README"), f.Name())
+	assert.NoError(t, f.Close())
+
+	_, err = CreateUniqueFile(dir, "/")
+	assert.Error(t, err)
+}
+
+func TestFormatFileSize(t *testing.T) {
+	cases := []struct {
+		size     int
+		expected string
+	}{
+		{size: 0, expected: "0 B"},
+		{size: 1023, expected: "1023 B"},
+		{size: 1024, expected: "1.0 KB"},
+		{size: 1536, expected: "1.5 KB"},
+		{size: 5 * 1024 * 1024, expected: "5.0 MB"},
+		{size: 3 * 1024 * 1024 * 1024, expected: "3.0 GB"},
+	}
+
+	for _, tc := range cases {
+		assert.Equal(t, tc.expected, FormatFileSize(tc.size))
+	}
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/shlex"
+	"github.com/spf13/viper"
+)
+
+// AliasesKey is the config key of the user-defined command aliases.
+const AliasesKey = "aliases"
+
+var aliasArgRegex = regexp.MustCompile(`\$(\d+)`)
+
+// Aliases returns the command aliases in the config.
+func Aliases() map[string]string {
+	return viper.GetStringMapString(AliasesKey)
+}
+
+// SetAlias saves the command alias in the config file, replacing the existing one.
+func SetAlias(file, name, expansion string) error {
+	config := viper.New()
+	config.SetConfigFile(file)
+
+	if err := config.ReadInConfig(); err != nil {
+		return err
+	}
+	config.Set(AliasesKey+"."+strings.ToLower(name), expansion)
+
+	return config.WriteConfig()
+}
+
+// DeleteAlias removes the command alias from the config file.
+// It returns false if there is no such alias.
+func DeleteAlias(file, name string) (bool, error) {
+	config := viper.New()
+	config.SetConfigFile(file)
+
+	if err := config.ReadInConfig(); err != nil {
+		return false, err
+	}
+
+	// Keys can't be unset in viper, so the config is written from its settings without the alias.
+	settings := config.AllSettings()
+	aliases, ok := settings[AliasesKey].(map[string]interface{})
+	if !ok {
+		return false, nil
+	}
+	if _, ok := aliases[strings.ToLower(name)]; !ok {
+		return false, nil
+	}
+	delete(aliases, strings.ToLower(name))
+	if len(aliases) == 0 {
+		delete(settings, AliasesKey)
+	}
+
+	out := viper.New()
+	out.SetConfigFile(file)
+	if err := out.MergeConfigMap(settings); err != nil {
+		return false, err
+	}
+
+	return true, out.WriteConfig()
+}
+
+// ExpandAlias expands the alias with the arguments passed to it. Placeholders like $1 are
+// replaced with the argument at that position, and the arguments not used by any placeholder
+// are appended, eg: 'issue move $1 Done' with 'ISSUE-1 --web' expands to 'issue move ISSUE-1 Done --web'.
+func ExpandAlias(expansion string, args []string) ([]string, error) {
+	parts, err := shlex.Split(expansion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alias %q: %w", expansion, err)
+	}
+
+	used := make(map[int]bool)
+	for i, p := range parts {
+		var errArg error
+
+		parts[i] = aliasArgRegex.ReplaceAllStringFunc(p, func(m string) string {
+			n, _ := strconv.Atoi(m[1:])
+			if n < 1 || n > len(args) {
+				errArg = fmt.Errorf("not enough arguments for alias %q, %s is not passed", expansion, m)
+				return m
+			}
+			used[n] = true
+			return args[n-1]
+		})
+		if errArg != nil {
+			return nil, errArg
+		}
+	}
+
+	for i, a := range args {
+		if !used[i+1] {
+			parts = append(parts, a)
+		}
+	}
+	return parts, nil
+}
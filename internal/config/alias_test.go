@@ -0,0 +1,100 @@
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandAlias(t *testing.T) {
+	cases := []struct {
+		name      string
+		expansion string
+		args      []string
+		expected  []string
+		err       string
+	}{
+		{
+			name:      "it splits the expansion like a shell",
+			expansion: `issue list --jql "assignee = currentUser() AND updated >= -1d"`,
+			expected:  []string{"issue", "list", "--jql", "assignee = currentUser() AND updated >= -1d"},
+		},
+		{
+			name:      "it appends the arguments",
+			expansion: "issue list -s Done",
+			args:      []string{"--plain"},
+			expected:  []string{"issue", "list", "-s", "Done", "--plain"},
+		},
+		{
+			name:      "it substitutes the arguments and appends the rest",
+			expansion: "issue move $1 Done",
+			args:      []string{"TEST-1", "--web"},
+			expected:  []string{"issue", "move", "TEST-1", "Done", "--web"},
+		},
+		{
+			name:      "it substitutes the arguments within a part",
+			expansion: `issue list -q "assignee = $2 AND sprint = $1"`,
+			args:      []string{"42", "jane"},
+			expected:  []string{"issue", "list", "-q", "assignee = jane AND sprint = 42"},
+		},
+		{
+			name:      "it fails if an argument is missing",
+			expansion: "issue move $1 $2",
+			args:      []string{"TEST-1"},
+			err:       `not enough arguments for alias "issue move $1 $2", $2 is not passed`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ExpandAlias(tc.expansion, tc.args)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestSetAndDeleteAlias(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".config.yml")
+	assert.NoError(t, ioutil.WriteFile(file, []byte("server: https://test.atlassian.net\n"), 0o600))
+
+	assert.NoError(t, SetAlias(file, "standup", "issue list -a$(jira me)"))
+	assert.NoError(t, SetAlias(file, "Done", "issue move $1 Done"))
+
+	read := func() *viper.Viper {
+		v := viper.New()
+		v.SetConfigFile(file)
+		assert.NoError(t, v.ReadInConfig())
+		return v
+	}
+
+	v := read()
+	assert.Equal(t, "https://test.atlassian.net", v.GetString("server"))
+	assert.Equal(t, map[string]string{
+		"standup": "issue list -a$(jira me)",
+		"done":    "issue move $1 Done",
+	}, v.GetStringMapString(AliasesKey))
+
+	ok, err := DeleteAlias(file, "done")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = DeleteAlias(file, "done")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	v = read()
+	assert.Equal(t, "https://test.atlassian.net", v.GetString("server"))
+	assert.Equal(t, map[string]string{"standup": "issue list -a$(jira me)"}, v.GetStringMapString(AliasesKey))
+}
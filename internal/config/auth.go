@@ -0,0 +1,33 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/auth"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// AuthStore returns the store of the OAuth credentials.
+func AuthStore() (*auth.Store, error) {
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return nil, err
+	}
+	return auth.NewStore(filepath.Join(home, Dir)), nil
+}
+
+// SetAuthType saves the authentication type in the config file.
+func SetAuthType(file string, authType jira.AuthType) error {
+	config := viper.New()
+	config.SetConfigFile(file)
+
+	if err := config.ReadInConfig(); err != nil {
+		return err
+	}
+	config.Set("auth_type", string(authType))
+
+	return config.WriteConfig()
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// BoardKey is the config key of the default board of a project.
+func BoardKey(project string) string {
+	return fmt.Sprintf("boards.%s", strings.ToLower(project))
+}
+
+// SetDefaultBoard saves the board as the default board of the project in the config file.
+func SetDefaultBoard(file, project string, board *jira.Board) error {
+	config := viper.New()
+	config.SetConfigFile(file)
+
+	if err := config.ReadInConfig(); err != nil {
+		return err
+	}
+	config.Set(BoardKey(project), board)
+
+	return config.WriteConfig()
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestSetDefaultBoard(t *testing.T) {
+	dir, err := os.MkdirTemp("", "jira-cli")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	file := filepath.Join(dir, ".config.yml")
+	assert.NoError(t, os.WriteFile(file, []byte("server: https://test.atlassian.net\nboard:\n  id: 1\n"), 0o600))
+
+	assert.NoError(t, SetDefaultBoard(file, "TEST", &jira.Board{ID: 2, Name: "Board 2", Type: "scrum"}))
+	assert.NoError(t, SetDefaultBoard(file, "OTHER", &jira.Board{ID: 3, Name: "Board 3", Type: "kanban"}))
+
+	config := viper.New()
+	config.SetConfigFile(file)
+	assert.NoError(t, config.ReadInConfig())
+
+	assert.Equal(t, "https://test.atlassian.net", config.GetString("server"))
+	assert.Equal(t, 1, config.GetInt("board.id"))
+	assert.Equal(t, 2, config.GetInt(BoardKey("TEST")+".id"))
+	assert.Equal(t, "Board 2", config.GetString(BoardKey("test")+".name"))
+	assert.Equal(t, 3, config.GetInt(BoardKey("OTHER")+".id"))
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cache"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+)
+
+// CacheDir returns the directory where the responses of metadata endpoints are cached.
+func CacheDir() (string, error) {
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, Dir, cache.DirName), nil
+}
+
+// CacheTTL returns the duration the responses are cached for, configured with the cache.ttl key, eg: 12h.
+func CacheTTL() (time.Duration, error) {
+	ttl := viper.GetString("cache.ttl")
+	if ttl == "" {
+		return cache.DefaultTTL, nil
+	}
+	return time.ParseDuration(ttl)
+}
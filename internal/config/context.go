@@ -0,0 +1,17 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/internal/contexts"
+)
+
+// ContextStore returns the store of the named configs.
+func ContextStore() (*contexts.Store, error) {
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return nil, err
+	}
+	return contexts.NewStore(filepath.Join(home, Dir)), nil
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -55,6 +57,7 @@ type JiraCLIConfig struct {
 		issueTypes   []*jira.IssueType
 	}
 	insecure           bool
+	preset             Preset
 	jiraClient         *jira.Client
 	projectSuggestions []string
 	boardSuggestions   []string
@@ -62,6 +65,20 @@ type JiraCLIConfig struct {
 	boardsMap          map[string]*jira.Board
 }
 
+// Preset holds config values passed upfront, eg: with flags. The prompts of the values that are set are
+// skipped, so the config can be generated without prompts in containers and CI images.
+type Preset struct {
+	Installation string
+	Server       string
+	Login        string
+	AuthType     jira.AuthType
+	Project      string
+	// Board is a board name or id. No board is set if the project is preset but the board isn't.
+	Board string
+	// Force overwrites the existing config without asking.
+	Force bool
+}
+
 // JiraCLIConfigFunc decorates option for JiraCLIConfig.
 type JiraCLIConfigFunc func(*JiraCLIConfig)
 
@@ -86,16 +103,29 @@ func WithInsecureTLS(ins bool) JiraCLIConfigFunc {
 	}
 }
 
+// WithPreset is a functional opt to set config values upfront instead of prompting for them.
+func WithPreset(p Preset) JiraCLIConfigFunc {
+	return func(c *JiraCLIConfig) {
+		c.preset = p
+		c.value.authType = p.AuthType
+	}
+}
+
 // Generate generates the config file.
 func (c *JiraCLIConfig) Generate() (string, error) {
+	file, err := configFile()
+	if err != nil {
+		return "", err
+	}
+
 	ce := func() bool {
 		s := cmdutil.Info("Checking configuration...")
 		defer s.Stop()
 
-		return Exists(viper.ConfigFileUsed())
+		return Exists(file)
 	}()
 
-	if ce && !shallOverwrite() {
+	if ce && !c.preset.Force && !shallOverwrite() {
 		return "", ErrSkip
 	}
 	if err := c.configureInstallationType(); err != nil {
@@ -111,25 +141,43 @@ func (c *JiraCLIConfig) Generate() (string, error) {
 		return "", err
 	}
 
-	home, err := cmdutil.GetConfigHome()
-	if err != nil {
-		return "", err
-	}
-	cfgDir := fmt.Sprintf("%s/%s", home, Dir)
-
 	if err := func() error {
 		s := cmdutil.Info("Creating new configuration...")
 		defer s.Stop()
 
-		return create(cfgDir, fmt.Sprintf("%s.%s", FileName, FileType))
+		return create(filepath.Dir(file), filepath.Base(file))
 	}(); err != nil {
 		return "", err
 	}
 
-	return c.write(cfgDir)
+	return c.write(file)
+}
+
+// configFile returns the config file in use, eg: the config of the context in use,
+// or the default config file if there is none yet.
+func configFile() (string, error) {
+	if file := viper.ConfigFileUsed(); file != "" {
+		return file, nil
+	}
+
+	home, err := cmdutil.GetConfigHome()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, Dir, fmt.Sprintf("%s.%s", FileName, FileType)), nil
 }
 
 func (c *JiraCLIConfig) configureInstallationType() error {
+	if c.preset.Installation != "" {
+		for _, it := range []string{jira.InstallationTypeCloud, jira.InstallationTypeLocal} {
+			if strings.EqualFold(c.preset.Installation, it) {
+				c.value.installation = it
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid installation type %q, use Cloud or Local", c.preset.Installation)
+	}
+
 	qs := &survey.Select{
 		Message: "Installation type:",
 		Help:    "Is this a cloud installation or an on-premise (local) installation.",
@@ -148,95 +196,118 @@ func (c *JiraCLIConfig) configureInstallationType() error {
 }
 
 func (c *JiraCLIConfig) configureServerAndLoginDetails() error {
-	qs := []*survey.Question{
-		{
+	var qs []*survey.Question
+
+	if c.preset.Server == "" {
+		qs = append(qs, &survey.Question{
 			Name: "server",
 			Prompt: &survey.Input{
 				Message: "Link to Jira server:",
 				Help:    "This is a link to your jira server, eg: https://company.atlassian.net",
 			},
-			Validate: func(val interface{}) error {
-				errInvalidURL := fmt.Errorf("not a valid URL")
+			Validate: validateServer,
+		})
+	} else if err := validateServer(c.preset.Server); err != nil {
+		return fmt.Errorf("server %q is %s", c.preset.Server, err)
+	}
 
-				str, ok := val.(string)
-				if !ok {
-					return errInvalidURL
-				}
-				u, err := url.Parse(str)
-				if err != nil || u.Scheme == "" || u.Host == "" {
-					return errInvalidURL
-				}
-				if u.Scheme != "http" && u.Scheme != "https" {
-					return errInvalidURL
-				}
+	validateLogin := validateUser
+	if c.value.installation == jira.InstallationTypeCloud {
+		validateLogin = validateEmail
+	}
+	if c.preset.Login == "" {
+		if c.value.installation == jira.InstallationTypeCloud {
+			qs = append(qs, &survey.Question{
+				Name: "login",
+				Prompt: &survey.Input{
+					Message: "Login email:",
+					Help:    "This is the email you use to login to your jira account.",
+				},
+				Validate: validateLogin,
+			})
+		} else if c.value.installation == jira.InstallationTypeLocal {
+			qs = append(qs, &survey.Question{
+				Name: "login",
+				Prompt: &survey.Input{
+					Message: "Login username:",
+					Help:    "This is the username you use to login to your jira account.",
+				},
+				Validate: validateLogin,
+			})
+		}
+	} else if err := validateLogin(c.preset.Login); err != nil {
+		return fmt.Errorf("login %q is %s", c.preset.Login, err)
+	}
 
-				return nil
-			},
-		},
+	ans := struct {
+		Server string
+		Login  string
+	}{
+		Server: c.preset.Server,
+		Login:  c.preset.Login,
 	}
 
-	if c.value.installation == jira.InstallationTypeCloud {
-		qs = append(qs, &survey.Question{
-			Name: "login",
-			Prompt: &survey.Input{
-				Message: "Login email:",
-				Help:    "This is the email you use to login to your jira account.",
-			},
-			Validate: func(val interface{}) error {
-				var (
-					emailRegex = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+\\/=?^_`{|}~-]+@[a-zA-Z0-9]" +
-						"(?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
+	if len(qs) > 0 {
+		if err := survey.Ask(qs, &ans); err != nil {
+			return err
+		}
+	}
 
-					errInvalidEmail = fmt.Errorf("not a valid email")
-				)
+	return c.verifyLoginDetails(ans.Server, ans.Login)
+}
 
-				str, ok := val.(string)
-				if !ok {
-					return errInvalidEmail
-				}
-				if len(str) < 3 || len(str) > 254 {
-					return errInvalidEmail
-				}
-				if !emailRegex.MatchString(str) {
-					return errInvalidEmail
-				}
+func validateServer(val interface{}) error {
+	errInvalidURL := fmt.Errorf("not a valid URL")
 
-				return nil
-			},
-		})
-	} else if c.value.installation == jira.InstallationTypeLocal {
-		qs = append(qs, &survey.Question{
-			Name: "login",
-			Prompt: &survey.Input{
-				Message: "Login username:",
-				Help:    "This is the username you use to login to your jira account.",
-			},
-			Validate: func(val interface{}) error {
-				errInvalidUser := fmt.Errorf("not a valid user")
+	str, ok := val.(string)
+	if !ok {
+		return errInvalidURL
+	}
+	u, err := url.Parse(str)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return errInvalidURL
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errInvalidURL
+	}
 
-				str, ok := val.(string)
-				if !ok {
-					return errInvalidUser
-				}
-				if len(str) < 3 || len(str) > 254 {
-					return errInvalidUser
-				}
+	return nil
+}
 
-				return nil
-			},
-		})
+func validateEmail(val interface{}) error {
+	var (
+		emailRegex = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+\\/=?^_`{|}~-]+@[a-zA-Z0-9]" +
+			"(?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
+
+		errInvalidEmail = fmt.Errorf("not a valid email")
+	)
+
+	str, ok := val.(string)
+	if !ok {
+		return errInvalidEmail
+	}
+	if len(str) < 3 || len(str) > 254 {
+		return errInvalidEmail
+	}
+	if !emailRegex.MatchString(str) {
+		return errInvalidEmail
 	}
 
-	ans := struct {
-		Server string
-		Login  string
-	}{}
+	return nil
+}
 
-	if err := survey.Ask(qs, &ans); err != nil {
-		return err
+func validateUser(val interface{}) error {
+	errInvalidUser := fmt.Errorf("not a valid user")
+
+	str, ok := val.(string)
+	if !ok {
+		return errInvalidUser
+	}
+	if len(str) < 3 || len(str) > 254 {
+		return errInvalidUser
 	}
 
-	return c.verifyLoginDetails(ans.Server, ans.Login)
+	return nil
 }
 
 func (c *JiraCLIConfig) verifyLoginDetails(server, login string) error {
@@ -245,6 +316,24 @@ func (c *JiraCLIConfig) verifyLoginDetails(server, login string) error {
 
 	server = strings.TrimRight(server, "/")
 
+	if c.value.authType == "" {
+		c.value.authType = jira.AuthType(viper.GetString("auth_type"))
+	}
+	// Jira Server and Data Center take both passwords with basic auth and
+	// Personal Access Tokens with bearer auth, so the token is tried with both.
+	if c.value.authType == "" && c.value.installation == jira.InstallationTypeLocal {
+		at, err := api.DetectAuthType(jira.Config{
+			Server:   server,
+			Login:    login,
+			Insecure: c.insecure,
+			Debug:    viper.GetBool("debug"),
+		})
+		if err != nil {
+			return err
+		}
+		c.value.authType = at
+	}
+
 	c.jiraClient = api.Client(jira.Config{
 		Server:   server,
 		Login:    login,
@@ -270,6 +359,9 @@ func (c *JiraCLIConfig) configureProjectAndBoardDetails() error {
 	if err := c.getProjectSuggestions(); err != nil {
 		return err
 	}
+	if c.preset.Project != "" {
+		return c.configurePresetProjectAndBoard()
+	}
 	projectPrompt := survey.Select{
 		Message: "Default project:",
 		Help:    "This is your project key that you want to access by default when using the cli.",
@@ -333,6 +425,43 @@ func (c *JiraCLIConfig) configureProjectAndBoardDetails() error {
 	return nil
 }
 
+// configurePresetProjectAndBoard sets the preset project and board. The board is
+// looked up by its name or id in the boards of the project.
+func (c *JiraCLIConfig) configurePresetProjectAndBoard() error {
+	for key, p := range c.projectsMap {
+		if strings.EqualFold(key, c.preset.Project) {
+			c.value.project = p
+		}
+	}
+	if c.value.project == nil {
+		return fmt.Errorf("project %q not found", c.preset.Project)
+	}
+	if c.preset.Board == "" {
+		return nil
+	}
+
+	s := cmdutil.Info(fmt.Sprintf("Fetching boards for project '%s'...", c.value.project.Key))
+	defer s.Stop()
+
+	resp, err := c.jiraClient.Boards(c.value.project.Key, "")
+	if err != nil {
+		return err
+	}
+	boards := resp.Boards
+
+	// Only the first page of the boards is fetched, so the board is also searched by its name.
+	if found, err := c.jiraClient.BoardSearch(c.value.project.Key, url.QueryEscape(c.preset.Board)); err == nil {
+		boards = append(boards, found.Boards...)
+	}
+	for _, b := range boards {
+		if strings.EqualFold(b.Name, c.preset.Board) || strconv.Itoa(b.ID) == c.preset.Board {
+			c.value.board = b
+			return nil
+		}
+	}
+	return fmt.Errorf("board %q not found in project %q", c.preset.Board, c.value.project.Key)
+}
+
 func (*JiraCLIConfig) getSearchKeyword() (string, error) {
 	var ans string
 
@@ -456,15 +585,28 @@ func (c *JiraCLIConfig) decipherEpicMeta(epicMeta map[string]interface{}) (strin
 	return epicName, epicLink
 }
 
-func (c *JiraCLIConfig) write(path string) (string, error) {
+func (c *JiraCLIConfig) write(file string) (string, error) {
 	config := viper.New()
-	config.AddConfigPath(path)
-	config.SetConfigName(FileName)
+	config.SetConfigFile(file)
 	config.SetConfigType(FileType)
 
 	if c.insecure {
 		config.Set("insecure", c.insecure)
 	}
+	// Basic auth is used by default.
+	if c.value.authType != "" && c.value.authType != jira.AuthTypeBasic {
+		config.Set("auth_type", string(c.value.authType))
+	}
+	// Certificates passed with the flags are needed by all commands, paths are
+	// saved as absolute paths so that commands can be run from any directory.
+	for _, k := range []string{"tls.ca_cert", "tls.client_cert", "tls.client_key"} {
+		if file := viper.GetString(k); file != "" {
+			if abs, err := filepath.Abs(file); err == nil {
+				file = abs
+			}
+			config.Set(k, file)
+		}
+	}
 
 	config.Set("installation", c.value.installation)
 	config.Set("server", c.value.server)
@@ -479,10 +621,19 @@ func (c *JiraCLIConfig) write(path string) (string, error) {
 		config.Set("board", "")
 	}
 
+	// Keep default boards of other projects from the existing config.
+	boards := viper.GetStringMap("boards")
+	if c.value.board != nil {
+		boards[strings.ToLower(c.value.project.Key)] = c.value.board
+	}
+	if len(boards) > 0 {
+		config.Set("boards", boards)
+	}
+
 	if err := config.WriteConfig(); err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("%s/%s.%s", path, FileName, FileType), nil
+	return file, nil
 }
 
 func (c *JiraCLIConfig) getProjectSuggestions() error {
@@ -67,3 +67,30 @@ func TestCreate(t *testing.T) {
 	assert.NoError(t, os.Remove(path+file+".bkp"))
 	assert.NoError(t, os.Remove(path))
 }
+
+func TestPresetInstallationType(t *testing.T) {
+	c := NewJiraCLIConfig(WithPreset(Preset{Installation: "cloud"}))
+	assert.NoError(t, c.configureInstallationType())
+	assert.Equal(t, "Cloud", c.value.installation)
+
+	c = NewJiraCLIConfig(WithPreset(Preset{Installation: "Local"}))
+	assert.NoError(t, c.configureInstallationType())
+	assert.Equal(t, "Local", c.value.installation)
+
+	c = NewJiraCLIConfig(WithPreset(Preset{Installation: "onprem"}))
+	assert.EqualError(t, c.configureInstallationType(), `invalid installation type "onprem", use Cloud or Local`)
+}
+
+func TestPresetServerAndLogin(t *testing.T) {
+	c := NewJiraCLIConfig(WithPreset(Preset{
+		Installation: "Cloud",
+		Server:       "company.atlassian.net",
+		Login:        "jane@company.com",
+	}))
+	assert.NoError(t, c.configureInstallationType())
+	assert.EqualError(t, c.configureServerAndLoginDetails(), `server "company.atlassian.net" is not a valid URL`)
+
+	c = NewJiraCLIConfig(WithPreset(Preset{Installation: "Cloud", Server: "https://company.atlassian.net", Login: "jane"}))
+	assert.NoError(t, c.configureInstallationType())
+	assert.EqualError(t, c.configureServerAndLoginDetails(), `login "jane" is not a valid email`)
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+)
+
+// LocalFileName is the name of the per-directory config file, eg: in the root of a repository.
+const LocalFileName = ".jira.yml"
+
+// LocalConfig is the per-directory config. It only overrides the project, the board and the
+// default JQL, other keys like the server are ignored so that a config file checked into
+// a repository can't send the token elsewhere.
+type LocalConfig struct {
+	Path    string
+	Project string
+	Board   int
+	JQL     string
+}
+
+// FindLocal looks for the per-directory config file in the dir and its parents.
+// It returns an empty string if there is none.
+func FindLocal(dir string) string {
+	for {
+		path := filepath.Join(dir, LocalFileName)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// ReadLocal reads the per-directory config file found from the dir upwards.
+// It returns nil if there is none.
+func ReadLocal(dir string) (*LocalConfig, error) {
+	path := FindLocal(dir)
+	if path == "" {
+		return nil, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	return &LocalConfig{
+		Path:    path,
+		Project: v.GetString("project.key"),
+		Board:   v.GetInt("board.id"),
+		JQL:     v.GetString("issue.jql"),
+	}, nil
+}
+
+// Merge merges the project and the default JQL into the config, so that they
+// still give way to flags and env variables. The board is left to the caller
+// as its name needs to be resolved from the server.
+func (lc *LocalConfig) Merge() error {
+	m := make(map[string]interface{})
+	if lc.Project != "" {
+		m["project"] = map[string]interface{}{"key": lc.Project}
+	}
+	if lc.JQL != "" {
+		m["issue"] = map[string]interface{}{"jql": lc.JQL}
+	}
+	return viper.MergeConfigMap(m)
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadLocal(t *testing.T) {
+	dir := t.TempDir()
+	sub := filepath.Join(dir, "repo", "cmd", "app")
+	assert.NoError(t, os.MkdirAll(sub, 0o755))
+
+	lc, err := ReadLocal(sub)
+	assert.NoError(t, err)
+	assert.Nil(t, lc)
+
+	local := `server: https://attacker.example.com
+project:
+  key: REPO
+board:
+  id: 42
+issue:
+  jql: component = cli
+`
+	path := filepath.Join(dir, "repo", LocalFileName)
+	assert.NoError(t, ioutil.WriteFile(path, []byte(local), 0o600))
+
+	lc, err = ReadLocal(sub)
+	assert.NoError(t, err)
+	assert.Equal(t, &LocalConfig{Path: path, Project: "REPO", Board: 42, JQL: "component = cli"}, lc)
+}
+
+func TestLocalConfigMerge(t *testing.T) {
+	defer viper.Reset()
+
+	viper.SetConfigType("yaml")
+	assert.NoError(t, viper.MergeConfigMap(map[string]interface{}{
+		"server":  "https://test.atlassian.net",
+		"project": map[string]interface{}{"key": "TEST", "type": "classic"},
+		"issue":   map[string]interface{}{"types": []string{"Bug"}},
+	}))
+
+	lc := LocalConfig{Project: "REPO", JQL: "component = cli"}
+	assert.NoError(t, lc.Merge())
+
+	assert.Equal(t, "https://test.atlassian.net", viper.GetString("server"))
+	assert.Equal(t, "REPO", viper.GetString("project.key"))
+	assert.Equal(t, "classic", viper.GetString("project.type"))
+	assert.Equal(t, "component = cli", viper.GetString("issue.jql"))
+	assert.Equal(t, []string{"Bug"}, viper.GetStringSlice("issue.types"))
+}
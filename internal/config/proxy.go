@@ -0,0 +1,39 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/spf13/viper"
+)
+
+// ProxyURL returns the proxy configured with the proxy.url key, eg: http://proxy.example.com:3128,
+// along with the credentials in the proxy.username and proxy.password keys. The password can also
+// be set with the JIRA_PROXY_PASSWORD env. It returns nil if no proxy is configured.
+func ProxyURL() (*url.URL, error) {
+	raw := viper.GetString("proxy.url")
+	if raw == "" {
+		return nil, nil
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("%q is not a valid proxy url, eg: http://proxy.example.com:3128", raw)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("proxy scheme %q is not supported, use http, https or socks5", u.Scheme)
+	}
+
+	if username := viper.GetString("proxy.username"); username != "" {
+		password := viper.GetString("proxy.password")
+		if password == "" {
+			password = os.Getenv("JIRA_PROXY_PASSWORD")
+		}
+		u.User = url.UserPassword(username, password)
+	}
+
+	return u, nil
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/spf13/viper"
+)
+
+// ClientCertificate loads the client certificate for mutual TLS from the files in the tls.client_cert
+// and tls.client_key keys. The key is read from the certificate file if it is not set, for files that
+// contain both. It returns nil if no certificate is configured.
+func ClientCertificate() (*tls.Certificate, error) {
+	certFile := viper.GetString("tls.client_cert")
+	keyFile := viper.GetString("tls.client_key")
+
+	if certFile == "" {
+		if keyFile != "" {
+			return nil, fmt.Errorf("tls.client_key is set without tls.client_cert")
+		}
+		return nil, nil
+	}
+	if keyFile == "" {
+		keyFile = certFile
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+// CACertPool returns the system CAs along with the CAs in the file in the tls.ca_cert key, eg: a
+// corporate root certificate. It returns nil if no file is configured.
+func CACertPool() (*x509.CertPool, error) {
+	file := viper.GetString("tls.ca_cert")
+	if file == "" {
+		return nil, nil
+	}
+
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no PEM encoded certificates found in %s", file)
+	}
+	return pool, nil
+}
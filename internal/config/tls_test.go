@@ -0,0 +1,104 @@
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCertificate(t *testing.T) {
+	defer viper.Reset()
+
+	cert, err := ClientCertificate()
+	assert.NoError(t, err)
+	assert.Nil(t, cert)
+
+	dir := t.TempDir()
+	certPEM, keyPEM := selfSignedCert(t)
+
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	bothFile := filepath.Join(dir, "client.pem")
+	assert.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	assert.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+	assert.NoError(t, os.WriteFile(bothFile, append(certPEM, keyPEM...), 0o600))
+
+	viper.Set("tls.client_cert", certFile)
+	viper.Set("tls.client_key", keyFile)
+	cert, err = ClientCertificate()
+	assert.NoError(t, err)
+	assert.Len(t, cert.Certificate, 1)
+
+	// Key is read from the certificate file if it is not set.
+	viper.Set("tls.client_cert", bothFile)
+	viper.Set("tls.client_key", "")
+	cert, err = ClientCertificate()
+	assert.NoError(t, err)
+	assert.Len(t, cert.Certificate, 1)
+
+	viper.Set("tls.client_cert", certFile)
+	_, err = ClientCertificate()
+	assert.Error(t, err)
+
+	viper.Set("tls.client_cert", "")
+	viper.Set("tls.client_key", keyFile)
+	_, err = ClientCertificate()
+	assert.EqualError(t, err, "tls.client_key is set without tls.client_cert")
+}
+
+func TestCACertPool(t *testing.T) {
+	defer viper.Reset()
+
+	pool, err := CACertPool()
+	assert.NoError(t, err)
+	assert.Nil(t, pool)
+
+	dir := t.TempDir()
+	certPEM, _ := selfSignedCert(t)
+
+	caFile := filepath.Join(dir, "ca.crt")
+	assert.NoError(t, os.WriteFile(caFile, certPEM, 0o600))
+
+	viper.Set("tls.ca_cert", caFile)
+	pool, err = CACertPool()
+	assert.NoError(t, err)
+	assert.NotNil(t, pool)
+
+	invalidFile := filepath.Join(dir, "invalid.crt")
+	assert.NoError(t, os.WriteFile(invalidFile, []byte("invalid"), 0o600))
+
+	viper.Set("tls.ca_cert", invalidFile)
+	_, err = CACertPool()
+	assert.EqualError(t, err, "no PEM encoded certificates found in "+invalidFile)
+}
+
+func selfSignedCert(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tmpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "jira-cli"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
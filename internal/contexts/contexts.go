@@ -0,0 +1,176 @@
+// Package contexts manages named configs of Jira instances, eg: a work Cloud site and a client Data Center.
+package contexts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+const (
+	// FileName is the name of the file where contexts are persisted.
+	FileName = "contexts.json"
+	// Dir is the directory, relative to the store, configs of the contexts are saved in.
+	Dir = "contexts"
+)
+
+// ErrNoContext is returned when a context with the given name doesn't exist.
+var ErrNoContext = fmt.Errorf("context not found")
+
+var validName = regexp.MustCompile(`^[\w.-]+$`)
+
+// Context is a named config.
+type Context struct {
+	Name   string `json:"name"`
+	Config string `json:"config"`
+}
+
+type contexts struct {
+	Current  string     `json:"current,omitempty"`
+	Contexts []*Context `json:"contexts"`
+}
+
+// Store persists the contexts and the one in use.
+type Store struct {
+	dir string
+}
+
+// NewStore creates a contexts store in the given directory.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// All returns the contexts ordered by name.
+func (s *Store) All() ([]*Context, error) {
+	c, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	return c.Contexts, nil
+}
+
+// Get returns the context with the given name.
+func (s *Store) Get(name string) (*Context, error) {
+	c, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	for _, ctx := range c.Contexts {
+		if ctx.Name == name {
+			return ctx, nil
+		}
+	}
+	return nil, ErrNoContext
+}
+
+// Current returns the name of the context in use, empty if none is.
+func (s *Store) Current() (string, error) {
+	c, err := s.read()
+	if err != nil {
+		return "", err
+	}
+	return c.Current, nil
+}
+
+// Add saves a copy of the config file as the context with the given name, replacing
+// the existing context with the same name. The copy is kept in the contexts directory,
+// so that the config file can be regenerated for another instance.
+func (s *Store) Add(name, config string) (*Context, error) {
+	if !validName.MatchString(name) {
+		return nil, fmt.Errorf("invalid context name %q, use letters, digits, dots, dashes and underscores", name)
+	}
+
+	c, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := ioutil.ReadFile(config)
+	if err != nil {
+		return nil, err
+	}
+
+	ext := filepath.Ext(config)
+	if ext == "" {
+		ext = ".yml"
+	}
+	ctx := Context{Name: name, Config: filepath.Join(s.dir, Dir, name+ext)}
+
+	if err := os.MkdirAll(filepath.Dir(ctx.Config), 0o700); err != nil {
+		return nil, err
+	}
+	// The config is being replaced by itself if it is added again from the context.
+	if abs, err := filepath.Abs(config); err != nil || abs != ctx.Config {
+		if err := ioutil.WriteFile(ctx.Config, b, 0o600); err != nil {
+			return nil, err
+		}
+	}
+
+	all := []*Context{&ctx}
+	for _, x := range c.Contexts {
+		if x.Name != name {
+			all = append(all, x)
+		}
+	}
+	c.Contexts = all
+
+	return &ctx, s.write(c)
+}
+
+// Use sets the context with the given name as the one in use.
+// The default config is used again if the name is empty.
+func (s *Store) Use(name string) error {
+	c, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	if name != "" {
+		if _, err := s.Get(name); err != nil {
+			return err
+		}
+	}
+	c.Current = name
+
+	return s.write(c)
+}
+
+func (s *Store) read() (*contexts, error) {
+	var c contexts
+
+	b, err := ioutil.ReadFile(filepath.Join(s.dir, FileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &c, nil
+		}
+		return nil, err
+	}
+	if len(b) == 0 {
+		return &c, nil
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(c.Contexts, func(i, j int) bool {
+		return c.Contexts[i].Name < c.Contexts[j].Name
+	})
+	return &c, nil
+}
+
+func (s *Store) write(c *contexts) error {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(s.dir, FileName), b, 0o600)
+}
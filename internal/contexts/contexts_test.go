@@ -0,0 +1,69 @@
+package contexts
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	s := NewStore(filepath.Join(dir, ".jira"))
+
+	config := filepath.Join(dir, ".config.yml")
+	assert.NoError(t, ioutil.WriteFile(config, []byte("server: https://work.atlassian.net\n"), 0o600))
+
+	all, err := s.All()
+	assert.NoError(t, err)
+	assert.Empty(t, all)
+
+	work, err := s.Add("work", config)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, ".jira", Dir, "work.yml"), work.Config)
+
+	// Config is copied, so the original can be regenerated for another instance.
+	assert.NoError(t, ioutil.WriteFile(config, []byte("server: https://jira.client.com\n"), 0o600))
+	_, err = s.Add("client-dc", config)
+	assert.NoError(t, err)
+
+	b, err := ioutil.ReadFile(work.Config)
+	assert.NoError(t, err)
+	assert.Equal(t, "server: https://work.atlassian.net\n", string(b))
+
+	info, err := os.Stat(work.Config)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	all, err = s.All()
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+	assert.Equal(t, "client-dc", all[0].Name)
+	assert.Equal(t, "work", all[1].Name)
+
+	// Adding the config of a context again keeps it.
+	_, err = s.Add("work", work.Config)
+	assert.NoError(t, err)
+	b, err = ioutil.ReadFile(work.Config)
+	assert.NoError(t, err)
+	assert.Equal(t, "server: https://work.atlassian.net\n", string(b))
+
+	current, err := s.Current()
+	assert.NoError(t, err)
+	assert.Empty(t, current)
+
+	assert.NoError(t, s.Use("work"))
+	current, err = s.Current()
+	assert.NoError(t, err)
+	assert.Equal(t, "work", current)
+
+	assert.Equal(t, ErrNoContext, s.Use("sandbox"))
+
+	_, err = s.Get("sandbox")
+	assert.Equal(t, ErrNoContext, err)
+
+	_, err = s.Add("../work", config)
+	assert.Error(t, err)
+}
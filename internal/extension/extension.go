@@ -0,0 +1,58 @@
+// Package extension runs extensions of the tool, ie: executables named jira-<command> on the PATH.
+package extension
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"sort"
+
+	"github.com/cli/safeexec"
+)
+
+// Prefix is the prefix of the names of extension executables.
+const Prefix = "jira-"
+
+// Find returns the path of the executable of the extension for the command.
+// It returns an empty string if there is no such extension.
+func Find(command string) string {
+	path, err := safeexec.LookPath(Prefix + command)
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
+// Run runs the extension with the arguments and the standard streams of the tool. Variables
+// in env are added to the environment of the tool. It returns the exit code of the extension.
+func Run(path string, args []string, env map[string]string) (int, error) {
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), Environ(env)...)
+
+	err := cmd.Run()
+
+	var e *exec.ExitError
+	if errors.As(err, &e) {
+		return e.ExitCode(), nil
+	}
+	if err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
+
+// Environ formats the variables with non-empty values in key=value format, ordered by key.
+func Environ(env map[string]string) []string {
+	out := make([]string, 0, len(env))
+	for k, v := range env {
+		if v != "" {
+			out = append(out, k+"="+v)
+		}
+	}
+	sort.Strings(out)
+
+	return out
+}
@@ -0,0 +1,45 @@
+package extension
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindAndRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("extension is a shell script")
+	}
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out")
+	script := "#!/bin/sh\necho \"$JIRA_SERVER $*\" > " + out + "\nexit 3\n"
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "jira-hello"), []byte(script), 0o700))
+
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	assert.Empty(t, Find("unknown"))
+
+	path := Find("hello")
+	assert.Equal(t, filepath.Join(dir, "jira-hello"), path)
+
+	code, err := Run(path, []string{"world", "--plain"}, map[string]string{"JIRA_SERVER": "https://test.atlassian.net"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, code)
+
+	b, err := ioutil.ReadFile(out)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://test.atlassian.net world --plain\n", string(b))
+}
+
+func TestEnviron(t *testing.T) {
+	t.Parallel()
+
+	env := map[string]string{"JIRA_SERVER": "https://test.atlassian.net", "JIRA_API_TOKEN": "", "JIRA_LOGIN": "jane"}
+
+	assert.Equal(t, []string{"JIRA_LOGIN=jane", "JIRA_SERVER=https://test.atlassian.net"}, Environ(env))
+}
@@ -0,0 +1,158 @@
+// Package gitbranch names git branches after issues, creates them and installs
+// the hook that prefixes commit messages with the issue key in the branch name.
+package gitbranch
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/ankitpokhrel/jira-cli/internal/gitlog"
+)
+
+const (
+	// DefaultTemplate is the template of branch names used if none is configured.
+	DefaultTemplate = "{{.Type}}/{{.Key}}-{{.SummarySlug}}"
+
+	maxSlugLength = 50
+)
+
+var reNonAlnum = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+
+// Data holds the variables available in branch name templates.
+type Data struct {
+	Key         string
+	Project     string
+	Type        string
+	Summary     string
+	SummarySlug string
+}
+
+// NewData prepares the template variables of the issue. Type is the
+// issue type in lowercase with dashes, eg: sub-task for Sub-task.
+func NewData(key, issueType, summary string) *Data {
+	project := key
+	if i := strings.LastIndex(key, "-"); i > 0 {
+		project = key[:i]
+	}
+
+	return &Data{
+		Key:         key,
+		Project:     project,
+		Type:        Slug(issueType, maxSlugLength),
+		Summary:     summary,
+		SummarySlug: Slug(summary, maxSlugLength),
+	}
+}
+
+// Slug converts s to lowercase words separated by dashes, cut at a word boundary to at most max characters.
+func Slug(s string, max int) string {
+	slug := strings.Trim(reNonAlnum.ReplaceAllString(strings.ToLower(s), "-"), "-")
+	r := []rune(slug)
+	if len(r) <= max {
+		return slug
+	}
+
+	// The character after the cut is kept to tell if the last word is complete.
+	slug = string(r[:max+1])
+	if i := strings.LastIndex(slug, "-"); i > 0 {
+		return slug[:i]
+	}
+	return string(r[:max])
+}
+
+// Name executes the template with the data and validates the result as a branch name.
+func Name(tmpl string, data *Data) (string, error) {
+	t, err := template.New("branch").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("invalid branch template: %w", err)
+	}
+
+	var out bytes.Buffer
+	if err := t.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("invalid branch template: %w", err)
+	}
+
+	name := strings.Trim(out.String(), "-/")
+	if err := Validate(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// Validate checks the rules of git for branch names, see git check-ref-format.
+func Validate(name string) error {
+	invalid := name == "" || name == "@" ||
+		strings.HasPrefix(name, "-") || strings.HasSuffix(name, ".") || strings.HasSuffix(name, ".lock") ||
+		strings.Contains(name, "..") || strings.Contains(name, "//") || strings.Contains(name, "@{") ||
+		strings.Contains(name, "/.") || strings.HasPrefix(name, ".") ||
+		strings.ContainsAny(name, " ~^:?*[\\\x7f")
+
+	for _, r := range name {
+		if r < 0x20 {
+			invalid = true
+		}
+	}
+
+	if invalid {
+		return fmt.Errorf("invalid branch name %q", name)
+	}
+	return nil
+}
+
+// Checkout checks out the branch in the repository in dir, creating it from base if it
+// doesn't exist. The current HEAD is used if base is empty. It reports whether the branch
+// was created.
+func Checkout(dir, name, base string) (bool, error) {
+	if _, err := git(dir, "rev-parse", "--verify", "--quiet", "refs/heads/"+name); err == nil {
+		_, err := git(dir, "checkout", name)
+		return false, err
+	}
+
+	args := []string{"checkout", "-b", name}
+	if base != "" {
+		args = append(args, base)
+	}
+	if _, err := git(dir, args...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Current returns the name of the branch checked out in the repository in dir.
+func Current(dir string) (string, error) {
+	out, err := git(dir, "symbolic-ref", "--short", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// IssueKey returns the first issue key in the branch name, eg: ISSUE-1 for bug/ISSUE-1-fix-login.
+// It returns an empty string if the name doesn't have any.
+func IssueKey(branch string) string {
+	if keys := gitlog.IssueKeys(branch); len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+func git(dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git: %s", msg)
+		}
+		return "", err
+	}
+	return stdout.String(), nil
+}
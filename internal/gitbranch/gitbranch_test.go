@@ -0,0 +1,76 @@
+package gitbranch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlug(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "fix-login-on-safari-14", Slug("Fix login on Safari 14!", 50))
+	assert.Equal(t, "sub-task", Slug("Sub-task", 50))
+	assert.Equal(t, "über-café", Slug("  [Über] café ", 50))
+	assert.Equal(t, "users-can-t", Slug("Users can't reset their password", 14))
+	assert.Equal(t, "users-can-t", Slug("Users can't reset their password", 11))
+	assert.Equal(t, "passwor", Slug("Password", 7))
+	assert.Equal(t, "", Slug("???", 50))
+}
+
+func TestNewData(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, &Data{
+		Key:         "TEST-12",
+		Project:     "TEST",
+		Type:        "sub-task",
+		Summary:     "Fix login on Safari",
+		SummarySlug: "fix-login-on-safari",
+	}, NewData("TEST-12", "Sub-task", "Fix login on Safari"))
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+
+	data := NewData("TEST-12", "Bug", "Fix login on Safari")
+
+	name, err := Name(DefaultTemplate, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "bug/TEST-12-fix-login-on-safari", name)
+
+	name, err = Name("{{.Key}}", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-12", name)
+
+	// Trailing dash of an empty slug is trimmed.
+	name, err = Name("{{.Key}}-{{.SummarySlug}}", NewData("TEST-1", "Task", "???"))
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-1", name)
+
+	_, err = Name("{{.Unknown}}", data)
+	assert.Error(t, err)
+
+	_, err = Name("{{.Summary}}", data)
+	assert.EqualError(t, err, `invalid branch name "Fix login on Safari"`)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"TEST-1", "feature/TEST-1-login", "bug/sub/TEST-1"} {
+		assert.NoError(t, Validate(name), name)
+	}
+	for _, name := range []string{"", "@", "-TEST-1", "a..b", "a b", "a:b", "a~1", "a.lock", "a/.b", "a//b", "a@{1}"} {
+		assert.Error(t, Validate(name), name)
+	}
+}
+
+func TestIssueKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "TEST-12", IssueKey("bug/TEST-12-fix-login"))
+	assert.Equal(t, "TEST-12", IssueKey("TEST-12"))
+	assert.Equal(t, "", IssueKey("main"))
+	assert.Equal(t, "", IssueKey("bug/test-12-fix-login"))
+}
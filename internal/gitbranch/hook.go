@@ -0,0 +1,116 @@
+package gitbranch
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// HookName is the name of the git hook that prefixes commit messages with the issue key.
+	HookName = "prepare-commit-msg"
+
+	// DefaultHookFormat is the printf format of the commit message prefix, %s is the issue key.
+	DefaultHookFormat = "%s: "
+
+	hookMarker = "# Installed by jira-cli."
+)
+
+// ErrHookExists is returned when a hook not installed by the tool already exists.
+var ErrHookExists = fmt.Errorf("%s hook already exists", HookName)
+
+// ErrNoHook is returned when the hook installed by the tool is not found.
+var ErrNoHook = fmt.Errorf("no %s hook installed by jira-cli found", HookName)
+
+// The hook is a plain shell script, so that it works without the tool on the PATH. Merge,
+// squash and amended commits are left as is, as are messages that already mention the key.
+const hookScript = `#!/bin/sh
+` + hookMarker + ` Prefixes the commit message with the issue key in the branch name.
+
+case "$2" in
+merge | squash | commit) exit 0 ;;
+esac
+
+branch=$(git symbolic-ref --short HEAD 2>/dev/null) || exit 0
+key=$(printf '%%s' "$branch" | grep -oE '[A-Z][A-Z0-9]+-[0-9]+' | head -n 1)
+[ -n "$key" ] || exit 0
+
+grep -v '^#' "$1" | grep -qE "(^|[^A-Z0-9])$key([^0-9]|$)" && exit 0
+
+msg=$(cat "$1")
+printf '%s%%s\n' "$key" "$msg" >"$1"
+`
+
+// HookScript returns the hook script that prefixes commit messages using the printf format.
+func HookScript(format string) (string, error) {
+	if strings.Count(format, "%s") != 1 || strings.Count(strings.ReplaceAll(format, "%%", ""), "%") != 1 {
+		return "", fmt.Errorf("invalid format %q, expected exactly one %%s for the issue key", format)
+	}
+	if strings.ContainsAny(format, "'\\\n") {
+		return "", fmt.Errorf("invalid format %q, quotes, backslashes and new lines are not allowed", format)
+	}
+	return fmt.Sprintf(hookScript, format), nil
+}
+
+// HooksDir returns the directory of the hooks of the repository in dir. It respects
+// core.hooksPath and works in linked worktrees.
+func HooksDir(dir string) (string, error) {
+	out, err := git(dir, "rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+
+	path := strings.TrimSpace(out)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	return path, nil
+}
+
+// InstallHook writes the hook to the hooks directory. A hook installed by the tool is replaced,
+// other hooks are only replaced if force is set. It returns the path of the hook.
+func InstallHook(hooksDir, format string, force bool) (string, error) {
+	script, err := HookScript(format)
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(hooksDir, HookName)
+	if !force && exists(path) && !isOwnHook(path) {
+		return "", ErrHookExists
+	}
+
+	if err := os.MkdirAll(hooksDir, 0o755); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(path, []byte(script), 0o755); err != nil {
+		return "", err
+	}
+	// Mode of an existing file is not changed by WriteFile.
+	return path, os.Chmod(path, 0o755)
+}
+
+// UninstallHook removes the hook installed by the tool from the hooks directory.
+// It returns the path of the removed hook.
+func UninstallHook(hooksDir string) (string, error) {
+	path := filepath.Join(hooksDir, HookName)
+	if !isOwnHook(path) {
+		return "", ErrNoHook
+	}
+	return path, os.Remove(path)
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func isOwnHook(path string) bool {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(b), hookMarker)
+}
@@ -0,0 +1,71 @@
+package gitbranch
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHookScript(t *testing.T) {
+	t.Parallel()
+
+	script, err := HookScript(DefaultHookFormat)
+	assert.NoError(t, err)
+	assert.Contains(t, script, hookMarker)
+	assert.Contains(t, script, `printf '%s: %s\n' "$key" "$msg" >"$1"`)
+
+	script, err = HookScript("[%s] 100%% ")
+	assert.NoError(t, err)
+	assert.Contains(t, script, `printf '[%s] 100%% %s\n' "$key" "$msg" >"$1"`)
+
+	for _, format := range []string{"", "%s %s", "%d: ", "%s: %", "%s'; rm -rf /; '"} {
+		_, err := HookScript(format)
+		assert.Error(t, err, format)
+	}
+}
+
+func TestInstallHook(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "hooks")
+	hook := filepath.Join(dir, HookName)
+
+	path, err := InstallHook(dir, DefaultHookFormat, false)
+	assert.NoError(t, err)
+	assert.Equal(t, hook, path)
+
+	info, err := os.Stat(hook)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	// Hook installed by the tool is replaced.
+	_, err = InstallHook(dir, "%s ", false)
+	assert.NoError(t, err)
+
+	path, err = UninstallHook(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, hook, path)
+	assert.NoFileExists(t, hook)
+
+	_, err = UninstallHook(dir)
+	assert.Equal(t, ErrNoHook, err)
+
+	// Other hooks are only replaced with force.
+	assert.NoError(t, ioutil.WriteFile(hook, []byte("#!/bin/sh\nexit 0\n"), 0o600))
+
+	_, err = InstallHook(dir, DefaultHookFormat, false)
+	assert.Equal(t, ErrHookExists, err)
+
+	_, err = UninstallHook(dir)
+	assert.Equal(t, ErrNoHook, err)
+
+	_, err = InstallHook(dir, DefaultHookFormat, true)
+	assert.NoError(t, err)
+
+	info, err = os.Stat(hook)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+}
@@ -0,0 +1,250 @@
+// Package gitlog reconstructs time spent on issues from the git commit history.
+package gitlog
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	fieldSep  = "\x1f"
+	recordSep = "\x1e"
+	logFormat = "--format=%H" + fieldSep + "%at" + fieldSep + "%s" + fieldSep + "%b" + recordSep
+)
+
+var issueKeyRegex = regexp.MustCompile(`\b[A-Z][A-Z0-9]+-[1-9][0-9]*\b`)
+
+// Commit is a git commit.
+type Commit struct {
+	Hash    string
+	Time    time.Time
+	Subject string
+	Body    string
+}
+
+// Entry is time spent on an issue reconstructed from the commits.
+type Entry struct {
+	IssueKey  string
+	Started   time.Time
+	TimeSpent time.Duration
+	Commits   []*Commit
+}
+
+// Comment builds a worklog comment from the commit subjects.
+func (e *Entry) Comment() string {
+	lines := make([]string, 0, len(e.Commits))
+	for _, c := range e.Commits {
+		lines = append(lines, "- "+c.Subject)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Log returns commits of the given author since the given time, eg: yesterday.
+// The since value is passed as is to git, so anything git understands works.
+func Log(dir, since, author string) ([]*Commit, error) {
+	args := []string{"log", "--no-merges", logFormat}
+	if since != "" {
+		args = append(args, "--since="+since)
+	}
+	if author != "" {
+		args = append(args, "--author="+author)
+	}
+
+	out, err := git(dir, args...)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(out)
+}
+
+// Range returns commits of the given author reachable from HEAD but not from the given
+// revision, eg: origin/main, oldest first.
+func Range(dir, since, author string) ([]*Commit, error) {
+	if since == "" || strings.HasPrefix(since, "-") {
+		return nil, fmt.Errorf("gitlog: invalid revision %q", since)
+	}
+
+	args := []string{"log", "--no-merges", "--reverse", logFormat}
+	if author != "" {
+		args = append(args, "--author="+author)
+	}
+	args = append(args, since+"..HEAD", "--")
+
+	out, err := git(dir, args...)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(out)
+}
+
+// Noted returns the hashes of the commits that have a note in the given notes ref.
+func Noted(dir, ref string) (map[string]bool, error) {
+	out, err := git(dir, "notes", "--ref="+ref, "list")
+	if err != nil {
+		return nil, err
+	}
+
+	// Each line is the hash of the note followed by the hash of the commit.
+	noted := make(map[string]bool)
+	for _, line := range strings.Split(out, "\n") {
+		if f := strings.Fields(line); len(f) == 2 {
+			noted[f[1]] = true
+		}
+	}
+	return noted, nil
+}
+
+// Note returns the note of the commit in the given notes ref.
+func Note(dir, ref, hash string) (string, error) {
+	out, err := git(dir, "notes", "--ref="+ref, "show", hash)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// AddNote adds the note to the commit in the given notes ref, replacing the existing note.
+func AddNote(dir, ref, hash, note string) error {
+	_, err := git(dir, "notes", "--ref="+ref, "add", "--force", "--message="+note, hash)
+	return err
+}
+
+// CurrentBranch returns the name of the checked out branch.
+func CurrentBranch(dir string) (string, error) {
+	out, err := git(dir, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// UserEmail returns email of the configured git user.
+func UserEmail(dir string) (string, error) {
+	out, err := git(dir, "config", "user.email")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// Parse parses output of the git log command run with the package log format.
+func Parse(out string) ([]*Commit, error) {
+	var commits []*Commit
+
+	for _, rec := range strings.Split(out, recordSep) {
+		rec = strings.TrimLeft(rec, "\n")
+		if rec == "" {
+			continue
+		}
+
+		fields := strings.SplitN(rec, fieldSep, 4)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("gitlog: malformed log record %q", rec)
+		}
+		ts, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("gitlog: invalid commit time %q", fields[1])
+		}
+
+		commits = append(commits, &Commit{
+			Hash:    fields[0],
+			Time:    time.Unix(ts, 0),
+			Subject: fields[2],
+			Body:    strings.TrimSpace(fields[3]),
+		})
+	}
+
+	return commits, nil
+}
+
+// IssueKeys extracts unique issue keys from the text in the order they appear.
+func IssueKeys(text string) []string {
+	var keys []string
+
+	seen := make(map[string]bool)
+	for _, k := range issueKeyRegex.FindAllString(text, -1) {
+		if !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// Estimate estimates time spent on each issue per day from the commit timestamps.
+//
+// Time spent on a commit is the time elapsed since the previous commit. If the gap
+// exceeds maxGap, the commit is considered to be the first one of a work session and
+// is credited with firstCommit instead. Commits referencing multiple issues share
+// the time equally and commits without an issue key are credited to fallbackKey.
+// Entries are grouped by the issue and the day of the commit, so that the work of
+// each day is logged separately.
+func Estimate(commits []*Commit, fallbackKey string, maxGap, firstCommit time.Duration) []*Entry {
+	sorted := make([]*Commit, len(commits))
+	copy(sorted, commits)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	var (
+		entries []*Entry
+		prev    time.Time
+	)
+
+	type group struct{ key, day string }
+
+	byGroup := make(map[group]*Entry)
+	for i, c := range sorted {
+		spent := firstCommit
+		if gap := c.Time.Sub(prev); i > 0 && gap <= maxGap {
+			spent = gap
+		}
+		prev = c.Time
+
+		keys := IssueKeys(c.Subject + "\n" + c.Body)
+		if len(keys) == 0 && fallbackKey != "" {
+			keys = []string{fallbackKey}
+		}
+		if len(keys) == 0 {
+			continue
+		}
+
+		share := spent / time.Duration(len(keys))
+		for _, k := range keys {
+			g := group{key: k, day: c.Time.Format("2006-01-02")}
+			e, ok := byGroup[g]
+			if !ok {
+				e = &Entry{IssueKey: k, Started: c.Time.Add(-share)}
+				byGroup[g] = e
+				entries = append(entries, e)
+			}
+			e.TimeSpent += share
+			e.Commits = append(e.Commits, c)
+		}
+	}
+
+	return entries
+}
+
+func git(dir string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git: %s", msg)
+		}
+		return "", err
+	}
+	return stdout.String(), nil
+}
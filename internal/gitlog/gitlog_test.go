@@ -0,0 +1,89 @@
+package gitlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	out := "abc\x1f1644400000\x1fTEST-1 Add feature\x1fMore details\n\x1e\n" +
+		"def\x1f1644403600\x1fFix typo\x1f\x1e\n"
+
+	commits, err := Parse(out)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Commit{
+		{Hash: "abc", Time: time.Unix(1644400000, 0), Subject: "TEST-1 Add feature", Body: "More details"},
+		{Hash: "def", Time: time.Unix(1644403600, 0), Subject: "Fix typo", Body: ""},
+	}, commits)
+
+	_, err = Parse("abc\x1fnot-a-time\x1fsubject\x1f\x1e")
+	assert.Error(t, err)
+
+	_, err = Parse("abc\x1e")
+	assert.Error(t, err)
+}
+
+func TestIssueKeys(t *testing.T) {
+	assert.Equal(t, []string{"TEST-1", "PROJ2-22"}, IssueKeys("feature/TEST-1 fixes PROJ2-22 and TEST-1"))
+	assert.Empty(t, IssueKeys("utf-8 and test-1 and TEST-0"))
+}
+
+func TestEstimate(t *testing.T) {
+	start := time.Date(2022, 2, 2, 9, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+
+	commits := []*Commit{
+		{Hash: "4", Time: at(5 * time.Hour), Subject: "TEST-2 After lunch"},
+		{Hash: "1", Time: at(0), Subject: "TEST-1 Start"},
+		{Hash: "2", Time: at(45 * time.Minute), Subject: "Refactor"},
+		{Hash: "3", Time: at(65 * time.Minute), Subject: "TEST-1 TEST-2 Shared"},
+	}
+
+	entries := Estimate(commits, "TEST-1", 2*time.Hour, 30*time.Minute)
+	assert.Len(t, entries, 2)
+
+	assert.Equal(t, "TEST-1", entries[0].IssueKey)
+	assert.Equal(t, at(-30*time.Minute), entries[0].Started)
+	assert.Equal(t, 30*time.Minute+45*time.Minute+10*time.Minute, entries[0].TimeSpent)
+	assert.Len(t, entries[0].Commits, 3)
+	assert.Equal(t, "- TEST-1 Start\n- Refactor\n- TEST-1 TEST-2 Shared", entries[0].Comment())
+
+	assert.Equal(t, "TEST-2", entries[1].IssueKey)
+	assert.Equal(t, at(55*time.Minute), entries[1].Started)
+	assert.Equal(t, 10*time.Minute+30*time.Minute, entries[1].TimeSpent)
+
+	entries = Estimate(commits, "", 2*time.Hour, 30*time.Minute)
+	assert.Len(t, entries[0].Commits, 2)
+}
+
+func TestEstimateMultipleDays(t *testing.T) {
+	day1 := time.Date(2022, 2, 2, 9, 0, 0, 0, time.UTC)
+	day2 := time.Date(2022, 2, 3, 10, 0, 0, 0, time.UTC)
+
+	commits := []*Commit{
+		{Hash: "1", Time: day1, Subject: "TEST-1 Start"},
+		{Hash: "2", Time: day1.Add(time.Hour), Subject: "TEST-1 Continue"},
+		{Hash: "3", Time: day2, Subject: "TEST-1 Next day"},
+		{Hash: "4", Time: day2.Add(20 * time.Minute), Subject: "TEST-2 Other issue"},
+		{Hash: "5", Time: day2.Add(50 * time.Minute), Subject: "TEST-1 Finish"},
+	}
+
+	entries := Estimate(commits, "", 2*time.Hour, 30*time.Minute)
+	assert.Len(t, entries, 3)
+
+	assert.Equal(t, "TEST-1", entries[0].IssueKey)
+	assert.Equal(t, day1.Add(-30*time.Minute), entries[0].Started)
+	assert.Equal(t, 30*time.Minute+time.Hour, entries[0].TimeSpent)
+	assert.Equal(t, "- TEST-1 Start\n- TEST-1 Continue", entries[0].Comment())
+
+	assert.Equal(t, "TEST-1", entries[1].IssueKey)
+	assert.Equal(t, day2.Add(-30*time.Minute), entries[1].Started)
+	assert.Equal(t, 30*time.Minute+30*time.Minute, entries[1].TimeSpent)
+	assert.Equal(t, "- TEST-1 Next day\n- TEST-1 Finish", entries[1].Comment())
+
+	assert.Equal(t, "TEST-2", entries[2].IssueKey)
+	assert.Equal(t, day2, entries[2].Started)
+	assert.Equal(t, 20*time.Minute, entries[2].TimeSpent)
+}
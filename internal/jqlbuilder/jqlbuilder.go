@@ -0,0 +1,106 @@
+// Package jqlbuilder assembles JQL queries from the clauses picked in the interactive JQL builder.
+package jqlbuilder
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// ConjunctionAnd joins clauses that must all match.
+	ConjunctionAnd = "AND"
+	// ConjunctionOr joins clauses where either must match.
+	ConjunctionOr = "OR"
+)
+
+var functionRegex = regexp.MustCompile(`^\w+\(.*\)$`)
+
+// Clause is a condition of a JQL query, eg: status IN ("To Do", "In Progress").
+type Clause struct {
+	Conjunction string // Joins the clause with the previous one, ignored for the first clause.
+	Field       string
+	Operator    string
+	Values      []string
+}
+
+// String returns the clause in JQL.
+func (c Clause) String() string {
+	op := strings.ToUpper(c.Operator)
+
+	switch {
+	case TakesNoValue(op):
+		return fmt.Sprintf("%s %s", c.Field, op)
+	case TakesList(op):
+		values := make([]string, 0, len(c.Values))
+		for _, v := range c.Values {
+			values = append(values, Quote(v))
+		}
+		return fmt.Sprintf("%s %s (%s)", c.Field, op, strings.Join(values, ", "))
+	}
+
+	var value string
+	if len(c.Values) > 0 {
+		value = c.Values[0]
+	}
+	return fmt.Sprintf("%s %s %s", c.Field, op, Quote(value))
+}
+
+// Build joins the clauses and adds the order by clause if orderBy is not empty.
+func Build(clauses []Clause, orderBy, direction string) string {
+	var sb strings.Builder
+
+	for i, c := range clauses {
+		if i > 0 {
+			conj := c.Conjunction
+			if conj == "" {
+				conj = ConjunctionAnd
+			}
+			sb.WriteString(fmt.Sprintf(" %s ", conj))
+		}
+		sb.WriteString(c.String())
+	}
+
+	if orderBy != "" {
+		if sb.Len() > 0 {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(strings.TrimSpace(fmt.Sprintf("ORDER BY %s %s", orderBy, direction)))
+	}
+
+	return sb.String()
+}
+
+// TakesList tells if the operator compares the field against a list of values, eg: IN.
+func TakesList(op string) bool {
+	switch strings.ToUpper(op) {
+	case "IN", "NOT IN", "WAS IN", "WAS NOT IN":
+		return true
+	}
+	return false
+}
+
+// TakesEmpty tells if the operator only accepts EMPTY or NULL as value, eg: IS NOT.
+func TakesEmpty(op string) bool {
+	switch strings.ToUpper(op) {
+	case "IS", "IS NOT":
+		return true
+	}
+	return false
+}
+
+// TakesNoValue tells if the operator doesn't take a value, eg: CHANGED.
+func TakesNoValue(op string) bool {
+	return strings.EqualFold(op, "CHANGED")
+}
+
+// Quote wraps the value in double quotes unless it is a keyword or a function call.
+func Quote(v string) string {
+	switch {
+	case strings.EqualFold(v, "EMPTY"), strings.EqualFold(v, "NULL"):
+		return strings.ToUpper(v)
+	case functionRegex.MatchString(v):
+		return v
+	}
+	return fmt.Sprintf("%q", v)
+}
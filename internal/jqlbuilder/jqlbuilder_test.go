@@ -0,0 +1,69 @@
+package jqlbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClauseString(t *testing.T) {
+	cases := []struct {
+		name     string
+		clause   Clause
+		expected string
+	}{
+		{
+			name:     "single value",
+			clause:   Clause{Field: "status", Operator: "=", Values: []string{"In Progress"}},
+			expected: `status = "In Progress"`,
+		},
+		{
+			name:     "list of values",
+			clause:   Clause{Field: "priority", Operator: "not in", Values: []string{"Low", "Lowest"}},
+			expected: `priority NOT IN ("Low", "Lowest")`,
+		},
+		{
+			name:     "empty",
+			clause:   Clause{Field: "assignee", Operator: "is", Values: []string{"empty"}},
+			expected: `assignee IS EMPTY`,
+		},
+		{
+			name:     "function",
+			clause:   Clause{Field: "reporter", Operator: "=", Values: []string{"currentUser()"}},
+			expected: `reporter = currentUser()`,
+		},
+		{
+			name:     "custom field and quotes",
+			clause:   Clause{Field: `"Story Points"`, Operator: "~", Values: []string{`say "hi"`}},
+			expected: `"Story Points" ~ "say \"hi\""`,
+		},
+		{
+			name:     "no value",
+			clause:   Clause{Field: "status", Operator: "changed"},
+			expected: `status CHANGED`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.clause.String())
+		})
+	}
+}
+
+func TestBuild(t *testing.T) {
+	clauses := []Clause{
+		{Field: "project", Operator: "=", Values: []string{"TEST"}},
+		{Conjunction: ConjunctionAnd, Field: "status", Operator: "in", Values: []string{"To Do", "Done"}},
+		{Conjunction: ConjunctionOr, Field: "assignee", Operator: "=", Values: []string{"currentUser()"}},
+	}
+
+	assert.Equal(t,
+		`project = "TEST" AND status IN ("To Do", "Done") OR assignee = currentUser() ORDER BY created DESC`,
+		Build(clauses, "created", "DESC"),
+	)
+	assert.Equal(t, `project = "TEST"`, Build(clauses[:1], "", ""))
+	assert.Equal(t, "ORDER BY rank", Build(nil, "rank", ""))
+	assert.Equal(t, "", Build(nil, "", ""))
+}
@@ -0,0 +1,142 @@
+// Package pullrequest finds pull requests and merge requests to link to issues.
+package pullrequest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cli/safeexec"
+)
+
+// ErrNotFound is returned when no open pull request is found for the current branch.
+var ErrNotFound = fmt.Errorf("no pull request found for the current branch")
+
+var reNumber = regexp.MustCompile(`/(?:pull|pulls|merge_requests|pull-requests)/(\d+)(?:/|$)`)
+
+// PullRequest is a pull request, or a merge request in GitLab.
+type PullRequest struct {
+	URL    string
+	Title  string
+	Number int
+}
+
+// LinkTitle returns the title of the link to the pull request, eg: PR #12: Fix login.
+func (pr *PullRequest) LinkTitle() string {
+	prefix := "PR"
+	if pr.Number > 0 {
+		prefix = fmt.Sprintf("PR #%d", pr.Number)
+		if strings.Contains(pr.URL, "/merge_requests/") {
+			prefix = fmt.Sprintf("MR !%d", pr.Number)
+		}
+	}
+	if pr.Title == "" {
+		return prefix
+	}
+	return prefix + ": " + pr.Title
+}
+
+// FromURL creates a pull request from its URL. The number is read from the URL of
+// GitHub, GitLab and Bitbucket pull requests, and is 0 for other URLs.
+func FromURL(link string) (*PullRequest, error) {
+	u, err := url.ParseRequestURI(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf(
+			"invalid pull request URL %q, the URL must be absolute, eg: https://github.com/org/repo/pull/1", link,
+		)
+	}
+
+	pr := PullRequest{URL: link}
+	if m := reNumber.FindStringSubmatch(u.Path); m != nil {
+		pr.Number, _ = strconv.Atoi(m[1])
+	}
+	return &pr, nil
+}
+
+// Detect finds the open pull request of the current branch of the repository in dir
+// with the GitHub CLI, or the merge request with the GitLab CLI.
+func Detect(dir string) (*PullRequest, error) {
+	var errs []string
+
+	for _, tool := range []struct {
+		name  string
+		args  []string
+		parse func([]byte) (*PullRequest, error)
+	}{
+		{name: "gh", args: []string{"pr", "view", "--json", "url,title,number"}, parse: parseGH},
+		{name: "glab", args: []string{"mr", "view", "--output", "json"}, parse: parseGlab},
+	} {
+		path, err := safeexec.LookPath(tool.name)
+		if err != nil {
+			continue
+		}
+
+		out, err := run(dir, path, tool.args...)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", tool.name, err))
+			continue
+		}
+		return tool.parse(out)
+	}
+
+	if len(errs) == 0 {
+		return nil, fmt.Errorf("%w, install gh or glab to detect it or pass the URL", ErrNotFound)
+	}
+	return nil, fmt.Errorf("%w:\n  - %s", ErrNotFound, strings.Join(errs, "\n  - "))
+}
+
+func parseGH(b []byte) (*PullRequest, error) {
+	var out struct {
+		URL    string `json:"url"`
+		Title  string `json:"title"`
+		Number int    `json:"number"`
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, fmt.Errorf("gh: invalid output: %w", err)
+	}
+	if out.URL == "" {
+		return nil, ErrNotFound
+	}
+	return &PullRequest{URL: out.URL, Title: out.Title, Number: out.Number}, nil
+}
+
+func parseGlab(b []byte) (*PullRequest, error) {
+	var out struct {
+		WebURL string `json:"web_url"`
+		Title  string `json:"title"`
+		IID    int    `json:"iid"`
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, fmt.Errorf("glab: invalid output: %w", err)
+	}
+	if out.WebURL == "" {
+		return nil, ErrNotFound
+	}
+	return &PullRequest{URL: out.WebURL, Title: out.Title, Number: out.IID}, nil
+}
+
+func run(dir, path string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(path, args...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		var e *exec.ExitError
+		if errors.As(err, &e) {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return nil, errors.New(msg)
+			}
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
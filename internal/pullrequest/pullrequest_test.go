@@ -0,0 +1,64 @@
+package pullrequest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromURL(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		url      string
+		number   int
+		expected string
+	}{
+		{url: "https://github.com/org/repo/pull/12", number: 12, expected: "PR #12"},
+		{url: "https://github.com/org/repo/pull/12/files", number: 12, expected: "PR #12"},
+		{url: "https://gitlab.com/org/repo/-/merge_requests/7", number: 7, expected: "MR !7"},
+		{url: "https://bitbucket.org/org/repo/pull-requests/3", number: 3, expected: "PR #3"},
+		{url: "https://git.example.com/review/abc", expected: "PR"},
+	}
+
+	for _, tc := range cases {
+		pr, err := FromURL(tc.url)
+		assert.NoError(t, err, tc.url)
+		assert.Equal(t, tc.url, pr.URL)
+		assert.Equal(t, tc.number, pr.Number, tc.url)
+		assert.Equal(t, tc.expected, pr.LinkTitle(), tc.url)
+	}
+
+	for _, link := range []string{"", "github.com/org/repo/pull/1", "/org/repo/pull/1", "ftp://example.com/pull/1"} {
+		_, err := FromURL(link)
+		assert.Error(t, err, link)
+	}
+}
+
+func TestLinkTitle(t *testing.T) {
+	t.Parallel()
+
+	pr := PullRequest{URL: "https://github.com/org/repo/pull/12", Title: "Fix login", Number: 12}
+	assert.Equal(t, "PR #12: Fix login", pr.LinkTitle())
+
+	pr = PullRequest{URL: "https://gitlab.com/org/repo/-/merge_requests/7", Title: "Fix login", Number: 7}
+	assert.Equal(t, "MR !7: Fix login", pr.LinkTitle())
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	pr, err := parseGH([]byte(`{"number":12,"title":"Fix login","url":"https://github.com/org/repo/pull/12"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &PullRequest{URL: "https://github.com/org/repo/pull/12", Title: "Fix login", Number: 12}, pr)
+
+	pr, err = parseGlab([]byte(`{"iid":7,"title":"Fix login","web_url":"https://gitlab.com/org/repo/-/merge_requests/7"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &PullRequest{URL: "https://gitlab.com/org/repo/-/merge_requests/7", Title: "Fix login", Number: 7}, pr)
+
+	_, err = parseGH([]byte(`{}`))
+	assert.Equal(t, ErrNotFound, err)
+
+	_, err = parseGlab([]byte(`not json`))
+	assert.Error(t, err)
+}
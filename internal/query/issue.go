@@ -2,11 +2,16 @@ package query
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jql"
 )
 
+// conjunctionRegex matches a conjunction the raw JQL starts with, eg: AND labels = urgent.
+var conjunctionRegex = regexp.MustCompile(`(?is)^(AND|OR)\s+(.*)$`)
+
 // Issue is a query type for issue command.
 type Issue struct {
 	Project string
@@ -29,16 +34,16 @@ func NewIssue(project string, flags FlagParser) (*Issue, error) {
 
 // Get returns constructed jql query.
 func (i *Issue) Get() string {
-	q, obf := jql.NewJQL(i.Project), i.params.OrderBy
-	if obf == "created" &&
+	q, order := jql.NewJQL(i.Project), i.params.Order()
+	if len(order) == 1 && order[0].Field == "created" &&
 		(i.params.Updated != "" || i.params.UpdatedBefore != "" || i.params.UpdatedAfter != "") &&
 		(i.params.Created == "" && i.params.CreatedBefore == "" && i.params.CreatedAfter == "") {
-		obf = "updated"
+		order[0].Field = "updated"
 	}
 	q.And(func() {
 		if i.params.Latest {
 			q.History()
-			obf = "lastViewed"
+			order = []OrderKey{{Field: "lastViewed", Direction: order[0].Direction}}
 		}
 		if i.params.Watching {
 			q.Watching()
@@ -60,16 +65,24 @@ func (i *Issue) Get() string {
 			q.In("labels", i.params.Labels...)
 		}
 	})
-	if i.params.Reverse {
-		q.OrderBy(obf, jql.DirectionAscending)
-	} else {
-		q.OrderBy(obf, jql.DirectionDescending)
+	for _, k := range order {
+		q.ThenBy(k.jqlField(), k.Direction)
 	}
 	if i.params.debug {
 		fmt.Printf("JQL: %s\n", q.String())
 	}
 	if i.params.jql != "" {
-		q.And(func() { q.Raw(i.params.jql) })
+		conj, raw := splitConjunction(i.params.jql)
+		if conj == "OR" {
+			// AND takes precedence over OR, so both sides are grouped
+			// and scoped to the project for the query to stay within it.
+			scoped := jql.NewJQL(i.Project)
+			scoped.And(func() { scoped.Raw(raw) })
+
+			q.Group().Or(func() { q.Raw(scoped.Group().String()) })
+		} else {
+			q.And(func() { q.Raw(raw) })
+		}
 	}
 	return q.String()
 }
@@ -147,14 +160,22 @@ type IssueParams struct {
 	Labels        []string
 	OrderBy       string
 	Reverse       bool
+	Paginate      bool
 	Limit         uint
 	debug         bool
+	order         []OrderKey
+}
+
+// Order returns the fields to order the issues with, as passed
+// in the order-by flag with the reverse flag applied.
+func (ip *IssueParams) Order() []OrderKey {
+	return append([]OrderKey{}, ip.order...)
 }
 
 func (ip *IssueParams) init(flags FlagParser) error {
 	var err error
 
-	boolParams := []string{"history", "watching", "reverse", "debug"}
+	boolParams := []string{"history", "watching", "reverse", "paginate", "debug"}
 	stringParams := []string{
 		"resolution", "type", "parent", "status", "priority", "reporter", "assignee", "component",
 		"created", "created-after", "created-before", "updated", "updated-after", "updated-before",
@@ -188,8 +209,13 @@ func (ip *IssueParams) init(flags FlagParser) error {
 	ip.setStringParams(stringParamsMap)
 	ip.Labels = labels
 	ip.Limit = limit
+	if ip.Paginate {
+		// Limit of 0 fetches all issues.
+		ip.Limit = 0
+	}
 
-	return nil
+	ip.order, err = ParseOrderBy(ip.OrderBy, ip.Reverse)
+	return err
 }
 
 func (ip *IssueParams) setBoolParams(paramsMap map[string]bool) {
@@ -201,6 +227,8 @@ func (ip *IssueParams) setBoolParams(paramsMap map[string]bool) {
 			ip.Watching = v
 		case "reverse":
 			ip.Reverse = v
+		case "paginate":
+			ip.Paginate = v
 		case "debug":
 			ip.debug = v
 		}
@@ -246,6 +274,18 @@ func (ip *IssueParams) setStringParams(paramsMap map[string]string) {
 	}
 }
 
+// splitConjunction splits the conjunction the raw JQL starts with from the rest of the query,
+// so that the query can be appended to the implicit query of a command. Defaults to AND.
+func splitConjunction(raw string) (string, string) {
+	raw = strings.TrimSpace(raw)
+
+	m := conjunctionRegex.FindStringSubmatch(raw)
+	if m == nil {
+		return "AND", raw
+	}
+	return strings.ToUpper(m[1]), strings.TrimSpace(m[2])
+}
+
 func isValidDate(date string) (time.Time, string, bool) {
 	supportedFormats := []string{
 		"2006-01-02",
@@ -74,8 +74,11 @@ func (tfp *issueFlagParser) GetString(name string) (string, error) {
 	if name == "jql" {
 		return tfp.jql, nil
 	}
-	if tfp.orderBy == "" && name == "order-by" {
-		return "created", nil
+	if name == "order-by" {
+		if tfp.orderBy == "" {
+			return "created", nil
+		}
+		return tfp.orderBy, nil
 	}
 	if strings.HasPrefix(name, "created") {
 		if tfp.withCreated {
@@ -385,6 +388,21 @@ func TestIssueGet(t *testing.T) {
 				`AND parent="test" AND updatedDate>"2020-11-31" AND updatedDate<"2020-12-31" ` +
 				`ORDER BY updated ASC`,
 		},
+		{
+			name: "query with multiple order by fields",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{
+					noHistory: true,
+					orderDesc: true,
+					orderBy:   "priority asc, updated,story points DESC",
+				})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `project="TEST" AND issue IN watchedIssues() AND type="test" AND resolution="test" ` +
+				`AND status="test" AND priority="test" AND reporter="test" AND assignee="test" AND component="test" ` +
+				`AND parent="test" ORDER BY priority ASC, updated DESC, "story points" DESC`,
+		},
 		{
 			name: "query with jql parameter",
 			initialize: func() *Issue {
@@ -396,6 +414,29 @@ func TestIssueGet(t *testing.T) {
 				`type="test" AND resolution="test" AND status="test" AND priority="test" AND reporter="test" ` +
 				`AND assignee="test" AND component="test" AND parent="test" AND summary ~ cli OR x = y ORDER BY lastViewed ASC`,
 		},
+		{
+			name: "query with jql parameter starting with a conjunction",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{jql: " and labels = urgent"})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `project="TEST" AND issue IN issueHistory() AND issue IN watchedIssues() AND ` +
+				`type="test" AND resolution="test" AND status="test" AND priority="test" AND reporter="test" ` +
+				`AND assignee="test" AND component="test" AND parent="test" AND labels = urgent ORDER BY lastViewed ASC`,
+		},
+		{
+			name: "query with jql parameter starting with or",
+			initialize: func() *Issue {
+				i, err := NewIssue("TEST", &issueFlagParser{jql: "OR\tlabels = urgent"})
+				assert.NoError(t, err)
+				return i
+			},
+			expected: `(project="TEST" AND issue IN issueHistory() AND issue IN watchedIssues() AND ` +
+				`type="test" AND resolution="test" AND status="test" AND priority="test" AND reporter="test" ` +
+				`AND assignee="test" AND component="test" AND parent="test") OR (project="TEST" AND labels = urgent) ` +
+				`ORDER BY lastViewed ASC`,
+		},
 	}
 
 	for _, tc := range cases {
@@ -411,3 +452,44 @@ func TestIssueGet(t *testing.T) {
 		})
 	}
 }
+
+func TestParseOrderBy(t *testing.T) {
+	keys, err := ParseOrderBy("priority desc, updated asc, created", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []OrderKey{
+		{Field: "priority", Direction: "DESC"},
+		{Field: "updated", Direction: "ASC"},
+		{Field: "created", Direction: "DESC"},
+	}, keys)
+
+	keys, err = ParseOrderBy("Story Points DESC,key", true)
+	assert.NoError(t, err)
+	assert.Equal(t, []OrderKey{
+		{Field: "Story Points", Direction: "ASC"},
+		{Field: "key", Direction: "ASC"},
+	}, keys)
+
+	_, err = ParseOrderBy(" , ", false)
+	assert.Error(t, err)
+
+	_, err = NewIssue("TEST", &issueFlagParser{orderBy: ","})
+	assert.Error(t, err)
+}
+
+func TestSplitConjunction(t *testing.T) {
+	cases := []struct {
+		raw, conj, query string
+	}{
+		{"labels = urgent", "AND", "labels = urgent"},
+		{"AND labels = urgent", "AND", "labels = urgent"},
+		{" or labels = urgent ", "OR", "labels = urgent"},
+		{"ANDROID = yes", "AND", "ANDROID = yes"},
+		{"ordered = true", "AND", "ordered = true"},
+	}
+
+	for _, tc := range cases {
+		conj, query := splitConjunction(tc.raw)
+		assert.Equal(t, tc.conj, conj, tc.raw)
+		assert.Equal(t, tc.query, query, tc.raw)
+	}
+}
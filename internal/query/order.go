@@ -0,0 +1,61 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jql"
+)
+
+// OrderKey is a field to order issues with.
+type OrderKey struct {
+	Field     string
+	Direction string
+}
+
+// ParseOrderBy parses a comma separated list of fields with an optional direction,
+// eg: "priority desc, updated asc". Fields without a direction are ordered in the
+// descending order. The directions are flipped if reverse is set.
+func ParseOrderBy(orderBy string, reverse bool) ([]OrderKey, error) {
+	var keys []OrderKey
+
+	for _, part := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+
+		key := OrderKey{Field: strings.Join(fields, " "), Direction: jql.DirectionDescending}
+		if n := len(fields); n > 1 {
+			switch dir := strings.ToUpper(fields[n-1]); dir {
+			case jql.DirectionAscending, jql.DirectionDescending:
+				key.Field, key.Direction = strings.Join(fields[:n-1], " "), dir
+			}
+		}
+		if reverse {
+			key.Direction = flipDirection(key.Direction)
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("invalid order-by %q: no field to order with", orderBy)
+	}
+	return keys, nil
+}
+
+// jqlField returns the field as it is referenced in an ORDER BY clause.
+// Field names with spaces, eg: custom fields, are quoted.
+func (k OrderKey) jqlField() string {
+	if strings.Contains(k.Field, " ") {
+		return fmt.Sprintf("%q", k.Field)
+	}
+	return k.Field
+}
+
+func flipDirection(dir string) string {
+	if dir == jql.DirectionAscending {
+		return jql.DirectionDescending
+	}
+	return jql.DirectionAscending
+}
@@ -0,0 +1,184 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tempo"
+)
+
+// FileName is the name of the file where queued operations are persisted.
+const FileName = "queue.json"
+
+// Kinds of the operations that can be queued.
+const (
+	KindWorklog    = "worklog"
+	KindComment    = "comment"
+	KindTransition = "transition"
+)
+
+// ErrNoOperation is returned when an operation is not in the queue.
+var ErrNoOperation = fmt.Errorf("no queued operation found")
+
+// Operation is a change to an issue queued while Jira was unreachable. Only
+// the field of its kind is set, eg: Worklog for a worklog operation. Server
+// and Login are of the config the operation was queued with.
+type Operation struct {
+	ID         int         `json:"id"`
+	Kind       string      `json:"kind"`
+	IssueKey   string      `json:"issueKey"`
+	Server     string      `json:"server"`
+	Login      string      `json:"login"`
+	Queued     time.Time   `json:"queued"`
+	Worklog    *Worklog    `json:"worklog,omitempty"`
+	Comment    *Comment    `json:"comment,omitempty"`
+	Transition *Transition `json:"transition,omitempty"`
+}
+
+// Worklog is a queued worklog.
+type Worklog struct {
+	Comment        string                  `json:"comment,omitempty"`
+	Started        string                  `json:"started"`
+	TimeSpent      string                  `json:"timeSpent"`
+	AdjustEstimate string                  `json:"adjustEstimate,omitempty"`
+	Estimate       string                  `json:"estimate,omitempty"`
+	Visibility     *jira.WorklogVisibility `json:"visibility,omitempty"`
+	Attributes     []tempo.Attribute       `json:"attributes,omitempty"`
+}
+
+// Comment is a queued comment.
+type Comment struct {
+	Body     string `json:"body"`
+	Internal bool   `json:"internal,omitempty"`
+}
+
+// Transition is a queued transition. Fields are in name=value format.
+type Transition struct {
+	State      string   `json:"state"`
+	Resolution string   `json:"resolution,omitempty"`
+	Fields     []string `json:"fields,omitempty"`
+	Comment    string   `json:"comment,omitempty"`
+}
+
+// String describes the operation, eg: Log 2h on TEST-1.
+func (o *Operation) String() string {
+	switch o.Kind {
+	case KindWorklog:
+		return fmt.Sprintf("Log %s on %s", o.Worklog.TimeSpent, o.IssueKey)
+	case KindComment:
+		return fmt.Sprintf("Comment on %s", o.IssueKey)
+	case KindTransition:
+		return fmt.Sprintf("Transition %s to %q", o.IssueKey, o.Transition.State)
+	}
+	return fmt.Sprintf("Unknown %q operation on %s", o.Kind, o.IssueKey)
+}
+
+// IsFor tells if the operation was queued for the user on the server.
+// Operations queued without a server are not for any server.
+func (o *Operation) IsFor(server, login string) bool {
+	return o.Server != "" && o.Server == server && o.Login == login
+}
+
+// Store persists queued operations in a file until they are sent to Jira.
+type Store struct {
+	path string
+}
+
+// NewStore creates a queue store in the given directory.
+func NewStore(dir string) *Store {
+	return &Store{path: filepath.Join(dir, FileName)}
+}
+
+// All returns the queued operations in the order they were queued.
+func (s *Store) All() ([]*Operation, error) {
+	ops, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].ID < ops[j].ID
+	})
+	return ops, nil
+}
+
+// Add appends the operation to the queue.
+func (s *Store) Add(op *Operation) error {
+	ops, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	op.ID = 1
+	for _, o := range ops {
+		if o.ID >= op.ID {
+			op.ID = o.ID + 1
+		}
+	}
+	if op.Queued.IsZero() {
+		op.Queued = time.Now()
+	}
+
+	return s.write(append(ops, op))
+}
+
+// Remove removes the operation with the given id from the queue.
+func (s *Store) Remove(id int) error {
+	ops, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	for i, o := range ops {
+		if o.ID == id {
+			return s.write(append(ops[:i], ops[i+1:]...))
+		}
+	}
+	return ErrNoOperation
+}
+
+// Clear removes all operations from the queue.
+func (s *Store) Clear() error {
+	if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *Store) read() ([]*Operation, error) {
+	var ops []*Operation
+
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ops, nil
+		}
+		return nil, err
+	}
+	if len(b) == 0 {
+		return ops, nil
+	}
+	if err := json.Unmarshal(b, &ops); err != nil {
+		return nil, err
+	}
+
+	return ops, nil
+}
+
+func (s *Store) write(ops []*Operation) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(ops, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path, b, 0o600)
+}
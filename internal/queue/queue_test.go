@@ -0,0 +1,73 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationIsFor(t *testing.T) {
+	op := Operation{Server: "https://test.atlassian.net", Login: "jane@domain.tld"}
+
+	assert.True(t, op.IsFor("https://test.atlassian.net", "jane@domain.tld"))
+	assert.False(t, op.IsFor("https://test.atlassian.net", "jon@domain.tld"))
+	assert.False(t, op.IsFor("https://jira.domain.tld", "jane@domain.tld"))
+	assert.False(t, (&Operation{}).IsFor("", ""))
+}
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir)
+
+	ops, err := store.All()
+	assert.NoError(t, err)
+	assert.Empty(t, ops)
+
+	assert.NoError(t, store.Add(&Operation{
+		Kind:     KindWorklog,
+		IssueKey: "TEST-1",
+		Worklog:  &Worklog{Started: "2022-02-02T10:00:00.000+0000", TimeSpent: "2h"},
+	}))
+	assert.NoError(t, store.Add(&Operation{
+		Kind:       KindTransition,
+		IssueKey:   "TEST-2",
+		Transition: &Transition{State: "Done", Fields: []string{"Root cause=Code"}},
+	}))
+	assert.NoError(t, store.Add(&Operation{
+		Kind:     KindComment,
+		IssueKey: "TEST-1",
+		Comment:  &Comment{Body: "Fixed"},
+	}))
+
+	// Operations are read back from the file by a new store.
+	store = NewStore(dir)
+
+	ops, err = store.All()
+	assert.NoError(t, err)
+	assert.Len(t, ops, 3)
+	assert.Equal(t, 1, ops[0].ID)
+	assert.Equal(t, "Log 2h on TEST-1", ops[0].String())
+	assert.Equal(t, `Transition TEST-2 to "Done"`, ops[1].String())
+	assert.Equal(t, []string{"Root cause=Code"}, ops[1].Transition.Fields)
+	assert.Equal(t, "Comment on TEST-1", ops[2].String())
+	assert.WithinDuration(t, time.Now(), ops[2].Queued, time.Minute)
+
+	assert.NoError(t, store.Remove(2))
+	assert.Equal(t, ErrNoOperation, store.Remove(2))
+
+	// Ids are not reused while newer operations are queued.
+	assert.NoError(t, store.Add(&Operation{Kind: KindComment, IssueKey: "TEST-3", Comment: &Comment{Body: "Done"}}))
+
+	ops, err = store.All()
+	assert.NoError(t, err)
+	assert.Len(t, ops, 3)
+	assert.Equal(t, []int{1, 3, 4}, []int{ops[0].ID, ops[1].ID, ops[2].ID})
+
+	assert.NoError(t, store.Clear())
+	assert.NoError(t, store.Clear())
+
+	ops, err = store.All()
+	assert.NoError(t, err)
+	assert.Empty(t, ops)
+}
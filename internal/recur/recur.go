@@ -0,0 +1,244 @@
+// Package recur manages locally scheduled recurring worklogs, eg: daily standups.
+package recur
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// FileName is the name of the file where recurring worklogs are persisted.
+	FileName = "recurring.json"
+
+	clockLayout = "15:04"
+	daysInWeek  = 7
+)
+
+// ErrNoSchedule is returned when a schedule with the given id doesn't exist.
+var ErrNoSchedule = fmt.Errorf("recurring worklog not found")
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Schedule is a recurring worklog.
+type Schedule struct {
+	ID        int            `json:"id"`
+	IssueKey  string         `json:"issueKey"`
+	TimeSpent string         `json:"timeSpent"`
+	Comment   string         `json:"comment"`
+	Days      []time.Weekday `json:"days"`
+	At        string         `json:"at"`
+	Created   time.Time      `json:"created"`
+	LastRun   time.Time      `json:"lastRun,omitempty"`
+}
+
+// Due returns occurrences of the schedule that are not yet submitted up until now.
+// Occurrences before the schedule was created are never due.
+func (s *Schedule) Due(now time.Time) []time.Time {
+	at, err := time.Parse(clockLayout, s.At)
+	if err != nil {
+		return nil
+	}
+
+	after := s.Created
+	if s.LastRun.After(after) {
+		after = s.LastRun
+	}
+	after = after.In(now.Location())
+
+	var due []time.Time
+
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, now.Location())
+	for !day.After(now) {
+		occ := time.Date(day.Year(), day.Month(), day.Day(), at.Hour(), at.Minute(), 0, 0, now.Location())
+		if s.runsOn(occ.Weekday()) && occ.After(after) && !occ.After(now) {
+			due = append(due, occ)
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return due
+}
+
+// DaysString returns a human readable list of the schedule days.
+func (s *Schedule) DaysString() string {
+	if len(s.Days) == daysInWeek {
+		return "daily"
+	}
+
+	out := make([]string, 0, len(s.Days))
+	for _, d := range s.Days {
+		out = append(out, d.String()[:3])
+	}
+	return strings.Join(out, ",")
+}
+
+func (s *Schedule) runsOn(wd time.Weekday) bool {
+	for _, d := range s.Days {
+		if d == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseDays parses days in a format like 'mon-fri', 'mon,wed,fri' or 'daily'.
+func ParseDays(s string) ([]time.Weekday, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	switch s {
+	case "daily", "all":
+		s = "sun-sat"
+	case "weekdays":
+		s = "mon-fri"
+	}
+
+	var set [daysInWeek]bool
+
+	for _, part := range strings.Split(s, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+
+		from, ok := weekdays[bounds[0]]
+		if !ok {
+			return nil, fmt.Errorf("invalid day %q", bounds[0])
+		}
+		to := from
+		if len(bounds) == 2 {
+			if to, ok = weekdays[bounds[1]]; !ok {
+				return nil, fmt.Errorf("invalid day %q", bounds[1])
+			}
+		}
+
+		for d := from; ; d = (d + 1) % daysInWeek {
+			set[d] = true
+			if d == to {
+				break
+			}
+		}
+	}
+
+	var days []time.Weekday
+	for d, ok := range set {
+		if ok {
+			days = append(days, time.Weekday(d))
+		}
+	}
+
+	return days, nil
+}
+
+// ValidateClock validates time of the day in hh:mm format.
+func ValidateClock(s string) error {
+	if _, err := time.Parse(clockLayout, s); err != nil {
+		return fmt.Errorf("invalid time %q, expected format hh:mm", s)
+	}
+	return nil
+}
+
+// Store persists recurring worklogs in a file.
+type Store struct {
+	path string
+}
+
+// NewStore creates a recurring worklog store in the given directory.
+func NewStore(dir string) *Store {
+	return &Store{path: filepath.Join(dir, FileName)}
+}
+
+// All returns all recurring worklogs.
+func (s *Store) All() ([]*Schedule, error) {
+	return s.read()
+}
+
+// Add adds a recurring worklog and assigns it a new id.
+func (s *Store) Add(sc *Schedule) error {
+	schedules, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	sc.ID = 1
+	for _, v := range schedules {
+		if v.ID >= sc.ID {
+			sc.ID = v.ID + 1
+		}
+	}
+
+	return s.write(append(schedules, sc))
+}
+
+// Update replaces a recurring worklog with the same id.
+func (s *Store) Update(sc *Schedule) error {
+	schedules, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	for i, v := range schedules {
+		if v.ID == sc.ID {
+			schedules[i] = sc
+			return s.write(schedules)
+		}
+	}
+	return ErrNoSchedule
+}
+
+// Remove removes a recurring worklog.
+func (s *Store) Remove(id int) error {
+	schedules, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	for i, v := range schedules {
+		if v.ID == id {
+			return s.write(append(schedules[:i], schedules[i+1:]...))
+		}
+	}
+	return ErrNoSchedule
+}
+
+func (s *Store) read() ([]*Schedule, error) {
+	var schedules []*Schedule
+
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return schedules, nil
+		}
+		return nil, err
+	}
+	if len(b) == 0 {
+		return schedules, nil
+	}
+	if err := json.Unmarshal(b, &schedules); err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+func (s *Store) write(schedules []*Schedule) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(schedules, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path, b, 0o600)
+}
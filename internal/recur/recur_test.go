@@ -0,0 +1,110 @@
+package recur
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDays(t *testing.T) {
+	t.Parallel()
+
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	cases := []struct {
+		input    string
+		expected []time.Weekday
+		err      bool
+	}{
+		{input: "mon-fri", expected: weekdays},
+		{input: "weekdays", expected: weekdays},
+		{input: "Mon, wed,fri", expected: []time.Weekday{time.Monday, time.Wednesday, time.Friday}},
+		{input: "fri-mon", expected: []time.Weekday{time.Sunday, time.Monday, time.Friday, time.Saturday}},
+		{input: "daily", expected: []time.Weekday{0, 1, 2, 3, 4, 5, 6}},
+		{input: "monday", err: true},
+		{input: "mon-xyz", err: true},
+		{input: "", err: true},
+	}
+
+	for _, tc := range cases {
+		actual, err := ParseDays(tc.input)
+		if tc.err {
+			assert.Error(t, err, tc.input)
+			continue
+		}
+		assert.NoError(t, err, tc.input)
+		assert.Equal(t, tc.expected, actual, tc.input)
+	}
+}
+
+func TestScheduleDue(t *testing.T) {
+	t.Parallel()
+
+	loc := time.UTC
+
+	// 2022-02-02 is a Wednesday.
+	sc := Schedule{
+		Days:    []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		At:      "09:30",
+		Created: time.Date(2022, 2, 2, 10, 0, 0, 0, loc),
+	}
+
+	assert.Empty(t, sc.Due(time.Date(2022, 2, 3, 9, 0, 0, 0, loc)))
+	assert.Equal(t, []time.Time{
+		time.Date(2022, 2, 3, 9, 30, 0, 0, loc),
+	}, sc.Due(time.Date(2022, 2, 3, 9, 30, 0, 0, loc)))
+
+	// Missed occurrences are caught up, weekends are skipped.
+	assert.Equal(t, []time.Time{
+		time.Date(2022, 2, 3, 9, 30, 0, 0, loc),
+		time.Date(2022, 2, 4, 9, 30, 0, 0, loc),
+		time.Date(2022, 2, 7, 9, 30, 0, 0, loc),
+	}, sc.Due(time.Date(2022, 2, 7, 12, 0, 0, 0, loc)))
+
+	sc.LastRun = time.Date(2022, 2, 4, 9, 30, 0, 0, loc)
+	assert.Equal(t, []time.Time{
+		time.Date(2022, 2, 7, 9, 30, 0, 0, loc),
+	}, sc.Due(time.Date(2022, 2, 7, 12, 0, 0, 0, loc)))
+
+	assert.Equal(t, "Mon,Tue,Wed,Thu,Fri", sc.DaysString())
+}
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir)
+
+	schedules, err := store.All()
+	assert.NoError(t, err)
+	assert.Empty(t, schedules)
+
+	first := Schedule{IssueKey: "TEST-1", TimeSpent: "30m", Days: []time.Weekday{time.Monday}, At: "09:30"}
+	second := Schedule{IssueKey: "TEST-2", TimeSpent: "1h", Days: []time.Weekday{time.Friday}, At: "16:00"}
+
+	assert.NoError(t, store.Add(&first))
+	assert.NoError(t, store.Add(&second))
+	assert.Equal(t, 1, first.ID)
+	assert.Equal(t, 2, second.ID)
+
+	lastRun := time.Date(2022, 2, 7, 9, 30, 0, 0, time.UTC)
+	first.LastRun = lastRun
+	assert.NoError(t, store.Update(&first))
+
+	// Schedules are read back from the file by a new store.
+	store = NewStore(dir)
+
+	schedules, err = store.All()
+	assert.NoError(t, err)
+	assert.Len(t, schedules, 2)
+	assert.True(t, lastRun.Equal(schedules[0].LastRun))
+	assert.Equal(t, "TEST-2", schedules[1].IssueKey)
+
+	assert.NoError(t, store.Remove(1))
+	assert.Equal(t, ErrNoSchedule, store.Remove(1))
+	assert.Equal(t, ErrNoSchedule, store.Update(&first))
+
+	schedules, err = store.All()
+	assert.NoError(t, err)
+	assert.Len(t, schedules, 1)
+	assert.Equal(t, 2, schedules[0].ID)
+}
@@ -0,0 +1,108 @@
+// Package smartcommit parses smart commit commands, eg: ISSUE-1 #time 2h #comment Fixed it #done,
+// in commit messages.
+package smartcommit
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ankitpokhrel/jira-cli/internal/gitlog"
+)
+
+// Kinds of the actions.
+const (
+	KindTime       = "time"
+	KindComment    = "comment"
+	KindTransition = "transition"
+)
+
+var (
+	reCommand  = regexp.MustCompile(`(?:^|\s)#([A-Za-z][A-Za-z0-9_-]*)`)
+	reDuration = regexp.MustCompile(`^\d+(?:\.\d+)?[wdhm]$`)
+)
+
+// Action is a command to perform on the issues of a smart commit.
+type Action struct {
+	Kind string
+	// Value is the time spent of a time action, eg: 1d 2h, the text of a comment action
+	// and the name of the transition of a transition action, eg: start progress.
+	Value string
+	// Text is the comment of the worklog of a time action, or the comment to add
+	// along with the transition of a transition action.
+	Text string
+}
+
+// SmartCommit is a line of a commit message with smart commit commands.
+type SmartCommit struct {
+	Keys    []string
+	Actions []*Action
+}
+
+// Parse returns the smart commits in the commit message.
+//
+// As in Jira, the issue keys come first on a line and are followed by the commands.
+// Text after #time that is not part of the duration is the worklog comment, and any
+// other command is the name of a transition with dashes in place of spaces, eg:
+// #start-progress. Commands with invalid or missing values are ignored.
+func Parse(message string) []*SmartCommit {
+	var out []*SmartCommit
+
+	for _, line := range strings.Split(message, "\n") {
+		loc := reCommand.FindAllStringSubmatchIndex(line, -1)
+		if len(loc) == 0 {
+			continue
+		}
+
+		keys := gitlog.IssueKeys(line[:loc[0][0]])
+		if len(keys) == 0 {
+			continue
+		}
+
+		sc := SmartCommit{Keys: keys}
+		for i, m := range loc {
+			end := len(line)
+			if i+1 < len(loc) {
+				end = loc[i+1][0]
+			}
+			if a := parseAction(line[m[2]:m[3]], strings.TrimSpace(line[m[1]:end])); a != nil {
+				sc.Actions = append(sc.Actions, a)
+			}
+		}
+		if len(sc.Actions) > 0 {
+			out = append(out, &sc)
+		}
+	}
+
+	return out
+}
+
+func parseAction(name, args string) *Action {
+	switch strings.ToLower(name) {
+	case KindTime:
+		fields := strings.Fields(args)
+
+		n := 0
+		for n < len(fields) && reDuration.MatchString(fields[n]) {
+			n++
+		}
+		if n == 0 {
+			return nil
+		}
+		return &Action{
+			Kind:  KindTime,
+			Value: strings.Join(fields[:n], " "),
+			Text:  strings.Join(fields[n:], " "),
+		}
+	case KindComment:
+		if args == "" {
+			return nil
+		}
+		return &Action{Kind: KindComment, Value: args}
+	default:
+		return &Action{
+			Kind:  KindTransition,
+			Value: strings.ReplaceAll(name, "-", " "),
+			Text:  args,
+		}
+	}
+}
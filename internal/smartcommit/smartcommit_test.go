@@ -0,0 +1,72 @@
+package smartcommit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		message  string
+		expected []*SmartCommit
+	}{
+		{
+			name:    "all commands",
+			message: "ISSUE-1 Fix login #time 2h 30m Debugging #comment did things #done",
+			expected: []*SmartCommit{
+				{
+					Keys: []string{"ISSUE-1"},
+					Actions: []*Action{
+						{Kind: KindTime, Value: "2h 30m", Text: "Debugging"},
+						{Kind: KindComment, Value: "did things"},
+						{Kind: KindTransition, Value: "done"},
+					},
+				},
+			},
+		},
+		{
+			name:    "multiple keys and transition with comment",
+			message: "ISSUE-1 ISSUE-2 #start-progress Picked up",
+			expected: []*SmartCommit{
+				{
+					Keys:    []string{"ISSUE-1", "ISSUE-2"},
+					Actions: []*Action{{Kind: KindTransition, Value: "start progress", Text: "Picked up"}},
+				},
+			},
+		},
+		{
+			name:    "commands in the body",
+			message: "Fix login\n\nISSUE-1 #time 1d\nISSUE-2 #comment Related",
+			expected: []*SmartCommit{
+				{Keys: []string{"ISSUE-1"}, Actions: []*Action{{Kind: KindTime, Value: "1d"}}},
+				{Keys: []string{"ISSUE-2"}, Actions: []*Action{{Kind: KindComment, Value: "Related"}}},
+			},
+		},
+		{
+			name:    "invalid values are ignored",
+			message: "ISSUE-1 #time soon #comment",
+		},
+		{
+			name:    "no issue key before the commands",
+			message: "Fix login #done ISSUE-1",
+		},
+		{
+			name:    "references are not commands",
+			message: "ISSUE-1 Fix login, see #123 and C#",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, Parse(tc.message))
+		})
+	}
+}
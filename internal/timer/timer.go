@@ -0,0 +1,137 @@
+package timer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// FileName is the name of the file where running timers are persisted.
+const FileName = "timers.json"
+
+var (
+	// ErrTimerExists is returned when a timer is already running for an issue.
+	ErrTimerExists = fmt.Errorf("timer is already running")
+	// ErrNoTimer is returned when no timer is running for an issue.
+	ErrNoTimer = fmt.Errorf("no running timer found")
+)
+
+// Timer is a running worklog timer.
+type Timer struct {
+	IssueKey string    `json:"issueKey"`
+	Started  time.Time `json:"started"`
+}
+
+// Elapsed returns time elapsed since the timer was started.
+func (t *Timer) Elapsed(now time.Time) time.Duration {
+	return now.Sub(t.Started)
+}
+
+// Store persists running timers in a file so that they survive restarts.
+type Store struct {
+	path string
+}
+
+// NewStore creates a timer store in the given directory.
+func NewStore(dir string) *Store {
+	return &Store{path: filepath.Join(dir, FileName)}
+}
+
+// All returns all running timers sorted by start time.
+func (s *Store) All() ([]*Timer, error) {
+	timers, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*Timer, 0, len(timers))
+	for _, t := range timers {
+		out = append(out, t)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Started.Before(out[j].Started)
+	})
+
+	return out, nil
+}
+
+// Get returns a running timer for the issue.
+func (s *Store) Get(key string) (*Timer, error) {
+	timers, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := timers[key]
+	if !ok {
+		return nil, ErrNoTimer
+	}
+	return t, nil
+}
+
+// Start starts a timer for the issue.
+func (s *Store) Start(key string, started time.Time) (*Timer, error) {
+	timers, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := timers[key]; ok {
+		return nil, ErrTimerExists
+	}
+
+	t := Timer{IssueKey: key, Started: started}
+	timers[key] = &t
+
+	return &t, s.write(timers)
+}
+
+// Remove removes a running timer of the issue.
+func (s *Store) Remove(key string) error {
+	timers, err := s.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := timers[key]; !ok {
+		return ErrNoTimer
+	}
+	delete(timers, key)
+
+	return s.write(timers)
+}
+
+func (s *Store) read() (map[string]*Timer, error) {
+	timers := make(map[string]*Timer)
+
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return timers, nil
+		}
+		return nil, err
+	}
+	if len(b) == 0 {
+		return timers, nil
+	}
+	if err := json.Unmarshal(b, &timers); err != nil {
+		return nil, err
+	}
+
+	return timers, nil
+}
+
+func (s *Store) write(timers map[string]*Timer) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(timers, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path, b, 0o600)
+}
@@ -0,0 +1,49 @@
+package timer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	store := NewStore(dir)
+
+	timers, err := store.All()
+	assert.NoError(t, err)
+	assert.Empty(t, timers)
+
+	started := time.Date(2022, 2, 2, 13, 35, 0, 0, time.UTC)
+
+	_, err = store.Start("TEST-2", started.Add(time.Hour))
+	assert.NoError(t, err)
+
+	tm, err := store.Start("TEST-1", started)
+	assert.NoError(t, err)
+	assert.Equal(t, "TEST-1", tm.IssueKey)
+
+	_, err = store.Start("TEST-1", started)
+	assert.Equal(t, ErrTimerExists, err)
+
+	// Timers are read back from the file by a new store.
+	store = NewStore(dir)
+
+	tm, err = store.Get("TEST-1")
+	assert.NoError(t, err)
+	assert.True(t, started.Equal(tm.Started))
+	assert.Equal(t, 90*time.Minute, tm.Elapsed(started.Add(90*time.Minute)))
+
+	timers, err = store.All()
+	assert.NoError(t, err)
+	assert.Len(t, timers, 2)
+	assert.Equal(t, "TEST-1", timers[0].IssueKey)
+	assert.Equal(t, "TEST-2", timers[1].IssueKey)
+
+	assert.NoError(t, store.Remove("TEST-1"))
+	assert.Equal(t, ErrNoTimer, store.Remove("TEST-1"))
+
+	_, err = store.Get("TEST-1")
+	assert.Equal(t, ErrNoTimer, err)
+}
@@ -0,0 +1,220 @@
+// Package timesheet arranges worklogs of a week in a grid of issues and days and
+// computes worklog changes required to match an edited grid.
+package timesheet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	// DaysInWeek is the number of columns in a timesheet.
+	DaysInWeek = 7
+
+	// StartHour is the hour of the day new worklogs are started at.
+	StartHour = 9
+)
+
+// Action is a type of worklog change.
+type Action int
+
+// Worklog change actions.
+const (
+	ActionCreate Action = iota
+	ActionUpdate
+	ActionDelete
+)
+
+// Change is a worklog operation required to apply an edited cell.
+type Change struct {
+	Action   Action
+	IssueKey string
+	Started  time.Time
+	Worklog  *jira.Worklog
+	Seconds  int
+}
+
+// Row is an issue in the timesheet along with the worklogs of each day of the week.
+type Row struct {
+	IssueKey string
+	Summary  string
+	Worklogs [DaysInWeek][]*jira.Worklog
+}
+
+// Total returns the time logged on the given day of the week in seconds.
+func (r *Row) Total(day int) int {
+	total := 0
+	for _, wl := range r.Worklogs[day] {
+		total += wl.TimeSpentSeconds
+	}
+	return total
+}
+
+// Sheet is a weekly timesheet.
+type Sheet struct {
+	Start time.Time
+	Rows  []*Row
+}
+
+// WeekStart returns midnight of the monday of the week t falls in.
+func WeekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + DaysInWeek - 1) % DaysInWeek
+	d := t.AddDate(0, 0, -offset)
+
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// New constructs an empty timesheet for the week t falls in.
+func New(t time.Time) *Sheet {
+	return &Sheet{Start: WeekStart(t)}
+}
+
+// Day returns midnight of the given day of the week.
+func (s *Sheet) Day(day int) time.Time {
+	return s.Start.AddDate(0, 0, day)
+}
+
+// End returns midnight of the monday after the week.
+func (s *Sheet) End() time.Time {
+	return s.Day(DaysInWeek)
+}
+
+// AddIssue adds a row for the issue unless it already exists.
+func (s *Sheet) AddIssue(key, summary string) *Row {
+	for _, r := range s.Rows {
+		if r.IssueKey == key {
+			if r.Summary == "" {
+				r.Summary = summary
+			}
+			return r
+		}
+	}
+
+	r := &Row{IssueKey: key, Summary: summary}
+	s.Rows = append(s.Rows, r)
+
+	return r
+}
+
+// AddWorklog places the worklog of the issue in the column of the day it was started on.
+// It reports whether the worklog falls within the week.
+func (s *Sheet) AddWorklog(key string, wl *jira.Worklog) (bool, error) {
+	started, err := time.Parse(jira.RFC3339, wl.Started)
+	if err != nil {
+		return false, fmt.Errorf("invalid start time %q of worklog %s: %w", wl.Started, wl.ID, err)
+	}
+	started = started.In(s.Start.Location())
+
+	if started.Before(s.Start) || !started.Before(s.End()) {
+		return false, nil
+	}
+
+	day := DaysInWeek - 1
+	for day > 0 && started.Before(s.Day(day)) {
+		day--
+	}
+
+	r := s.AddIssue(key, "")
+	r.Worklogs[day] = append(r.Worklogs[day], wl)
+
+	return true, nil
+}
+
+// Entry is the time logged on an issue.
+type Entry struct {
+	IssueKey string
+	Summary  string
+	Seconds  int
+}
+
+// Entries returns the time logged on each issue from the first to the last day of the week,
+// both included, in the order of the rows. Issues without any time logged are left out.
+func (s *Sheet) Entries(first, last int) []*Entry {
+	var entries []*Entry
+
+	for _, r := range s.Rows {
+		secs := 0
+		for day := first; day <= last && day < DaysInWeek; day++ {
+			secs += r.Total(day)
+		}
+		if secs > 0 {
+			entries = append(entries, &Entry{IssueKey: r.IssueKey, Summary: r.Summary, Seconds: secs})
+		}
+	}
+
+	return entries
+}
+
+// Diff returns changes required to make logged time match the given values. Values
+// hold seconds per row and day of the week in the same order as the rows.
+//
+// Empty cells get a new worklog and cleared cells lose all their worklogs. When a
+// cell has multiple worklogs, the latest ones are adjusted first so that the rest
+// of the worklogs, and their comments, stay untouched.
+func (s *Sheet) Diff(values [][]int) []*Change {
+	var changes []*Change
+
+	for i, r := range s.Rows {
+		if i >= len(values) {
+			break
+		}
+		for day := 0; day < DaysInWeek && day < len(values[i]); day++ {
+			changes = append(changes, s.diffCell(r, day, values[i][day])...)
+		}
+	}
+
+	return changes
+}
+
+func (s *Sheet) diffCell(r *Row, day, seconds int) []*Change {
+	worklogs := r.Worklogs[day]
+	delta := seconds - r.Total(day)
+
+	if delta == 0 {
+		return nil
+	}
+
+	if len(worklogs) == 0 {
+		return []*Change{{
+			Action:   ActionCreate,
+			IssueKey: r.IssueKey,
+			Started:  s.Day(day).Add(StartHour * time.Hour),
+			Seconds:  seconds,
+		}}
+	}
+
+	if delta > 0 {
+		wl := worklogs[len(worklogs)-1]
+		return []*Change{{
+			Action:   ActionUpdate,
+			IssueKey: r.IssueKey,
+			Worklog:  wl,
+			Seconds:  wl.TimeSpentSeconds + delta,
+		}}
+	}
+
+	var changes []*Change
+
+	for i := len(worklogs) - 1; i >= 0 && delta < 0; i-- {
+		wl := worklogs[i]
+		if wl.TimeSpentSeconds+delta > 0 {
+			changes = append(changes, &Change{
+				Action:   ActionUpdate,
+				IssueKey: r.IssueKey,
+				Worklog:  wl,
+				Seconds:  wl.TimeSpentSeconds + delta,
+			})
+			break
+		}
+		changes = append(changes, &Change{
+			Action:   ActionDelete,
+			IssueKey: r.IssueKey,
+			Worklog:  wl,
+		})
+		delta += wl.TimeSpentSeconds
+	}
+
+	return changes
+}
@@ -0,0 +1,133 @@
+package timesheet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestWeekStart(t *testing.T) {
+	t.Parallel()
+
+	// 2022-02-02 is a Wednesday.
+	assert.Equal(t, time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC), WeekStart(time.Date(2022, 2, 2, 15, 4, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC), WeekStart(time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC), WeekStart(time.Date(2022, 2, 6, 23, 59, 0, 0, time.UTC)))
+}
+
+func TestSheetAddWorklog(t *testing.T) {
+	t.Parallel()
+
+	s := New(time.Date(2022, 2, 2, 0, 0, 0, 0, time.UTC))
+	s.AddIssue("TEST-1", "First issue")
+
+	ok, err := s.AddWorklog("TEST-2", &jira.Worklog{ID: "1", Started: "2022-02-01T09:00:00.000+0000", TimeSpentSeconds: 3600})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = s.AddWorklog("TEST-1", &jira.Worklog{ID: "2", Started: "2022-02-06T23:30:00.000+0000", TimeSpentSeconds: 1800})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// Started on the monday of the next week in UTC.
+	ok, err = s.AddWorklog("TEST-1", &jira.Worklog{ID: "3", Started: "2022-02-07T00:30:00.000+0100", TimeSpentSeconds: 1800})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = s.AddWorklog("TEST-1", &jira.Worklog{ID: "4", Started: "2022-02-07T09:00:00.000+0000", TimeSpentSeconds: 1800})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = s.AddWorklog("TEST-1", &jira.Worklog{ID: "5", Started: "yesterday"})
+	assert.Error(t, err)
+
+	assert.Len(t, s.Rows, 2)
+	assert.Equal(t, "First issue", s.Rows[0].Summary)
+	assert.Equal(t, 3600, s.Rows[0].Total(6))
+	assert.Equal(t, 3600, s.Rows[1].Total(1))
+}
+
+func TestSheetDiff(t *testing.T) {
+	t.Parallel()
+
+	s := New(time.Date(2022, 2, 2, 0, 0, 0, 0, time.UTC))
+
+	first := &jira.Worklog{ID: "1", Started: "2022-02-01T09:00:00.000+0000", TimeSpentSeconds: 3600}
+	second := &jira.Worklog{ID: "2", Started: "2022-02-01T13:00:00.000+0000", TimeSpentSeconds: 1800}
+	other := &jira.Worklog{ID: "3", Started: "2022-02-02T09:00:00.000+0000", TimeSpentSeconds: 7200}
+
+	for _, wl := range []*jira.Worklog{first, second} {
+		_, err := s.AddWorklog("TEST-1", wl)
+		assert.NoError(t, err)
+	}
+	_, err := s.AddWorklog("TEST-2", other)
+	assert.NoError(t, err)
+
+	// Nothing changed.
+	assert.Empty(t, s.Diff([][]int{
+		{0, 5400, 0, 0, 0, 0, 0},
+		{0, 0, 7200, 0, 0, 0, 0},
+	}))
+
+	// New cell, increased cell and cleared cell.
+	assert.Equal(t, []*Change{
+		{Action: ActionCreate, IssueKey: "TEST-1", Started: time.Date(2022, 1, 31, 9, 0, 0, 0, time.UTC), Seconds: 900},
+		{Action: ActionUpdate, IssueKey: "TEST-1", Worklog: second, Seconds: 3600},
+		{Action: ActionDelete, IssueKey: "TEST-2", Worklog: other},
+	}, s.Diff([][]int{
+		{900, 7200, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0},
+	}))
+
+	// Decreasing a cell adjusts the latest worklogs first.
+	assert.Equal(t, []*Change{
+		{Action: ActionDelete, IssueKey: "TEST-1", Worklog: second},
+		{Action: ActionUpdate, IssueKey: "TEST-1", Worklog: first, Seconds: 2700},
+	}, s.Diff([][]int{
+		{0, 2700, 0, 0, 0, 0, 0},
+		{0, 0, 7200, 0, 0, 0, 0},
+	}))
+
+	assert.Equal(t, []*Change{
+		{Action: ActionUpdate, IssueKey: "TEST-1", Worklog: second, Seconds: 900},
+	}, s.Diff([][]int{
+		{0, 4500, 0, 0, 0, 0, 0},
+	}))
+}
+
+func TestSheetEntries(t *testing.T) {
+	t.Parallel()
+
+	s := New(time.Date(2022, 2, 2, 0, 0, 0, 0, time.UTC))
+	s.AddIssue("TEST-1", "First issue")
+	s.AddIssue("TEST-2", "Second issue")
+	s.AddIssue("TEST-3", "Not worked on")
+
+	for _, wl := range []struct {
+		key     string
+		started string
+		secs    int
+	}{
+		{"TEST-1", "2022-02-01T09:00:00.000+0000", 3600},
+		{"TEST-1", "2022-02-02T09:00:00.000+0000", 1800},
+		{"TEST-2", "2022-02-02T13:00:00.000+0000", 7200},
+	} {
+		_, err := s.AddWorklog(wl.key, &jira.Worklog{Started: wl.started, TimeSpentSeconds: wl.secs})
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []*Entry{
+		{IssueKey: "TEST-1", Summary: "First issue", Seconds: 1800},
+		{IssueKey: "TEST-2", Summary: "Second issue", Seconds: 7200},
+	}, s.Entries(2, 2))
+
+	assert.Equal(t, []*Entry{
+		{IssueKey: "TEST-1", Summary: "First issue", Seconds: 5400},
+		{IssueKey: "TEST-2", Summary: "Second issue", Seconds: 7200},
+	}, s.Entries(0, DaysInWeek-1))
+
+	assert.Empty(t, s.Entries(5, 6))
+}
@@ -0,0 +1,220 @@
+// Package timesync imports time entries from external time trackers as worklogs.
+package timesync
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/internal/gitlog"
+	"github.com/ankitpokhrel/jira-cli/pkg/clockify"
+	"github.com/ankitpokhrel/jira-cli/pkg/toggl"
+)
+
+// Supported providers.
+const (
+	ProviderToggl    = "toggl"
+	ProviderClockify = "clockify"
+)
+
+// FileName is the name of the file where ids of synced entries are persisted.
+const FileName = "synced.json"
+
+// Entry is a finished time entry of an external time tracker.
+type Entry struct {
+	ID          string
+	Description string
+	Tags        []string
+	Start       time.Time
+	Duration    time.Duration
+}
+
+// IssueKey returns the first issue key found in the entry description or tags.
+func (e *Entry) IssueKey() string {
+	text := e.Description + " " + strings.Join(e.Tags, " ")
+	if keys := gitlog.IssueKeys(text); len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+// Provider fetches finished time entries started between start and end.
+type Provider interface {
+	Entries(start, end time.Time) ([]*Entry, error)
+}
+
+type togglProvider struct {
+	client *toggl.Client
+}
+
+// NewToggl creates a Toggl Track provider.
+func NewToggl(c *toggl.Client) Provider {
+	return &togglProvider{client: c}
+}
+
+func (p *togglProvider) Entries(start, end time.Time) ([]*Entry, error) {
+	res, err := p.client.TimeEntries(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*Entry, 0, len(res))
+	for _, te := range res {
+		// Running entries have a negative duration.
+		if te.Duration <= 0 {
+			continue
+		}
+		entries = append(entries, &Entry{
+			ID:          strconv.FormatInt(te.ID, 10),
+			Description: te.Description,
+			Tags:        te.Tags,
+			Start:       te.Start,
+			Duration:    time.Duration(te.Duration) * time.Second,
+		})
+	}
+	return entries, nil
+}
+
+type clockifyProvider struct {
+	client    *clockify.Client
+	workspace string
+}
+
+// NewClockify creates a Clockify provider. The active workspace of the
+// user is used if workspace is empty.
+func NewClockify(c *clockify.Client, workspace string) Provider {
+	return &clockifyProvider{client: c, workspace: workspace}
+}
+
+func (p *clockifyProvider) Entries(start, end time.Time) ([]*Entry, error) {
+	me, err := p.client.Me()
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := p.workspace
+	if workspace == "" {
+		workspace = me.ActiveWorkspace
+	}
+
+	res, err := p.client.TimeEntries(workspace, me.ID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*Entry, 0, len(res))
+	for _, te := range res {
+		if te.TimeInterval.End == nil {
+			continue
+		}
+		entries = append(entries, &Entry{
+			ID:          te.ID,
+			Description: te.Description,
+			Start:       te.TimeInterval.Start,
+			Duration:    te.TimeInterval.End.Sub(te.TimeInterval.Start),
+		})
+	}
+	return entries, nil
+}
+
+// ParseSince parses start of the sync window relative to now. It accepts today,
+// yesterday, a weekday name, eg: monday, for its most recent occurrence, or a
+// date in YYYY-MM-DD format.
+func ParseSince(since string, now time.Time) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	s := strings.ToLower(strings.TrimSpace(since))
+	switch s {
+	case "", "today":
+		return today, nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if name := strings.ToLower(d.String()); s == name || s == name[:3] {
+			diff := (int(now.Weekday()) - int(d) + 7) % 7
+			return today.AddDate(0, 0, -diff), nil
+		}
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", since, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid since %q, expected today, yesterday, a weekday or YYYY-MM-DD", since)
+	}
+	return t, nil
+}
+
+// Store keeps track of time entries that are already synced.
+type Store struct {
+	path string
+}
+
+// NewStore creates a synced entries store in the given directory.
+func NewStore(dir string) *Store {
+	return &Store{path: filepath.Join(dir, FileName)}
+}
+
+// IsSynced checks if the entry of the provider is already synced.
+func (s *Store) IsSynced(provider, id string) (bool, error) {
+	synced, err := s.read()
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range synced[provider] {
+		if v == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// MarkSynced marks the entry of the provider as synced.
+func (s *Store) MarkSynced(provider, id string) error {
+	synced, err := s.read()
+	if err != nil {
+		return err
+	}
+	synced[provider] = append(synced[provider], id)
+
+	return s.write(synced)
+}
+
+func (s *Store) read() (map[string][]string, error) {
+	synced := make(map[string][]string)
+
+	b, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return synced, nil
+		}
+		return nil, err
+	}
+	if len(b) == 0 {
+		return synced, nil
+	}
+	if err := json.Unmarshal(b, &synced); err != nil {
+		return nil, err
+	}
+
+	return synced, nil
+}
+
+func (s *Store) write(synced map[string][]string) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(synced, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path, b, 0o600)
+}
@@ -0,0 +1,67 @@
+package timesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntryIssueKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "TEST-1", (&Entry{Description: "TEST-1 Implementation"}).IssueKey())
+	assert.Equal(t, "TEST-2", (&Entry{Description: "Review", Tags: []string{"dev", "TEST-2"}}).IssueKey())
+	assert.Equal(t, "", (&Entry{Description: "Lunch"}).IssueKey())
+}
+
+func TestParseSince(t *testing.T) {
+	t.Parallel()
+
+	// 2022-02-09 is a Wednesday.
+	now := time.Date(2022, 2, 9, 15, 4, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2022, 2, d, 0, 0, 0, 0, time.UTC) }
+
+	cases := []struct {
+		input    string
+		expected time.Time
+		err      bool
+	}{
+		{input: "", expected: day(9)},
+		{input: "today", expected: day(9)},
+		{input: "yesterday", expected: day(8)},
+		{input: "monday", expected: day(7)},
+		{input: "Wed", expected: day(9)},
+		{input: "thursday", expected: day(3)},
+		{input: "2022-02-01", expected: day(1)},
+		{input: "last week", err: true},
+	}
+
+	for _, tc := range cases {
+		actual, err := ParseSince(tc.input, now)
+		if tc.err {
+			assert.Error(t, err, tc.input)
+			continue
+		}
+		assert.NoError(t, err, tc.input)
+		assert.Equal(t, tc.expected, actual, tc.input)
+	}
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore(t.TempDir())
+
+	synced, err := store.IsSynced(ProviderToggl, "1")
+	assert.NoError(t, err)
+	assert.False(t, synced)
+
+	assert.NoError(t, store.MarkSynced(ProviderToggl, "1"))
+
+	synced, err = store.IsSynced(ProviderToggl, "1")
+	assert.NoError(t, err)
+	assert.True(t, synced)
+
+	synced, err = store.IsSynced(ProviderClockify, "1")
+	assert.NoError(t, err)
+	assert.False(t, synced)
+}
@@ -0,0 +1,96 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/internal/activity"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	activitySummaryWidth = 30
+	activityTextWidth    = 60
+)
+
+// ActivityOption is a functional option to wrap activity properties.
+type ActivityOption func(*Activity)
+
+// Activity is an activity feed view.
+type Activity struct {
+	data    []*activity.Event
+	display DisplayFormat
+	writer  io.Writer
+	buf     *bytes.Buffer
+}
+
+// NewActivity initializes an activity feed view.
+// The events are expected to be in chronological order.
+func NewActivity(data []*activity.Event, opts ...ActivityOption) *Activity {
+	a := Activity{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	a.writer = tabwriter.NewWriter(a.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&a)
+	}
+	return &a
+}
+
+// WithActivityWriter sets a writer for the activity feed.
+func WithActivityWriter(w io.Writer) ActivityOption {
+	return func(a *Activity) {
+		a.writer = w
+	}
+}
+
+// WithActivityDisplayFormat sets a display format for the activity feed.
+func WithActivityDisplayFormat(df DisplayFormat) ActivityOption {
+	return func(a *Activity) {
+		a.display = df
+	}
+}
+
+// Render renders the activity feed view.
+func (a Activity) Render() error {
+	if !a.display.NoHeaders {
+		fmt.Fprintln(a.writer, "DATE\tKEY\tSUMMARY\tAUTHOR\tACTIVITY\tDETAILS")
+	}
+
+	for _, e := range a.data {
+		fmt.Fprintf(
+			a.writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			e.Created.Local().Format("2006-01-02 15:04:05"), e.Key, a.shorten(e.Summary, activitySummaryWidth),
+			e.Author.Name, e.Kind, a.shorten(e.Text, activityTextWidth),
+		)
+	}
+	if tw, ok := a.writer.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if a.display.Plain {
+		_, err := fmt.Print(a.buf.String())
+		return err
+	}
+	return tui.PagerOut(a.buf.String())
+}
+
+// RenderJSON renders the activity feed in json format.
+func (a Activity) RenderJSON(out io.Writer) error {
+	return RenderJSON(out, a.data)
+}
+
+func (a Activity) shorten(s string, width int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if a.display.NoTruncate || len(s) <= width {
+		return s
+	}
+	return shortenAndPad(s, width)
+}
@@ -0,0 +1,35 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/internal/activity"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestActivityRender(t *testing.T) {
+	var b bytes.Buffer
+
+	created := time.Date(2022, 5, 1, 10, 5, 0, 0, time.Local)
+	data := []*activity.Event{
+		{
+			Key: "TEST-1", Summary: "Login is broken", Kind: activity.KindTransition,
+			Author: jira.User{Name: "Jane Doe"}, Created: created, Text: "To Do → In Progress",
+		},
+		{
+			Key: "TEST-2", Summary: "Add dark mode", Kind: activity.KindComment,
+			Author: jira.User{Name: "Jon Doe"}, Created: created.Add(time.Minute), Text: "Can you\ncheck?",
+		},
+	}
+	assert.NoError(t, NewActivity(data, WithActivityWriter(&b)).Render())
+
+	expected := `DATE	KEY	SUMMARY	AUTHOR	ACTIVITY	DETAILS
+2022-05-01 10:05:00	TEST-1	Login is broken	Jane Doe	transition	To Do → In Progress
+2022-05-01 10:06:00	TEST-2	Add dark mode	Jon Doe	comment	Can you check?
+`
+	assert.Equal(t, expected, b.String())
+}
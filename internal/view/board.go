@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
@@ -76,3 +77,88 @@ func (b Board) printHeader() {
 	}
 	fmt.Fprintln(b.writer, "")
 }
+
+// BoardDetailOption is a functional option to wrap board detail properties.
+type BoardDetailOption func(*BoardDetail)
+
+// BoardDetail is a board detail view showing columns, mapped statuses and the backing filter.
+type BoardDetail struct {
+	config   *jira.BoardConfiguration
+	filter   *jira.SavedFilter
+	statuses map[string]string
+	writer   io.Writer
+	buf      *bytes.Buffer
+}
+
+// NewBoardDetail initializes a board detail view. Statuses maps status id to its name,
+// ids without a name are displayed as is.
+func NewBoardDetail(config *jira.BoardConfiguration, filter *jira.SavedFilter, statuses map[string]string, opts ...BoardDetailOption) *BoardDetail {
+	b := BoardDetail{
+		config:   config,
+		filter:   filter,
+		statuses: statuses,
+		buf:      new(bytes.Buffer),
+	}
+	b.writer = tabwriter.NewWriter(b.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&b)
+	}
+	return &b
+}
+
+// WithBoardDetailWriter sets a writer for the board detail.
+func WithBoardDetailWriter(w io.Writer) BoardDetailOption {
+	return func(b *BoardDetail) {
+		b.writer = w
+	}
+}
+
+// Render renders the board detail view.
+func (b BoardDetail) Render() error {
+	fmt.Fprintf(b.writer, "BOARD\t%s (#%d)\n", b.config.Name, b.config.ID)
+	fmt.Fprintf(b.writer, "TYPE\t%s\n", b.config.Type)
+	if b.filter != nil {
+		fmt.Fprintf(b.writer, "FILTER\t%s (#%s)\n", b.filter.Name, b.filter.ID)
+		fmt.Fprintf(b.writer, "JQL\t%s\n", b.filter.JQL)
+	} else {
+		fmt.Fprintf(b.writer, "FILTER\t#%s\n", b.config.Filter.ID)
+	}
+	if b.config.Estimation.Field.DisplayName != "" {
+		fmt.Fprintf(b.writer, "ESTIMATION\t%s\n", b.config.Estimation.Field.DisplayName)
+	}
+	fmt.Fprintln(b.writer, "")
+
+	fmt.Fprintln(b.writer, "COLUMN\tSTATUSES\tLIMIT")
+	for _, col := range b.config.ColumnConfig.Columns {
+		statuses := make([]string, 0, len(col.Statuses))
+		for _, st := range col.Statuses {
+			if name, ok := b.statuses[st.ID]; ok {
+				statuses = append(statuses, name)
+			} else {
+				statuses = append(statuses, st.ID)
+			}
+		}
+		fmt.Fprintf(b.writer, "%s\t%s\t%s\n", col.Name, strings.Join(statuses, ", "), columnLimit(col))
+	}
+	if _, ok := b.writer.(*tabwriter.Writer); ok {
+		err := b.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(b.buf.String())
+}
+
+func columnLimit(col *jira.BoardColumn) string {
+	switch {
+	case col.Min > 0 && col.Max > 0:
+		return fmt.Sprintf("%d - %d", col.Min, col.Max)
+	case col.Min > 0:
+		return fmt.Sprintf("min %d", col.Min)
+	case col.Max > 0:
+		return fmt.Sprintf("max %d", col.Max)
+	}
+	return ""
+}
@@ -27,3 +27,41 @@ func TestBoardRender(t *testing.T) {
 `
 	assert.Equal(t, expected, b.String())
 }
+
+func TestBoardDetailRender(t *testing.T) {
+	var b bytes.Buffer
+
+	config := &jira.BoardConfiguration{ID: 1, Name: "Board 1", Type: "scrum"}
+	config.Filter.ID = "10001"
+	config.Estimation.Field.DisplayName = "Story Points"
+	config.ColumnConfig.Columns = []*jira.BoardColumn{
+		{Name: "To Do"},
+		{Name: "In Progress", Max: 5},
+		{Name: "Done", Min: 1, Max: 10},
+	}
+	config.ColumnConfig.Columns[0].Statuses = []struct {
+		ID string `json:"id"`
+	}{{ID: "1"}, {ID: "4"}}
+	config.ColumnConfig.Columns[1].Statuses = []struct {
+		ID string `json:"id"`
+	}{{ID: "3"}}
+
+	filter := &jira.SavedFilter{ID: "10001", Name: "Filter for board", JQL: "project = TEST ORDER BY Rank ASC"}
+	statuses := map[string]string{"1": "Open", "3": "In Progress"}
+
+	board := NewBoardDetail(config, filter, statuses, WithBoardDetailWriter(&b))
+	assert.NoError(t, board.Render())
+
+	expected := `BOARD	Board 1 (#1)
+TYPE	scrum
+FILTER	Filter for board (#10001)
+JQL	project = TEST ORDER BY Rank ASC
+ESTIMATION	Story Points
+
+COLUMN	STATUSES	LIMIT
+To Do	Open, 4	
+In Progress	In Progress	max 5
+Done		1 - 10
+`
+	assert.Equal(t, expected, b.String())
+}
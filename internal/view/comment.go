@@ -0,0 +1,140 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/fatih/color"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// Comments is a view for a page of issue comments.
+//
+// Comments in the page are expected to be ordered from the newest
+// to the oldest and are displayed as a thread, oldest first.
+type Comments struct {
+	Server  string
+	Key     string
+	Data    *jira.CommentResult
+	Display DisplayFormat
+}
+
+// Render renders the comments view.
+func (c Comments) Render() error {
+	if c.Display.Plain {
+		return c.renderPlain(os.Stdout)
+	}
+	r, err := MDRenderer()
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+
+	for _, p := range c.fragments() {
+		if !p.Parse {
+			out.WriteString(p.Body)
+			continue
+		}
+		body, err := r.Render(p.Body)
+		if err != nil {
+			return err
+		}
+		out.WriteString(body)
+	}
+
+	return tui.PagerOut(out.String())
+}
+
+func (c Comments) String() string {
+	var s strings.Builder
+
+	for _, p := range c.fragments() {
+		s.WriteString(p.Body)
+	}
+
+	return s.String()
+}
+
+func (c Comments) fragments() []fragment {
+	scraps := []fragment{
+		{Body: separator(c.title(), c.Display.Plain)},
+		newBlankFragment(1),
+	}
+
+	for idx := len(c.Data.Comments) - 1; idx >= 0; idx-- {
+		cm := c.Data.Comments[idx]
+		scraps = append(
+			scraps,
+			newBlankFragment(1),
+			fragment{Body: c.meta(cm)},
+			newBlankFragment(2),
+			fragment{Body: strings.TrimRight(md.FromJiraMD(cm.Body), "\n"), Parse: true},
+			newBlankFragment(1),
+		)
+	}
+
+	return append(scraps, newBlankFragment(1), fragment{Body: c.footer()}, newBlankFragment(1))
+}
+
+func (c Comments) title() string {
+	from := c.Data.StartAt + 1
+	to := c.Data.StartAt + len(c.Data.Comments)
+	return fmt.Sprintf("Comments %d-%d of %d", from, to, c.Data.Total)
+}
+
+func (c Comments) meta(cm *jira.Comment) string {
+	meta := fmt.Sprintf(
+		" %s • %s • #%s",
+		coloredOut(cm.Author.Name, color.FgWhite, color.Bold),
+		coloredOut(cmdutil.FormatDateTimeHuman(cm.Created, jira.RFC3339), color.FgWhite, color.Bold),
+		cm.ID,
+	)
+	switch cm.ServiceDeskVisibility() {
+	case jira.CommentVisibilityInternal:
+		meta += fmt.Sprintf(" • %s", coloredOut("Internal", color.FgYellow, color.Bold))
+	case jira.CommentVisibilityPublic:
+		meta += fmt.Sprintf(" • %s", coloredOut("Public", color.FgGreen))
+	}
+	if cm.Updated != "" && cm.Updated != cm.Created {
+		meta += fmt.Sprintf(" • %s", coloredOut("Edited", color.FgCyan))
+	}
+	return meta
+}
+
+func (c Comments) footer() string {
+	var out strings.Builder
+
+	if c.Data.StartAt+len(c.Data.Comments) < c.Data.Total && c.Data.MaxResults > 0 {
+		next := c.Data.StartAt/c.Data.MaxResults + 2 //nolint:gomnd
+		out.WriteString(gray(fmt.Sprintf("Use --page %d to load older comments", next)))
+		out.WriteString("\n")
+	}
+	out.WriteString(gray(fmt.Sprintf("View this issue on Jira: %s/browse/%s", c.Server, c.Key)))
+
+	return out.String()
+}
+
+// renderPlain renders the comments in plain view.
+func (c Comments) renderPlain(w io.Writer) error {
+	r, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle("notty"),
+		glamour.WithWordWrap(wordWrap),
+	)
+	if err != nil {
+		return err
+	}
+	out, err := r.Render(c.String())
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(w, out)
+	return err
+}
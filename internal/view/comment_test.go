@@ -0,0 +1,57 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestCommentsString(t *testing.T) {
+	data := &jira.CommentResult{
+		StartAt:    0,
+		MaxResults: 2,
+		Total:      3,
+		Comments: []*jira.Comment{
+			{
+				ID:      "10003",
+				Author:  jira.User{Name: "Person B"},
+				Body:    "Latest *comment*",
+				Created: "2021-11-24T10:00:00.000+0100",
+				Updated: "2021-11-25T10:00:00.000+0100",
+				Properties: []jira.CommentProperty{{
+					Key:   jira.CommentPropertyServiceDesk,
+					Value: map[string]interface{}{"internal": true},
+				}},
+			},
+			{
+				ID:      "10002",
+				Author:  jira.User{Name: "Person A"},
+				Body:    "Older comment",
+				Created: "2021-11-23T10:00:00.000+0100",
+				Updated: "2021-11-23T10:00:00.000+0100",
+			},
+		},
+	}
+
+	comments := Comments{
+		Server:  "https://test.local",
+		Key:     "TEST-1",
+		Data:    data,
+		Display: DisplayFormat{Plain: true},
+	}
+
+	expected := "------------------------ Comments 1-2 of 3 ------------------------\n" +
+		"\n Person A • Tue, 23 Nov 21 • #10002\n\nOlder comment\n" +
+		"\n Person B • Wed, 24 Nov 21 • #10003 • Internal • Edited\n\nLatest **comment**\n"
+	if xterm256() {
+		expected += "\n\x1b[38;5;242mUse --page 2 to load older comments\x1b[m\n" +
+			"\x1b[38;5;242mView this issue on Jira: https://test.local/browse/TEST-1\x1b[m\n"
+	} else {
+		expected += "\n\x1b[0;90mUse --page 2 to load older comments\x1b[0m\n" +
+			"\x1b[0;90mView this issue on Jira: https://test.local/browse/TEST-1\x1b[0m\n"
+	}
+
+	assert.Equal(t, expected, comments.String())
+}
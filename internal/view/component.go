@@ -0,0 +1,86 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// ComponentOption is a functional option to wrap component properties.
+type ComponentOption func(*Component)
+
+// Component is a project component view.
+type Component struct {
+	data    []*jira.Component
+	display DisplayFormat
+	writer  io.Writer
+	buf     *bytes.Buffer
+}
+
+// NewComponent initializes a component view.
+func NewComponent(data []*jira.Component, opts ...ComponentOption) *Component {
+	c := Component{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	c.writer = tabwriter.NewWriter(c.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &c
+}
+
+// WithComponentWriter sets a writer for the component view.
+func WithComponentWriter(w io.Writer) ComponentOption {
+	return func(c *Component) {
+		c.writer = w
+	}
+}
+
+// WithComponentDisplayFormat sets a display format for the component view.
+func WithComponentDisplayFormat(df DisplayFormat) ComponentOption {
+	return func(c *Component) {
+		c.display = df
+	}
+}
+
+// Render renders the component view.
+func (c Component) Render() error {
+	if !c.display.NoHeaders {
+		fmt.Fprintln(c.writer, "ID\tNAME\tLEAD\tDEFAULT ASSIGNEE\tDESCRIPTION")
+	}
+
+	for _, d := range c.data {
+		fmt.Fprintf(
+			c.writer, "%s\t%s\t%s\t%s\t%s\n",
+			d.ID, prepareTitle(d.Name), d.Lead.Name, assigneeType(d.AssigneeType), prepareTitle(d.Description),
+		)
+	}
+	if w, ok := c.writer.(*tabwriter.Writer); ok {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if c.display.Plain {
+		_, err := fmt.Print(c.buf.String())
+		return err
+	}
+	return tui.PagerOut(c.buf.String())
+}
+
+// RenderJSON renders the component list in json format.
+func (c Component) RenderJSON(out io.Writer) error {
+	return RenderJSON(out, c.data)
+}
+
+// assigneeType formats the assignee type of a component, eg: COMPONENT_LEAD to component-lead.
+func assigneeType(t string) string {
+	return strings.ToLower(strings.ReplaceAll(t, "_", "-"))
+}
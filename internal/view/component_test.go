@@ -0,0 +1,52 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestComponentRender(t *testing.T) {
+	var b bytes.Buffer
+
+	backend := &jira.Component{
+		ID: "10000", Name: "Backend", Description: "Server side", AssigneeType: jira.ComponentAssigneeComponentLead,
+	}
+	backend.Lead.Name = "Person A"
+
+	data := []*jira.Component{
+		backend,
+		{ID: "10001", Name: "[UI] Frontend"},
+	}
+	assert.NoError(t, NewComponent(data, WithComponentWriter(&b)).Render())
+
+	expected := `ID	NAME	LEAD	DEFAULT ASSIGNEE	DESCRIPTION
+10000	Backend	Person A	component-lead	Server side
+10001	⦗UI⦘ Frontend			
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestComponentRenderJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Component{{ID: "10000", Name: "Backend", AssigneeType: jira.ComponentAssigneeUnassigned}}
+	assert.NoError(t, NewComponent(data).RenderJSON(&b))
+
+	expected := `[
+  {
+    "id": "10000",
+    "name": "Backend",
+    "description": "",
+    "lead": {
+      "displayName": ""
+    },
+    "assigneeType": "UNASSIGNED"
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -0,0 +1,77 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// FilterOption is a functional option to wrap filter properties.
+type FilterOption func(*Filter)
+
+// Filter is a saved filter list view.
+type Filter struct {
+	data    []*jira.SavedFilter
+	display DisplayFormat
+	writer  io.Writer
+	buf     *bytes.Buffer
+}
+
+// NewFilter initializes a filter list view.
+func NewFilter(data []*jira.SavedFilter, opts ...FilterOption) *Filter {
+	f := Filter{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	f.writer = tabwriter.NewWriter(f.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&f)
+	}
+	return &f
+}
+
+// WithFilterWriter sets a writer for the filter list.
+func WithFilterWriter(w io.Writer) FilterOption {
+	return func(f *Filter) {
+		f.writer = w
+	}
+}
+
+// WithFilterDisplayFormat sets a display format for the filter list.
+func WithFilterDisplayFormat(df DisplayFormat) FilterOption {
+	return func(f *Filter) {
+		f.display = df
+	}
+}
+
+// Render renders the filter list view.
+func (f Filter) Render() error {
+	if !f.display.NoHeaders {
+		fmt.Fprintln(f.writer, "ID\tNAME\tOWNER\tJQL")
+	}
+
+	for _, d := range f.data {
+		fmt.Fprintf(f.writer, "%s\t%s\t%s\t%s\n", d.ID, prepareTitle(d.Name), d.Owner.Name, d.JQL)
+	}
+	if w, ok := f.writer.(*tabwriter.Writer); ok {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if f.display.Plain {
+		_, err := fmt.Print(f.buf.String())
+		return err
+	}
+	return tui.PagerOut(f.buf.String())
+}
+
+// RenderJSON renders the filter list in json format.
+func (f Filter) RenderJSON(out io.Writer) error {
+	return RenderJSON(out, f.data)
+}
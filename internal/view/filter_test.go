@@ -0,0 +1,49 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestFilterRender(t *testing.T) {
+	var b bytes.Buffer
+
+	bugs := &jira.SavedFilter{ID: "10042", Name: "My open bugs", JQL: "type = Bug AND resolution IS EMPTY"}
+	bugs.Owner.Name = "Person A"
+
+	data := []*jira.SavedFilter{
+		bugs,
+		{ID: "10043", Name: "[Team] Due this week", JQL: "due <= endOfWeek()"},
+	}
+	assert.NoError(t, NewFilter(data, WithFilterWriter(&b)).Render())
+
+	expected := `ID	NAME	OWNER	JQL
+10042	My open bugs	Person A	type = Bug AND resolution IS EMPTY
+10043	⦗Team⦘ Due this week		due <= endOfWeek()
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestFilterRenderJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.SavedFilter{{ID: "10042", Name: "My open bugs", JQL: "type = Bug"}}
+	assert.NoError(t, NewFilter(data).RenderJSON(&b))
+
+	expected := `[
+  {
+    "id": "10042",
+    "name": "My open bugs",
+    "jql": "type = Bug",
+    "owner": {
+      "displayName": ""
+    }
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -0,0 +1,77 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// GroupOption is a functional option to wrap filter properties.
+type GroupOption func(*Group)
+
+// Group is a group list view.
+type Group struct {
+	data    []*jira.Group
+	display DisplayFormat
+	writer  io.Writer
+	buf     *bytes.Buffer
+}
+
+// NewGroup initializes a group list view.
+func NewGroup(data []*jira.Group, opts ...GroupOption) *Group {
+	g := Group{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	g.writer = tabwriter.NewWriter(g.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&g)
+	}
+	return &g
+}
+
+// WithGroupWriter sets a writer for the group list.
+func WithGroupWriter(w io.Writer) GroupOption {
+	return func(g *Group) {
+		g.writer = w
+	}
+}
+
+// WithGroupDisplayFormat sets a display format for the group list.
+func WithGroupDisplayFormat(df DisplayFormat) GroupOption {
+	return func(g *Group) {
+		g.display = df
+	}
+}
+
+// Render renders the group list view.
+func (g Group) Render() error {
+	if !g.display.NoHeaders {
+		fmt.Fprintln(g.writer, "NAME\tID")
+	}
+
+	for _, d := range g.data {
+		fmt.Fprintf(g.writer, "%s\t%s\n", d.Name, d.GroupID)
+	}
+	if w, ok := g.writer.(*tabwriter.Writer); ok {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if g.display.Plain {
+		_, err := fmt.Print(g.buf.String())
+		return err
+	}
+	return tui.PagerOut(g.buf.String())
+}
+
+// RenderJSON renders the group list in json format.
+func (g Group) RenderJSON(out io.Writer) error {
+	return RenderJSON(out, g.data)
+}
@@ -0,0 +1,26 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestGroupRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Group{
+		{Name: "jira-admins", GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"},
+		{Name: "jira-users"},
+	}
+	assert.NoError(t, NewGroup(data, WithGroupWriter(&b)).Render())
+
+	expected := `NAME	ID
+jira-admins	276f955c-63d7-42c8-9520-92d01dca0625
+jira-users	
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -202,3 +203,29 @@ func max(a, b int) int {
 	}
 	return b
 }
+
+// formatCustomField formats the value of a custom field for display. Options, users
+// and other objects are displayed by their value or name, lists are comma separated.
+func formatCustomField(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case []interface{}:
+		out := make([]string, 0, len(val))
+		for _, item := range val {
+			out = append(out, formatCustomField(item))
+		}
+		return strings.Join(out, ", ")
+	case map[string]interface{}:
+		for _, k := range []string{"value", "name", "displayName", "key"} {
+			if s, ok := val[k].(string); ok {
+				return s
+			}
+		}
+	}
+	return fmt.Sprint(v)
+}
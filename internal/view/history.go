@@ -0,0 +1,133 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	historyValueWidth = 30
+	historyEmptyValue = "-"
+)
+
+// HistoryOption is a functional option to wrap history properties.
+type HistoryOption func(*History)
+
+// History is an issue changelog view.
+type History struct {
+	data    []*jira.ChangelogHistory
+	fields  []string
+	display DisplayFormat
+	writer  io.Writer
+	buf     *bytes.Buffer
+}
+
+// NewHistory initializes an issue changelog view.
+// The histories are expected to be in chronological order.
+func NewHistory(data []*jira.ChangelogHistory, opts ...HistoryOption) *History {
+	h := History{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	h.writer = tabwriter.NewWriter(h.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&h)
+	}
+	return &h
+}
+
+// WithHistoryWriter sets a writer for the history.
+func WithHistoryWriter(w io.Writer) HistoryOption {
+	return func(h *History) {
+		h.writer = w
+	}
+}
+
+// WithHistoryFields only displays the changes of the given fields.
+// Fields are matched case-insensitively against the field name and id.
+func WithHistoryFields(fields ...string) HistoryOption {
+	return func(h *History) {
+		h.fields = fields
+	}
+}
+
+// WithHistoryDisplayFormat sets a display format for the history.
+func WithHistoryDisplayFormat(df DisplayFormat) HistoryOption {
+	return func(h *History) {
+		h.display = df
+	}
+}
+
+// Len returns the number of field changes that will be displayed.
+func (h History) Len() int {
+	n := 0
+	for _, d := range h.data {
+		for _, item := range d.Items {
+			if h.matches(item) {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// Render renders the history view.
+func (h History) Render() error {
+	if !h.display.NoHeaders {
+		fmt.Fprintln(h.writer, "DATE\tAUTHOR\tFIELD\tCHANGE")
+	}
+
+	for _, d := range h.data {
+		for _, item := range d.Items {
+			if !h.matches(item) {
+				continue
+			}
+			fmt.Fprintf(
+				h.writer, "%s\t%s\t%s\t%s → %s\n",
+				formatDateTime(d.Created, jira.RFC3339), d.Author.Name, item.Field,
+				h.value(item.FromString), h.value(item.ToString),
+			)
+		}
+	}
+	if tw, ok := h.writer.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if h.display.Plain {
+		_, err := fmt.Print(h.buf.String())
+		return err
+	}
+	return tui.PagerOut(h.buf.String())
+}
+
+func (h History) matches(item *jira.ChangelogItem) bool {
+	if len(h.fields) == 0 {
+		return true
+	}
+	for _, f := range h.fields {
+		if strings.EqualFold(f, item.Field) || strings.EqualFold(f, item.FieldID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h History) value(v string) string {
+	v = strings.Join(strings.Fields(v), " ")
+	if v == "" {
+		return historyEmptyValue
+	}
+	if h.display.NoTruncate || len(v) <= historyValueWidth {
+		return v
+	}
+	return shortenAndPad(v, historyValueWidth)
+}
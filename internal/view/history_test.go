@@ -0,0 +1,75 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func historyData() []*jira.ChangelogHistory {
+	return []*jira.ChangelogHistory{
+		{
+			ID:      "10001",
+			Author:  jira.User{Name: "Person A"},
+			Created: "2022-02-01T09:00:00.000+0100",
+			Items: []*jira.ChangelogItem{
+				{Field: "status", FieldID: "status", FromString: "To Do", ToString: "In Progress"},
+			},
+		},
+		{
+			ID:      "10002",
+			Author:  jira.User{Name: "Person B"},
+			Created: "2022-02-02T10:30:00.000+0100",
+			Items: []*jira.ChangelogItem{
+				{Field: "assignee", FieldID: "assignee", ToString: "Person A"},
+				{Field: "description", FieldID: "description", FromString: "Old\ndescription", ToString: "A much longer description that goes on"},
+			},
+		},
+		{
+			ID:      "10003",
+			Author:  jira.User{Name: "Person A"},
+			Created: "2022-02-03T17:45:00.000+0100",
+			Items: []*jira.ChangelogItem{
+				{Field: "Status", FieldID: "status", FromString: "In Progress", ToString: "Done"},
+			},
+		},
+	}
+}
+
+func TestHistoryRender(t *testing.T) {
+	var b bytes.Buffer
+
+	h := NewHistory(historyData(), WithHistoryWriter(&b), WithHistoryDisplayFormat(DisplayFormat{Plain: true}))
+	assert.Equal(t, 4, h.Len())
+	assert.NoError(t, h.Render())
+
+	expected := `DATE	AUTHOR	FIELD	CHANGE
+2022-02-01 09:00:00	Person A	status	To Do → In Progress
+2022-02-02 10:30:00	Person B	assignee	- → Person A
+2022-02-02 10:30:00	Person B	description	Old description → A much longer description t...
+2022-02-03 17:45:00	Person A	Status	In Progress → Done
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestHistoryRenderWithFieldsAndNoTruncate(t *testing.T) {
+	var b bytes.Buffer
+
+	h := NewHistory(
+		historyData(),
+		WithHistoryWriter(&b),
+		WithHistoryFields("STATUS", "description"),
+		WithHistoryDisplayFormat(DisplayFormat{Plain: true, NoHeaders: true, NoTruncate: true}),
+	)
+	assert.Equal(t, 3, h.Len())
+	assert.NoError(t, h.Render())
+
+	expected := `2022-02-01 09:00:00	Person A	status	To Do → In Progress
+2022-02-02 10:30:00	Person B	description	Old description → A much longer description that goes on
+2022-02-03 17:45:00	Person A	Status	In Progress → Done
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -95,6 +95,9 @@ func (i Issue) String() string {
 	if desc != "" {
 		s.WriteString(fmt.Sprintf("\n\n%s\n\n%s", i.separator("Description"), desc))
 	}
+	if tt := i.timeTracking(); tt != "" {
+		s.WriteString(fmt.Sprintf("\n\n%s\n\n%s\n", i.separator("Time Tracking"), tt))
+	}
 	if len(i.Data.Fields.IssueLinks) > 0 {
 		s.WriteString(fmt.Sprintf("\n\n%s\n\n%s\n", i.separator("Linked Issues"), i.linkedIssues()))
 	}
@@ -127,6 +130,17 @@ func (i Issue) fragments() []fragment {
 		)
 	}
 
+	if tt := i.timeTracking(); tt != "" {
+		scraps = append(
+			scraps,
+			newBlankFragment(1),
+			fragment{Body: i.separator("Time Tracking")},
+			newBlankFragment(2),
+			fragment{Body: tt},
+			newBlankFragment(1),
+		)
+	}
+
 	if len(i.Data.Fields.IssueLinks) > 0 {
 		scraps = append(
 			scraps,
@@ -159,6 +173,10 @@ func (i Issue) fragments() []fragment {
 }
 
 func (i Issue) separator(msg string) string {
+	return separator(msg, i.Display.Plain)
+}
+
+func separator(msg string, plain bool) string {
 	pad := func(m string) string {
 		if m != "" {
 			return fmt.Sprintf(" %s ", m)
@@ -166,7 +184,7 @@ func (i Issue) separator(msg string) string {
 		return m
 	}
 
-	if i.Display.Plain {
+	if plain {
 		sep := "------------------------"
 		return fmt.Sprintf("%s%s%s", sep, pad(msg), sep)
 	}
@@ -208,31 +226,57 @@ func (i Issue) header() string {
 	} else if i.Data.Fields.Watches.IsWatching {
 		wch = fmt.Sprintf("You + %d watchers", i.Data.Fields.Watches.WatchCount-1)
 	}
+	vts := fmt.Sprintf("%d votes", i.Data.Fields.Votes.Votes)
+	if i.Data.Fields.Votes.Votes == 1 && i.Data.Fields.Votes.HasVoted {
+		vts = "You voted"
+	} else if i.Data.Fields.Votes.HasVoted {
+		vts = fmt.Sprintf("You + %d votes", i.Data.Fields.Votes.Votes-1)
+	}
 	return fmt.Sprintf(
-		"%s %s  %s %s  ⌛ %s  👷 %s  🔑️ %s  💭 %d comments  \U0001F9F5 %d linked\n# %s\n⏱️  %s  🔎 %s  🚀 %s  📦 %s  🏷️  %s  👀 %s",
+		"%s %s  %s %s  ⌛ %s  👷 %s  🔑️ %s  💭 %d comments  \U0001F9F5 %d linked\n# %s\n⏱️  %s  🔎 %s  🚀 %s  📦 %s  🏷️  %s  👀 %s  👍 %s",
 		iti, it, sti, st, cmdutil.FormatDateTimeHuman(i.Data.Fields.Updated, jira.RFC3339), as, i.Data.Key,
 		i.Data.Fields.Comment.Total, len(i.Data.Fields.IssueLinks),
 		i.Data.Fields.Summary,
 		cmdutil.FormatDateTimeHuman(i.Data.Fields.Created, jira.RFC3339), i.Data.Fields.Reporter.Name,
-		i.Data.Fields.Priority.Name, cmpt, lbl, wch,
+		i.Data.Fields.Priority.Name, cmpt, lbl, wch, vts,
 	)
 }
 
 func (i Issue) description() string {
-	if i.Data.Fields.Description == nil {
-		return ""
+	return toMarkdown(i.Data.Fields.Description)
+}
+
+// toMarkdown converts a description or comment body to markdown. The body is
+// an Atlassian document in v3 and a string in Jira wiki markup otherwise.
+func toMarkdown(body interface{}) string {
+	switch b := body.(type) {
+	case *adf.ADF:
+		return adf.NewTranslator(b, adf.NewMarkdownTranslator()).Translate()
+	case string:
+		return md.FromJiraMD(b)
 	}
+	return ""
+}
 
-	var desc string
+func (i Issue) timeTracking() string {
+	tt := i.Data.Fields.TimeTracking
+	if tt.TimeSpent == "" && tt.OriginalEstimate == "" && tt.RemainingEstimate == "" {
+		return ""
+	}
 
-	if adfNode, ok := i.Data.Fields.Description.(*adf.ADF); ok {
-		desc = adf.NewTranslator(adfNode, adf.NewMarkdownTranslator()).Translate()
-	} else {
-		desc = i.Data.Fields.Description.(string)
-		desc = md.FromJiraMD(desc)
+	orNone := func(s string) string {
+		if s == "" {
+			return "None"
+		}
+		return s
 	}
 
-	return desc
+	return fmt.Sprintf(
+		"  %s %s • %s %s • %s %s",
+		coloredOut("Logged", color.FgWhite, color.Bold), orNone(tt.TimeSpent),
+		coloredOut("Remaining", color.FgWhite, color.Bold), orNone(tt.RemainingEstimate),
+		coloredOut("Estimate", color.FgWhite, color.Bold), orNone(tt.OriginalEstimate),
+	)
 }
 
 func (i Issue) linkedIssues() string {
@@ -325,13 +369,7 @@ func (i Issue) comments() []issueComment {
 
 	for idx := total - 1; idx >= total-limit; idx-- {
 		c := i.Data.Fields.Comment.Comments[idx]
-		var body string
-		if adfNode, ok := c.Body.(*adf.ADF); ok {
-			body = adf.NewTranslator(adfNode, adf.NewMarkdownTranslator()).Translate()
-		} else {
-			body = c.Body.(string)
-			body = md.FromJiraMD(body)
-		}
+		body := toMarkdown(c.Body)
 		meta := fmt.Sprintf(
 			"\n %s • %s",
 			coloredOut(c.Author.Name, color.FgWhite, color.Bold),
@@ -5,8 +5,11 @@ import (
 	"io"
 	"os"
 	"strings"
+	"sync"
 	"text/tabwriter"
 
+	"github.com/charmbracelet/glamour"
+
 	"github.com/ankitpokhrel/jira-cli/api"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira"
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
@@ -16,6 +19,8 @@ import (
 const (
 	colPadding  = 1
 	maxColWidth = 60
+
+	previewNumComments = 3
 )
 
 // DisplayFormat is a issue display type.
@@ -24,6 +29,19 @@ type DisplayFormat struct {
 	NoHeaders  bool
 	NoTruncate bool
 	Columns    []string
+	// CustomColumns maps custom columns in upper case, eg: STORY POINTS,
+	// to the id of the custom field displayed in the column.
+	CustomColumns map[string]string
+	// GroupBy returns the group of an issue. If set, issues are
+	// displayed in sections of their group with a count of issues.
+	GroupBy func(*jira.Issue) string
+}
+
+// IssueAction is an action applied at once to the issues marked in the interactive list.
+type IssueAction struct {
+	Name   string
+	Prompt string
+	Run    func(key, input string) error
 }
 
 // IssueList is a list view for issues.
@@ -34,6 +52,7 @@ type IssueList struct {
 	Data       []*jira.Issue
 	Display    DisplayFormat
 	Refresh    tui.RefreshFunc
+	Actions    []IssueAction
 	FooterText string
 }
 
@@ -49,9 +68,14 @@ func (l *IssueList) Render() error {
 		return err
 	}
 
-	data := l.data()
+	data, sections := l.data(), []int(nil)
+	if l.Display.GroupBy != nil {
+		data, sections = l.groupedData()
+	}
 	if l.FooterText == "" {
-		l.FooterText = fmt.Sprintf("Showing %d of %d results for project \"%s\"", len(data)-1, l.Total, l.Project)
+		l.FooterText = fmt.Sprintf(
+			"Showing %d of %d results for project \"%s\"", len(data)-len(sections)-1, l.Total, l.Project,
+		)
 	}
 
 	view := tui.NewTable(
@@ -59,10 +83,9 @@ func (l *IssueList) Render() error {
 		tui.WithMaxColWidth(maxColWidth),
 		tui.WithTableFooterText(l.FooterText),
 		tui.WithSelectedFunc(navigate(l.Server)),
-		tui.WithViewModeFunc(func(r, c int, _ interface{}) (func() interface{}, func(interface{}) (string, error)) {
+		tui.WithViewModeFunc(func(r, c int, d interface{}) (func() interface{}, func(interface{}) (string, error)) {
 			dataFn := func() interface{} {
-				ci := getKeyColumnIndex(data[0])
-				iss, _ := api.ProxyGetIssue(api.Client(jira.Config{}), data[r][ci], issue.NewNumCommentsFilter(1))
+				iss, _ := api.ProxyGetIssue(api.Client(jira.Config{}), issueKeyFromTuiData(r, d), issue.NewNumCommentsFilter(1))
 				return iss
 			}
 			renderFn := func(i interface{}) (string, error) {
@@ -78,23 +101,122 @@ func (l *IssueList) Render() error {
 		tui.WithCopyFunc(copyURL(l.Server)),
 		tui.WithCopyKeyFunc(copyKey()),
 		tui.WithRefreshFunc(l.Refresh),
+		tui.WithSections(sections...),
+		tui.WithPreviewFunc(l.preview(renderer)),
+		tui.WithBulkActions(l.bulkActions()...),
 	)
 
 	return view.Paint(data)
 }
 
+// bulkActions wraps the issue actions to be applied to the marked rows of the table.
+func (l *IssueList) bulkActions() []tui.BulkAction {
+	actions := make([]tui.BulkAction, 0, len(l.Actions))
+	for _, a := range l.Actions {
+		run := a.Run
+		actions = append(actions, tui.BulkAction{
+			Name:   a.Name,
+			Prompt: a.Prompt,
+			Run: func(r int, d interface{}, input string) error {
+				key := issueKeyFromTuiData(r, d)
+				if err := run(key, input); err != nil {
+					return fmt.Errorf("%s: %w", key, err)
+				}
+				return nil
+			},
+		})
+	}
+	return actions
+}
+
+// preview returns the details of the selected issue along with its latest comments for
+// the preview pane. Issues are fetched once and the rendered details are cached.
+func (l *IssueList) preview(renderer *glamour.TermRenderer) tui.PreviewFunc {
+	var (
+		mu    sync.Mutex
+		cache = make(map[string]string)
+	)
+
+	return func(r, _ int, d interface{}) string {
+		key := issueKeyFromTuiData(r, d)
+
+		mu.Lock()
+		out, ok := cache[key]
+		mu.Unlock()
+		if ok {
+			return out
+		}
+
+		iss, err := api.ProxyGetIssue(api.Client(jira.Config{}), key, issue.NewNumCommentsFilter(previewNumComments))
+		if err != nil {
+			return fmt.Sprintf("Unable to fetch %s: %s", key, err)
+		}
+
+		// Previews of rows selected in quick succession are fetched concurrently,
+		// so they are rendered one at a time with the shared renderer.
+		mu.Lock()
+		defer mu.Unlock()
+
+		out, err = Issue{
+			Server:  l.Server,
+			Data:    iss,
+			Options: IssueOption{NumComments: previewNumComments},
+		}.RenderedOut(renderer)
+		if err != nil {
+			return err.Error()
+		}
+		cache[key] = out
+
+		return out
+	}
+}
+
 // renderPlain renders the issue in plain view.
 func (l *IssueList) renderPlain(w io.Writer) error {
-	return renderPlain(w, l.data())
+	if l.Display.GroupBy == nil {
+		return renderPlain(w, l.data())
+	}
+
+	// Sections are printed on their own lines, so the columns
+	// of the header and of each group are aligned separately.
+	data, sections := l.groupedData()
+	if len(sections) == 0 {
+		return renderPlain(w, data)
+	}
+	rows := data[:sections[0]]
+	for i, r := range sections {
+		if err := renderPlain(w, rows); err != nil {
+			return err
+		}
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, data[r][0])
+
+		rows = data[r+1:]
+		if i < len(sections)-1 {
+			rows = data[r+1 : sections[i+1]]
+		}
+	}
+	return renderPlain(w, rows)
+}
+
+// RenderCSV renders the issues in csv format. Unlike other views, the key
+// column is only included if it is selected and summaries are not altered.
+func (l *IssueList) RenderCSV(w io.Writer) error {
+	return RenderCSV(w, l.csvData())
 }
 
-func (*IssueList) validColumnsMap() map[string]struct{} {
+func (l *IssueList) validColumnsMap() map[string]struct{} {
 	columns := ValidIssueColumns()
-	out := make(map[string]struct{}, len(columns))
+	out := make(map[string]struct{}, len(columns)+len(l.Display.CustomColumns))
 
 	for _, c := range columns {
 		out[c] = struct{}{}
 	}
+	for c := range l.Display.CustomColumns {
+		out[c] = struct{}{}
+	}
 
 	return out
 }
@@ -115,7 +237,7 @@ func (l *IssueList) header() []string {
 
 	columnsMap := l.validColumnsMap()
 	for _, c := range l.Display.Columns {
-		c = strings.ToUpper(c)
+		c = strings.ToUpper(strings.TrimSpace(c))
 		if _, ok := columnsMap[c]; ok {
 			headers = append(headers, strings.ToUpper(c))
 		}
@@ -150,7 +272,79 @@ func (l *IssueList) data() tui.TableData {
 	return data
 }
 
-func (IssueList) assignColumns(columns []string, issue *jira.Issue) []string {
+// groupedData returns the table data with the issues grouped by the group of the display
+// format. Groups are in the order their first issue appears in the list. Each group is
+// preceded by a section row, whose indexes are returned along with the data.
+func (l *IssueList) groupedData() (tui.TableData, []int) {
+	var (
+		data     tui.TableData
+		sections []int
+	)
+
+	headers := l.header()
+	if !(l.Display.Plain && l.Display.NoHeaders) {
+		data = append(data, headers)
+	}
+
+	var groups []string
+	grouped := make(map[string][]*jira.Issue)
+	for _, iss := range l.Data {
+		g := l.Display.GroupBy(iss)
+		if _, ok := grouped[g]; !ok {
+			groups = append(groups, g)
+		}
+		grouped[g] = append(grouped[g], iss)
+	}
+
+	for _, g := range groups {
+		section := make([]string, len(headers))
+		section[0] = fmt.Sprintf("%s (%d)", g, len(grouped[g]))
+
+		sections = append(sections, len(data))
+		data = append(data, section)
+		for _, iss := range grouped[g] {
+			data = append(data, l.assignColumns(headers, iss))
+		}
+	}
+
+	return data, sections
+}
+
+func (l *IssueList) csvData() tui.TableData {
+	var (
+		data    tui.TableData
+		headers []string
+	)
+
+	if len(l.Display.Columns) == 0 {
+		headers = ValidIssueColumns()
+	} else {
+		columnsMap := l.validColumnsMap()
+		for _, c := range l.Display.Columns {
+			c = strings.ToUpper(strings.TrimSpace(c))
+			if _, ok := columnsMap[c]; ok {
+				headers = append(headers, c)
+			}
+		}
+	}
+
+	if !l.Display.NoHeaders {
+		data = append(data, headers)
+	}
+	for _, iss := range l.Data {
+		row := l.assignColumns(headers, iss)
+		for i, c := range headers {
+			if c == fieldSummary {
+				row[i] = strings.TrimSpace(iss.Fields.Summary)
+			}
+		}
+		data = append(data, row)
+	}
+
+	return data
+}
+
+func (l *IssueList) assignColumns(columns []string, issue *jira.Issue) []string {
 	var bucket []string
 
 	for _, column := range columns {
@@ -175,6 +369,10 @@ func (IssueList) assignColumns(columns []string, issue *jira.Issue) []string {
 			bucket = append(bucket, formatDateTime(issue.Fields.Created, jira.RFC3339))
 		case fieldUpdated:
 			bucket = append(bucket, formatDateTime(issue.Fields.Updated, jira.RFC3339))
+		default:
+			if id, ok := l.Display.CustomColumns[column]; ok {
+				bucket = append(bucket, formatCustomField(issue.Fields.CustomFields[id]))
+			}
 		}
 	}
 
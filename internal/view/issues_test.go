@@ -131,6 +131,93 @@ TEST-2	Story	Open	2020-12-13 14:05:20
 	assert.Equal(t, expected, b.String())
 }
 
+func TestIssueRenderInPlainViewWithCustomColumns(t *testing.T) {
+	var b bytes.Buffer
+
+	data := getIssues()
+	data[0].Fields.CustomFields = map[string]interface{}{
+		"customfield_10001": float64(5),
+		"customfield_10002": map[string]interface{}{"value": "Backend"},
+	}
+
+	issue := IssueList{
+		Total:   2,
+		Project: "TEST",
+		Server:  "https://test.local",
+		Data:    data,
+		Display: DisplayFormat{
+			Plain:   true,
+			Columns: []string{"key", "story points", "team"},
+			CustomColumns: map[string]string{
+				"STORY POINTS": "customfield_10001",
+				"TEAM":         "customfield_10002",
+			},
+		},
+	}
+	assert.NoError(t, issue.renderPlain(&b))
+
+	expected := "KEY\tSTORY POINTS\tTEAM\nTEST-1\t5\tBackend\nTEST-2\t\t\n"
+	assert.Equal(t, expected, b.String())
+}
+
+func TestIssueRenderInPlainViewGrouped(t *testing.T) {
+	var b bytes.Buffer
+
+	data := getIssues()
+	data = append(data, &jira.Issue{Key: "TEST-3"})
+	data[2].Fields.Status.Name = "Done"
+
+	issue := IssueList{
+		Total:   3,
+		Project: "TEST",
+		Server:  "https://test.local",
+		Data:    data,
+		Display: DisplayFormat{
+			Plain:   true,
+			Columns: []string{"key", "status"},
+			GroupBy: func(iss *jira.Issue) string {
+				return iss.Fields.Status.Name
+			},
+		},
+	}
+	assert.NoError(t, issue.renderPlain(&b))
+
+	expected := `KEY	STATUS
+Done (2)
+TEST-1	Done
+TEST-3	Done
+
+Open (1)
+TEST-2	Open
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestIssueRenderCSV(t *testing.T) {
+	var b bytes.Buffer
+
+	data := getIssues()
+	data[0].Fields.Summary = "This is a [BE] test,\nwith \"quotes\""
+
+	issue := IssueList{
+		Total:   2,
+		Project: "TEST",
+		Server:  "https://test.local",
+		Data:    data,
+		Display: DisplayFormat{
+			Columns: []string{"summary", "status", "assignee"},
+		},
+	}
+	assert.NoError(t, issue.RenderCSV(&b))
+
+	expected := `SUMMARY,STATUS,ASSIGNEE
+"This is a [BE] test,
+with ""quotes""",Done,Person A
+This is another test,Open,
+`
+	assert.Equal(t, expected, b.String())
+}
+
 func getIssues() []*jira.Issue {
 	return []*jira.Issue{
 		{
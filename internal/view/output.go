@@ -0,0 +1,45 @@
+package view
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+const (
+	// OutputJSON is a json output format passed via the global --output flag.
+	OutputJSON = "json"
+	// OutputCSV is a csv output format passed via the global --output flag.
+	OutputCSV = "csv"
+	// OutputYAML is a yaml output format passed via the global --output flag.
+	OutputYAML = "yaml"
+)
+
+// RenderJSON renders the data in indented json format.
+func RenderJSON(out io.Writer, data interface{}) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(data)
+}
+
+// RenderCSV renders the table data in csv format. Fields containing
+// separators, quotes or line breaks are quoted as per RFC 4180.
+func RenderCSV(out io.Writer, data tui.TableData) error {
+	return csv.NewWriter(out).WriteAll(data)
+}
+
+// RenderYAML renders the data in yaml format.
+func RenderYAML(out io.Writer, data interface{}) error {
+	enc := yaml.NewEncoder(out)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(data); err != nil {
+		return err
+	}
+	return enc.Close()
+}
@@ -0,0 +1,49 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	data := struct {
+		Key    string   `json:"key"`
+		Labels []string `json:"labels"`
+	}{Key: "TEST-1", Labels: []string{"cli"}}
+
+	assert.NoError(t, RenderJSON(&b, data))
+
+	expected := `{
+  "key": "TEST-1",
+  "labels": [
+    "cli"
+  ]
+}
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestRenderYAML(t *testing.T) {
+	var b bytes.Buffer
+
+	data := struct {
+		Key         string   `yaml:"key"`
+		Labels      []string `yaml:"labels"`
+		Description string   `yaml:"description"`
+	}{Key: "TEST-1", Labels: []string{"cli"}, Description: "Line 1\nLine 2"}
+
+	assert.NoError(t, RenderYAML(&b, data))
+
+	expected := `key: TEST-1
+labels:
+  - cli
+description: |-
+  Line 1
+  Line 2
+`
+	assert.Equal(t, expected, b.String())
+}
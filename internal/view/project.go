@@ -15,9 +15,10 @@ type ProjectOption func(*Project)
 
 // Project is a project view.
 type Project struct {
-	data   []*jira.Project
-	writer io.Writer
-	buf    *bytes.Buffer
+	data    []*jira.Project
+	display DisplayFormat
+	writer  io.Writer
+	buf     *bytes.Buffer
 }
 
 // NewProject initializes a project.
@@ -41,12 +42,24 @@ func WithProjectWriter(w io.Writer) ProjectOption {
 	}
 }
 
+// WithProjectDisplayFormat sets a display format for the project.
+func WithProjectDisplayFormat(df DisplayFormat) ProjectOption {
+	return func(p *Project) {
+		p.display = df
+	}
+}
+
 // Render renders the project view.
 func (p Project) Render() error {
-	p.printHeader()
+	if !p.display.NoHeaders {
+		p.printHeader()
+	}
 
 	for _, d := range p.data {
-		fmt.Fprintf(p.writer, "%s\t%s\t%s\t%s\n", d.Key, prepareTitle(d.Name), d.Type, d.Lead.Name)
+		fmt.Fprintf(
+			p.writer, "%s\t%s\t%s\t%s\t%s\n",
+			d.Key, prepareTitle(d.Name), d.Type, d.Lead.Name, d.ProjectTypeKey,
+		)
 	}
 	if _, ok := p.writer.(*tabwriter.Writer); ok {
 		err := p.writer.(*tabwriter.Writer).Flush()
@@ -55,15 +68,25 @@ func (p Project) Render() error {
 		}
 	}
 
+	if p.display.Plain {
+		_, err := fmt.Print(p.buf.String())
+		return err
+	}
 	return tui.PagerOut(p.buf.String())
 }
 
+// RenderJSON renders the project list in json format.
+func (p Project) RenderJSON(out io.Writer) error {
+	return RenderJSON(out, p.data)
+}
+
 func (p Project) header() []string {
 	return []string{
 		"KEY",
 		"NAME",
 		"TYPE",
 		"LEAD",
+		"PROJECT TYPE",
 	}
 }
 
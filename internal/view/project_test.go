@@ -17,17 +17,39 @@ func TestProjectRender(t *testing.T) {
 	}
 
 	data := []*jira.Project{
-		{Key: "FRST", Name: "First", Lead: lead{Name: "Person A"}, Type: jira.ProjectTypeClassic},
-		{Key: "SCND", Name: "[2] Second", Lead: lead{Name: "Person B"}, Type: jira.ProjectTypeNextGen},
-		{Key: "THIRD", Name: "Third", Lead: lead{Name: "Person C"}, Type: jira.ProjectTypeClassic},
+		{Key: "FRST", Name: "First", Lead: lead{Name: "Person A"}, Type: jira.ProjectTypeClassic, ProjectTypeKey: "software"},
+		{Key: "SCND", Name: "[2] Second", Lead: lead{Name: "Person B"}, Type: jira.ProjectTypeNextGen, ProjectTypeKey: "business"},
+		{Key: "THIRD", Name: "Third", Lead: lead{Name: "Person C"}, Type: jira.ProjectTypeClassic, ProjectTypeKey: "service_desk"},
 	}
 	board := NewProject(data, WithProjectWriter(&b))
 	assert.NoError(t, board.Render())
 
-	expected := `KEY	NAME	TYPE	LEAD
-FRST	First	classic	Person A
-SCND	⦗2⦘ Second	next-gen	Person B
-THIRD	Third	classic	Person C
+	expected := `KEY	NAME	TYPE	LEAD	PROJECT TYPE
+FRST	First	classic	Person A	software
+SCND	⦗2⦘ Second	next-gen	Person B	business
+THIRD	Third	classic	Person C	service_desk
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestProjectRenderJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	p := NewProject([]*jira.Project{{ID: "10000", Key: "FRST", Name: "First", Type: jira.ProjectTypeClassic, ProjectTypeKey: "software"}})
+	assert.NoError(t, p.RenderJSON(&b))
+
+	expected := `[
+  {
+    "id": "10000",
+    "key": "FRST",
+    "name": "First",
+    "lead": {
+      "displayName": ""
+    },
+    "style": "classic",
+    "projectTypeKey": "software"
+  }
+]
 `
 	assert.Equal(t, expected, b.String())
 }
@@ -0,0 +1,90 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// ReleaseOption is a functional option to wrap release properties.
+type ReleaseOption func(*Release)
+
+// Release is a project version list view.
+type Release struct {
+	data    []*jira.Version
+	display DisplayFormat
+	writer  io.Writer
+	buf     *bytes.Buffer
+}
+
+// NewRelease initializes a release list view.
+func NewRelease(data []*jira.Version, opts ...ReleaseOption) *Release {
+	r := Release{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	r.writer = tabwriter.NewWriter(r.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return &r
+}
+
+// WithReleaseWriter sets a writer for the release list.
+func WithReleaseWriter(w io.Writer) ReleaseOption {
+	return func(r *Release) {
+		r.writer = w
+	}
+}
+
+// WithReleaseDisplayFormat sets a display format for the release list.
+func WithReleaseDisplayFormat(df DisplayFormat) ReleaseOption {
+	return func(r *Release) {
+		r.display = df
+	}
+}
+
+// Render renders the release list view.
+func (r Release) Render() error {
+	if !r.display.NoHeaders {
+		fmt.Fprintln(r.writer, "ID\tNAME\tSTATUS\tSTART DATE\tRELEASE DATE\tDESCRIPTION")
+	}
+
+	for _, d := range r.data {
+		fmt.Fprintf(
+			r.writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			d.ID, prepareTitle(d.Name), releaseStatus(d), d.StartDate, d.ReleaseDate, prepareTitle(d.Description),
+		)
+	}
+	if w, ok := r.writer.(*tabwriter.Writer); ok {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if r.display.Plain {
+		_, err := fmt.Print(r.buf.String())
+		return err
+	}
+	return tui.PagerOut(r.buf.String())
+}
+
+// RenderJSON renders the release list in json format.
+func (r Release) RenderJSON(out io.Writer) error {
+	return RenderJSON(out, r.data)
+}
+
+func releaseStatus(v *jira.Version) string {
+	switch {
+	case v.Archived:
+		return "Archived"
+	case v.Released:
+		return "Released"
+	}
+	return "Unreleased"
+}
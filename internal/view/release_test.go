@@ -0,0 +1,48 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestReleaseRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Version{
+		{ID: "10000", Name: "v1.0", Released: true, StartDate: "2022-01-01", ReleaseDate: "2022-01-10"},
+		{ID: "10001", Name: "v1.1", Archived: true, Released: true, Description: "Hotfix"},
+		{ID: "10002", Name: "v2.0"},
+	}
+	r := NewRelease(data, WithReleaseWriter(&b))
+	assert.NoError(t, r.Render())
+
+	expected := `ID	NAME	STATUS	START DATE	RELEASE DATE	DESCRIPTION
+10000	v1.0	Released	2022-01-01	2022-01-10	
+10001	v1.1	Archived			Hotfix
+10002	v2.0	Unreleased			
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestReleaseRenderJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	r := NewRelease([]*jira.Version{{ID: "10000", Name: "v1.0", Released: true, ReleaseDate: "2022-01-10"}})
+	assert.NoError(t, r.RenderJSON(&b))
+
+	expected := `[
+  {
+    "id": "10000",
+    "name": "v1.0",
+    "archived": false,
+    "released": true,
+    "releaseDate": "2022-01-10"
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+}
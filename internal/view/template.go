@@ -0,0 +1,82 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// IssueTemplate renders an issue using a user defined Go template.
+//
+// The template receives the issue as is, eg: {{.Key}} or {{.Fields.Summary}}, and
+// can use the following functions on top of the built-in ones:
+//
+//	markdown  converts a description or comment body to markdown, eg: {{markdown .Fields.Description}}
+//	date      formats a date time field, eg: {{date "2006-01-02" .Fields.Created}}
+//	url       returns the browse url of an issue, eg: {{url .Key}}
+//	join      joins a list of strings, eg: {{join ", " .Fields.Labels}}
+//	upper, lower and trim
+type IssueTemplate struct {
+	Server string
+	Data   *jira.Issue
+}
+
+// Render executes the template and writes the output to w.
+func (t IssueTemplate) Render(w io.Writer, body string) error {
+	tmpl, err := template.New("issue").Funcs(t.funcs()).Parse(body)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	if err := tmpl.Execute(w, t.Data); err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	return nil
+}
+
+// RenderIssuesTemplate executes the template for each issue and writes the output to w,
+// one issue per line. Escape sequences \t and \n in the template are interpreted so that
+// it can be passed in single quotes, eg: '{{.Key}}\t{{.Fields.Summary}}'. The template
+// receives a single issue and can use the same functions as IssueTemplate.
+func RenderIssuesTemplate(w io.Writer, server, body string, issues []*jira.Issue) error {
+	body = strings.NewReplacer(`\t`, "\t", `\n`, "\n").Replace(body)
+	if !strings.HasSuffix(body, "\n") {
+		body += "\n"
+	}
+
+	tmpl, err := template.New("issues").Funcs(IssueTemplate{Server: server}.funcs()).Parse(body)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	for _, iss := range issues {
+		if err := tmpl.Execute(w, iss); err != nil {
+			return fmt.Errorf("invalid template: %w", err)
+		}
+	}
+	return nil
+}
+
+func (t IssueTemplate) funcs() template.FuncMap {
+	return template.FuncMap{
+		"markdown": toMarkdown,
+		"date": func(layout, dt string) string {
+			d, err := time.Parse(jira.RFC3339, dt)
+			if err != nil {
+				return dt
+			}
+			return d.Format(layout)
+		},
+		"url": func(key string) string {
+			return fmt.Sprintf("%s/browse/%s", t.Server, key)
+		},
+		"join": func(sep string, elems []string) string {
+			return strings.Join(elems, sep)
+		},
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"trim":  strings.TrimSpace,
+	}
+}
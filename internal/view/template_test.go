@@ -0,0 +1,64 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestIssueTemplateRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := &jira.Issue{
+		Key: "TEST-1",
+		Fields: jira.IssueFields{
+			Summary:     "  This is a test  ",
+			Description: "This is a *bold* text.",
+			Labels:      []string{"backend", "urgent"},
+			Created:     "2022-02-01T09:00:00.000+0100",
+			Status: struct {
+				Name string `json:"name"`
+			}{Name: "Done"},
+		},
+	}
+
+	tmpl := `- [{{.Key}}]({{url .Key}}) {{trim .Fields.Summary}} ({{lower .Fields.Status.Name}})
+  Created: {{date "2006-01-02" .Fields.Created}}, labels: {{join ", " .Fields.Labels}}
+  {{markdown .Fields.Description}}`
+
+	it := IssueTemplate{Server: "https://test.local", Data: data}
+	assert.NoError(t, it.Render(&b, tmpl))
+
+	expected := `- [TEST-1](https://test.local/browse/TEST-1) This is a test (done)
+  Created: 2022-02-01, labels: backend, urgent
+  This is a **bold** text.
+`
+	assert.Equal(t, expected, b.String())
+
+	assert.Error(t, it.Render(&b, "{{.Key"))
+	assert.Error(t, it.Render(&b, "{{.Unknown}}"))
+}
+
+func TestRenderIssuesTemplate(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.Issue{
+		{Key: "TEST-1", Fields: jira.IssueFields{Summary: "First", Labels: []string{"cli"}}},
+		{Key: "TEST-2", Fields: jira.IssueFields{Summary: "Second"}},
+	}
+
+	tmpl := `{{.Key}}\t{{upper .Fields.Summary}}\t{{join "," .Fields.Labels}}`
+
+	assert.NoError(t, RenderIssuesTemplate(&b, "https://test.local", tmpl, data))
+	assert.Equal(t, "TEST-1\tFIRST\tcli\nTEST-2\tSECOND\t\n", b.String())
+
+	b.Reset()
+	assert.NoError(t, RenderIssuesTemplate(&b, "https://test.local", "{{url .Key}}\n", data))
+	assert.Equal(t, "https://test.local/browse/TEST-1\nhttps://test.local/browse/TEST-2\n", b.String())
+
+	assert.Error(t, RenderIssuesTemplate(&b, "", "{{.Key", data))
+	assert.Error(t, RenderIssuesTemplate(&b, "", "{{.Unknown}}", data))
+}
@@ -0,0 +1,82 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// UserOption is a functional option to wrap user properties.
+type UserOption func(*User)
+
+// User is a user list view.
+type User struct {
+	data    []*jira.User
+	display DisplayFormat
+	writer  io.Writer
+	buf     *bytes.Buffer
+}
+
+// NewUser initializes a user list view.
+func NewUser(data []*jira.User, opts ...UserOption) *User {
+	u := User{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	u.writer = tabwriter.NewWriter(u.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&u)
+	}
+	return &u
+}
+
+// WithUserWriter sets a writer for the user list.
+func WithUserWriter(w io.Writer) UserOption {
+	return func(u *User) {
+		u.writer = w
+	}
+}
+
+// WithUserDisplayFormat sets a display format for the user list.
+func WithUserDisplayFormat(df DisplayFormat) UserOption {
+	return func(u *User) {
+		u.display = df
+	}
+}
+
+// Render renders the user list view. Users are identified by their account id
+// in Jira cloud and by their username in Jira server.
+func (u User) Render() error {
+	if !u.display.NoHeaders {
+		fmt.Fprintln(u.writer, "ID\tNAME\tEMAIL\tACTIVE")
+	}
+
+	for _, d := range u.data {
+		id := d.AccountID
+		if id == "" {
+			id = d.Login
+		}
+		fmt.Fprintf(u.writer, "%s\t%s\t%s\t%t\n", id, d.Name, d.Email, d.Active)
+	}
+	if w, ok := u.writer.(*tabwriter.Writer); ok {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if u.display.Plain {
+		_, err := fmt.Print(u.buf.String())
+		return err
+	}
+	return tui.PagerOut(u.buf.String())
+}
+
+// RenderJSON renders the user list in json format.
+func (u User) RenderJSON(out io.Writer) error {
+	return RenderJSON(out, u.data)
+}
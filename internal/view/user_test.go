@@ -0,0 +1,26 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestUserRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.User{
+		{AccountID: "5fb82376aca10c006949f35b", Name: "Jane Doe", Email: "jane@domain.tld", Active: true},
+		{Login: "jon", Name: "Jon Doe"},
+	}
+	assert.NoError(t, NewUser(data, WithUserWriter(&b)).Render())
+
+	expected := `ID	NAME	EMAIL	ACTIVE
+5fb82376aca10c006949f35b	Jane Doe	jane@domain.tld	true
+jon	Jon Doe		false
+`
+	assert.Equal(t, expected, b.String())
+}
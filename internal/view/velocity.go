@@ -0,0 +1,121 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// VelocityOption is a functional option to wrap velocity properties.
+type VelocityOption func(*Velocity)
+
+// Velocity is a sprint velocity report view.
+type Velocity struct {
+	data    []*jira.SprintVelocity
+	display DisplayFormat
+	writer  io.Writer
+	buf     *bytes.Buffer
+}
+
+// velocityEntry is an exported representation of a sprint velocity.
+type velocityEntry struct {
+	ID        int     `json:"id"`
+	Name      string  `json:"name"`
+	Committed float64 `json:"committed"`
+	Completed float64 `json:"completed"`
+}
+
+// NewVelocity initializes a velocity report view.
+func NewVelocity(data []*jira.SprintVelocity, opts ...VelocityOption) *Velocity {
+	v := Velocity{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	v.writer = tabwriter.NewWriter(v.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&v)
+	}
+	return &v
+}
+
+// WithVelocityWriter sets a writer for the velocity report.
+func WithVelocityWriter(w io.Writer) VelocityOption {
+	return func(v *Velocity) {
+		v.writer = w
+	}
+}
+
+// WithVelocityDisplayFormat sets a display format for the velocity report.
+func WithVelocityDisplayFormat(df DisplayFormat) VelocityOption {
+	return func(v *Velocity) {
+		v.display = df
+	}
+}
+
+// Render renders the velocity report in a table with an average of all sprints.
+func (v Velocity) Render() error {
+	if !v.display.NoHeaders {
+		fmt.Fprintln(v.writer, "ID\tNAME\tCOMMITTED\tCOMPLETED\tCOMPLETION")
+	}
+
+	var committed, completed float64
+	for _, d := range v.data {
+		committed += d.Committed
+		completed += d.Completed
+
+		fmt.Fprintf(
+			v.writer, "%d\t%s\t%s\t%s\t%s\n",
+			d.Sprint.ID, prepareTitle(d.Sprint.Name), formatPoints(d.Committed), formatPoints(d.Completed),
+			completion(d.Committed, d.Completed),
+		)
+	}
+	if n := float64(len(v.data)); n > 0 {
+		fmt.Fprintf(
+			v.writer, "\tAVERAGE\t%s\t%s\t%s\n",
+			formatPoints(committed/n), formatPoints(completed/n), completion(committed, completed),
+		)
+	}
+	if _, ok := v.writer.(*tabwriter.Writer); ok {
+		err := v.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	if v.display.Plain {
+		_, err := fmt.Print(v.buf.String())
+		return err
+	}
+	return tui.PagerOut(v.buf.String())
+}
+
+// RenderJSON renders the velocity report in json format.
+func (v Velocity) RenderJSON(out io.Writer) error {
+	entries := make([]velocityEntry, 0, len(v.data))
+	for _, d := range v.data {
+		entries = append(entries, velocityEntry{
+			ID:        d.Sprint.ID,
+			Name:      d.Sprint.Name,
+			Committed: d.Committed,
+			Completed: d.Completed,
+		})
+	}
+
+	return RenderJSON(out, entries)
+}
+
+func formatPoints(p float64) string {
+	return fmt.Sprintf("%.1f", p)
+}
+
+func completion(committed, completed float64) string {
+	if committed == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", completed/committed*100) //nolint:gomnd
+}
@@ -0,0 +1,51 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func getVelocityData() []*jira.SprintVelocity {
+	return []*jira.SprintVelocity{
+		{Sprint: &jira.Sprint{ID: 3, Name: "Sprint 3"}, Committed: 20, Completed: 15},
+		{Sprint: &jira.Sprint{ID: 2, Name: "Sprint 2"}, Committed: 10, Completed: 10},
+		{Sprint: &jira.Sprint{ID: 1, Name: "Sprint 1"}, Committed: 0, Completed: 2},
+	}
+}
+
+func TestVelocityRender(t *testing.T) {
+	var b bytes.Buffer
+
+	v := NewVelocity(getVelocityData(), WithVelocityWriter(&b))
+	assert.NoError(t, v.Render())
+
+	expected := `ID	NAME	COMMITTED	COMPLETED	COMPLETION
+3	Sprint 3	20.0	15.0	75%
+2	Sprint 2	10.0	10.0	100%
+1	Sprint 1	0.0	2.0	-
+	AVERAGE	10.0	9.0	90%
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestVelocityRenderJSON(t *testing.T) {
+	var b bytes.Buffer
+
+	v := NewVelocity(getVelocityData()[:1])
+	assert.NoError(t, v.RenderJSON(&b))
+
+	expected := `[
+  {
+    "id": 3,
+    "name": "Sprint 3",
+    "committed": 20,
+    "completed": 15
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+}
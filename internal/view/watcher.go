@@ -0,0 +1,62 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// WatcherOption is a functional option to wrap watcher properties.
+type WatcherOption func(*Watchers)
+
+// Watchers is an issue watchers view.
+type Watchers struct {
+	data   []*jira.User
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewWatchers initializes an issue watchers view.
+func NewWatchers(data []*jira.User, opts ...WatcherOption) *Watchers {
+	w := Watchers{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	w.writer = tabwriter.NewWriter(w.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&w)
+	}
+	return &w
+}
+
+// WithWatcherWriter sets a writer for the watchers view.
+func WithWatcherWriter(w io.Writer) WatcherOption {
+	return func(ws *Watchers) {
+		ws.writer = w
+	}
+}
+
+// Render renders the watchers view.
+func (w Watchers) Render() error {
+	fmt.Fprintln(w.writer, "NAME\tEMAIL\tID\tACTIVE")
+
+	for _, u := range w.data {
+		id := u.AccountID
+		if u.Login != "" {
+			id = u.Login
+		}
+		fmt.Fprintf(w.writer, "%s\t%s\t%s\t%t\n", u.Name, u.Email, id, u.Active)
+	}
+	if tw, ok := w.writer.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(w.buf.String())
+}
@@ -0,0 +1,26 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestWatchersRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.User{
+		{AccountID: "a-1", Name: "Person A", Email: "a@domain.tld", Active: true},
+		{AccountID: "a-2", Login: "person.b", Name: "Person B"},
+	}
+	assert.NoError(t, NewWatchers(data, WithWatcherWriter(&b)).Render())
+
+	expected := `NAME	EMAIL	ID	ACTIVE
+Person A	a@domain.tld	a-1	true
+Person B		person.b	false
+`
+	assert.Equal(t, expected, b.String())
+}
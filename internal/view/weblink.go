@@ -0,0 +1,58 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// WebLinkOption is a functional option to wrap web link properties.
+type WebLinkOption func(*WebLinks)
+
+// WebLinks is an issue web links view.
+type WebLinks struct {
+	data   []*jira.RemoteLink
+	writer io.Writer
+	buf    *bytes.Buffer
+}
+
+// NewWebLinks initializes an issue web links view.
+func NewWebLinks(data []*jira.RemoteLink, opts ...WebLinkOption) *WebLinks {
+	w := WebLinks{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	w.writer = tabwriter.NewWriter(w.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&w)
+	}
+	return &w
+}
+
+// WithWebLinkWriter sets a writer for the web links view.
+func WithWebLinkWriter(w io.Writer) WebLinkOption {
+	return func(wl *WebLinks) {
+		wl.writer = w
+	}
+}
+
+// Render renders the web links view.
+func (w WebLinks) Render() error {
+	fmt.Fprintln(w.writer, "ID\tTITLE\tURL")
+
+	for _, l := range w.data {
+		fmt.Fprintf(w.writer, "%d\t%s\t%s\n", l.ID, l.Object.Title, l.Object.URL)
+	}
+	if tw, ok := w.writer.(*tabwriter.Writer); ok {
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return tui.PagerOut(w.buf.String())
+}
@@ -0,0 +1,26 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestWebLinksRender(t *testing.T) {
+	var b bytes.Buffer
+
+	data := []*jira.RemoteLink{
+		{ID: 10000, Object: jira.RemoteLinkObject{URL: "https://docs.example.com", Title: "Design doc"}},
+		{ID: 10001, Object: jira.RemoteLinkObject{URL: "https://ci.example.com/1", Title: "Build #1"}},
+	}
+	assert.NoError(t, NewWebLinks(data, WithWebLinkWriter(&b)).Render())
+
+	expected := `ID	TITLE	URL
+10000	Design doc	https://docs.example.com
+10001	Build #1	https://ci.example.com/1
+`
+	assert.Equal(t, expected, b.String())
+}
@@ -0,0 +1,160 @@
+package view
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+	"github.com/ankitpokhrel/jira-cli/pkg/tui"
+)
+
+// WorklogOption is a functional option to wrap worklog properties.
+type WorklogOption func(*Worklog)
+
+// Worklog is a worklog list view.
+type Worklog struct {
+	issueKey string
+	data     []*jira.Worklog
+	display  DisplayFormat
+	writer   io.Writer
+	buf      *bytes.Buffer
+}
+
+// worklogEntry is an exported representation of a worklog.
+type worklogEntry struct {
+	Issue   string `json:"issue"`
+	ID      string `json:"id"`
+	Author  string `json:"author"`
+	Started string `json:"started"`
+	Seconds int    `json:"seconds"`
+	Comment string `json:"comment"`
+}
+
+// NewWorklog initializes a worklog list view.
+func NewWorklog(data []*jira.Worklog, opts ...WorklogOption) *Worklog {
+	w := Worklog{
+		data: data,
+		buf:  new(bytes.Buffer),
+	}
+	w.writer = tabwriter.NewWriter(w.buf, 0, tabWidth, 1, '\t', 0)
+
+	for _, opt := range opts {
+		opt(&w)
+	}
+	return &w
+}
+
+// WithWorklogWriter sets a writer for the worklog.
+func WithWorklogWriter(w io.Writer) WorklogOption {
+	return func(wl *Worklog) {
+		wl.writer = w
+	}
+}
+
+// WithWorklogIssueKey sets the key of the issue the worklogs belong to.
+func WithWorklogIssueKey(key string) WorklogOption {
+	return func(wl *Worklog) {
+		wl.issueKey = key
+	}
+}
+
+// WithWorklogDisplayFormat sets a display format for the worklog.
+func WithWorklogDisplayFormat(df DisplayFormat) WorklogOption {
+	return func(wl *Worklog) {
+		wl.display = df
+	}
+}
+
+// Render renders the worklog view.
+func (w Worklog) Render() error {
+	if !w.display.NoHeaders {
+		w.printHeader()
+	}
+
+	for _, d := range w.data {
+		fmt.Fprintf(
+			w.writer, "%s\t%s\t%s\t%s\t%s\n",
+			d.ID, d.Author.Name, formatDateTime(d.Started, jira.RFC3339), d.TimeSpent, w.comment(d.Comment),
+		)
+	}
+	if _, ok := w.writer.(*tabwriter.Writer); ok {
+		err := w.writer.(*tabwriter.Writer).Flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	if w.display.Plain {
+		_, err := fmt.Print(w.buf.String())
+		return err
+	}
+	return tui.PagerOut(w.buf.String())
+}
+
+// RenderCSV renders raw worklog entries in csv format.
+func (w Worklog) RenderCSV(out io.Writer) error {
+	var data tui.TableData
+
+	if !w.display.NoHeaders {
+		data = append(data, []string{"issue", "id", "author", "started", "seconds", "comment"})
+	}
+	for _, e := range w.entries() {
+		data = append(data, []string{e.Issue, e.ID, e.Author, e.Started, strconv.Itoa(e.Seconds), e.Comment})
+	}
+
+	return RenderCSV(out, data)
+}
+
+// RenderJSON renders raw worklog entries in json format.
+func (w Worklog) RenderJSON(out io.Writer) error {
+	return RenderJSON(out, w.entries())
+}
+
+func (w Worklog) entries() []worklogEntry {
+	entries := make([]worklogEntry, 0, len(w.data))
+	for _, d := range w.data {
+		entries = append(entries, worklogEntry{
+			Issue:   w.issueKey,
+			ID:      d.ID,
+			Author:  d.Author.Name,
+			Started: d.Started,
+			Seconds: d.TimeSpentSeconds,
+			Comment: d.Comment,
+		})
+	}
+	return entries
+}
+
+func (w Worklog) comment(c string) string {
+	c = strings.Join(strings.Fields(c), " ")
+	if w.display.NoTruncate || len(c) <= maxColWidth {
+		return c
+	}
+	return shortenAndPad(c, maxColWidth)
+}
+
+func (w Worklog) header() []string {
+	return []string{
+		"ID",
+		"AUTHOR",
+		"STARTED",
+		"TIME SPENT",
+		"COMMENT",
+	}
+}
+
+func (w Worklog) printHeader() {
+	headers := w.header()
+	end := len(headers) - 1
+	for i, h := range headers {
+		fmt.Fprintf(w.writer, "%s", h)
+		if i != end {
+			fmt.Fprintf(w.writer, "\t")
+		}
+	}
+	fmt.Fprintln(w.writer)
+}
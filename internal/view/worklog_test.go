@@ -0,0 +1,110 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestWorklogRender(t *testing.T) {
+	data := []*jira.Worklog{
+		{
+			ID:        "10001",
+			Author:    jira.User{Name: "Person A"},
+			Comment:   "Implementation",
+			Started:   "2022-02-02T13:35:00.000+0100",
+			TimeSpent: "1h",
+		},
+		{
+			ID:        "10002",
+			Author:    jira.User{Name: "Person B"},
+			Comment:   "Multi-line\n\ncomment",
+			Started:   "2022-02-03T09:00:00.000+0100",
+			TimeSpent: "30m",
+		},
+	}
+
+	var b bytes.Buffer
+
+	wl := NewWorklog(data, WithWorklogWriter(&b), WithWorklogDisplayFormat(DisplayFormat{NoTruncate: true}))
+	assert.NoError(t, wl.Render())
+
+	expected := `ID	AUTHOR	STARTED	TIME SPENT	COMMENT
+10001	Person A	2022-02-02 13:35:00	1h	Implementation
+10002	Person B	2022-02-03 09:00:00	30m	Multi-line comment
+`
+	assert.Equal(t, expected, b.String())
+
+	b.Reset()
+
+	wl = NewWorklog(data, WithWorklogWriter(&b), WithWorklogDisplayFormat(DisplayFormat{NoHeaders: true, NoTruncate: true}))
+	assert.NoError(t, wl.Render())
+
+	expected = `10001	Person A	2022-02-02 13:35:00	1h	Implementation
+10002	Person B	2022-02-03 09:00:00	30m	Multi-line comment
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestWorklogRenderCSV(t *testing.T) {
+	data := []*jira.Worklog{
+		{
+			ID:               "10001",
+			Author:           jira.User{Name: "Person A"},
+			Comment:          "Implementation, tests",
+			Started:          "2022-02-02T13:35:00.000+0100",
+			TimeSpentSeconds: 3600,
+		},
+		{
+			ID:               "10002",
+			Author:           jira.User{Name: "Person B"},
+			Comment:          "Code review",
+			Started:          "2022-02-03T09:00:00.000+0100",
+			TimeSpentSeconds: 1800,
+		},
+	}
+
+	var b bytes.Buffer
+
+	wl := NewWorklog(data, WithWorklogIssueKey("TEST-1"))
+	assert.NoError(t, wl.RenderCSV(&b))
+
+	expected := `issue,id,author,started,seconds,comment
+TEST-1,10001,Person A,2022-02-02T13:35:00.000+0100,3600,"Implementation, tests"
+TEST-1,10002,Person B,2022-02-03T09:00:00.000+0100,1800,Code review
+`
+	assert.Equal(t, expected, b.String())
+}
+
+func TestWorklogRenderJSON(t *testing.T) {
+	data := []*jira.Worklog{
+		{
+			ID:               "10001",
+			Author:           jira.User{Name: "Person A"},
+			Comment:          "Implementation",
+			Started:          "2022-02-02T13:35:00.000+0100",
+			TimeSpentSeconds: 3600,
+		},
+	}
+
+	var b bytes.Buffer
+
+	wl := NewWorklog(data, WithWorklogIssueKey("TEST-1"))
+	assert.NoError(t, wl.RenderJSON(&b))
+
+	expected := `[
+  {
+    "issue": "TEST-1",
+    "id": "10001",
+    "author": "Person A",
+    "started": "2022-02-02T13:35:00.000+0100",
+    "seconds": 3600,
+    "comment": "Implementation"
+  }
+]
+`
+	assert.Equal(t, expected, b.String())
+}
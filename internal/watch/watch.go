@@ -0,0 +1,132 @@
+// Package watch finds the changes to issues worth a notification: new comments, transitions and assignments.
+package watch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const maxMessageLength = 200
+
+// Notification is a change to an issue.
+type Notification struct {
+	Key     string
+	Title   string
+	Message string
+	Created time.Time
+}
+
+func (n *Notification) String() string {
+	return fmt.Sprintf("%s: %s", n.Title, strings.ReplaceAll(n.Message, "\n", " "))
+}
+
+// Watcher finds the changes made after the given time by anyone but the user.
+// Each change is only reported once.
+type Watcher struct {
+	since time.Time
+	me    *jira.Me
+	seen  map[string]struct{}
+}
+
+// New creates a watcher for the changes made after since by anyone but me.
+func New(since time.Time, me *jira.Me) *Watcher {
+	return &Watcher{
+		since: since,
+		me:    me,
+		seen:  make(map[string]struct{}),
+	}
+}
+
+// Changes returns the notifications for the changes in the changelog and the comments of
+// the issue that were not reported yet, oldest first.
+func (w *Watcher) Changes(
+	issue *jira.Issue, histories []*jira.ChangelogHistory, comments []*jira.Comment,
+) []*Notification {
+	var out []*Notification
+
+	for _, h := range histories {
+		created, ok := w.unseen("history:"+h.ID, h.Created, &h.Author)
+		if !ok {
+			continue
+		}
+		for _, item := range h.Items {
+			var title string
+
+			switch item.Field {
+			case "status":
+				title = fmt.Sprintf("%s moved to %s", issue.Key, item.ToString)
+			case "assignee":
+				to := item.ToString
+				if to == "" {
+					to = "no one"
+				}
+				title = fmt.Sprintf("%s assigned to %s", issue.Key, to)
+			default:
+				continue
+			}
+
+			out = append(out, &Notification{
+				Key:     issue.Key,
+				Title:   title,
+				Message: fmt.Sprintf("%s\nby %s", issue.Fields.Summary, h.Author.Name),
+				Created: created,
+			})
+		}
+	}
+
+	for _, c := range comments {
+		created, ok := w.unseen("comment:"+c.ID, c.Created, &c.Author)
+		if !ok {
+			continue
+		}
+		out = append(out, &Notification{
+			Key:     issue.Key,
+			Title:   fmt.Sprintf("%s commented on %s", c.Author.Name, issue.Key),
+			Message: shorten(strings.TrimSpace(c.Body)),
+			Created: created,
+		})
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Created.Before(out[j].Created)
+	})
+
+	return out
+}
+
+// unseen marks the change as seen and reports whether it should be notified, ie: it was
+// made after since by someone else and was not seen before.
+func (w *Watcher) unseen(id, created string, author *jira.User) (time.Time, bool) {
+	if _, ok := w.seen[id]; ok {
+		return time.Time{}, false
+	}
+	w.seen[id] = struct{}{}
+
+	t, err := time.Parse(jira.RFC3339MilliLayout, created)
+	if err != nil || !t.After(w.since) || w.isMe(author) {
+		return t, false
+	}
+	return t, true
+}
+
+func (w *Watcher) isMe(u *jira.User) bool {
+	if w.me == nil {
+		return false
+	}
+	if u.AccountID != "" {
+		return u.AccountID == w.me.AccountID
+	}
+	return u.Login != "" && u.Login == w.me.Login
+}
+
+func shorten(s string) string {
+	r := []rune(s)
+	if len(r) <= maxMessageLength {
+		return s
+	}
+	return string(r[:maxMessageLength-1]) + "…"
+}
@@ -0,0 +1,73 @@
+package watch
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestWatcherChanges(t *testing.T) {
+	t.Parallel()
+
+	var (
+		me    = &jira.Me{AccountID: "me-1"}
+		jane  = jira.User{AccountID: "jane-1", Name: "Jane Doe"}
+		since = time.Date(2022, 5, 1, 10, 0, 0, 0, time.UTC)
+		issue = &jira.Issue{Key: "TEST-1", Fields: jira.IssueFields{Summary: "Login is broken"}}
+	)
+
+	histories := []*jira.ChangelogHistory{
+		{
+			ID:      "1",
+			Author:  jane,
+			Created: "2022-05-01T09:00:00.000+0000",
+			Items:   []*jira.ChangelogItem{{Field: "status", FromString: "Open", ToString: "To Do"}},
+		},
+		{
+			ID:      "2",
+			Author:  jane,
+			Created: "2022-05-01T10:05:00.000+0000",
+			Items: []*jira.ChangelogItem{
+				{Field: "status", FromString: "To Do", ToString: "In Progress"},
+				{Field: "labels", FromString: "", ToString: "backend"},
+			},
+		},
+		{
+			ID:      "3",
+			Author:  jira.User{AccountID: "me-1", Name: "Me"},
+			Created: "2022-05-01T10:06:00.000+0000",
+			Items:   []*jira.ChangelogItem{{Field: "status", FromString: "In Progress", ToString: "Done"}},
+		},
+		{
+			ID:      "4",
+			Author:  jane,
+			Created: "2022-05-01T10:07:00.000+0000",
+			Items:   []*jira.ChangelogItem{{Field: "assignee", FromString: "Me", ToString: ""}},
+		},
+	}
+	comments := []*jira.Comment{
+		{ID: "10", Author: jane, Body: "Old comment", Created: "2022-05-01T09:30:00.000+0000"},
+		{ID: "11", Author: jane, Body: "Can you check?\n", Created: "2022-05-01T10:06:30.000+0000"},
+		{ID: "12", Author: jane, Body: strings.Repeat("a", 250), Created: "2022-05-01T10:08:00.000+0000"},
+	}
+
+	w := New(since, me)
+	got := w.Changes(issue, histories, comments)
+
+	assert.Len(t, got, 4)
+	assert.Equal(t, "TEST-1 moved to In Progress", got[0].Title)
+	assert.Equal(t, "Login is broken\nby Jane Doe", got[0].Message)
+	assert.Equal(t, "Jane Doe commented on TEST-1", got[1].Title)
+	assert.Equal(t, "Can you check?", got[1].Message)
+	assert.Equal(t, "TEST-1 assigned to no one", got[2].Title)
+	assert.Equal(t, "Jane Doe commented on TEST-1", got[3].Title)
+	assert.Len(t, []rune(got[3].Message), maxMessageLength)
+	assert.Equal(t, "TEST-1 moved to In Progress: Login is broken by Jane Doe", got[0].String())
+
+	// Changes are reported only once.
+	assert.Empty(t, w.Changes(issue, histories, comments))
+}
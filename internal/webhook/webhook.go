@@ -0,0 +1,224 @@
+// Package webhook receives Jira webhooks and normalizes their payloads.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader is the header with the signature of the payload, sent
+	// by Jira Cloud if the webhook has a secret, eg: sha256=<hex encoded HMAC>.
+	SignatureHeader = "X-Hub-Signature"
+
+	// SecretParam is the query parameter with the secret, for Jira instances
+	// that don't sign the payloads, eg: https://example.com/?secret=s3cr3t.
+	SecretParam = "secret"
+
+	maxPayloadSize = 10 << 20
+)
+
+// Event is a normalized Jira webhook event.
+type Event struct {
+	Event     string          `json:"event"`
+	Type      string          `json:"type,omitempty"`
+	Timestamp time.Time       `json:"timestamp"`
+	User      string          `json:"user,omitempty"`
+	Issue     *Issue          `json:"issue,omitempty"`
+	Changes   []Change        `json:"changes,omitempty"`
+	Comment   *Comment        `json:"comment,omitempty"`
+	Raw       json.RawMessage `json:"-"`
+}
+
+// Issue is the issue the event is about.
+type Issue struct {
+	ID       string `json:"id"`
+	Key      string `json:"key"`
+	Project  string `json:"project,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Summary  string `json:"summary,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Assignee string `json:"assignee,omitempty"`
+}
+
+// Change is a change of a field of the issue.
+type Change struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// Comment is the comment the event is about.
+type Comment struct {
+	ID     string `json:"id"`
+	Author string `json:"author,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+type user struct {
+	Name        string `json:"name"`
+	AccountID   string `json:"accountId"`
+	DisplayName string `json:"displayName"`
+}
+
+func (u *user) String() string {
+	if u == nil {
+		return ""
+	}
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name != "" {
+		return u.Name
+	}
+	return u.AccountID
+}
+
+type name struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type payload struct {
+	Timestamp int64  `json:"timestamp"`
+	Event     string `json:"webhookEvent"`
+	Type      string `json:"issue_event_type_name"`
+	User      *user  `json:"user"`
+	Issue     *struct {
+		ID     string `json:"id"`
+		Key    string `json:"key"`
+		Fields struct {
+			Project   *name  `json:"project"`
+			IssueType *name  `json:"issuetype"`
+			Summary   string `json:"summary"`
+			Status    *name  `json:"status"`
+			Assignee  *user  `json:"assignee"`
+		} `json:"fields"`
+	} `json:"issue"`
+	Changelog *struct {
+		Items []struct {
+			Field      string `json:"field"`
+			FromString string `json:"fromString"`
+			ToString   string `json:"toString"`
+		} `json:"items"`
+	} `json:"changelog"`
+	Comment *struct {
+		ID     string          `json:"id"`
+		Author *user           `json:"author"`
+		Body   json.RawMessage `json:"body"`
+	} `json:"comment"`
+}
+
+// Parse normalizes the payload of a Jira webhook.
+func Parse(b []byte) (*Event, error) {
+	var p payload
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+	if p.Event == "" {
+		return nil, fmt.Errorf("invalid payload: webhookEvent is missing")
+	}
+
+	ev := Event{
+		Event: p.Event,
+		Type:  p.Type,
+		User:  p.User.String(),
+		Raw:   b,
+	}
+	if p.Timestamp > 0 {
+		ev.Timestamp = time.Unix(0, p.Timestamp*int64(time.Millisecond)).UTC()
+	}
+
+	if p.Issue != nil {
+		f := p.Issue.Fields
+		ev.Issue = &Issue{
+			ID:       p.Issue.ID,
+			Key:      p.Issue.Key,
+			Summary:  f.Summary,
+			Assignee: f.Assignee.String(),
+		}
+		if f.Project != nil {
+			ev.Issue.Project = f.Project.Key
+		}
+		if f.IssueType != nil {
+			ev.Issue.Type = f.IssueType.Name
+		}
+		if f.Status != nil {
+			ev.Issue.Status = f.Status.Name
+		}
+	}
+
+	if p.Changelog != nil {
+		for _, item := range p.Changelog.Items {
+			ev.Changes = append(ev.Changes, Change{Field: item.Field, From: item.FromString, To: item.ToString})
+		}
+	}
+
+	if p.Comment != nil {
+		ev.Comment = &Comment{ID: p.Comment.ID, Author: p.Comment.Author.String()}
+		// Body is in wiki markup, bodies in ADF sent by some Jira Cloud events are left out.
+		_ = json.Unmarshal(p.Comment.Body, &ev.Comment.Body)
+	}
+
+	return &ev, nil
+}
+
+// Verify checks that the request is sent by Jira with the secret, either by the signature
+// of the payload or by the secret query parameter. Any request is valid if secret is empty.
+func Verify(r *http.Request, body []byte, secret string) bool {
+	if secret == "" {
+		return true
+	}
+
+	if sig := r.Header.Get(SignatureHeader); sig != "" {
+		got, err := hex.DecodeString(strings.TrimPrefix(sig, "sha256="))
+		if err != nil {
+			return false
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		_, _ = mac.Write(body)
+
+		return hmac.Equal(got, mac.Sum(nil))
+	}
+
+	return subtle.ConstantTimeCompare([]byte(r.URL.Query().Get(SecretParam)), []byte(secret)) == 1
+}
+
+// NewHandler creates a handler that receives the webhooks with the secret and calls fn with
+// the normalized events. fn is called before responding, so it shouldn't block for long.
+func NewHandler(secret string, fn func(*Event)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+		if err != nil {
+			http.Error(w, "unable to read the payload", http.StatusBadRequest)
+			return
+		}
+		if !Verify(r, body, secret) {
+			http.Error(w, "invalid secret", http.StatusUnauthorized)
+			return
+		}
+
+		ev, err := Parse(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		fn(ev)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const issueUpdated = `{
+  "timestamp": 1525698237764,
+  "webhookEvent": "jira:issue_updated",
+  "issue_event_type_name": "issue_generic",
+  "user": {"accountId": "5b10a2844c20165700ede21g", "displayName": "Jane Doe"},
+  "issue": {
+    "id": "10001",
+    "key": "TEST-1",
+    "fields": {
+      "project": {"key": "TEST", "name": "Test"},
+      "issuetype": {"name": "Bug"},
+      "summary": "Login is broken",
+      "status": {"name": "In Progress"},
+      "assignee": {"name": "john", "displayName": ""}
+    }
+  },
+  "changelog": {
+    "items": [{"field": "status", "fromString": "To Do", "toString": "In Progress"}]
+  }
+}`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	ev, err := Parse([]byte(issueUpdated))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "jira:issue_updated", ev.Event)
+	assert.Equal(t, "issue_generic", ev.Type)
+	assert.Equal(t, "Jane Doe", ev.User)
+	assert.Equal(t, time.Date(2018, 5, 7, 13, 3, 57, 764000000, time.UTC), ev.Timestamp)
+	assert.Equal(t, &Issue{
+		ID:       "10001",
+		Key:      "TEST-1",
+		Project:  "TEST",
+		Type:     "Bug",
+		Summary:  "Login is broken",
+		Status:   "In Progress",
+		Assignee: "john",
+	}, ev.Issue)
+	assert.Equal(t, []Change{{Field: "status", From: "To Do", To: "In Progress"}}, ev.Changes)
+	assert.Nil(t, ev.Comment)
+}
+
+func TestParseComment(t *testing.T) {
+	t.Parallel()
+
+	ev, err := Parse([]byte(`{
+  "webhookEvent": "comment_created",
+  "issue": {"id": "10001", "key": "TEST-1", "fields": {"summary": "Login is broken"}},
+  "comment": {"id": "10100", "author": {"name": "jane"}, "body": "Fixed in *main*"}
+}`))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "comment_created", ev.Event)
+	assert.True(t, ev.Timestamp.IsZero())
+	assert.Equal(t, "TEST-1", ev.Issue.Key)
+	assert.Equal(t, &Comment{ID: "10100", Author: "jane", Body: "Fixed in *main*"}, ev.Comment)
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := Parse([]byte(`not json`))
+	assert.Error(t, err)
+
+	_, err = Parse([]byte(`{"issue": {"key": "TEST-1"}}`))
+	assert.EqualError(t, err, "invalid payload: webhookEvent is missing")
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(issueUpdated)
+
+	mac := hmac.New(sha256.New, []byte("s3cr3t"))
+	_, _ = mac.Write(body)
+	sig := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	cases := []struct {
+		name     string
+		target   string
+		sig      string
+		secret   string
+		expected bool
+	}{
+		{name: "it accepts any request without secret", target: "/", expected: true},
+		{name: "it accepts valid signature", target: "/", sig: sig, secret: "s3cr3t", expected: true},
+		{name: "it rejects invalid signature", target: "/", sig: "sha256=abcd", secret: "s3cr3t"},
+		{name: "it rejects signature with another secret", target: "/", sig: sig, secret: "other"},
+		{name: "it accepts valid secret param", target: "/?secret=s3cr3t", secret: "s3cr3t", expected: true},
+		{name: "it rejects invalid secret param", target: "/?secret=guess", secret: "s3cr3t"},
+		{name: "it rejects missing secret", target: "/", secret: "s3cr3t"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodPost, tc.target, nil)
+			if tc.sig != "" {
+				r.Header.Set(SignatureHeader, tc.sig)
+			}
+			assert.Equal(t, tc.expected, Verify(r, body, tc.secret))
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var events []*Event
+	h := NewHandler("s3cr3t", func(ev *Event) {
+		events = append(events, ev)
+	})
+
+	cases := []struct {
+		method   string
+		target   string
+		body     string
+		expected int
+	}{
+		{method: http.MethodGet, target: "/?secret=s3cr3t", expected: http.StatusMethodNotAllowed},
+		{method: http.MethodPost, target: "/", body: issueUpdated, expected: http.StatusUnauthorized},
+		{method: http.MethodPost, target: "/?secret=s3cr3t", body: "{}", expected: http.StatusBadRequest},
+		{method: http.MethodPost, target: "/?secret=s3cr3t", body: issueUpdated, expected: http.StatusAccepted},
+	}
+
+	for _, tc := range cases {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body)))
+
+		assert.Equal(t, tc.expected, w.Code)
+	}
+
+	assert.Len(t, events, 1)
+	assert.Equal(t, "TEST-1", events[0].Issue.Key)
+	assert.JSONEq(t, issueUpdated, string(events[0].Raw))
+}
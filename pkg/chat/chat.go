@@ -0,0 +1,112 @@
+// Package chat posts messages to Slack and Microsoft Teams with incoming webhooks.
+//
+// See: https://api.slack.com/messaging/webhooks
+// See: https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/add-incoming-webhook
+package chat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Services the messages can be posted to.
+const (
+	Slack = "slack"
+	Teams = "teams"
+)
+
+const timeout = 15 * time.Second
+
+// slackEscaper escapes the control characters of Slack mrkdwn, eg: in issue summaries.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// ErrUnexpectedResponse denotes response code other than the expected one.
+type ErrUnexpectedResponse struct {
+	Service    string
+	Status     string
+	StatusCode int
+}
+
+func (e *ErrUnexpectedResponse) Error() string {
+	return fmt.Sprintf("%s: received unexpected response '%s'", e.Service, e.Status)
+}
+
+// Message is a message with a title and a line per item.
+type Message struct {
+	Title string
+	Lines []string
+}
+
+// PostFunc decorates option for Post.
+type PostFunc func(*http.Client, *http.Transport)
+
+// WithTimeout is a functional opt to set the timeout of the request.
+func WithTimeout(to time.Duration) PostFunc {
+	return func(c *http.Client, _ *http.Transport) {
+		c.Timeout = to
+	}
+}
+
+// WithProxy is a functional opt to send the request through the given proxy
+// instead of the one in HTTPS_PROXY env.
+func WithProxy(u *url.URL) PostFunc {
+	return func(_ *http.Client, t *http.Transport) {
+		t.Proxy = http.ProxyURL(u)
+	}
+}
+
+// Payload formats the message for the incoming webhook of the service.
+func Payload(service string, msg *Message) ([]byte, error) {
+	switch service {
+	case Slack:
+		text := "*" + slackEscaper.Replace(msg.Title) + "*"
+		for _, l := range msg.Lines {
+			text += "\n• " + slackEscaper.Replace(l)
+		}
+		return json.Marshal(map[string]string{"text": text})
+	case Teams:
+		lines := make([]string, 0, len(msg.Lines))
+		for _, l := range msg.Lines {
+			lines = append(lines, "- "+l)
+		}
+		return json.Marshal(map[string]string{
+			"@type":    "MessageCard",
+			"@context": "https://schema.org/extensions",
+			"summary":  msg.Title,
+			"title":    msg.Title,
+			"text":     strings.Join(lines, "\n"),
+		})
+	}
+	return nil, fmt.Errorf("unknown chat service %q, expected %s or %s", service, Slack, Teams)
+}
+
+// Post posts the message to the incoming webhook of the service.
+func Post(service, webhookURL string, msg *Message, opts ...PostFunc) error {
+	body, err := Payload(service, msg)
+	if err != nil {
+		return err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	client := http.Client{Transport: transport, Timeout: timeout}
+
+	for _, opt := range opts {
+		opt(&client, transport)
+	}
+
+	res, err := client.Post(webhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return &ErrUnexpectedResponse{Service: service, Status: res.Status, StatusCode: res.StatusCode}
+	}
+	return nil
+}
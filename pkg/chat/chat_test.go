@@ -0,0 +1,91 @@
+package chat
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var msg = &Message{
+	Title: "Worklog of Wed, 02 Feb 2022: 2h 30m",
+	Lines: []string{"TEST-1 First issue: 30m", "TEST-2 Second issue: 2h"},
+}
+
+func TestPayload(t *testing.T) {
+	t.Parallel()
+
+	b, err := Payload(Slack, msg)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"text": "*Worklog of Wed, 02 Feb 2022: 2h 30m*\n• TEST-1 First issue: 30m\n• TEST-2 Second issue: 2h"}`,
+		string(b),
+	)
+
+	b, err = Payload(Teams, msg)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+  "@type": "MessageCard",
+  "@context": "https://schema.org/extensions",
+  "summary": "Worklog of Wed, 02 Feb 2022: 2h 30m",
+  "title": "Worklog of Wed, 02 Feb 2022: 2h 30m",
+  "text": "- TEST-1 First issue: 30m\n- TEST-2 Second issue: 2h"
+}`, string(b))
+
+	b, err = Payload(Slack, &Message{Title: "Worklog", Lines: []string{"TEST-3 Fix <b> & <i> tags: 1h"}})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"text": "*Worklog*\n• TEST-3 Fix &lt;b&gt; &amp; &lt;i&gt; tags: 1h"}`, string(b))
+
+	_, err = Payload("irc", msg)
+	assert.EqualError(t, err, `unknown chat service "irc", expected slack or teams`)
+}
+
+func TestPost(t *testing.T) {
+	t.Parallel()
+
+	var received []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		received, _ = ioutil.ReadAll(r.Body)
+
+		if r.URL.Path == "/invalid" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	assert.NoError(t, Post(Slack, server.URL+"/hook", msg))
+
+	expected, _ := Payload(Slack, msg)
+	assert.Equal(t, expected, received)
+
+	err := Post(Teams, server.URL+"/invalid", msg)
+	assert.Equal(t, &ErrUnexpectedResponse{Service: Teams, Status: "404 Not Found", StatusCode: 404}, err)
+}
+
+func TestPostWithProxy(t *testing.T) {
+	t.Parallel()
+
+	var host string
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host = r.URL.Host
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer proxy.Close()
+
+	u, err := url.Parse(proxy.URL)
+	assert.NoError(t, err)
+
+	assert.NoError(t, Post(Slack, "http://hooks.example.test/hook", msg, WithProxy(u)))
+	assert.Equal(t, "hooks.example.test", host)
+}
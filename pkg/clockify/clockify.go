@@ -0,0 +1,192 @@
+// Package clockify fetches time entries from the Clockify API.
+//
+// See: https://docs.clockify.me/
+package clockify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultServer is the base URL of the Clockify API.
+	DefaultServer = "https://api.clockify.me/api/v1"
+
+	timeLayout = "2006-01-02T15:04:05Z"
+	pageSize   = 200
+)
+
+// ErrEmptyResponse denotes empty response from the server.
+var ErrEmptyResponse = fmt.Errorf("clockify: empty response from server")
+
+// ErrUnexpectedResponse denotes response code other than the expected one.
+type ErrUnexpectedResponse struct {
+	Message    string `json:"message"`
+	Status     string `json:"-"`
+	StatusCode int    `json:"-"`
+}
+
+func (e *ErrUnexpectedResponse) Error() string {
+	msg := fmt.Sprintf("clockify: received unexpected response '%s'", e.Status)
+	if e.Message != "" {
+		msg += "\n  - " + e.Message
+	}
+	return msg
+}
+
+// Config is a clockify config.
+type Config struct {
+	Server string
+	Token  string
+	Debug  bool
+}
+
+// Client is a clockify client.
+type Client struct {
+	transport http.RoundTripper
+	server    string
+	token     string
+	timeout   time.Duration
+	debug     bool
+}
+
+// ClientFunc decorates option for client.
+type ClientFunc func(*Client)
+
+// User is a clockify user.
+type User struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	ActiveWorkspace string `json:"activeWorkspace"`
+}
+
+// TimeEntry is a clockify time entry. End is nil for a running time entry.
+type TimeEntry struct {
+	ID           string `json:"id"`
+	Description  string `json:"description"`
+	TimeInterval struct {
+		Start time.Time  `json:"start"`
+		End   *time.Time `json:"end"`
+	} `json:"timeInterval"`
+}
+
+// NewClient instantiates new clockify client.
+func NewClient(c Config, opts ...ClientFunc) *Client {
+	server := c.Server
+	if server == "" {
+		server = DefaultServer
+	}
+
+	client := Client{
+		server: strings.TrimSuffix(server, "/"),
+		token:  c.Token,
+		debug:  c.Debug,
+	}
+
+	for _, opt := range opts {
+		opt(&client)
+	}
+
+	client.transport = &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout: client.timeout,
+		}).DialContext,
+	}
+
+	return &client
+}
+
+// WithTimeout is a functional opt to attach timeout to the client.
+func WithTimeout(to time.Duration) ClientFunc {
+	return func(c *Client) {
+		c.timeout = to
+	}
+}
+
+// Me fetches the current user using GET /user endpoint.
+func (c *Client) Me() (*User, error) {
+	var out User
+
+	if err := c.get("/user", &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TimeEntries fetches time entries of a user in a workspace started between start and
+// end using GET /workspaces/{workspace}/user/{user}/time-entries endpoint.
+func (c *Client) TimeEntries(workspace, user string, start, end time.Time) ([]*TimeEntry, error) {
+	var entries []*TimeEntry
+
+	for page := 1; ; page++ {
+		q := url.Values{}
+		q.Set("start", start.UTC().Format(timeLayout))
+		q.Set("end", end.UTC().Format(timeLayout))
+		q.Set("page", fmt.Sprintf("%d", page))
+		q.Set("page-size", fmt.Sprintf("%d", pageSize))
+
+		path := fmt.Sprintf(
+			"/workspaces/%s/user/%s/time-entries?%s", url.PathEscape(workspace), url.PathEscape(user), q.Encode(),
+		)
+
+		var out []*TimeEntry
+		if err := c.get(path, &out); err != nil {
+			return nil, err
+		}
+		entries = append(entries, out...)
+
+		if len(out) < pageSize {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+func (c *Client) get(path string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, c.server+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Api-Key", c.token)
+
+	res, err := c.transport.RoundTrip(req.WithContext(context.Background()))
+	if c.debug && err == nil {
+		dump(req, res)
+	}
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		e := ErrUnexpectedResponse{Status: res.Status, StatusCode: res.StatusCode}
+
+		// We don't care about decoding error here.
+		_ = json.NewDecoder(res.Body).Decode(&e)
+
+		return &e
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+func dump(req *http.Request, res *http.Response) {
+	reqDump, _ := httputil.DumpRequest(req, true)
+	respDump, _ := httputil.DumpResponse(res, false)
+
+	fmt.Printf("\n\nREQUEST DETAILS\n%s\n\n%s", strings.Repeat("-", 60), reqDump)
+	fmt.Printf("\n\nRESPONSE DETAILS\n%s\n\n%s", strings.Repeat("-", 60), respDump)
+}
@@ -0,0 +1,68 @@
+package clockify
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/user", r.URL.Path)
+		assert.Equal(t, "token", r.Header.Get("X-Api-Key"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "u-1", "name": "Person A", "activeWorkspace": "w-1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, Token: "token"}, WithTimeout(3*time.Second))
+
+	actual, err := client.Me()
+	assert.NoError(t, err)
+	assert.Equal(t, &User{ID: "u-1", Name: "Person A", ActiveWorkspace: "w-1"}, actual)
+}
+
+func TestTimeEntries(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/workspaces/w-1/user/u-1/time-entries", r.URL.Path)
+		assert.Equal(t, "2022-02-07T00:00:00Z", r.URL.Query().Get("start"))
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(401)
+			_, _ = w.Write([]byte(`{"message": "Api key does not exist", "code": 4003}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[
+  {"id": "e-1", "description": "TEST-1 Review", "timeInterval": {"start": "2022-02-07T09:00:00Z", "end": "2022-02-07T09:45:00Z"}},
+  {"id": "e-2", "description": "Running", "timeInterval": {"start": "2022-02-07T10:00:00Z", "end": null}}
+]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, Token: "token"}, WithTimeout(3*time.Second))
+
+	start := time.Date(2022, 2, 7, 0, 0, 0, 0, time.UTC)
+
+	actual, err := client.TimeEntries("w-1", "u-1", start, start.AddDate(0, 0, 1))
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "e-1", actual[0].ID)
+	assert.Equal(t, 45*time.Minute, actual[0].TimeInterval.End.Sub(actual[0].TimeInterval.Start))
+	assert.Nil(t, actual[1].TimeInterval.End)
+
+	unexpectedStatusCode = true
+
+	_, err = client.TimeEntries("w-1", "u-1", start, start.AddDate(0, 0, 1))
+	assert.EqualError(t, err, "clockify: received unexpected response '401 Unauthorized'\n  - Api key does not exist")
+}
@@ -0,0 +1,36 @@
+// Package desktop raises desktop notifications: with osascript on macOS, notify-send
+// on Linux and BSDs, and a tray balloon shown by PowerShell on Windows.
+package desktop
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// ErrUnsupported is returned when desktop notifications are not supported on the platform.
+var ErrUnsupported = fmt.Errorf("desktop notifications are not supported on this platform")
+
+// Notify raises a desktop notification with the title and the message.
+func Notify(title, message string) error {
+	return notify(title, message)
+}
+
+func run(cmd *exec.Cmd, name string) error {
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return cmdError(err, out, name)
+	}
+	return nil
+}
+
+func cmdError(err error, out []byte, name string) error {
+	if errors.Is(err, exec.ErrNotFound) {
+		return fmt.Errorf("%w: %s is not installed", ErrUnsupported, name)
+	}
+	if msg := strings.TrimSpace(string(out)); msg != "" {
+		return fmt.Errorf("%s: %s", name, msg)
+	}
+	return fmt.Errorf("%s: %w", name, err)
+}
@@ -0,0 +1,15 @@
+//go:build darwin
+// +build darwin
+
+package desktop
+
+import "os/exec"
+
+// The title and the message are passed as arguments of the script, so they don't need to be escaped.
+const script = `on run argv
+	display notification (item 2 of argv) with title (item 1 of argv)
+end run`
+
+func notify(title, message string) error {
+	return run(exec.Command("osascript", "-e", script, title, message), "osascript")
+}
@@ -0,0 +1,8 @@
+//go:build !darwin && !linux && !freebsd && !openbsd && !netbsd && !windows
+// +build !darwin,!linux,!freebsd,!openbsd,!netbsd,!windows
+
+package desktop
+
+func notify(string, string) error {
+	return ErrUnsupported
+}
@@ -0,0 +1,10 @@
+//go:build linux || freebsd || openbsd || netbsd
+// +build linux freebsd openbsd netbsd
+
+package desktop
+
+import "os/exec"
+
+func notify(title, message string) error {
+	return run(exec.Command("notify-send", "--app-name", "jira-cli", "--", title, message), "notify-send")
+}
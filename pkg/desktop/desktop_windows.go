@@ -0,0 +1,31 @@
+//go:build windows
+// +build windows
+
+package desktop
+
+import (
+	"os"
+	"os/exec"
+)
+
+// The title and the message are passed in the environment, so they don't need to be escaped.
+const script = `Add-Type -AssemblyName System.Windows.Forms
+$n = New-Object System.Windows.Forms.NotifyIcon
+$n.Icon = [System.Drawing.SystemIcons]::Information
+$n.Visible = $true
+$n.ShowBalloonTip(10000, $env:JIRA_NOTIFY_TITLE, $env:JIRA_NOTIFY_MESSAGE, 'Info')
+Start-Sleep -Seconds 10
+$n.Dispose()`
+
+func notify(title, message string) error {
+	cmd := exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+	cmd.Env = append(os.Environ(), "JIRA_NOTIFY_TITLE="+title, "JIRA_NOTIFY_MESSAGE="+message)
+
+	// The balloon is removed when the icon is disposed, so it is shown without waiting for it.
+	if err := cmd.Start(); err != nil {
+		return cmdError(err, nil, "powershell")
+	}
+	go func() { _ = cmd.Wait() }()
+
+	return nil
+}
@@ -0,0 +1,197 @@
+// Package ics parses events from iCalendar (.ics) files.
+//
+// Only the subset of RFC 5545 required to read event title and timing is
+// supported. Recurrence rules are not expanded.
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	dateTimeLayout = "20060102T150405"
+	dateLayout     = "20060102"
+)
+
+// Event is a calendar event.
+type Event struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	End     time.Time
+	AllDay  bool
+}
+
+// Duration returns the length of the event.
+func (e *Event) Duration() time.Duration {
+	return e.End.Sub(e.Start)
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse parses events from the calendar. Times without a timezone are
+// interpreted in the given location.
+func Parse(r io.Reader, loc *time.Location) ([]*Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events []*Event
+		cur    *Event
+		dur    time.Duration
+	)
+
+	for _, line := range lines {
+		p, ok := parseProperty(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case p.name == "BEGIN" && p.value == "VEVENT":
+			cur, dur = &Event{}, 0
+		case p.name == "END" && p.value == "VEVENT":
+			if cur == nil {
+				continue
+			}
+			if cur.End.IsZero() {
+				cur.End = cur.Start.Add(dur)
+			}
+			if !cur.Start.IsZero() {
+				events = append(events, cur)
+			}
+			cur = nil
+		case cur == nil:
+			continue
+		case p.name == "UID":
+			cur.UID = p.value
+		case p.name == "SUMMARY":
+			cur.Summary = unescape(p.value)
+		case p.name == "DTSTART":
+			if cur.Start, cur.AllDay, err = parseTime(p, loc); err != nil {
+				return nil, err
+			}
+		case p.name == "DTEND":
+			if cur.End, _, err = parseTime(p, loc); err != nil {
+				return nil, err
+			}
+		case p.name == "DURATION":
+			if dur, err = parseDuration(p.value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return events, nil
+}
+
+// unfold joins lines split according to the RFC 5545 line folding rules.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+func parseProperty(line string) (*property, bool) {
+	idx := strings.Index(line, ":")
+	if idx < 0 {
+		return nil, false
+	}
+
+	parts := strings.Split(line[:idx], ";")
+	p := property{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string),
+		value:  line[idx+1:],
+	}
+	for _, param := range parts[1:] {
+		if kv := strings.SplitN(param, "=", 2); len(kv) == 2 {
+			p.params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+		}
+	}
+
+	return &p, true
+}
+
+func parseTime(p *property, loc *time.Location) (time.Time, bool, error) {
+	if p.params["VALUE"] == "DATE" || len(p.value) == len(dateLayout) {
+		t, err := time.ParseInLocation(dateLayout, p.value, loc)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(p.value, "Z") {
+		t, err := time.ParseInLocation(dateTimeLayout, strings.TrimSuffix(p.value, "Z"), time.UTC)
+		return t, false, err
+	}
+
+	if tz, ok := p.params["TZID"]; ok {
+		if l, err := time.LoadLocation(tz); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation(dateTimeLayout, p.value, loc)
+
+	return t, false, err
+}
+
+// parseDuration parses durations like PT1H30M or P1D.
+func parseDuration(s string) (time.Duration, error) {
+	v := strings.TrimPrefix(strings.TrimPrefix(s, "+"), "P")
+	if v == s || v == "" {
+		return 0, fmt.Errorf("ics: invalid duration %q", s)
+	}
+
+	units := map[byte]time.Duration{
+		'W': 7 * 24 * time.Hour,
+		'D': 24 * time.Hour,
+		'H': time.Hour,
+		'M': time.Minute,
+		'S': time.Second,
+	}
+
+	var (
+		total time.Duration
+		n     int
+	)
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		switch {
+		case c == 'T':
+		case c >= '0' && c <= '9':
+			n = n*10 + int(c-'0')
+		default:
+			unit, ok := units[c]
+			if !ok {
+				return 0, fmt.Errorf("ics: invalid duration %q", s)
+			}
+			total += time.Duration(n) * unit
+			n = 0
+		}
+	}
+
+	return total, nil
+}
+
+func unescape(s string) string {
+	return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(s)
+}
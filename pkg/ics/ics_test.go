@@ -0,0 +1,65 @@
+package ics
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	f, err := os.Open("./testdata/calendar.ics")
+	assert.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	events, err := Parse(f, time.UTC)
+	assert.NoError(t, err)
+	assert.Len(t, events, 4)
+
+	assert.Equal(t, "1@test", events[0].UID)
+	assert.Equal(t, "Daily standup, TEST-1", events[0].Summary)
+	assert.True(t, time.Date(2022, 2, 2, 8, 30, 0, 0, time.UTC).Equal(events[0].Start))
+	assert.Equal(t, 15*time.Minute, events[0].Duration())
+	assert.False(t, events[0].AllDay)
+
+	assert.Equal(t, "Sprint planning for a very long title", events[1].Summary)
+	assert.True(t, time.Date(2022, 2, 2, 13, 0, 0, 0, berlin).Equal(events[1].Start))
+	assert.Equal(t, 90*time.Minute, events[1].Duration())
+
+	assert.True(t, events[2].AllDay)
+	assert.Equal(t, 24*time.Hour, events[2].Duration())
+
+	assert.True(t, time.Date(2022, 2, 2, 16, 0, 0, 0, time.UTC).Equal(events[3].Start))
+}
+
+func TestParseInvalidTime(t *testing.T) {
+	_, err := Parse(strings.NewReader("BEGIN:VEVENT\nDTSTART:2022-02-02\nEND:VEVENT\n"), time.UTC)
+	assert.Error(t, err)
+}
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"PT15M":   15 * time.Minute,
+		"PT1H30M": 90 * time.Minute,
+		"P1D":     24 * time.Hour,
+		"P1DT2H":  26 * time.Hour,
+		"+PT10S":  10 * time.Second,
+		"P1W":     7 * 24 * time.Hour,
+	}
+	for input, expected := range cases {
+		actual, err := parseDuration(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, actual, input)
+	}
+
+	_, err := parseDuration("1H")
+	assert.Error(t, err)
+
+	_, err = parseDuration("PT1X")
+	assert.Error(t, err)
+}
@@ -0,0 +1,37 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ArchiveIssueV2 archives an issue using PUT /issue/{key}/archive endpoint.
+// The endpoint is only available in Jira Data Center.
+func (c *Client) ArchiveIssueV2(key string) error {
+	return c.putIssueArchive(fmt.Sprintf("/issue/%s/archive", key))
+}
+
+// RestoreIssueV2 restores an archived issue using PUT /issue/{key}/restore endpoint.
+// The endpoint is only available in Jira Data Center.
+func (c *Client) RestoreIssueV2(key string) error {
+	return c.putIssueArchive(fmt.Sprintf("/issue/%s/restore", key))
+}
+
+func (c *Client) putIssueArchive(path string) error {
+	res, err := c.PutV2(context.Background(), path, nil, Header{
+		"Accept": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchiveIssueV2(t *testing.T) {
+	var (
+		expectedPath         string
+		unexpectedStatusCode bool
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, expectedPath, r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(403)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	expectedPath = "/rest/api/2/issue/TEST-1/archive"
+	assert.NoError(t, client.ArchiveIssueV2("TEST-1"))
+
+	expectedPath = "/rest/api/2/issue/TEST-1/restore"
+	assert.NoError(t, client.RestoreIssueV2("TEST-1"))
+
+	unexpectedStatusCode = true
+
+	expectedPath = "/rest/api/2/issue/TEST-1/archive"
+	assert.Error(t, &ErrUnexpectedResponse{}, client.ArchiveIssueV2("TEST-1"))
+
+	expectedPath = "/rest/api/2/issue/TEST-1/restore"
+	assert.Error(t, &ErrUnexpectedResponse{}, client.RestoreIssueV2("TEST-1"))
+}
@@ -0,0 +1,112 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// maxRedirects is the number of redirects followed when downloading an attachment.
+const maxRedirects = 5
+
+// Attachment holds attachment info.
+type Attachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	MimeType string `json:"mimeType"`
+	Size     int    `json:"size"`
+	Content  string `json:"content"`
+}
+
+// DownloadAttachment downloads content of the attachment. Caller is responsible for closing the
+// returned reader. Attachment content may be served from a different host, eg: media service
+// in Jira cloud, so the redirects are followed without sending the credentials.
+func (c *Client) DownloadAttachment(a *Attachment) (io.ReadCloser, error) {
+	res, err := c.request(context.Background(), http.MethodGet, a.Content, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; res != nil && isRedirect(res.StatusCode); i++ {
+		loc, err := res.Location()
+		_ = res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if i == maxRedirects {
+			return nil, fmt.Errorf("jira: too many redirects when downloading attachment %q", a.Filename)
+		}
+
+		req, err := http.NewRequest(http.MethodGet, loc.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if res, err = c.transport.RoundTrip(req); err != nil {
+			return nil, err
+		}
+	}
+
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	if res.StatusCode != http.StatusOK {
+		defer func() { _ = res.Body.Close() }()
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	return res.Body, nil
+}
+
+// AddAttachment uploads a file to the issue using POST /issue/{key}/attachments endpoint.
+func (c *Client) AddAttachment(key, filename string, content io.Reader) ([]*Attachment, error) {
+	var body bytes.Buffer
+
+	w := multipart.NewWriter(&body)
+
+	part, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV2(context.Background(), "/issue/"+key+"/attachments", body.Bytes(), Header{
+		"Accept":            "application/json",
+		"Content-Type":      w.FormDataContentType(),
+		"X-Atlassian-Token": "no-check",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Attachment
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+func isRedirect(code int) bool {
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther,
+		http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	}
+	return false
+}
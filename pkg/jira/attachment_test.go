@@ -0,0 +1,95 @@
+package jira
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadAttachment(t *testing.T) {
+	media := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/file/abc", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		_, _ = w.Write([]byte("file content"))
+	}))
+	defer media.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(t, r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/rest/api/2/attachment/content/10001":
+			http.Redirect(w, r, media.URL+"/file/abc", http.StatusSeeOther)
+		case "/rest/api/2/attachment/content/10002":
+			_, _ = w.Write([]byte("direct content"))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, Login: "test", APIToken: "token"}, WithTimeout(3*time.Second))
+
+	rc, err := client.DownloadAttachment(&Attachment{Filename: "a.txt", Content: server.URL + "/rest/api/2/attachment/content/10001"})
+	assert.NoError(t, err)
+	b, err := ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+	assert.NoError(t, rc.Close())
+	assert.Equal(t, "file content", string(b))
+
+	rc, err = client.DownloadAttachment(&Attachment{Filename: "b.txt", Content: server.URL + "/rest/api/2/attachment/content/10002"})
+	assert.NoError(t, err)
+	b, err = ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+	assert.NoError(t, rc.Close())
+	assert.Equal(t, "direct content", string(b))
+
+	_, err = client.DownloadAttachment(&Attachment{Filename: "c.txt", Content: server.URL + "/rest/api/2/attachment/content/10003"})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAddAttachment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/attachments", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		f, h, err := r.FormFile("file")
+		assert.NoError(t, err)
+		assert.Equal(t, "notes.txt", h.Filename)
+
+		content := new(strings.Builder)
+		_, _ = io.Copy(content, f)
+		assert.Equal(t, "some notes", content.String())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[{"id":"10010","filename":"notes.txt","mimeType":"text/plain","size":10}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.AddAttachment("TEST-1", "notes.txt", strings.NewReader("some notes"))
+	assert.NoError(t, err)
+	assert.Equal(t, []*Attachment{{ID: "10010", Filename: "notes.txt", MimeType: "text/plain", Size: 10}}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.AddAttachment("TEST-1", "notes.txt", strings.NewReader("some notes"))
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
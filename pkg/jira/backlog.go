@@ -0,0 +1,79 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// BacklogIssues fetches issues in the backlog of the given board, ordered by rank.
+func (c *Client) BacklogIssues(boardID int, jql string, limit uint) (*SearchResult, error) {
+	return c.BacklogIssuesFrom(boardID, jql, 0, limit)
+}
+
+// BacklogIssuesFrom fetches issues in the backlog of the given board starting
+// at the given offset. It is used to paginate through the results.
+func (c *Client) BacklogIssuesFrom(boardID int, jql string, from, limit uint) (*SearchResult, error) {
+	path := fmt.Sprintf("/board/%d/backlog?maxResults=%d", boardID, limit)
+	if from > 0 {
+		path += fmt.Sprintf("&startAt=%d", from)
+	}
+	if jql != "" {
+		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
+	}
+
+	res, err := c.GetV1(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out SearchResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// RankRequest holds request data for issue rank request.
+// Only one of RankBeforeIssue and RankAfterIssue should be set.
+type RankRequest struct {
+	Issues          []string `json:"issues"`
+	RankBeforeIssue string   `json:"rankBeforeIssue,omitempty"`
+	RankAfterIssue  string   `json:"rankAfterIssue,omitempty"`
+}
+
+// RankIssues moves issues before or after the given issue using PUT /issue/rank endpoint.
+func (c *Client) RankIssues(req *RankRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PutV1(context.Background(), "/issue/rank", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	// Jira responds with 207 if some of the issues failed to rank.
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
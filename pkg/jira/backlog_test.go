@@ -0,0 +1,87 @@
+package jira
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBacklogIssues(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/1/backlog", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, url.Values{
+				"jql":        []string{"project=TEST AND type=Bug"},
+				"maxResults": []string{"100"},
+			}, r.URL.Query())
+
+			resp, err := ioutil.ReadFile("./testdata/search.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.BacklogIssues(1, "project=TEST AND type=Bug", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, actual.Total)
+	assert.Len(t, actual.Issues, 3)
+	assert.Equal(t, "TEST-1", actual.Issues[0].Key)
+
+	unexpectedStatusCode = true
+
+	_, err = client.BacklogIssues(1, "", 100)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestRankIssues(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/issue/rank", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(207)
+		} else {
+			assert.Equal(t, "PUT", r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Accept"))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			expectedBody := `{"issues":["TEST-1","TEST-2"],"rankBeforeIssue":"TEST-3"}`
+			actualBody := new(strings.Builder)
+			_, _ = io.Copy(actualBody, r.Body)
+
+			assert.Equal(t, expectedBody, actualBody.String())
+
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.RankIssues(&RankRequest{Issues: []string{"TEST-1", "TEST-2"}, RankBeforeIssue: "TEST-3"})
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.RankIssues(&RankRequest{Issues: []string{"TEST-1"}, RankAfterIssue: "TEST-3"})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
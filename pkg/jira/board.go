@@ -58,3 +58,56 @@ func (c *Client) board(path string) (*BoardResult, error) {
 
 	return &out, err
 }
+
+// BoardColumn is a column of a board with statuses mapped to it.
+type BoardColumn struct {
+	Name     string `json:"name"`
+	Statuses []struct {
+		ID string `json:"id"`
+	} `json:"statuses"`
+	Min int `json:"min,omitempty"`
+	Max int `json:"max,omitempty"`
+}
+
+// BoardConfiguration holds response from /board/{boardID}/configuration endpoint.
+type BoardConfiguration struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Filter struct {
+		ID string `json:"id"`
+	} `json:"filter"`
+	ColumnConfig struct {
+		Columns        []*BoardColumn `json:"columns"`
+		ConstraintType string         `json:"constraintType"`
+	} `json:"columnConfig"`
+	Estimation struct {
+		Type  string `json:"type"`
+		Field struct {
+			FieldID     string `json:"fieldId"`
+			DisplayName string `json:"displayName"`
+		} `json:"field"`
+	} `json:"estimation"`
+}
+
+// GetBoardConfiguration fetches the column, filter and estimation configuration of a board.
+func (c *Client) GetBoardConfiguration(id int) (*BoardConfiguration, error) {
+	res, err := c.GetV1(context.Background(), fmt.Sprintf("/board/%d/configuration", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out BoardConfiguration
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
@@ -81,3 +81,43 @@ func TestBoards(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestGetBoardConfiguration(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/1/configuration", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			resp, err := ioutil.ReadFile("./testdata/board-configuration.json")
+			assert.NoError(t, err)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write(resp)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetBoardConfiguration(1)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, actual.ID)
+	assert.Equal(t, "Board 1", actual.Name)
+	assert.Equal(t, "10001", actual.Filter.ID)
+	assert.Equal(t, "customfield_10016", actual.Estimation.Field.FieldID)
+	assert.Len(t, actual.ColumnConfig.Columns, 3)
+	assert.Equal(t, "To Do", actual.ColumnConfig.Columns[0].Name)
+	assert.Len(t, actual.ColumnConfig.Columns[0].Statuses, 2)
+	assert.Equal(t, "4", actual.ColumnConfig.Columns[0].Statuses[1].ID)
+	assert.Equal(t, 5, actual.ColumnConfig.Columns[1].Max)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetBoardConfiguration(1)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
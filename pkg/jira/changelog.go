@@ -0,0 +1,113 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ChangelogItem is a single field change in a changelog history.
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldID    string `json:"fieldId"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+// ChangelogHistory is a set of field changes made by a user at once.
+type ChangelogHistory struct {
+	ID      string           `json:"id"`
+	Author  User             `json:"author"`
+	Created string           `json:"created"`
+	Items   []*ChangelogItem `json:"items"`
+}
+
+// ChangelogResult holds response from GET /issue/{key}/changelog endpoint.
+type ChangelogResult struct {
+	StartAt    int                 `json:"startAt"`
+	MaxResults int                 `json:"maxResults"`
+	Total      int                 `json:"total"`
+	IsLast     bool                `json:"isLast"`
+	Histories  []*ChangelogHistory `json:"values"`
+}
+
+// GetIssueChangelog fetches a page of the changelog of an issue, oldest first,
+// using GET /issue/{key}/changelog endpoint.
+func (c *Client) GetIssueChangelog(key string, startAt, max int) (*ChangelogResult, error) {
+	path := fmt.Sprintf("/issue/%s/changelog?startAt=%d&maxResults=%d", key, startAt, max)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out ChangelogResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetAllIssueChangelog fetches the complete changelog of an issue, oldest first.
+//
+// Long-lived issues can have thousands of changes, so we will keep
+// sending requests until we have fetched all the pages.
+func (c *Client) GetAllIssueChangelog(key string, pageSize int) ([]*ChangelogHistory, error) {
+	var histories []*ChangelogHistory
+
+	for n := 0; ; {
+		cr, err := c.GetIssueChangelog(key, n, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		histories = append(histories, cr.Histories...)
+
+		n += len(cr.Histories)
+		if len(cr.Histories) == 0 || cr.IsLast || n >= cr.Total {
+			break
+		}
+	}
+
+	return histories, nil
+}
+
+// GetIssueChangelogV2 fetches the complete changelog of an issue using the changelog
+// expand of GET /issue/{key} endpoint. The changelog endpoint is not available in
+// older local installations, but the expand returns all histories at once there.
+func (c *Client) GetIssueChangelogV2(key string) ([]*ChangelogHistory, error) {
+	path := fmt.Sprintf("/issue/%s?fields=created&expand=changelog", key)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Changelog struct {
+			Histories []*ChangelogHistory `json:"histories"`
+		} `json:"changelog"`
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.Changelog.Histories, err
+}
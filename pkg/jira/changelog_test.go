@@ -0,0 +1,143 @@
+package jira
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueChangelog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/changelog", r.URL.Path)
+		assert.Equal(t, "startAt=0&maxResults=2", r.URL.RawQuery)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		resp, err := ioutil.ReadFile("./testdata/changelog.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueChangelog("TEST-1", 0, 2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, actual.Total)
+	assert.False(t, actual.IsLast)
+	assert.Len(t, actual.Histories, 2)
+	assert.Equal(t, "Person A", actual.Histories[0].Author.Name)
+	assert.Equal(t, &ChangelogItem{
+		Field:      "status",
+		FieldID:    "status",
+		From:       "10000",
+		FromString: "To Do",
+		To:         "3",
+		ToString:   "In Progress",
+	}, actual.Histories[0].Items[0])
+	assert.Len(t, actual.Histories[1].Items, 2)
+	assert.Equal(t, "", actual.Histories[1].Items[0].FromString)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueChangelog("TEST-1", 0, 2)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetAllIssueChangelog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/changelog", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		file := "./testdata/changelog.json"
+		if r.URL.Query().Get("startAt") == "2" {
+			file = "./testdata/changelog-2.json"
+		}
+
+		resp, err := ioutil.ReadFile(file)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetAllIssueChangelog("TEST-1", 2)
+	assert.NoError(t, err)
+
+	assert.Len(t, actual, 3)
+	assert.Equal(t, "10001", actual[0].ID)
+	assert.Equal(t, "10002", actual[1].ID)
+	assert.Equal(t, "10003", actual[2].ID)
+	assert.Equal(t, "Done", actual[2].Items[0].ToString)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetAllIssueChangelog("TEST-1", 2)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetIssueChangelogV2(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "changelog", r.URL.Query().Get("expand"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"key": "TEST-1", "changelog": {"startAt": 0, "maxResults": 1, "total": 1, "histories": [
+			{"id": "10001", "author": {"name": "person.a", "displayName": "Person A"}, "created": "2022-02-01T09:00:00.000+0100",
+			"items": [{"field": "status", "fromString": "To Do", "toString": "Done"}]}]}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueChangelogV2("TEST-1")
+	assert.NoError(t, err)
+
+	expected := []*ChangelogHistory{
+		{
+			ID:      "10001",
+			Author:  User{Login: "person.a", Name: "Person A"},
+			Created: "2022-02-01T09:00:00.000+0100",
+			Items:   []*ChangelogItem{{Field: "status", FromString: "To Do", ToString: "Done"}},
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueChangelogV2("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
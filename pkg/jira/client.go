@@ -4,11 +4,17 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand"
 	"net"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -16,6 +22,8 @@ import (
 const (
 	// RFC3339 is jira datetime format.
 	RFC3339 = "2006-01-02T15:04:05-0700"
+	// RFC3339MilliLayout is jira datetime format with milliseconds, eg: worklog started time.
+	RFC3339MilliLayout = "2006-01-02T15:04:05.000-0700"
 
 	// InstallationTypeCloud represents Jira cloud server.
 	InstallationTypeCloud = "Cloud"
@@ -28,6 +36,17 @@ const (
 
 	apiVersion2 = "v2"
 	apiVersion3 = "v3"
+
+	// DefaultMaxRetries is the number of times the CLI retries rate limited requests by default.
+	DefaultMaxRetries = 3
+
+	retryBaseDelay = time.Second
+	retryMaxDelay  = time.Minute
+
+	// RetryOnNetwork retries requests failing with network errors, eg: timeouts and connection resets.
+	RetryOnNetwork = "network"
+	// RetryOn5xx retries requests failing with a server error.
+	RetryOn5xx = "5xx"
 )
 
 var (
@@ -35,6 +54,9 @@ var (
 	ErrNoResult = fmt.Errorf("jira: no result")
 	// ErrEmptyResponse denotes empty response from the server.
 	ErrEmptyResponse = fmt.Errorf("jira: empty response from server")
+
+	// DefaultRetryOn are the transient errors requests are retried on if not configured otherwise.
+	DefaultRetryOn = []string{RetryOnNetwork, RetryOn5xx}
 )
 
 // ErrUnexpectedResponse denotes response code other than the expected one.
@@ -105,12 +127,21 @@ type Config struct {
 type Client struct {
 	transport http.RoundTripper
 	insecure  bool
+	cert      *tls.Certificate
+	rootCAs   *x509.CertPool
 	server    string
 	login     string
 	authType  AuthType
 	token     string
 	timeout   time.Duration
+	proxy     *url.URL
 	debug     bool
+	wrap      func(http.RoundTripper) http.RoundTripper
+
+	maxRetries uint
+	retries    uint
+	retryOn    []string
+	retryDelay time.Duration
 }
 
 // ClientFunc decorates option for client.
@@ -124,19 +155,34 @@ func NewClient(c Config, opts ...ClientFunc) *Client {
 		token:    c.APIToken,
 		authType: c.AuthType,
 		debug:    c.Debug,
+
+		retryDelay: retryBaseDelay,
 	}
 
 	for _, opt := range opts {
 		opt(&client)
 	}
 
+	proxy := http.ProxyFromEnvironment
+	if client.proxy != nil {
+		proxy = http.ProxyURL(client.proxy)
+	}
+
+	tlsConfig := tls.Config{InsecureSkipVerify: client.insecure, RootCAs: client.rootCAs}
+	if client.cert != nil {
+		tlsConfig.Certificates = []tls.Certificate{*client.cert}
+	}
+
 	client.transport = &http.Transport{
-		Proxy:           http.ProxyFromEnvironment,
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: client.insecure},
+		Proxy:           proxy,
+		TLSClientConfig: &tlsConfig,
 		DialContext: (&net.Dialer{
 			Timeout: client.timeout,
 		}).DialContext,
 	}
+	if client.wrap != nil {
+		client.transport = client.wrap(client.transport)
+	}
 
 	return &client
 }
@@ -148,6 +194,14 @@ func WithTimeout(to time.Duration) ClientFunc {
 	}
 }
 
+// WithProxy is a functional opt to send requests through the given proxy, credentials
+// are taken from the url. Proxy is taken from HTTPS_PROXY and NO_PROXY env by default.
+func WithProxy(u *url.URL) ClientFunc {
+	return func(c *Client) {
+		c.proxy = u
+	}
+}
+
 // WithInsecureTLS is a functional opt that allow you to skip TLS certificate verfication.
 func WithInsecureTLS(ins bool) ClientFunc {
 	return func(c *Client) {
@@ -155,6 +209,54 @@ func WithInsecureTLS(ins bool) ClientFunc {
 	}
 }
 
+// WithClientCert is a functional opt to present the certificate to servers
+// that require mutual TLS, eg: instances behind an mTLS gateway.
+func WithClientCert(cert *tls.Certificate) ClientFunc {
+	return func(c *Client) {
+		c.cert = cert
+	}
+}
+
+// WithRootCAs is a functional opt to verify server certificates with the given CAs,
+// eg: a corporate root certificate. System CAs are used by default.
+func WithRootCAs(pool *x509.CertPool) ClientFunc {
+	return func(c *Client) {
+		c.rootCAs = pool
+	}
+}
+
+// WithMaxRetries is a functional opt to retry requests rate limited by Jira, ie: responded with
+// 429 status, up to the given number of times. Requests are not retried by default.
+func WithMaxRetries(n uint) ClientFunc {
+	return func(c *Client) {
+		c.maxRetries = n
+	}
+}
+
+// WithRetries is a functional opt to retry idempotent requests, ie: GET, PUT and DELETE, failing
+// with transient errors up to the given number of times. Errors are RetryOnNetwork, RetryOn5xx
+// or a status code, eg: "502". Requests are not retried by default.
+func WithRetries(n uint, on []string) ClientFunc {
+	return func(c *Client) {
+		c.retries = n
+		c.retryOn = on
+	}
+}
+
+// WithTransport is a functional opt to wrap the transport of the client, eg: to cache the responses.
+func WithTransport(wrap func(http.RoundTripper) http.RoundTripper) ClientFunc {
+	return func(c *Client) {
+		c.wrap = wrap
+	}
+}
+
+// HTTPClient returns an http client that sends requests with the transport of the client,
+// ie: through the same proxy and with the same TLS settings, eg: for requests outside the
+// Jira api.
+func (c *Client) HTTPClient() *http.Client {
+	return &http.Client{Transport: c.transport}
+}
+
 // Get sends GET request to v3 version of the jira api.
 func (c *Client) Get(ctx context.Context, path string, headers Header) (*http.Response, error) {
 	return c.request(ctx, http.MethodGet, c.server+baseURLv3+path, nil, headers)
@@ -215,6 +317,20 @@ func (c *Client) PutV2(ctx context.Context, path string, body []byte, headers He
 	return res, err
 }
 
+// PutV1 sends PUT request to v1 version of the jira api.
+func (c *Client) PutV1(ctx context.Context, path string, body []byte, headers Header) (*http.Response, error) {
+	res, err := c.request(ctx, http.MethodPut, c.server+baseURLv1+path, body, headers)
+	if err != nil {
+		return res, err
+	}
+	return res, err
+}
+
+// DeleteV2 sends DELETE request to v2 version of the jira api.
+func (c *Client) DeleteV2(ctx context.Context, path string, headers Header) (*http.Response, error) {
+	return c.request(ctx, http.MethodDelete, c.server+baseURLv2+path, nil, headers)
+}
+
 func (c *Client) request(ctx context.Context, method, endpoint string, body []byte, headers Header) (*http.Response, error) {
 	var (
 		req *http.Request
@@ -222,37 +338,133 @@ func (c *Client) request(ctx context.Context, method, endpoint string, body []by
 		err error
 	)
 
-	req, err = http.NewRequest(method, endpoint, bytes.NewReader(body))
-	if err != nil {
-		return nil, err
-	}
-
 	defer func() {
-		if c.debug {
-			dump(req, res)
+		if c.debug && req != nil {
+			Dump(req, res)
 		}
 	}()
 
-	for k, v := range headers {
-		req.Header.Set(k, v)
+	for attempt := uint(0); ; attempt++ {
+		req, err = http.NewRequest(method, endpoint, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+
+		switch c.authType {
+		case AuthTypeBearer:
+			req.Header.Add("Authorization", "Bearer "+c.token)
+		case AuthTypeOAuth:
+			// Access token is refreshed when it expires, so it is added by the transport.
+		default:
+			req.SetBasicAuth(c.login, c.token)
+		}
+
+		res, err = c.transport.RoundTrip(req.WithContext(ctx))
+		if !c.shouldRetry(method, res, err, attempt) {
+			return res, err
+		}
+
+		delay := c.backoff(res, attempt)
+		if res != nil {
+			_ = res.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// shouldRetry tells if the request is retried after the given attempt. Rate limited requests are
+// retried up to maxRetries times and requests failing with transient errors up to retries times.
+func (c *Client) shouldRetry(method string, res *http.Response, err error, attempt uint) bool {
+	if err == nil && res.StatusCode == http.StatusTooManyRequests {
+		return attempt < c.maxRetries
+	}
+	return attempt < c.retries && c.isTransient(method, res, err)
+}
+
+// isTransient tells if an idempotent request failed with one of the errors it is retried on.
+// Other requests are never retried as they may have been applied, eg: a worklog may be added
+// even though the response times out.
+func (c *Client) isTransient(method string, res *http.Response, err error) bool {
+	if method != http.MethodGet && method != http.MethodPut && method != http.MethodDelete {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
 	}
 
-	if c.authType == AuthTypeBearer {
-		req.Header.Add("Authorization", "Bearer "+c.token)
-	} else {
-		req.SetBasicAuth(c.login, c.token)
+	for _, on := range c.retryOn {
+		switch {
+		case err != nil:
+			if on == RetryOnNetwork && isNetworkError(err) {
+				return true
+			}
+		case on == RetryOn5xx:
+			if res.StatusCode >= http.StatusInternalServerError {
+				return true
+			}
+		case on == strconv.Itoa(res.StatusCode):
+			return true
+		}
 	}
+	return false
+}
 
-	res, err = c.transport.RoundTrip(req.WithContext(ctx))
+// isNetworkError tells if the request failed to reach the server or to get a response,
+// as opposed to failing before it was sent, eg: when a token can't be refreshed.
+func isNetworkError(err error) bool {
+	var ne net.Error
+	return errors.As(err, &ne) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
 
-	return res, err
+// backoff returns the time to wait before retrying a request. It is the time asked for in
+// the Retry-After header if any, otherwise it doubles with each attempt. Random jitter is
+// added so that requests sent at once, eg: by bulk commands, are not retried at once.
+func (c *Client) backoff(res *http.Response, attempt uint) time.Duration {
+	// The delay is compared before shifting, as shifting it by many attempts overflows.
+	delay := retryMaxDelay
+	if attempt < 63 && c.retryDelay <= retryMaxDelay>>attempt {
+		delay = c.retryDelay << attempt
+	}
+
+	// Response is nil if the request failed with a network error.
+	if res != nil {
+		if after := res.Header.Get("Retry-After"); after != "" {
+			if secs, err := strconv.Atoi(after); err == nil {
+				delay = time.Duration(secs) * time.Second
+			} else if t, err := http.ParseTime(after); err == nil {
+				delay = time.Until(t)
+			}
+		}
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+
+	return delay + time.Duration(rand.Int63n(int64(delay)/2+1)) //nolint:gosec
 }
 
-func dump(req *http.Request, res *http.Response) {
+// Dump prints the request and the response for debugging. The response
+// is nil if the request failed, eg: on network errors, and is skipped.
+func Dump(req *http.Request, res *http.Response) {
 	reqDump, _ := httputil.DumpRequest(req, true)
-	respDump, _ := httputil.DumpResponse(res, false)
-
 	prettyPrintDump("Request Details", reqDump)
+
+	if res == nil {
+		return
+	}
+	respDump, _ := httputil.DumpResponse(res, false)
 	prettyPrintDump("Response Details", respDump)
 }
 
@@ -270,6 +482,10 @@ func formatUnexpectedResponse(res *http.Response) *ErrUnexpectedResponse {
 	// We don't care about decoding error here.
 	_ = json.NewDecoder(res.Body).Decode(&b)
 
+	if res.StatusCode == http.StatusTooManyRequests {
+		b.ErrorMessages = append([]string{"Jira rate limit exceeded, please wait a moment and try again"}, b.ErrorMessages...)
+	}
+
 	return &ErrUnexpectedResponse{
 		Body:       b,
 		Status:     res.Status,
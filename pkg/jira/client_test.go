@@ -3,6 +3,9 @@ package jira
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -187,3 +190,264 @@ func TestPutV2(t *testing.T) {
 
 	_ = resp.Body.Close()
 }
+
+func TestDebugRequestWithoutResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	client := NewClient(Config{Server: server.URL, Debug: true}, WithTimeout(3*time.Second))
+
+	assert.NotPanics(t, func() {
+		_, err := client.Get(context.Background(), "/myself", nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestRateLimitedRequestIsRetried(t *testing.T) {
+	var (
+		attempts int
+		bodies   []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(429)
+			return
+		}
+		w.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithMaxRetries(3))
+
+	resp, err := client.PostV2(context.Background(), "/issue", []byte(`{"key":"TEST-1"}`), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 201, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, []string{`{"key":"TEST-1"}`, `{"key":"TEST-1"}`, `{"key":"TEST-1"}`}, bodies)
+
+	_ = resp.Body.Close()
+}
+
+func TestRateLimitedRequestFailsAfterMaxRetries(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(429)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithMaxRetries(2))
+	client.retryDelay = time.Millisecond
+
+	_, err := client.GetIssue("TEST-1")
+	assert.Error(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Contains(t, err.Error(), "Jira rate limit exceeded")
+
+	// Requests are not retried by default.
+	attempts = 0
+	client = NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	_, err = client.GetIssue("TEST-1")
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestTransientErrorIsRetried(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(502)
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithRetries(2, DefaultRetryOn))
+	client.retryDelay = time.Millisecond
+
+	resp, err := client.Get(context.Background(), "/issue/TEST-1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+	_ = resp.Body.Close()
+
+	// Non idempotent requests are not retried.
+	attempts = 0
+
+	resp, err = client.Post(context.Background(), "/issue", []byte(`{}`), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 502, resp.StatusCode)
+	assert.Equal(t, 1, attempts)
+	_ = resp.Body.Close()
+
+	// Only configured errors are retried.
+	attempts = 0
+	client = NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithRetries(2, []string{"503"}))
+
+	resp, err = client.Get(context.Background(), "/issue/TEST-1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 502, resp.StatusCode)
+	assert.Equal(t, 1, attempts)
+	_ = resp.Body.Close()
+}
+
+func TestNetworkErrorIsRetried(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	attempts := 0
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithRetries(2, DefaultRetryOn),
+		WithTransport(func(next http.RoundTripper) http.RoundTripper {
+			return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				attempts++
+				return next.RoundTrip(req)
+			})
+		}),
+	)
+	client.retryDelay = time.Millisecond
+
+	_, err := client.Get(context.Background(), "/issue/TEST-1", nil)
+	assert.Error(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestRequestIsSentThroughProxy(t *testing.T) {
+	var (
+		target string
+		auth   string
+	)
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target = r.URL.String()
+		auth = r.Header.Get("Proxy-Authorization")
+		w.WriteHeader(200)
+	}))
+	defer proxy.Close()
+
+	u, err := url.Parse(proxy.URL)
+	assert.NoError(t, err)
+	u.User = url.UserPassword("jon", "s3cr3t")
+
+	client := NewClient(Config{Server: "http://jira.example.com"}, WithTimeout(3*time.Second), WithProxy(u))
+
+	resp, err := client.Get(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "http://jira.example.com/rest/api/3/myself", target)
+	assert.Equal(t, "Basic am9uOnMzY3IzdA==", auth)
+
+	_ = resp.Body.Close()
+}
+
+func TestHTTPClientIsSentThroughProxy(t *testing.T) {
+	var target string
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target = r.URL.String()
+		w.WriteHeader(200)
+	}))
+	defer proxy.Close()
+
+	u, err := url.Parse(proxy.URL)
+	assert.NoError(t, err)
+
+	client := NewClient(Config{}, WithTimeout(3*time.Second), WithProxy(u))
+
+	resp, err := client.HTTPClient().Post("http://auth.example.com/oauth/token", "application/json", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "http://auth.example.com/oauth/token", target)
+
+	_ = resp.Body.Close()
+}
+
+func TestClientCertIsPresented(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	// Certificate of the test server is used as the client certificate.
+	cert := server.TLS.Certificates[0]
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithInsecureTLS(true), WithClientCert(&cert))
+
+	resp, err := client.Get(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	_ = resp.Body.Close()
+
+	client = NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithInsecureTLS(true))
+
+	_, err = client.Get(context.Background(), "/myself", nil)
+	assert.Error(t, err)
+}
+
+func TestRootCAsVerifyServer(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	_, err := client.Get(context.Background(), "/myself", nil)
+	assert.Error(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	client = NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second), WithRootCAs(pool))
+
+	resp, err := client.Get(context.Background(), "/myself", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	_ = resp.Body.Close()
+}
+
+func TestBackoff(t *testing.T) {
+	client := NewClient(Config{})
+
+	res := &http.Response{Header: http.Header{}}
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		delay := client.backoff(res, uint(attempt))
+		assert.GreaterOrEqual(t, int64(delay), int64(expected))
+		assert.LessOrEqual(t, int64(delay), int64(expected+expected/2))
+	}
+
+	res.Header.Set("Retry-After", "10")
+	delay := client.backoff(res, 0)
+	assert.GreaterOrEqual(t, int64(delay), int64(10*time.Second))
+	assert.LessOrEqual(t, int64(delay), int64(15*time.Second))
+
+	res.Header.Set("Retry-After", "3600")
+	assert.LessOrEqual(t, int64(client.backoff(res, 0)), int64(retryMaxDelay+retryMaxDelay/2))
+
+	// Network errors don't have a response.
+	assert.GreaterOrEqual(t, int64(client.backoff(nil, 1)), int64(2*time.Second))
+
+	// Delay of many attempts is capped instead of overflowing.
+	for _, attempt := range []uint{6, 34, 64, 100} {
+		delay := client.backoff(nil, attempt)
+		assert.GreaterOrEqual(t, int64(delay), int64(retryMaxDelay))
+		assert.LessOrEqual(t, int64(delay), int64(retryMaxDelay+retryMaxDelay/2))
+	}
+}
@@ -0,0 +1,188 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/md"
+)
+
+// Comment orders supported by the GET /issue/{key}/comment endpoint.
+const (
+	CommentOrderCreated     = "created"
+	CommentOrderCreatedDesc = "-created"
+)
+
+// CommentPropertyServiceDesk is a comment property that holds the visibility of
+// the comment to service desk customers.
+const CommentPropertyServiceDesk = "sd.public.comment"
+
+// Visibility of comments to service desk customers.
+const (
+	CommentVisibilityInternal = "internal"
+	CommentVisibilityPublic   = "public"
+)
+
+// CommentProperty is an entity property of a comment.
+type CommentProperty struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+// Comment holds comment info. Body is in Jira wiki markup.
+type Comment struct {
+	ID         string            `json:"id"`
+	Author     User              `json:"author"`
+	Body       string            `json:"body"`
+	Created    string            `json:"created"`
+	Updated    string            `json:"updated"`
+	Properties []CommentProperty `json:"properties,omitempty"`
+}
+
+// ServiceDeskVisibility returns the visibility of the comment to service desk customers.
+// It is empty if the comment was not added in a service desk project.
+func (c *Comment) ServiceDeskVisibility() string {
+	for _, p := range c.Properties {
+		if p.Key != CommentPropertyServiceDesk {
+			continue
+		}
+		if internal, _ := p.Value["internal"].(bool); internal {
+			return CommentVisibilityInternal
+		}
+		return CommentVisibilityPublic
+	}
+	return ""
+}
+
+// CommentResult holds response from GET /issue/{key}/comment endpoint.
+type CommentResult struct {
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+	Comments   []*Comment `json:"comments"`
+}
+
+// GetIssueComment fetches a comment of an issue using GET /issue/{key}/comment/{id} endpoint.
+func (c *Client) GetIssueComment(key, id string) (*Comment, error) {
+	path := fmt.Sprintf("/issue/%s/comment/%s?expand=properties", key, id)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Comment
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetIssueComments fetches a page of comments of an issue using GET /issue/{key}/comment endpoint.
+// orderBy can be one of CommentOrderCreated or CommentOrderCreatedDesc, server default is used if empty.
+func (c *Client) GetIssueComments(key string, startAt, max int, orderBy string) (*CommentResult, error) {
+	qp := url.Values{}
+	qp.Set("startAt", fmt.Sprintf("%d", startAt))
+	qp.Set("maxResults", fmt.Sprintf("%d", max))
+	qp.Set("expand", "properties")
+	if orderBy != "" {
+		qp.Set("orderBy", orderBy)
+	}
+	path := fmt.Sprintf("/issue/%s/comment?%s", key, qp.Encode())
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out CommentResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetAllIssueComments fetches all comments of an issue, oldest first.
+func (c *Client) GetAllIssueComments(key string, pageSize int) ([]*Comment, error) {
+	var comments []*Comment
+
+	for n := 0; ; {
+		cr, err := c.GetIssueComments(key, n, pageSize, CommentOrderCreated)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, cr.Comments...)
+
+		n += len(cr.Comments)
+		if len(cr.Comments) == 0 || n >= cr.Total {
+			break
+		}
+	}
+
+	return comments, nil
+}
+
+// UpdateIssueComment updates a comment of an issue using PUT /issue/{key}/comment/{id} endpoint.
+// It only supports plain text comments at the moment.
+func (c *Client) UpdateIssueComment(key, id, comment string) error {
+	body, err := json.Marshal(&issueCommentRequest{Body: md.ToJiraMD(comment)})
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/issue/%s/comment/%s", key, id)
+	res, err := c.PutV2(context.Background(), path, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// DeleteIssueComment deletes a comment of an issue using DELETE /issue/{key}/comment/{id} endpoint.
+func (c *Client) DeleteIssueComment(key, id string) error {
+	path := fmt.Sprintf("/issue/%s/comment/%s", key, id)
+
+	res, err := c.DeleteV2(context.Background(), path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package jira
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIssueComment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment/10001", r.URL.Path)
+		assert.Equal(t, "properties", r.URL.Query().Get("expand"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "10001", "author": {"displayName": "Person A"}, "body": "Test comment",
+			"created": "2022-02-01T09:00:00.000+0100", "updated": "2022-02-01T09:00:00.000+0100"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueComment("TEST-1", "10001")
+	assert.NoError(t, err)
+
+	expected := &Comment{
+		ID:      "10001",
+		Author:  User{Name: "Person A"},
+		Body:    "Test comment",
+		Created: "2022-02-01T09:00:00.000+0100",
+		Updated: "2022-02-01T09:00:00.000+0100",
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetIssueComment("TEST-1", "10001")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetIssueComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment", r.URL.Path)
+		assert.Equal(t, "expand=properties&maxResults=2&orderBy=-created&startAt=0", r.URL.RawQuery)
+
+		resp, err := ioutil.ReadFile("./testdata/comments.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetIssueComments("TEST-1", 0, 2, CommentOrderCreatedDesc)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, actual.Total)
+	assert.Len(t, actual.Comments, 2)
+	assert.Equal(t, "10001", actual.Comments[0].ID)
+	assert.Equal(t, "First *comment*", actual.Comments[0].Body)
+	assert.Equal(t, "Person B", actual.Comments[1].Author.Name)
+	assert.Equal(t, CommentVisibilityInternal, actual.Comments[0].ServiceDeskVisibility())
+	assert.Equal(t, CommentVisibilityPublic, actual.Comments[1].ServiceDeskVisibility())
+}
+
+func TestGetAllIssueComments(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("maxResults"))
+		assert.Equal(t, CommentOrderCreated, r.URL.Query().Get("orderBy"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		file := "./testdata/comments.json"
+		if r.URL.Query().Get("startAt") == "2" {
+			file = "./testdata/comments-2.json"
+		}
+
+		resp, err := ioutil.ReadFile(file)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetAllIssueComments("TEST-1", 2)
+	assert.NoError(t, err)
+
+	assert.Len(t, actual, 3)
+	assert.Equal(t, "10001", actual[0].ID)
+	assert.Equal(t, "10002", actual[1].ID)
+	assert.Equal(t, "10003", actual[2].ID)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetAllIssueComments("TEST-1", 2)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateIssueComment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment/10001", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, `{"body":"Updated comment"}`, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.UpdateIssueComment("TEST-1", "10001", "Updated comment")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.UpdateIssueComment("TEST-1", "10001", "Updated comment")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteIssueComment(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment/10001", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteIssueComment("TEST-1", "10001")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteIssueComment("TEST-1", "10001")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestCommentServiceDeskVisibility(t *testing.T) {
+	c := Comment{}
+	assert.Equal(t, "", c.ServiceDeskVisibility())
+
+	c.Properties = []CommentProperty{{Key: "other", Value: map[string]interface{}{"internal": true}}}
+	assert.Equal(t, "", c.ServiceDeskVisibility())
+
+	c.Properties = append(c.Properties, CommentProperty{
+		Key: CommentPropertyServiceDesk, Value: map[string]interface{}{"internal": false},
+	})
+	assert.Equal(t, CommentVisibilityPublic, c.ServiceDeskVisibility())
+
+	c.Properties[1].Value["internal"] = true
+	assert.Equal(t, CommentVisibilityInternal, c.ServiceDeskVisibility())
+}
@@ -0,0 +1,163 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// ComponentAssigneeProjectDefault assigns issues of the component to the default assignee of the project.
+	ComponentAssigneeProjectDefault = "PROJECT_DEFAULT"
+	// ComponentAssigneeComponentLead assigns issues of the component to the component lead.
+	ComponentAssigneeComponentLead = "COMPONENT_LEAD"
+	// ComponentAssigneeProjectLead assigns issues of the component to the project lead.
+	ComponentAssigneeProjectLead = "PROJECT_LEAD"
+	// ComponentAssigneeUnassigned leaves issues of the component unassigned.
+	ComponentAssigneeUnassigned = "UNASSIGNED"
+)
+
+// Component holds project component info.
+type Component struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Lead        struct {
+		Name string `json:"displayName"`
+	} `json:"lead"`
+	AssigneeType string `json:"assigneeType,omitempty"`
+}
+
+// ComponentRequest struct holds request data for component create and update requests.
+//
+// LeadUserName is the username of the component lead in local installation
+// and LeadAccountID is the account id of the lead in the cloud.
+type ComponentRequest struct {
+	Name          string `json:"name,omitempty"`
+	Project       string `json:"project,omitempty"`
+	Description   string `json:"description,omitempty"`
+	LeadUserName  string `json:"leadUserName,omitempty"`
+	LeadAccountID string `json:"leadAccountId,omitempty"`
+	AssigneeType  string `json:"assigneeType,omitempty"`
+}
+
+// ProjectComponents fetches components of a project using GET /project/{key}/components endpoint.
+func (c *Client) ProjectComponents(project string) ([]*Component, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/project/%s/components", project), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Component
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// CreateComponent creates a component in the project using POST /component endpoint.
+func (c *Client) CreateComponent(req *ComponentRequest) (*Component, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV2(context.Background(), "/component", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Component
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// UpdateComponent updates a component using PUT /component/{id} endpoint.
+// Only the fields set in the request are updated.
+func (c *Client) UpdateComponent(id string, req *ComponentRequest) (*Component, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PutV2(context.Background(), fmt.Sprintf("/component/%s", id), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Component
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// DeleteComponent deletes a component using DELETE /component/{id} endpoint. Issues of
+// the component are moved to the component with id moveIssuesTo if it is not empty.
+func (c *Client) DeleteComponent(id, moveIssuesTo string) error {
+	path := fmt.Sprintf("/component/%s", id)
+	if moveIssuesTo != "" {
+		path += fmt.Sprintf("?moveIssuesTo=%s", moveIssuesTo)
+	}
+
+	res, err := c.DeleteV2(context.Background(), path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// UpdateComponents adds or removes components of an issue using PUT /issue/{key} endpoint.
+func (c *Client) UpdateComponents(key, op string, components []string) error {
+	if op != UpdateOpAdd && op != UpdateOpRemove {
+		return fmt.Errorf("jira: invalid component operation %q", op)
+	}
+
+	ops := make([]map[string]interface{}, 0, len(components))
+	for _, cmp := range components {
+		ops = append(ops, map[string]interface{}{op: map[string]string{"name": cmp}})
+	}
+
+	return c.updateIssueField(key, "components", ops)
+}
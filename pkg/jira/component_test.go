@@ -0,0 +1,186 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectComponents(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/PRJ/components", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[
+			{"id": "10000", "name": "Backend", "description": "Server side", "lead": {"displayName": "Person A"}},
+			{"id": "10001", "name": "Frontend"}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.ProjectComponents("PRJ")
+	assert.NoError(t, err)
+
+	backend := &Component{ID: "10000", Name: "Backend", Description: "Server side"}
+	backend.Lead.Name = "Person A"
+
+	assert.Equal(t, []*Component{backend, {ID: "10001", Name: "Frontend"}}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.ProjectComponents("PRJ")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateComponents(t *testing.T) {
+	var expectedBody string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	expectedBody = `{"update":{"components":[{"add":{"name":"Backend"}},{"add":{"name":"Frontend"}}]}}`
+	assert.NoError(t, client.UpdateComponents("TEST-1", UpdateOpAdd, []string{"Backend", "Frontend"}))
+
+	expectedBody = `{"update":{"components":[{"remove":{"name":"Backend"}}]}}`
+	assert.NoError(t, client.UpdateComponents("TEST-1", UpdateOpRemove, []string{"Backend"}))
+
+	assert.Error(t, client.UpdateComponents("TEST-1", UpdateOpSet, []string{"Backend"}))
+}
+
+func TestCreateComponent(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/component", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, `{"name":"Backend","project":"PRJ","leadAccountId":"a12b3","assigneeType":"COMPONENT_LEAD"}`, actualBody.String())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"id": "10000", "name": "Backend", "lead": {"displayName": "Person A"}, "assigneeType": "COMPONENT_LEAD"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	req := ComponentRequest{
+		Name:          "Backend",
+		Project:       "PRJ",
+		LeadAccountID: "a12b3",
+		AssigneeType:  ComponentAssigneeComponentLead,
+	}
+
+	actual, err := client.CreateComponent(&req)
+	assert.NoError(t, err)
+
+	expected := &Component{ID: "10000", Name: "Backend", AssigneeType: ComponentAssigneeComponentLead}
+	expected.Lead.Name = "Person A"
+
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateComponent(&req)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateComponent(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/component/10000", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, `{"description":"Server side","assigneeType":"UNASSIGNED"}`, actualBody.String())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "10000", "name": "Backend", "description": "Server side", "assigneeType": "UNASSIGNED"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	req := ComponentRequest{Description: "Server side", AssigneeType: ComponentAssigneeUnassigned}
+
+	actual, err := client.UpdateComponent("10000", &req)
+	assert.NoError(t, err)
+	assert.Equal(t, &Component{
+		ID: "10000", Name: "Backend", Description: "Server side", AssigneeType: ComponentAssigneeUnassigned,
+	}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.UpdateComponent("10000", &req)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteComponent(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/component/10000", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "10001", r.URL.Query().Get("moveIssuesTo"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	assert.NoError(t, client.DeleteComponent("10000", "10001"))
+
+	unexpectedStatusCode = true
+
+	assert.Error(t, &ErrUnexpectedResponse{}, client.DeleteComponent("10000", "10001"))
+}
@@ -29,6 +29,8 @@ type CreateRequest struct {
 	Labels         []string
 	Components     []string
 	FixVersions    []string
+	// AffectsVersions are the versions affected by the issue.
+	AffectsVersions []string
 	// EpicField is the dynamic epic field name
 	// that changes per jira installation.
 	EpicField string
@@ -36,6 +38,9 @@ type CreateRequest struct {
 	// case-sensitive in Jira and can differ slightly
 	// in different Jira versions.
 	SubtaskField string
+	// CustomFields holds values of custom fields keyed
+	// by field id, eg: customfield_10001.
+	CustomFields map[string]interface{}
 
 	projectType string
 }
@@ -108,10 +113,11 @@ func (*Client) getRequestData(req *CreateRequest) *createRequest {
 		IssueType: struct {
 			Name string `json:"name"`
 		}{Name: req.IssueType},
-		Name:      req.Name,
-		Summary:   req.Summary,
-		Labels:    req.Labels,
-		epicField: req.EpicField,
+		Name:         req.Name,
+		Summary:      req.Summary,
+		Labels:       req.Labels,
+		epicField:    req.EpicField,
+		customFields: req.CustomFields,
 	}
 
 	switch v := req.Body.(type) {
@@ -169,6 +175,18 @@ func (*Client) getRequestData(req *CreateRequest) *createRequest {
 		}
 		data.Fields.M.FixVersions = versions
 	}
+	if len(req.AffectsVersions) > 0 {
+		versions := make([]struct {
+			Name string `json:"name,omitempty"`
+		}, 0, len(req.AffectsVersions))
+
+		for _, v := range req.AffectsVersions {
+			versions = append(versions, struct {
+				Name string `json:"name,omitempty"`
+			}{v})
+		}
+		data.Fields.M.AffectsVersions = versions
+	}
 
 	return &data
 }
@@ -201,8 +219,12 @@ type createFields struct {
 	FixVersions []struct {
 		Name string `json:"name,omitempty"`
 	} `json:"fixVersions,omitempty"`
+	AffectsVersions []struct {
+		Name string `json:"name,omitempty"`
+	} `json:"versions,omitempty"`
 
-	epicField string
+	epicField    string
+	customFields map[string]interface{}
 }
 
 type createFieldsMarshaler struct {
@@ -227,5 +249,9 @@ func (cfm *createFieldsMarshaler) MarshalJSON() ([]byte, error) {
 	}
 	delete(dm, "name")
 
+	for k, v := range cfm.M.customFields {
+		dm[k] = v
+	}
+
 	return json.Marshal(dm)
 }
@@ -79,6 +79,32 @@ func TestCreate(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestCreateWithAffectsVersions(t *testing.T) {
+	expectedBody := `{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Bug"},` +
+		`"summary":"Test bug","versions":[{"name":"v1.0"},{"name":"v1.1"}]}}`
+	testServer := createTestServer{code: 201}
+	server := testServer.serve(t, expectedBody)
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	requestData := CreateRequest{
+		Project:         "TEST",
+		IssueType:       "Bug",
+		Summary:         "Test bug",
+		AffectsVersions: []string{"v1.0", "v1.1"},
+	}
+	actual, err := client.CreateV2(&requestData)
+	assert.NoError(t, err)
+
+	expected := &CreateResponse{
+		ID:  "10057",
+		Key: "TEST-3",
+	}
+
+	assert.Equal(t, expected, actual)
+}
+
 func TestCreateSubtask(t *testing.T) {
 	expectedBody := `{"update":{},"fields":{"project":{"key":"TEST"},"issuetype":{"name":"Sub-task"},` +
 		`"parent":{"key":"TEST-123"},"summary":"Test sub-task","description":"Test description"}}`
@@ -175,3 +201,31 @@ func TestCreateEpicNextGen(t *testing.T) {
 	_, err = client.CreateV2(&requestData)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestCreateWithCustomFields(t *testing.T) {
+	expectedBody := `{"update":{},"fields":{"customfield_10010":{"value":"Platform"},"customfield_10020":[{"value":"BE"},` +
+		`{"value":"FE"}],"customfield_10030":5,"issuetype":{"name":"Task"},"project":{"key":"TEST"},"summary":"Test task"}}`
+	testServer := createTestServer{code: 201}
+	server := testServer.serve(t, expectedBody)
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+	requestData := CreateRequest{
+		Project:   "TEST",
+		IssueType: "Task",
+		Summary:   "Test task",
+		CustomFields: map[string]interface{}{
+			"customfield_10010": map[string]string{"value": "Platform"},
+			"customfield_10020": []interface{}{map[string]string{"value": "BE"}, map[string]string{"value": "FE"}},
+			"customfield_10030": 5.0,
+		},
+	}
+	actual, err := client.CreateV2(&requestData)
+	assert.NoError(t, err)
+
+	expected := &CreateResponse{
+		ID:  "10057",
+		Key: "TEST-3",
+	}
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,133 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Field schema types as returned in the field metadata.
+const (
+	FieldTypeString          = "string"
+	FieldTypeNumber          = "number"
+	FieldTypeOption          = "option"
+	FieldTypeOptionWithChild = "option-with-child"
+	FieldTypeArray           = "array"
+	FieldTypeUser            = "user"
+	FieldTypeVersion         = "version"
+	FieldTypeComponent       = "component"
+	FieldTypeGroup           = "group"
+	FieldTypePriority        = "priority"
+	FieldTypeResolution      = "resolution"
+)
+
+// FieldMeta holds metadata of an issue field.
+type FieldMeta struct {
+	ID     string `json:"-"`
+	Name   string `json:"name"`
+	Schema struct {
+		Type   string `json:"type"`
+		Items  string `json:"items,omitempty"`
+		Custom string `json:"custom,omitempty"`
+	} `json:"schema"`
+}
+
+// FieldsMeta returns metadata of the fields of the issue type.
+func (it *CreateMetaIssueType) FieldsMeta() ([]*FieldMeta, error) {
+	fields := make([]*FieldMeta, 0, len(it.Fields))
+
+	for id, f := range it.Fields {
+		b, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+
+		var meta FieldMeta
+		if err := json.Unmarshal(b, &meta); err != nil {
+			return nil, fmt.Errorf("jira: invalid metadata of field %q: %w", id, err)
+		}
+		meta.ID = id
+
+		fields = append(fields, &meta)
+	}
+
+	return fields, nil
+}
+
+// FindField finds a field of the issue type by its id, eg: customfield_10001, or its name.
+func (it *CreateMetaIssueType) FindField(field string) (*FieldMeta, error) {
+	fields, err := it.FieldsMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range fields {
+		if f.ID == field {
+			return f, nil
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.Name, field) {
+			return f, nil
+		}
+	}
+
+	return nil, fmt.Errorf("jira: field %q not found for issue type %q", field, it.Name)
+}
+
+// Value converts the given value to the json shape the field expects. Arrays accept comma
+// separated values and cascading options accept the parent and the child option separated
+// by '->', eg: Hardware->Keyboard.
+func (f *FieldMeta) Value(value, installation string) (interface{}, error) {
+	if f.Schema.Type == FieldTypeArray {
+		parts := strings.Split(value, ",")
+		values := make([]interface{}, 0, len(parts))
+
+		for _, p := range parts {
+			p = strings.TrimSpace(p)
+			if p == "" {
+				continue
+			}
+			v, err := f.value(f.Schema.Items, p, installation)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		return values, nil
+	}
+
+	return f.value(f.Schema.Type, value, installation)
+}
+
+func (f *FieldMeta) value(typ, value, installation string) (interface{}, error) {
+	switch typ {
+	case FieldTypeNumber:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("jira: field %q expects a number, got %q", f.Name, value)
+		}
+		return n, nil
+	case FieldTypeOption:
+		return map[string]string{"value": value}, nil
+	case FieldTypeOptionWithChild:
+		parts := strings.SplitN(value, "->", 2) //nolint:gomnd
+		if len(parts) == 1 {
+			return map[string]string{"value": strings.TrimSpace(value)}, nil
+		}
+		return map[string]interface{}{
+			"value": strings.TrimSpace(parts[0]),
+			"child": map[string]string{"value": strings.TrimSpace(parts[1])},
+		}, nil
+	case FieldTypeUser:
+		if installation == InstallationTypeLocal {
+			return map[string]string{"name": value}, nil
+		}
+		return map[string]string{"accountId": value}, nil
+	case FieldTypeVersion, FieldTypeComponent, FieldTypeGroup, FieldTypePriority, FieldTypeResolution:
+		return map[string]string{"name": value}, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,118 @@
+package jira
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindField(t *testing.T) {
+	it := &CreateMetaIssueType{
+		IssueType: IssueType{Name: "Task"},
+		Fields: map[string]interface{}{
+			"customfield_10010": map[string]interface{}{
+				"name":   "Team",
+				"key":    "customfield_10010",
+				"schema": map[string]interface{}{"type": "option", "custom": "com.atlassian.jira.plugin.system.customfieldtypes:select"},
+			},
+			"customfield_10020": map[string]interface{}{
+				"name":   "Reviewers",
+				"key":    "customfield_10020",
+				"schema": map[string]interface{}{"type": "array", "items": "user"},
+			},
+		},
+	}
+
+	f, err := it.FindField("team")
+	assert.NoError(t, err)
+	assert.Equal(t, "customfield_10010", f.ID)
+	assert.Equal(t, FieldTypeOption, f.Schema.Type)
+
+	f, err = it.FindField("customfield_10020")
+	assert.NoError(t, err)
+	assert.Equal(t, "Reviewers", f.Name)
+	assert.Equal(t, FieldTypeUser, f.Schema.Items)
+
+	_, err = it.FindField("Story Points")
+	assert.Error(t, err)
+}
+
+func TestFieldMetaValue(t *testing.T) {
+	field := func(typ, items string) *FieldMeta {
+		f := FieldMeta{Name: "Field"}
+		f.Schema.Type = typ
+		f.Schema.Items = items
+		return &f
+	}
+
+	cases := []struct {
+		name         string
+		field        *FieldMeta
+		value        string
+		installation string
+		expected     interface{}
+		err          bool
+	}{
+		{name: "string", field: field(FieldTypeString, ""), value: "text", expected: "text"},
+		{name: "number", field: field(FieldTypeNumber, ""), value: "2.5", expected: 2.5},
+		{name: "invalid number", field: field(FieldTypeNumber, ""), value: "two", err: true},
+		{name: "option", field: field(FieldTypeOption, ""), value: "BE", expected: map[string]string{"value": "BE"}},
+		{
+			name:     "cascading option",
+			field:    field(FieldTypeOptionWithChild, ""),
+			value:    "Hardware -> Keyboard",
+			expected: map[string]interface{}{"value": "Hardware", "child": map[string]string{"value": "Keyboard"}},
+		},
+		{
+			name:         "cloud user",
+			field:        field(FieldTypeUser, ""),
+			value:        "5b10a2844c20165700ede21g",
+			installation: InstallationTypeCloud,
+			expected:     map[string]string{"accountId": "5b10a2844c20165700ede21g"},
+		},
+		{
+			name:     "user with default installation",
+			field:    field(FieldTypeUser, ""),
+			value:    "5b10a2844c20165700ede21g",
+			expected: map[string]string{"accountId": "5b10a2844c20165700ede21g"},
+		},
+		{
+			name:         "local user",
+			field:        field(FieldTypeUser, ""),
+			value:        "john",
+			installation: InstallationTypeLocal,
+			expected:     map[string]string{"name": "john"},
+		},
+		{
+			name:     "array of strings",
+			field:    field(FieldTypeArray, FieldTypeString),
+			value:    "a, b,,c",
+			expected: []interface{}{"a", "b", "c"},
+		},
+		{
+			name:     "array of options",
+			field:    field(FieldTypeArray, FieldTypeOption),
+			value:    "BE,FE",
+			expected: []interface{}{map[string]string{"value": "BE"}, map[string]string{"value": "FE"}},
+		},
+		{
+			name:     "array of versions",
+			field:    field(FieldTypeArray, FieldTypeVersion),
+			value:    "v1.0",
+			expected: []interface{}{map[string]string{"name": "v1.0"}},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := tc.field.Value(tc.value, tc.installation)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
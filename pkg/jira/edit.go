@@ -23,6 +23,15 @@ type EditRequest struct {
 	Priority       string
 	Labels         []string
 	Components     []string
+	FixVersions    []string
+	// AffectsVersions replaces the versions affected by the issue.
+	AffectsVersions []string
+	// Reporter replaces the reporter of the issue. The user is identified by
+	// the account ID in cloud and by the username in local installation.
+	Reporter *User
+	// CustomFields holds values of custom fields keyed
+	// by field id, eg: customfield_10001.
+	CustomFields map[string]interface{}
 }
 
 // Edit updates an issue using POST /issue endpoint.
@@ -70,6 +79,12 @@ type editFields struct {
 			Name string `json:"name,omitempty"`
 		} `json:"set,omitempty"`
 	} `json:"priority,omitempty"`
+	Reporter []struct {
+		Set struct {
+			AccountID string `json:"accountId,omitempty"`
+			Name      string `json:"name,omitempty"`
+		} `json:"set,omitempty"`
+	} `json:"reporter,omitempty"`
 	Labels []struct {
 		Set []string `json:"set,omitempty"`
 	} `json:"labels,omitempty"`
@@ -78,6 +93,16 @@ type editFields struct {
 			Name string `json:"name,omitempty"`
 		} `json:"set,omitempty"`
 	} `json:"components,omitempty"`
+	FixVersions []struct {
+		Set []struct {
+			Name string `json:"name,omitempty"`
+		} `json:"set,omitempty"`
+	} `json:"fixVersions,omitempty"`
+	AffectsVersions []struct {
+		Set []struct {
+			Name string `json:"name,omitempty"`
+		} `json:"set,omitempty"`
+	} `json:"versions,omitempty"`
 }
 
 type editFieldsMarshaler struct {
@@ -104,18 +129,48 @@ func (cfm editFieldsMarshaler) MarshalJSON() ([]byte, error) {
 	if len(cfm.M.Labels) == 0 || len(cfm.M.Labels[0].Set) == 0 {
 		cfm.M.Labels = nil
 	}
+	if len(cfm.M.FixVersions) == 0 || len(cfm.M.FixVersions[0].Set) == 0 {
+		cfm.M.FixVersions = nil
+	}
+	if len(cfm.M.AffectsVersions) == 0 || len(cfm.M.AffectsVersions[0].Set) == 0 {
+		cfm.M.AffectsVersions = nil
+	}
 
 	return json.Marshal(cfm.M)
 }
 
+type editRequestFields struct {
+	Parent *struct {
+		Key string `json:"key,omitempty"`
+		Set string `json:"set,omitempty"`
+	} `json:"parent,omitempty"`
+
+	customFields map[string]interface{}
+}
+
+// MarshalJSON is a custom marshaler to handle dynamic custom fields.
+func (erf editRequestFields) MarshalJSON() ([]byte, error) {
+	type fields editRequestFields
+
+	m, err := json.Marshal(fields(erf))
+	if err != nil || len(erf.customFields) == 0 {
+		return m, err
+	}
+
+	var dm map[string]interface{}
+	if err := json.Unmarshal(m, &dm); err != nil {
+		return nil, err
+	}
+	for k, v := range erf.customFields {
+		dm[k] = v
+	}
+
+	return json.Marshal(dm)
+}
+
 type editRequest struct {
 	Update editFieldsMarshaler `json:"update"`
-	Fields struct {
-		Parent *struct {
-			Key string `json:"key,omitempty"`
-			Set string `json:"set,omitempty"`
-		} `json:"parent,omitempty"`
-	} `json:"fields"`
+	Fields editRequestFields   `json:"fields"`
 }
 
 func (c *Client) getRequestDataForEdit(req *EditRequest) *editRequest {
@@ -167,16 +222,40 @@ func (c *Client) getRequestDataForEdit(req *EditRequest) *editRequest {
 		}{{Set: cmp}}
 	}
 
-	fields := struct {
-		Parent *struct {
-			Key string `json:"key,omitempty"`
-			Set string `json:"set,omitempty"`
-		} `json:"parent,omitempty"`
-	}{
+	if req.Reporter != nil {
+		update.M.Reporter = []struct {
+			Set struct {
+				AccountID string `json:"accountId,omitempty"`
+				Name      string `json:"name,omitempty"`
+			} `json:"set,omitempty"`
+		}{{Set: struct {
+			AccountID string `json:"accountId,omitempty"`
+			Name      string `json:"name,omitempty"`
+		}{AccountID: req.Reporter.AccountID, Name: req.Reporter.Login}}}
+	}
+
+	if len(req.FixVersions) > 0 {
+		update.M.FixVersions = []struct {
+			Set []struct {
+				Name string `json:"name,omitempty"`
+			} `json:"set,omitempty"`
+		}{{Set: namedValues(req.FixVersions)}}
+	}
+
+	if len(req.AffectsVersions) > 0 {
+		update.M.AffectsVersions = []struct {
+			Set []struct {
+				Name string `json:"name,omitempty"`
+			} `json:"set,omitempty"`
+		}{{Set: namedValues(req.AffectsVersions)}}
+	}
+
+	fields := editRequestFields{
 		Parent: &struct {
 			Key string `json:"key,omitempty"`
 			Set string `json:"set,omitempty"`
 		}{},
+		customFields: req.CustomFields,
 	}
 	if req.ParentIssueKey != "" {
 		if req.ParentIssueKey == AssigneeNone {
@@ -193,3 +272,19 @@ func (c *Client) getRequestDataForEdit(req *EditRequest) *editRequest {
 
 	return &data
 }
+
+func namedValues(names []string) []struct {
+	Name string `json:"name,omitempty"`
+} {
+	out := make([]struct {
+		Name string `json:"name,omitempty"`
+	}, 0, len(names))
+
+	for _, n := range names {
+		out = append(out, struct {
+			Name string `json:"name,omitempty"`
+		}{Name: n})
+	}
+
+	return out
+}
@@ -0,0 +1,113 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEdit(t *testing.T) {
+	var (
+		expectedBody string
+		statusCode   = http.StatusNoContent
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	expectedBody = `{"update":{"summary":[{"set":"New summary"}],"priority":[{"set":{"name":"High"}}],` +
+		`"labels":[{"set":["test"]}],"components":[{"set":[{"name":"BE"}]}],"fixVersions":[{"set":[{"name":"v2.0"}]}]},` +
+		`"fields":{"parent":{}}}`
+
+	err := client.Edit("TEST-1", &EditRequest{
+		Summary:     "New summary",
+		Priority:    "High",
+		Labels:      []string{"test"},
+		Components:  []string{"BE"},
+		FixVersions: []string{"v2.0"},
+	})
+	assert.NoError(t, err)
+
+	expectedBody = `{"update":{},"fields":{"parent":{},"customfield_10010":{"value":"Platform"},"customfield_10030":5}}`
+
+	err = client.Edit("TEST-1", &EditRequest{
+		CustomFields: map[string]interface{}{
+			"customfield_10010": map[string]string{"value": "Platform"},
+			"customfield_10030": 5,
+		},
+	})
+	assert.NoError(t, err)
+
+	statusCode = http.StatusBadRequest
+	expectedBody = `{"update":{},"fields":{"parent":{},"customfield_10030":5}}`
+
+	err = client.Edit("TEST-1", &EditRequest{CustomFields: map[string]interface{}{"customfield_10030": 5}})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestEditReporter(t *testing.T) {
+	var expectedBody string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.JSONEq(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	expectedBody = `{"update":{"reporter":[{"set":{"accountId":"a-1"}}]},"fields":{"parent":{}}}`
+
+	err := client.Edit("TEST-1", &EditRequest{Reporter: &User{AccountID: "a-1", Name: "Jane Doe"}})
+	assert.NoError(t, err)
+
+	expectedBody = `{"update":{"reporter":[{"set":{"name":"jane"}}]},"fields":{"parent":{}}}`
+
+	err = client.Edit("TEST-1", &EditRequest{Reporter: &User{Login: "jane", Name: "Jane Doe"}})
+	assert.NoError(t, err)
+}
+
+func TestEditAffectsVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.JSONEq(
+			t,
+			`{"update":{"versions":[{"set":[{"name":"v1.0"},{"name":"v1.1"}]}]},"fields":{"parent":{}}}`,
+			actualBody.String(),
+		)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.Edit("TEST-1", &EditRequest{AffectsVersions: []string{"v1.0", "v1.1"}})
+	assert.NoError(t, err)
+}
@@ -17,7 +17,16 @@ const (
 
 // EpicIssues fetches issues in the given epic.
 func (c *Client) EpicIssues(key, jql string, limit uint) (*SearchResult, error) {
+	return c.EpicIssuesFrom(key, jql, 0, limit)
+}
+
+// EpicIssuesFrom fetches issues in the given epic starting at the
+// given offset. It is used to paginate through the results.
+func (c *Client) EpicIssuesFrom(key, jql string, from, limit uint) (*SearchResult, error) {
 	path := fmt.Sprintf("/epic/%s/issue?maxResults=%d", key, limit)
+	if from > 0 {
+		path += fmt.Sprintf("&startAt=%d", from)
+	}
 	if jql != "" {
 		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
 	}
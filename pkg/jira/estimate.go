@@ -0,0 +1,89 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// EstimateFieldOriginal is the original time estimate field of an issue in seconds.
+	EstimateFieldOriginal = "timeoriginalestimate"
+
+	fieldResolutionDate = "resolutiondate"
+)
+
+// IssueEstimate holds an estimate of an issue and the date it was resolved.
+type IssueEstimate struct {
+	Key      string
+	Estimate float64
+	Resolved string // Empty if the issue is not resolved.
+}
+
+// SprintIssueEstimates fetches estimates of all issues in the given sprint.
+//
+// field is the estimation field, eg: a story points custom field or timeoriginalestimate.
+// Issues without an estimate are returned with a zero estimate.
+func (c *Client) SprintIssueEstimates(boardID, sprintID int, field string) ([]*IssueEstimate, error) {
+	const pageSize = 100
+
+	var estimates []*IssueEstimate
+
+	for startAt := 0; ; startAt += pageSize {
+		path := fmt.Sprintf(
+			"/board/%d/sprint/%d/issue?fields=%s&startAt=%d&maxResults=%d",
+			boardID, sprintID, url.QueryEscape(field+","+fieldResolutionDate), startAt, pageSize,
+		)
+
+		res, err := c.GetV1(context.Background(), path, nil)
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			return nil, ErrEmptyResponse
+		}
+
+		out, err := func() (*estimateResult, error) {
+			defer func() { _ = res.Body.Close() }()
+
+			if res.StatusCode != http.StatusOK {
+				return nil, formatUnexpectedResponse(res)
+			}
+
+			var out estimateResult
+			err := json.NewDecoder(res.Body).Decode(&out)
+
+			return &out, err
+		}()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, iss := range out.Issues {
+			e := IssueEstimate{Key: iss.Key}
+			if v, ok := iss.Fields[field].(float64); ok {
+				e.Estimate = v
+			}
+			if v, ok := iss.Fields[fieldResolutionDate].(string); ok {
+				e.Resolved = v
+			}
+			estimates = append(estimates, &e)
+		}
+
+		if len(out.Issues) == 0 || startAt+len(out.Issues) >= out.Total {
+			break
+		}
+	}
+
+	return estimates, nil
+}
+
+type estimateResult struct {
+	Total  int `json:"total"`
+	Issues []struct {
+		Key    string                 `json:"key"`
+		Fields map[string]interface{} `json:"fields"`
+	} `json:"issues"`
+}
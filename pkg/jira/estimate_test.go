@@ -0,0 +1,62 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSprintIssueEstimates(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/1/sprint/2/issue", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		qs := r.URL.Query()
+		assert.Equal(t, "customfield_10016,resolutiondate", qs.Get("fields"))
+		assert.Equal(t, "100", qs.Get("maxResults"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+
+		switch qs.Get("startAt") {
+		case "0":
+			_, _ = w.Write([]byte(`{"total": 101, "issues": [
+				{"key": "TEST-1", "fields": {"customfield_10016": 5, "resolutiondate": "2022-01-12T10:00:00.000+0000"}},
+				{"key": "TEST-2", "fields": {"customfield_10016": null, "resolutiondate": null}}
+			]}`))
+		case "100":
+			_, _ = w.Write([]byte(`{"total": 101, "issues": [
+				{"key": "TEST-3", "fields": {"customfield_10016": 2.5}}
+			]}`))
+		default:
+			t.Errorf("unexpected startAt %s", qs.Get("startAt"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SprintIssueEstimates(1, 2, "customfield_10016")
+	assert.NoError(t, err)
+
+	expected := []*IssueEstimate{
+		{Key: "TEST-1", Estimate: 5, Resolved: "2022-01-12T10:00:00.000+0000"},
+		{Key: "TEST-2"},
+		{Key: "TEST-3", Estimate: 2.5},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.SprintIssueEstimates(1, 2, "customfield_10016")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,41 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+const customFieldPrefix = "customfield_"
+
+// Field holds info of a system or custom field.
+type Field struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Custom bool   `json:"custom"`
+	Schema struct {
+		Type string `json:"type"`
+	} `json:"schema"`
+}
+
+// Fields fetches all system and custom fields using GET /field endpoint.
+func (c *Client) Fields() ([]*Field, error) {
+	res, err := c.GetV2(context.Background(), "/field", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Field
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
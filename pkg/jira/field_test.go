@@ -0,0 +1,64 @@
+package jira
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFields(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/field", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`[
+				{"id": "summary", "name": "Summary", "custom": false, "schema": {"type": "string"}},
+				{"id": "customfield_10016", "name": "Story Points", "custom": true, "schema": {"type": "number"}}
+			]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.Fields()
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "summary", actual[0].ID)
+	assert.False(t, actual[0].Custom)
+	assert.Equal(t, "Story Points", actual[1].Name)
+	assert.Equal(t, "number", actual[1].Schema.Type)
+
+	unexpectedStatusCode = true
+
+	_, err = client.Fields()
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestIssueFieldsCustomFields(t *testing.T) {
+	var iss Issue
+
+	err := json.Unmarshal([]byte(`{"key": "TEST-1", "fields": {
+		"summary": "Test",
+		"customfield_10016": 5,
+		"customfield_10020": {"value": "Platform"},
+		"customfield_10030": null
+	}}`), &iss)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Test", iss.Fields.Summary)
+	assert.Equal(t, map[string]interface{}{
+		"customfield_10016": float64(5),
+		"customfield_10020": map[string]interface{}{"value": "Platform"},
+	}, iss.Fields.CustomFields)
+}
@@ -0,0 +1,148 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Group holds group info.
+type Group struct {
+	Name    string `json:"name"`
+	GroupID string `json:"groupId,omitempty"`
+}
+
+// GroupMembersResult holds response from /group/member endpoint.
+type GroupMembersResult struct {
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	IsLast     bool    `json:"isLast"`
+	Users      []*User `json:"values"`
+}
+
+type groupsPickerResponse struct {
+	Total  int      `json:"total"`
+	Groups []*Group `json:"groups"`
+}
+
+// Groups fetches groups whose name contains the query using GET /groups/picker endpoint.
+// All groups are returned, up to the limit, if the query is empty.
+func (c *Client) Groups(query string, limit int) ([]*Group, error) {
+	path := fmt.Sprintf("/groups/picker?query=%s&maxResults=%d", url.QueryEscape(query), limit)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out groupsPickerResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out.Groups, nil
+}
+
+// GroupMembers fetches a page of the members of the group using GET /group/member endpoint.
+func (c *Client) GroupMembers(group string, includeInactive bool, from, limit int) (*GroupMembersResult, error) {
+	path := fmt.Sprintf(
+		"/group/member?groupname=%s&includeInactiveUsers=%t&startAt=%d&maxResults=%d",
+		url.QueryEscape(group), includeInactive, from, limit,
+	)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out GroupMembersResult
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// AddGroupUser adds a user to the group using POST /group/user endpoint.
+// The user is identified by the account id.
+func (c *Client) AddGroupUser(group, accountID string) error {
+	return c.addGroupUser(group, map[string]string{"accountId": accountID})
+}
+
+// AddGroupUserV2 adds a user to the group using POST /group/user endpoint.
+// The user is identified by the username.
+func (c *Client) AddGroupUserV2(group, username string) error {
+	return c.addGroupUser(group, map[string]string{"name": username})
+}
+
+func (c *Client) addGroupUser(group string, user map[string]string) error {
+	body, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/group/user?groupname=%s", url.QueryEscape(group))
+
+	res, err := c.PostV2(context.Background(), path, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// RemoveGroupUser removes a user from the group using DELETE /group/user endpoint.
+// The user is identified by the account id.
+func (c *Client) RemoveGroupUser(group, accountID string) error {
+	return c.removeGroupUser(group, "accountId", accountID)
+}
+
+// RemoveGroupUserV2 removes a user from the group using DELETE /group/user endpoint.
+// The user is identified by the username.
+func (c *Client) RemoveGroupUserV2(group, username string) error {
+	return c.removeGroupUser(group, "username", username)
+}
+
+func (c *Client) removeGroupUser(group, param, user string) error {
+	path := fmt.Sprintf("/group/user?groupname=%s&%s=%s", url.QueryEscape(group), param, url.QueryEscape(user))
+
+	res, err := c.DeleteV2(context.Background(), path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
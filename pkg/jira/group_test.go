@@ -0,0 +1,131 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/groups/picker", r.URL.Path)
+		assert.Equal(t, "query=jira+users&maxResults=20", r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"header":"Showing 2 of 2 matching groups","total":2,"groups":[
+			{"name":"jira-users","html":"<b>jira-users</b>","groupId":"g-1"},
+			{"name":"jira-users-eu","html":"<b>jira-users</b>-eu","groupId":"g-2"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.Groups("jira users", 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Group{{Name: "jira-users", GroupID: "g-1"}, {Name: "jira-users-eu", GroupID: "g-2"}}, actual)
+}
+
+func TestGroupMembers(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/group/member", r.URL.Path)
+		assert.Equal(t, "groupname=jira+admins&includeInactiveUsers=true&startAt=50&maxResults=50", r.URL.RawQuery)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"startAt":50,"maxResults":50,"total":51,"isLast":true,"values":[
+			{"accountId":"a-1","displayName":"Jon Doe","emailAddress":"jon@domain.tld","active":true}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GroupMembers("jira admins", true, 50, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, &GroupMembersResult{
+		StartAt:    50,
+		MaxResults: 50,
+		Total:      51,
+		IsLast:     true,
+		Users:      []*User{{AccountID: "a-1", Name: "Jon Doe", Email: "jon@domain.tld", Active: true}},
+	}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GroupMembers("jira admins", true, 50, 50)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAddGroupUser(t *testing.T) {
+	var expectedBody string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/group/user", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "groupname=jira-admins", r.URL.RawQuery)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(201)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	expectedBody = `{"accountId":"a-1"}`
+	assert.NoError(t, client.AddGroupUser("jira-admins", "a-1"))
+
+	expectedBody = `{"name":"jon.doe"}`
+	assert.NoError(t, client.AddGroupUserV2("jira-admins", "jon.doe"))
+}
+
+func TestRemoveGroupUser(t *testing.T) {
+	var (
+		unexpectedStatusCode bool
+		expectedQuery        string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/group/user", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, expectedQuery, r.URL.RawQuery)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+		w.WriteHeader(200)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	expectedQuery = "groupname=jira-admins&accountId=a-1"
+	assert.NoError(t, client.RemoveGroupUser("jira-admins", "a-1"))
+
+	expectedQuery = "groupname=jira-admins&username=jon.doe"
+	assert.NoError(t, client.RemoveGroupUserV2("jira-admins", "jon.doe"))
+
+	unexpectedStatusCode = true
+	expectedQuery = "groupname=jira-admins&accountId=a-1"
+
+	err := client.RemoveGroupUser("jira-admins", "a-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/ankitpokhrel/jira-cli/pkg/jira/filter/issue"
 
@@ -24,6 +25,14 @@ const (
 	AssigneeDefault = "default"
 )
 
+// Remaining estimate adjustment types for worklog operations.
+const (
+	AdjustEstimateAuto   = "auto"
+	AdjustEstimateLeave  = "leave"
+	AdjustEstimateNew    = "new"
+	AdjustEstimateManual = "manual"
+)
+
 // GetIssue fetches issue details using GET /issue/{key} endpoint.
 func (c *Client) GetIssue(key string, opts ...filter.Filter) (*Issue, error) {
 	return c.getIssue(key, apiVersion3, opts)
@@ -155,6 +164,34 @@ func (c *Client) assignIssue(key, assignee, ver string) error {
 	return nil
 }
 
+// ErrNoDeletePermission denotes that the user is not allowed to delete the issue.
+var ErrNoDeletePermission = fmt.Errorf("jira: you don't have permission to delete the issue")
+
+// DeleteIssue deletes an issue using DELETE /issue/{key} endpoint.
+// Subtasks of the issue are deleted as well if cascade is set, otherwise
+// issues with subtasks can't be deleted.
+func (c *Client) DeleteIssue(key string, cascade bool) error {
+	path := fmt.Sprintf("/issue/%s?deleteSubtasks=%t", key, cascade)
+
+	res, err := c.DeleteV2(context.Background(), path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	switch res.StatusCode {
+	case http.StatusNoContent:
+		return nil
+	case http.StatusForbidden:
+		return ErrNoDeletePermission
+	default:
+		return formatUnexpectedResponse(res)
+	}
+}
+
 // GetIssueLinkTypes fetches issue link types using GET /issueLinkType endpoint.
 func (c *Client) GetIssueLinkTypes() ([]*IssueLinkType, error) {
 	res, err := c.GetV2(context.Background(), "/issueLinkType", nil)
@@ -228,14 +265,42 @@ func (c *Client) LinkIssue(inwardIssue, outwardIssue, linkType string) error {
 	return nil
 }
 
+// UnlinkIssue removes an issue link using DELETE /issueLink/{id} endpoint.
+func (c *Client) UnlinkIssue(linkID string) error {
+	res, err := c.DeleteV2(context.Background(), "/issueLink/"+linkID, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
 type issueCommentRequest struct {
-	Body string `json:"body"`
+	Body       string            `json:"body"`
+	Properties []CommentProperty `json:"properties,omitempty"`
 }
 
 // AddIssueComment adds comment to an issue using POST /issue/{key}/comment endpoint.
 // It only supports plain text comments at the moment.
-func (c *Client) AddIssueComment(key, comment string) error {
-	body, err := json.Marshal(&issueCommentRequest{Body: md.ToJiraMD(comment)})
+//
+// Internal comments are hidden from customers and are only supported in service desk projects.
+func (c *Client) AddIssueComment(key, comment string, internal bool) error {
+	cr := issueCommentRequest{Body: md.ToJiraMD(comment)}
+	if internal {
+		cr.Properties = []CommentProperty{{
+			Key:   CommentPropertyServiceDesk,
+			Value: map[string]interface{}{"internal": true},
+		}}
+	}
+
+	body, err := json.Marshal(&cr)
 	if err != nil {
 		return err
 	}
@@ -260,24 +325,100 @@ func (c *Client) AddIssueComment(key, comment string) error {
 }
 
 type issueWorklogRequest struct {
-	Comment   string `json:"comment"`
-	Started   string `json:"started"`
-	TimeSpent string `json:"timeSpent"`
+	Comment    string             `json:"comment"`
+	Started    string             `json:"started"`
+	TimeSpent  string             `json:"timeSpent"`
+	Visibility *WorklogVisibility `json:"visibility,omitempty"`
 }
 
-// AddIssueWorklog adds worklog to an issue using POST /issue/{key}/worklog endpoint.
-// It only supports plain text worklog at the moment.
-func (c *Client) AddIssueWorklog(key, worklog string, started string, timeSpent string) error {
-	body, err := json.Marshal(&issueWorklogRequest{Comment: md.ToJiraMD(worklog), Started: started, TimeSpent: timeSpent})
+// AddIssueWorklog adds worklog to an issue using POST /issue/{key}/worklog endpoint
+// and returns the created worklog. It only supports plain text worklog at the moment.
+//
+// adjustEstimate can be one of auto, leave, new or manual. The value of
+// estimate is sent as newEstimate for new and as reduceBy for manual.
+// The worklog is visible to everyone who can see the issue if visibility is nil.
+func (c *Client) AddIssueWorklog(
+	key, worklog, started, timeSpent, adjustEstimate, estimate string, visibility *WorklogVisibility,
+) (*Worklog, error) {
+	body, err := json.Marshal(&issueWorklogRequest{
+		Comment:    md.ToJiraMD(worklog),
+		Started:    started,
+		TimeSpent:  timeSpent,
+		Visibility: visibility,
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	path := fmt.Sprintf("/issue/%s/worklog", key)
+	if qp := adjustEstimateQuery(adjustEstimate, estimate, "reduceBy"); qp != "" {
+		path += "?" + qp
+	}
 	res, err := c.PostV2(context.Background(), path, body, Header{
 		"Accept":       "application/json",
 		"Content-Type": "application/json",
 	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Worklog
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetIssueWorklog fetches a worklog of an issue using GET /issue/{key}/worklog/{id} endpoint.
+func (c *Client) GetIssueWorklog(key, id string) (*Worklog, error) {
+	path := fmt.Sprintf("/issue/%s/worklog/%s", key, id)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Worklog
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// UpdateIssueWorklog updates a worklog of an issue using PUT /issue/{key}/worklog/{id} endpoint.
+// It only supports plain text worklog at the moment.
+func (c *Client) UpdateIssueWorklog(key, id, worklog, started, timeSpent string, visibility *WorklogVisibility) error {
+	body, err := json.Marshal(&issueWorklogRequest{
+		Comment:    md.ToJiraMD(worklog),
+		Started:    started,
+		TimeSpent:  timeSpent,
+		Visibility: visibility,
+	})
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/issue/%s/worklog/%s", key, id)
+	res, err := c.PutV2(context.Background(), path, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
 	if err != nil {
 		return err
 	}
@@ -286,12 +427,108 @@ func (c *Client) AddIssueWorklog(key, worklog string, started string, timeSpent
 	}
 	defer func() { _ = res.Body.Close() }()
 
-	if res.StatusCode != http.StatusCreated {
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// DeleteIssueWorklog deletes a worklog of an issue using DELETE /issue/{key}/worklog/{id} endpoint.
+//
+// adjustEstimate can be one of auto, leave, new or manual. The value of
+// estimate is sent as newEstimate for new and as increaseBy for manual.
+func (c *Client) DeleteIssueWorklog(key, id, adjustEstimate, estimate string) error {
+	path := fmt.Sprintf("/issue/%s/worklog/%s", key, id)
+	if qp := adjustEstimateQuery(adjustEstimate, estimate, "increaseBy"); qp != "" {
+		path += "?" + qp
+	}
+
+	res, err := c.DeleteV2(context.Background(), path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
 		return formatUnexpectedResponse(res)
 	}
 	return nil
 }
 
+// WorklogResult holds response from GET /issue/{key}/worklog endpoint.
+type WorklogResult struct {
+	StartAt    int        `json:"startAt"`
+	MaxResults int        `json:"maxResults"`
+	Total      int        `json:"total"`
+	Worklogs   []*Worklog `json:"worklogs"`
+}
+
+// GetIssueWorklogs fetches a page of worklogs of an issue using GET /issue/{key}/worklog endpoint.
+func (c *Client) GetIssueWorklogs(key string, startAt, max int) (*WorklogResult, error) {
+	path := fmt.Sprintf("/issue/%s/worklog?startAt=%d&maxResults=%d", key, startAt, max)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out WorklogResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// GetAllIssueWorklogs fetches all worklogs of an issue.
+//
+// Jira returns worklogs in pages, so we will keep sending requests
+// until we have fetched all the worklogs reported by the server.
+func (c *Client) GetAllIssueWorklogs(key string, pageSize int) ([]*Worklog, error) {
+	var worklogs []*Worklog
+
+	for n := 0; ; {
+		wr, err := c.GetIssueWorklogs(key, n, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		worklogs = append(worklogs, wr.Worklogs...)
+
+		n += len(wr.Worklogs)
+		if len(wr.Worklogs) == 0 || n >= wr.Total {
+			break
+		}
+	}
+
+	return worklogs, nil
+}
+
+// adjustEstimateQuery builds query params for the remaining estimate adjustment.
+// manualKey is the query param used to send the estimate for the manual adjustment.
+func adjustEstimateQuery(adjustEstimate, estimate, manualKey string) string {
+	qp := url.Values{}
+	if adjustEstimate != "" {
+		qp.Set("adjustEstimate", adjustEstimate)
+	}
+	switch adjustEstimate {
+	case AdjustEstimateNew:
+		qp.Set("newEstimate", estimate)
+	case AdjustEstimateManual:
+		qp.Set(manualKey, estimate)
+	}
+	return qp.Encode()
+}
+
 func ifaceToADF(v interface{}) *adf.ADF {
 	if v == nil {
 		return nil
@@ -224,6 +224,42 @@ func TestAssignIssue(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
+func TestDeleteIssue(t *testing.T) {
+	var (
+		statusCode    = 204
+		expectedQuery = "deleteSubtasks=false"
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, expectedQuery, r.URL.RawQuery)
+
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteIssue("TEST-1", false)
+	assert.NoError(t, err)
+
+	expectedQuery = "deleteSubtasks=true"
+
+	err = client.DeleteIssue("TEST-1", true)
+	assert.NoError(t, err)
+
+	statusCode = 403
+
+	err = client.DeleteIssue("TEST-1", true)
+	assert.Equal(t, ErrNoDeletePermission, err)
+
+	statusCode = 400
+
+	err = client.DeleteIssue("TEST-1", true)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
 func TestGetIssueLinkTypes(t *testing.T) {
 	var unexpectedStatusCode bool
 
@@ -301,9 +337,38 @@ func TestLinkIssue(t *testing.T) {
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
-func TestAddIssueComment(t *testing.T) {
+func TestUnlinkIssue(t *testing.T) {
 	var unexpectedStatusCode bool
 
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/rest/api/2/issueLink/10001", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.UnlinkIssue("10001")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.UnlinkIssue("10001")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAddIssueComment(t *testing.T) {
+	var (
+		unexpectedStatusCode bool
+		expectedBody         = `{"body":"comment"}`
+	)
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "POST", r.Method)
 		assert.Equal(t, "/rest/api/2/issue/TEST-1/comment", r.URL.Path)
@@ -313,8 +378,6 @@ func TestAddIssueComment(t *testing.T) {
 		actualBody := new(strings.Builder)
 		_, _ = io.Copy(actualBody, r.Body)
 
-		expectedBody := `{"body":"comment"}`
-
 		assert.Equal(t, expectedBody, actualBody.String())
 
 		if unexpectedStatusCode {
@@ -327,46 +390,208 @@ func TestAddIssueComment(t *testing.T) {
 
 	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
 
-	err := client.AddIssueComment("TEST-1", "comment")
+	err := client.AddIssueComment("TEST-1", "comment", false)
+	assert.NoError(t, err)
+
+	expectedBody = `{"body":"comment","properties":[{"key":"sd.public.comment","value":{"internal":true}}]}`
+
+	err = client.AddIssueComment("TEST-1", "comment", true)
 	assert.NoError(t, err)
 
 	unexpectedStatusCode = true
 
-	err = client.AddIssueComment("TEST-1", "comment")
+	err = client.AddIssueComment("TEST-1", "comment", true)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
 
 func TestAddIssueWorklog(t *testing.T) {
-	var unexpectedStatusCode bool
+	var (
+		unexpectedStatusCode bool
+		expectedQuery        string
+		expectedBody         = `{"comment":"comment","started":"today","timeSpent":"30m"}`
+	)
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "POST", r.Method)
 		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog", r.URL.Path)
+		assert.Equal(t, expectedQuery, r.URL.RawQuery)
 		assert.Equal(t, "application/json", r.Header.Get("Accept"))
 		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
 
 		actualBody := new(strings.Builder)
 		_, _ = io.Copy(actualBody, r.Body)
 
-		expectedBody := `{"comment":"comment", "started": "today", "timeSpent": "30m"}`
-
 		assert.Equal(t, expectedBody, actualBody.String())
 
 		if unexpectedStatusCode {
 			w.WriteHeader(400)
 		} else {
+			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(201)
+			_, _ = w.Write([]byte(`{"id":"10001","issueId":"10100","timeSpentSeconds":1800}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	wl, err := client.AddIssueWorklog("TEST-1", "comment", "today", "30m", "", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "10001", wl.ID)
+	assert.Equal(t, 1800, wl.TimeSpentSeconds)
+
+	expectedQuery = "adjustEstimate=new&newEstimate=2d"
+
+	_, err = client.AddIssueWorklog("TEST-1", "comment", "today", "30m", AdjustEstimateNew, "2d", nil)
+	assert.NoError(t, err)
+
+	expectedQuery = "adjustEstimate=manual&reduceBy=4h"
+
+	_, err = client.AddIssueWorklog("TEST-1", "comment", "today", "30m", AdjustEstimateManual, "4h", nil)
+	assert.NoError(t, err)
+
+	expectedQuery = ""
+	expectedBody = `{"comment":"comment","started":"today","timeSpent":"30m","visibility":{"type":"role","value":"Developers"}}`
+
+	_, err = client.AddIssueWorklog(
+		"TEST-1", "comment", "today", "30m", "", "", &WorklogVisibility{Type: VisibilityTypeRole, Value: "Developers"},
+	)
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+	expectedBody = `{"comment":"comment","started":"today","timeSpent":"30m"}`
+
+	_, err = client.AddIssueWorklog("TEST-1", "comment", "today", "30m", "", "", nil)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetAllIssueWorklogs(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("maxResults"))
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
 		}
+
+		file := "./testdata/worklogs.json"
+		if r.URL.Query().Get("startAt") == "2" {
+			file = "./testdata/worklogs-2.json"
+		}
+
+		resp, err := ioutil.ReadFile(file)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
 	}))
 	defer server.Close()
 
 	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
 
-	err := client.AddIssueWorklog("TEST-1", "comment", "today", "30m")
+	actual, err := client.GetAllIssueWorklogs("TEST-1", 2)
+	assert.NoError(t, err)
+
+	assert.Len(t, actual, 3)
+	assert.Equal(t, "10001", actual[0].ID)
+	assert.Equal(t, "Person A", actual[0].Author.Name)
+	assert.Equal(t, "Implementation", actual[0].Comment)
+	assert.Equal(t, 3600, actual[0].TimeSpentSeconds)
+	assert.Equal(t, "10002", actual[1].ID)
+	assert.Equal(t, "30m", actual[1].TimeSpent)
+	assert.Equal(t, "10003", actual[2].ID)
+	assert.Equal(t, "2022-02-04T10:15:00.000+0100", actual[2].Started)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetAllIssueWorklogs("TEST-1", 2)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateIssueWorklog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog/10001", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"comment":"comment","started":"2022-02-02T13:35:00.000+0100","timeSpent":"2h",` +
+			`"visibility":{"type":"group","value":"engineering"}}`
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(200)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	visibility := &WorklogVisibility{Type: VisibilityTypeGroup, Value: "engineering"}
+
+	err := client.UpdateIssueWorklog("TEST-1", "10001", "comment", "2022-02-02T13:35:00.000+0100", "2h", visibility)
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.UpdateIssueWorklog("TEST-1", "10001", "comment", "2022-02-02T13:35:00.000+0100", "2h", visibility)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteIssueWorklog(t *testing.T) {
+	var (
+		unexpectedStatusCode bool
+		expectedQuery        string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/worklog/10001", r.URL.Path)
+		assert.Equal(t, expectedQuery, r.URL.RawQuery)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteIssueWorklog("TEST-1", "10001", "", "")
+	assert.NoError(t, err)
+
+	expectedQuery = "adjustEstimate=leave"
+
+	err = client.DeleteIssueWorklog("TEST-1", "10001", AdjustEstimateLeave, "")
+	assert.NoError(t, err)
+
+	expectedQuery = "adjustEstimate=new&newEstimate=2d"
+
+	err = client.DeleteIssueWorklog("TEST-1", "10001", AdjustEstimateNew, "2d")
+	assert.NoError(t, err)
+
+	expectedQuery = "adjustEstimate=manual&increaseBy=4h"
+
+	err = client.DeleteIssueWorklog("TEST-1", "10001", AdjustEstimateManual, "4h")
 	assert.NoError(t, err)
 
 	unexpectedStatusCode = true
 
-	err = client.AddIssueWorklog("TEST-1", "comment", "today", "30m")
+	err = client.DeleteIssueWorklog("TEST-1", "10001", AdjustEstimateManual, "4h")
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
@@ -0,0 +1,114 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// JQLField is a field that can be used in JQL queries.
+type JQLField struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	Orderable   string   `json:"orderable"`
+	Searchable  string   `json:"searchable"`
+	CFID        string   `json:"cfid,omitempty"`
+	Operators   []string `json:"operators"`
+	Types       []string `json:"types"`
+}
+
+// JQLFunction is a function that can be used in JQL queries, eg: currentUser().
+type JQLFunction struct {
+	Value       string   `json:"value"`
+	DisplayName string   `json:"displayName"`
+	IsList      string   `json:"isList"`
+	Types       []string `json:"types"`
+}
+
+// JQLAutocompleteData holds response from GET /jql/autocompletedata endpoint.
+type JQLAutocompleteData struct {
+	VisibleFieldNames    []*JQLField    `json:"visibleFieldNames"`
+	VisibleFunctionNames []*JQLFunction `json:"visibleFunctionNames"`
+	JQLReservedWords     []string       `json:"jqlReservedWords"`
+}
+
+// JQLSuggestion is a suggested value of a field in JQL queries.
+type JQLSuggestion struct {
+	Value       string `json:"value"`
+	DisplayName string `json:"displayName"`
+}
+
+// JQLAutocomplete fetches fields and functions that can be used in JQL queries
+// using GET /jql/autocompletedata endpoint.
+func (c *Client) JQLAutocomplete() (*JQLAutocompleteData, error) {
+	res, err := c.GetV2(context.Background(), "/jql/autocompletedata", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out JQLAutocompleteData
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// JQLSuggestions fetches suggested values of a field starting with the given value
+// using GET /jql/autocompletedata/suggestions endpoint.
+func (c *Client) JQLSuggestions(field, value string) ([]*JQLSuggestion, error) {
+	path := fmt.Sprintf(
+		"/jql/autocompletedata/suggestions?fieldName=%s&fieldValue=%s",
+		url.QueryEscape(field), url.QueryEscape(value),
+	)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		Results []*JQLSuggestion `json:"results"`
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.Results, err
+}
+
+// ValidateJQL validates a JQL query on the server. It runs the query using GET /search
+// endpoint with strict validation without fetching any issue.
+func (c *Client) ValidateJQL(jql string) error {
+	path := fmt.Sprintf("/search?jql=%s&maxResults=0&fields=key&validateQuery=strict", url.QueryEscape(jql))
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
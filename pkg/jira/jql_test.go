@@ -0,0 +1,136 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJQLAutocomplete(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/jql/autocompletedata", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"visibleFieldNames": [
+				{"value": "status", "displayName": "Status", "orderable": "true", "searchable": "true",
+				 "operators": ["=", "!=", "in", "not in", "is", "is not"], "types": ["com.atlassian.jira.issue.status.Status"]},
+				{"value": "\"Story Points\"", "displayName": "Story Points - cf[10016]", "orderable": "true", "searchable": "true",
+				 "cfid": "cf[10016]", "operators": ["=", ">", "<"], "types": ["java.lang.Number"]}
+			],
+			"visibleFunctionNames": [
+				{"value": "currentUser()", "displayName": "currentUser()", "types": ["com.atlassian.jira.user.ApplicationUser"]}
+			],
+			"jqlReservedWords": ["empty", "null"]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.JQLAutocomplete()
+	assert.NoError(t, err)
+
+	expected := &JQLAutocompleteData{
+		VisibleFieldNames: []*JQLField{
+			{
+				Value: "status", DisplayName: "Status", Orderable: "true", Searchable: "true",
+				Operators: []string{"=", "!=", "in", "not in", "is", "is not"},
+				Types:     []string{"com.atlassian.jira.issue.status.Status"},
+			},
+			{
+				Value: `"Story Points"`, DisplayName: "Story Points - cf[10016]", Orderable: "true", Searchable: "true",
+				CFID: "cf[10016]", Operators: []string{"=", ">", "<"}, Types: []string{"java.lang.Number"},
+			},
+		},
+		VisibleFunctionNames: []*JQLFunction{
+			{Value: "currentUser()", DisplayName: "currentUser()", Types: []string{"com.atlassian.jira.user.ApplicationUser"}},
+		},
+		JQLReservedWords: []string{"empty", "null"},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.JQLAutocomplete()
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestJQLSuggestions(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/jql/autocompletedata/suggestions", r.URL.Path)
+		assert.Equal(t, url.Values{
+			"fieldName":  []string{"status"},
+			"fieldValue": []string{"In"},
+		}, r.URL.Query())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"results": [
+			{"value": "In Progress", "displayName": "<b>In</b> Progress"},
+			{"value": "In Review", "displayName": "<b>In</b> Review"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.JQLSuggestions("status", "In")
+	assert.NoError(t, err)
+	assert.Equal(t, []*JQLSuggestion{
+		{Value: "In Progress", DisplayName: "<b>In</b> Progress"},
+		{Value: "In Review", DisplayName: "<b>In</b> Review"},
+	}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.JQLSuggestions("status", "In")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestValidateJQL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/search", r.URL.Path)
+		assert.Equal(t, "0", r.URL.Query().Get("maxResults"))
+		assert.Equal(t, "strict", r.URL.Query().Get("validateQuery"))
+
+		if r.URL.Query().Get("jql") != "status = Done" {
+			w.WriteHeader(400)
+			_, _ = w.Write([]byte(`{"errorMessages": ["Field 'stat' does not exist or you do not have permission to view it."]}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"startAt": 0, "maxResults": 0, "total": 12, "issues": []}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	assert.NoError(t, client.ValidateJQL("status = Done"))
+
+	err := client.ValidateJQL("stat = Done")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Field 'stat' does not exist")
+}
@@ -0,0 +1,31 @@
+package jira
+
+import "fmt"
+
+// Operations supported when updating multi-value fields of an issue, eg: labels.
+const (
+	UpdateOpAdd    = "add"
+	UpdateOpRemove = "remove"
+	UpdateOpSet    = "set"
+)
+
+// UpdateLabels adds, removes or replaces labels of an issue using PUT /issue/{key} endpoint.
+func (c *Client) UpdateLabels(key, op string, labels []string) error {
+	var ops []map[string]interface{}
+
+	switch op {
+	case UpdateOpAdd, UpdateOpRemove:
+		for _, l := range labels {
+			ops = append(ops, map[string]interface{}{op: l})
+		}
+	case UpdateOpSet:
+		if labels == nil {
+			labels = []string{}
+		}
+		ops = append(ops, map[string]interface{}{op: labels})
+	default:
+		return fmt.Errorf("jira: invalid label operation %q", op)
+	}
+
+	return c.updateIssueField(key, "labels", ops)
+}
@@ -0,0 +1,56 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateLabels(t *testing.T) {
+	var (
+		expectedBody         string
+		unexpectedStatusCode bool
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	expectedBody = `{"update":{"labels":[{"add":"backend"},{"add":"urgent"}]}}`
+	assert.NoError(t, client.UpdateLabels("TEST-1", UpdateOpAdd, []string{"backend", "urgent"}))
+
+	expectedBody = `{"update":{"labels":[{"remove":"urgent"}]}}`
+	assert.NoError(t, client.UpdateLabels("TEST-1", UpdateOpRemove, []string{"urgent"}))
+
+	expectedBody = `{"update":{"labels":[{"set":[]}]}}`
+	assert.NoError(t, client.UpdateLabels("TEST-1", UpdateOpSet, nil))
+
+	assert.Error(t, client.UpdateLabels("TEST-1", "replace", []string{"x"}))
+
+	unexpectedStatusCode = true
+	expectedBody = `{"update":{"labels":[{"set":["backend"]}]}}`
+
+	err := client.UpdateLabels("TEST-1", UpdateOpSet, []string{"backend"})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
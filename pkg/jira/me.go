@@ -8,14 +8,35 @@ import (
 
 // Me struct holds response from /myself endpoint.
 type Me struct {
-	Login string `json:"name"`
-	Name  string `json:"displayName"`
-	Email string `json:"emailAddress"`
+	AccountID string    `json:"accountId"`
+	Login     string    `json:"name"`
+	Name      string    `json:"displayName"`
+	Email     string    `json:"emailAddress"`
+	TimeZone  string    `json:"timeZone,omitempty"`
+	Groups    *MeGroups `json:"groups,omitempty"`
+}
+
+// MeGroups holds the groups of the user, only returned by MeWithGroups.
+type MeGroups struct {
+	Size  int `json:"size"`
+	Items []struct {
+		Name    string `json:"name"`
+		GroupID string `json:"groupId,omitempty"`
+	} `json:"items"`
 }
 
 // Me fetches response from /myself endpoint.
 func (c *Client) Me() (*Me, error) {
-	res, err := c.GetV2(context.Background(), "/myself", nil)
+	return c.me("/myself")
+}
+
+// MeWithGroups fetches response from /myself endpoint along with the groups of the user.
+func (c *Client) MeWithGroups() (*Me, error) {
+	return c.me("/myself?expand=groups")
+}
+
+func (c *Client) me(path string) (*Me, error) {
+	res, err := c.GetV2(context.Background(), path, nil)
 	if err != nil {
 		return nil, err
 	}
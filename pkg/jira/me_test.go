@@ -45,3 +45,28 @@ func TestMe(t *testing.T) {
 	_, err = client.Me()
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestMeWithGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/myself", r.URL.Path)
+		assert.Equal(t, "groups", r.URL.Query().Get("expand"))
+
+		resp, err := ioutil.ReadFile("./testdata/myself-groups.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.MeWithGroups()
+	assert.NoError(t, err)
+
+	assert.Equal(t, "5b10a2844c20165700ede21g", actual.AccountID)
+	assert.Equal(t, "Europe/Berlin", actual.TimeZone)
+	assert.Equal(t, 2, actual.Groups.Size)
+	assert.Equal(t, "site-admins", actual.Groups.Items[1].Name)
+}
@@ -0,0 +1,187 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// StatusCategoryDone is the key of the status category of resolved issues.
+	StatusCategoryDone = "done"
+
+	// TaskStatusComplete denotes a successfully completed async task.
+	TaskStatusComplete = "COMPLETE"
+	// TaskStatusEnqueued denotes an async task waiting to be run.
+	TaskStatusEnqueued = "ENQUEUED"
+	// TaskStatusRunning denotes a running async task.
+	TaskStatusRunning = "RUNNING"
+	// TaskStatusCancelRequested denotes an async task being cancelled.
+	TaskStatusCancelRequested = "CANCEL_REQUESTED"
+)
+
+// ErrMoveNotSupported denotes that the installation doesn't expose the issue move API.
+var ErrMoveNotSupported = fmt.Errorf("jira: moving issues is not supported by the server")
+
+// IssueTypeStatuses holds statuses available for an issue type of a project.
+type IssueTypeStatuses struct {
+	IssueType
+	Statuses []*Status `json:"statuses"`
+}
+
+// MoveRequest struct holds request data for move request.
+type MoveRequest struct {
+	// Keys are the issues to move.
+	Keys []string
+	// Project is the key of the target project.
+	Project string
+	// IssueTypeID is the issue type of the moved issues in the target project.
+	IssueTypeID string
+	// StatusID is the status of the moved issues in the target project.
+	// Jira infers the status from the workflow if it is empty.
+	StatusID string
+}
+
+type moveMapping struct {
+	InferClassificationDefaults bool     `json:"inferClassificationDefaults"`
+	InferFieldDefaults          bool     `json:"inferFieldDefaults"`
+	InferStatusDefaults         bool     `json:"inferStatusDefaults"`
+	InferSubtaskTypeDefault     bool     `json:"inferSubtaskTypeDefault"`
+	IssueIdsOrKeys              []string `json:"issueIdsOrKeys"`
+	TargetStatus                []struct {
+		Statuses map[string][]moveStatusCriteria `json:"statuses"`
+	} `json:"targetStatus,omitempty"`
+}
+
+type moveStatusCriteria struct {
+	AnyStatus bool     `json:"anyStatus"`
+	StatusIds []string `json:"statusIds"`
+}
+
+type moveRequest struct {
+	SendBulkNotification   bool                    `json:"sendBulkNotification"`
+	TargetToSourcesMapping map[string]*moveMapping `json:"targetToSourcesMapping"`
+}
+
+// Task holds info of an async task in Jira.
+type Task struct {
+	ID       string `json:"id"`
+	Status   string `json:"status"`
+	Message  string `json:"message"`
+	Progress int    `json:"progress"`
+}
+
+// Done tells if the task has finished running, successfully or not.
+func (t *Task) Done() bool {
+	switch t.Status {
+	case TaskStatusEnqueued, TaskStatusRunning, TaskStatusCancelRequested:
+		return false
+	}
+	return true
+}
+
+// ProjectStatuses fetches issue types and their statuses of a project
+// using GET /project/{key}/statuses endpoint.
+func (c *Client) ProjectStatuses(project string) ([]*IssueTypeStatuses, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/project/%s/statuses", project), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*IssueTypeStatuses
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// Move moves issues to another project using POST /bulk/issues/move endpoint. The move
+// runs asynchronously in Jira, the returned task id can be used to track its progress.
+// ErrMoveNotSupported is returned if the endpoint is not available in the installation.
+func (c *Client) Move(req *MoveRequest) (string, error) {
+	mapping := moveMapping{
+		InferClassificationDefaults: true,
+		InferFieldDefaults:          true,
+		InferStatusDefaults:         req.StatusID == "",
+		InferSubtaskTypeDefault:     true,
+		IssueIdsOrKeys:              req.Keys,
+	}
+	if req.StatusID != "" {
+		mapping.TargetStatus = []struct {
+			Statuses map[string][]moveStatusCriteria `json:"statuses"`
+		}{
+			{Statuses: map[string][]moveStatusCriteria{
+				req.StatusID: {{AnyStatus: true, StatusIds: []string{}}},
+			}},
+		}
+	}
+
+	body, err := json.Marshal(&moveRequest{
+		SendBulkNotification: true,
+		TargetToSourcesMapping: map[string]*moveMapping{
+			req.Project + "," + req.IssueTypeID: &mapping,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.Post(context.Background(), "/bulk/issues/move", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return "", err
+	}
+	if res == nil {
+		return "", ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	switch res.StatusCode {
+	case http.StatusCreated:
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		return "", ErrMoveNotSupported
+	default:
+		return "", formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		TaskID string `json:"taskId"`
+	}
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out.TaskID, err
+}
+
+// GetTask fetches status of an async task using GET /task/{id} endpoint.
+func (c *Client) GetTask(id string) (*Task, error) {
+	res, err := c.Get(context.Background(), "/task/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Task
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
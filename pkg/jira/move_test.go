@@ -0,0 +1,126 @@
+package jira
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectStatuses(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/PRJ/statuses", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[
+			{"id": "10001", "name": "Task", "subtask": false, "statuses": [
+				{"id": "1", "name": "To Do", "statusCategory": {"key": "new"}},
+				{"id": "3", "name": "Done", "statusCategory": {"key": "done"}}
+			]},
+			{"id": "10002", "name": "Sub-task", "subtask": true, "statuses": []}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.ProjectStatuses("PRJ")
+	assert.NoError(t, err)
+
+	assert.Len(t, actual, 2)
+	assert.Equal(t, IssueType{ID: "10001", Name: "Task"}, actual[0].IssueType)
+	assert.Len(t, actual[0].Statuses, 2)
+	assert.Equal(t, "Done", actual[0].Statuses[1].Name)
+	assert.Equal(t, StatusCategoryDone, actual[0].Statuses[1].StatusCategory.Key)
+	assert.True(t, actual[1].Subtask)
+
+	unexpectedStatusCode = true
+
+	_, err = client.ProjectStatuses("PRJ")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestMove(t *testing.T) {
+	var statusCode int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/bulk/issues/move", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		b, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.Unmarshal(b, &body))
+
+		mapping := body["targetToSourcesMapping"].(map[string]interface{})["NEW,10001"].(map[string]interface{})
+		assert.Equal(t, []interface{}{"TEST-1"}, mapping["issueIdsOrKeys"])
+		assert.Equal(t, false, mapping["inferStatusDefaults"])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"statuses": map[string]interface{}{
+					"3": []interface{}{map[string]interface{}{"anyStatus": true, "statusIds": []interface{}{}}},
+				},
+			},
+		}, mapping["targetStatus"])
+
+		w.WriteHeader(statusCode)
+		if statusCode == 201 {
+			_, _ = w.Write([]byte(`{"taskId": "10641"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+	req := MoveRequest{Keys: []string{"TEST-1"}, Project: "NEW", IssueTypeID: "10001", StatusID: "3"}
+
+	statusCode = 201
+
+	id, err := client.Move(&req)
+	assert.NoError(t, err)
+	assert.Equal(t, "10641", id)
+
+	statusCode = 404
+
+	_, err = client.Move(&req)
+	assert.Equal(t, ErrMoveNotSupported, err)
+
+	statusCode = 400
+
+	_, err = client.Move(&req)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestGetTask(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/task/10641", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "10641", "status": "RUNNING", "progress": 50}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	task, err := client.GetTask("10641")
+	assert.NoError(t, err)
+	assert.Equal(t, &Task{ID: "10641", Status: TaskStatusRunning, Progress: 50}, task)
+	assert.False(t, task.Done())
+
+	task.Status = TaskStatusComplete
+	assert.True(t, task.Done())
+}
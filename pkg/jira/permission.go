@@ -0,0 +1,65 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Permission holds a permission and whether the user has it.
+type Permission struct {
+	ID             string `json:"id"`
+	Key            string `json:"key"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Description    string `json:"description"`
+	HavePermission bool   `json:"havePermission"`
+}
+
+// MyPermissionsOptions holds options to check permissions of the user.
+type MyPermissionsOptions struct {
+	IssueKey    string
+	ProjectKey  string
+	Permissions []string
+}
+
+type myPermissionsResponse struct {
+	Permissions map[string]*Permission `json:"permissions"`
+}
+
+// MyPermissions fetches permissions of the user in the issue or the project, or the global
+// permissions if neither is set, using GET /mypermissions endpoint. Permissions are mapped
+// by their keys, eg: EDIT_ISSUES.
+func (c *Client) MyPermissions(opt *MyPermissionsOptions) (map[string]*Permission, error) {
+	q := url.Values{}
+	if opt.IssueKey != "" {
+		q.Set("issueKey", opt.IssueKey)
+	}
+	if opt.ProjectKey != "" {
+		q.Set("projectKey", opt.ProjectKey)
+	}
+	if len(opt.Permissions) > 0 {
+		q.Set("permissions", strings.Join(opt.Permissions, ","))
+	}
+
+	res, err := c.GetV2(context.Background(), "/mypermissions?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out myPermissionsResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out.Permissions, nil
+}
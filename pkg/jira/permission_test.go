@@ -0,0 +1,48 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMyPermissions(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/mypermissions", r.URL.Path)
+		assert.Equal(t, "issueKey=TEST-1&permissions=EDIT_ISSUES%2CDELETE_ISSUES", r.URL.RawQuery)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"permissions":{
+			"EDIT_ISSUES":{"id":"12","key":"EDIT_ISSUES","name":"Edit Issues","type":"PROJECT","havePermission":true},
+			"DELETE_ISSUES":{"id":"16","key":"DELETE_ISSUES","name":"Delete Issues","type":"PROJECT","havePermission":false}
+		}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	opts := MyPermissionsOptions{IssueKey: "TEST-1", Permissions: []string{"EDIT_ISSUES", "DELETE_ISSUES"}}
+
+	actual, err := client.MyPermissions(&opts)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*Permission{
+		"EDIT_ISSUES":   {ID: "12", Key: "EDIT_ISSUES", Name: "Edit Issues", Type: "PROJECT", HavePermission: true},
+		"DELETE_ISSUES": {ID: "16", Key: "DELETE_ISSUES", Name: "Delete Issues", Type: "PROJECT"},
+	}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.MyPermissions(&opts)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
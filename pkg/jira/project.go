@@ -3,6 +3,7 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 )
 
@@ -11,8 +12,49 @@ const (
 	ProjectTypeClassic = "classic"
 	// ProjectTypeNextGen is a next gen project type.
 	ProjectTypeNextGen = "next-gen"
+	// ProjectTypeServiceDesk is a project type key of Jira Service Management projects.
+	ProjectTypeServiceDesk = "service_desk"
+	// ProjectTypeSoftware is a project type key of Jira Software projects.
+	ProjectTypeSoftware = "software"
+	// ProjectTypeBusiness is a project type key of Jira Work Management projects.
+	ProjectTypeBusiness = "business"
 )
 
+// ProjectTemplates maps short template names to project template keys per project type.
+var ProjectTemplates = map[string]map[string]string{
+	ProjectTypeSoftware: {
+		"scrum":  "com.pyxis.greenhopper.jira:gh-simplified-scrum-classic",
+		"kanban": "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic",
+		"basic":  "com.pyxis.greenhopper.jira:gh-simplified-basic",
+	},
+	ProjectTypeBusiness: {
+		"project-management": "com.atlassian.jira-core-project-templates:jira-core-simplified-project-management",
+		"task-tracking":      "com.atlassian.jira-core-project-templates:jira-core-simplified-task-tracking",
+		"process-control":    "com.atlassian.jira-core-project-templates:jira-core-simplified-process-control",
+	},
+}
+
+// ProjectCreateRequest struct holds request data for project create request.
+//
+// Lead is the username of the project lead in local installation
+// and LeadAccountID is the account id of the lead in the cloud.
+type ProjectCreateRequest struct {
+	Key                string `json:"key"`
+	Name               string `json:"name"`
+	Description        string `json:"description,omitempty"`
+	ProjectTypeKey     string `json:"projectTypeKey"`
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty"`
+	Lead               string `json:"lead,omitempty"`
+	LeadAccountID      string `json:"leadAccountId,omitempty"`
+}
+
+// ProjectCreateResponse struct holds response from POST /project endpoint.
+type ProjectCreateResponse struct {
+	ID   int    `json:"id"`
+	Key  string `json:"key"`
+	Self string `json:"self"`
+}
+
 // Project fetches response from /project endpoint.
 func (c *Client) Project() ([]*Project, error) {
 	res, err := c.GetV2(context.Background(), "/project?expand=lead", nil)
@@ -34,3 +76,55 @@ func (c *Client) Project() ([]*Project, error) {
 
 	return out, err
 }
+
+// GetProject fetches project details using GET /project/{key} endpoint.
+func (c *Client) GetProject(key string) (*Project, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/project/%s", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Project
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// CreateProject creates a project using POST /project endpoint.
+func (c *Client) CreateProject(req *ProjectCreateRequest) (*ProjectCreateResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV2(context.Background(), "/project", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out ProjectCreateResponse
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
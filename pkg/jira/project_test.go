@@ -1,10 +1,12 @@
 package jira
 
 import (
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -71,3 +73,82 @@ func TestProjects(t *testing.T) {
 	_, err = client.Project()
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestGetProject(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/SD", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"key": "SD", "name": "Support", "style": "classic", "projectTypeKey": "service_desk"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetProject("SD")
+	assert.NoError(t, err)
+
+	expected := &Project{Key: "SD", Name: "Support", Type: ProjectTypeClassic, ProjectTypeKey: ProjectTypeServiceDesk}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetProject("SD")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestCreateProject(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"key":"FOO","name":"Foo","projectTypeKey":"software",` +
+			`"projectTemplateKey":"com.pyxis.greenhopper.jira:gh-simplified-scrum-classic","leadAccountId":"a12b3"}`
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"id": 10010, "key": "FOO", "self": "https://test.local/rest/api/2/project/10010"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	req := ProjectCreateRequest{
+		Key:                "FOO",
+		Name:               "Foo",
+		ProjectTypeKey:     ProjectTypeSoftware,
+		ProjectTemplateKey: ProjectTemplates[ProjectTypeSoftware]["scrum"],
+		LeadAccountID:      "a12b3",
+	}
+
+	actual, err := client.CreateProject(&req)
+	assert.NoError(t, err)
+
+	expected := &ProjectCreateResponse{ID: 10010, Key: "FOO", Self: "https://test.local/rest/api/2/project/10010"}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.CreateProject(&req)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,97 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// RemoteLinkObject holds the link info of a remote link.
+type RemoteLinkObject struct {
+	URL   string `json:"url"`
+	Title string `json:"title"`
+}
+
+// RemoteLink is a link from an issue to a resource outside of Jira, eg: a web page.
+type RemoteLink struct {
+	ID     int              `json:"id"`
+	Self   string           `json:"self,omitempty"`
+	Object RemoteLinkObject `json:"object"`
+}
+
+type remoteLinkRequest struct {
+	Object RemoteLinkObject `json:"object"`
+}
+
+// GetRemoteLinks fetches remote links of an issue using GET /issue/{key}/remotelink endpoint.
+func (c *Client) GetRemoteLinks(key string) ([]*RemoteLink, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/issue/%s/remotelink", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*RemoteLink
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// AddRemoteLink adds a web link to an issue using POST /issue/{key}/remotelink endpoint.
+func (c *Client) AddRemoteLink(key, url, title string) (*RemoteLink, error) {
+	body, err := json.Marshal(remoteLinkRequest{
+		Object: RemoteLinkObject{URL: url, Title: title},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV2(context.Background(), fmt.Sprintf("/issue/%s/remotelink", key), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	out := RemoteLink{Object: RemoteLinkObject{URL: url, Title: title}}
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// DeleteRemoteLink removes a remote link from an issue using
+// DELETE /issue/{key}/remotelink/{linkId} endpoint.
+func (c *Client) DeleteRemoteLink(key string, id int) error {
+	res, err := c.DeleteV2(context.Background(), fmt.Sprintf("/issue/%s/remotelink/%d", key, id), nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
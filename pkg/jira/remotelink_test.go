@@ -0,0 +1,126 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRemoteLinks(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[
+			{"id": 10000, "self": "https://jira.example.com/rest/api/2/issue/TEST-1/remotelink/10000",
+			"object": {"url": "https://docs.example.com", "title": "Design doc", "icon": {}}},
+			{"id": 10001, "object": {"url": "https://ci.example.com/1", "title": "Build #1"}}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetRemoteLinks("TEST-1")
+	assert.NoError(t, err)
+
+	expected := []*RemoteLink{
+		{
+			ID:     10000,
+			Self:   "https://jira.example.com/rest/api/2/issue/TEST-1/remotelink/10000",
+			Object: RemoteLinkObject{URL: "https://docs.example.com", Title: "Design doc"},
+		},
+		{
+			ID:     10001,
+			Object: RemoteLinkObject{URL: "https://ci.example.com/1", Title: "Build #1"},
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetRemoteLinks("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAddRemoteLink(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, `{"object":{"url":"https://docs.example.com","title":"Design doc"}}`, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"id": 10000, "self": "https://jira.example.com/rest/api/2/issue/TEST-1/remotelink/10000"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.AddRemoteLink("TEST-1", "https://docs.example.com", "Design doc")
+	assert.NoError(t, err)
+
+	expected := &RemoteLink{
+		ID:     10000,
+		Self:   "https://jira.example.com/rest/api/2/issue/TEST-1/remotelink/10000",
+		Object: RemoteLinkObject{URL: "https://docs.example.com", Title: "Design doc"},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.AddRemoteLink("TEST-1", "https://docs.example.com", "Design doc")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteRemoteLink(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/remotelink/10000", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.DeleteRemoteLink("TEST-1", 10000)
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteRemoteLink("TEST-1", 10000)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,97 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SavedFilter is a filter saved in Jira, eg: the filter backing a board.
+type SavedFilter struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	JQL   string `json:"jql"`
+	Owner struct {
+		Name string `json:"displayName"`
+	} `json:"owner"`
+}
+
+// SavedFilterSearchResult struct holds response from GET /filter/search endpoint.
+type SavedFilterSearchResult struct {
+	StartAt    int            `json:"startAt"`
+	MaxResults int            `json:"maxResults"`
+	Total      int            `json:"total"`
+	IsLast     bool           `json:"isLast"`
+	Values     []*SavedFilter `json:"values"`
+}
+
+// GetSavedFilter fetches a saved filter using GET /filter/{id} endpoint.
+func (c *Client) GetSavedFilter(id string) (*SavedFilter, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/filter/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out SavedFilter
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// FavouriteFilters fetches filters marked as favourite by the user using GET /filter/favourite endpoint.
+func (c *Client) FavouriteFilters() ([]*SavedFilter, error) {
+	res, err := c.GetV2(context.Background(), "/filter/favourite", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*SavedFilter
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// SearchSavedFilters searches filters visible to the user whose name contains the given
+// name using GET /filter/search endpoint. The endpoint is only available in the cloud.
+func (c *Client) SearchSavedFilters(name string, limit int) (*SavedFilterSearchResult, error) {
+	path := fmt.Sprintf("/filter/search?filterName=%s&expand=jql,owner&maxResults=%d", url.QueryEscape(name), limit)
+
+	res, err := c.GetV2(context.Background(), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out SavedFilterSearchResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
@@ -0,0 +1,130 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSavedFilter(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/10001", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{
+				"id": "10001",
+				"name": "Filter for TEST board",
+				"jql": "project = TEST ORDER BY Rank ASC",
+				"owner": {"displayName": "Person A"}
+			}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetSavedFilter("10001")
+	assert.NoError(t, err)
+
+	expected := &SavedFilter{
+		ID:   "10001",
+		Name: "Filter for TEST board",
+		JQL:  "project = TEST ORDER BY Rank ASC",
+	}
+	expected.Owner.Name = "Person A"
+
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetSavedFilter("10001")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestFavouriteFilters(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/favourite", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`[
+				{"id": "10042", "name": "My open bugs", "jql": "type = Bug AND resolution IS EMPTY", "owner": {"displayName": "Person A"}},
+				{"id": "10043", "name": "Due this week", "jql": "due <= endOfWeek()"}
+			]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.FavouriteFilters()
+	assert.NoError(t, err)
+
+	bugs := &SavedFilter{ID: "10042", Name: "My open bugs", JQL: "type = Bug AND resolution IS EMPTY"}
+	bugs.Owner.Name = "Person A"
+
+	assert.Equal(t, []*SavedFilter{bugs, {ID: "10043", Name: "Due this week", JQL: "due <= endOfWeek()"}}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.FavouriteFilters()
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestSearchSavedFilters(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/filter/search", r.URL.Path)
+		assert.Equal(t, url.Values{
+			"filterName": []string{"My open bugs"},
+			"expand":     []string{"jql,owner"},
+			"maxResults": []string{"50"},
+		}, r.URL.Query())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{
+				"startAt": 0, "maxResults": 50, "total": 1, "isLast": true,
+				"values": [{"id": "10042", "name": "My open bugs", "jql": "type = Bug"}]
+			}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchSavedFilters("My open bugs", 50)
+	assert.NoError(t, err)
+
+	expected := &SavedFilterSearchResult{
+		MaxResults: 50,
+		Total:      1,
+		IsLast:     true,
+		Values:     []*SavedFilter{{ID: "10042", Name: "My open bugs", JQL: "type = Bug"}},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.SearchSavedFilters("My open bugs", 50)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -18,21 +18,36 @@ type SearchResult struct {
 
 // Search searches for issues using v3 version of the Jira GET /search endpoint.
 func (c *Client) Search(jql string, limit uint) (*SearchResult, error) {
-	return c.search(jql, limit, apiVersion3)
+	return c.search(jql, 0, limit, apiVersion3)
 }
 
 // SearchV2 searches an issues using v2 version of the Jira GET /search endpoint.
 func (c *Client) SearchV2(jql string, limit uint) (*SearchResult, error) {
-	return c.search(jql, limit, apiVersion2)
+	return c.search(jql, 0, limit, apiVersion2)
 }
 
-func (c *Client) search(jql string, limit uint, ver string) (*SearchResult, error) {
+// SearchFrom searches for issues starting at the given offset using v3 version
+// of the Jira GET /search endpoint. It is used to paginate through the results.
+func (c *Client) SearchFrom(jql string, from, limit uint) (*SearchResult, error) {
+	return c.search(jql, from, limit, apiVersion3)
+}
+
+// SearchFromV2 searches for issues starting at the given offset using v2 version
+// of the Jira GET /search endpoint. It is used to paginate through the results.
+func (c *Client) SearchFromV2(jql string, from, limit uint) (*SearchResult, error) {
+	return c.search(jql, from, limit, apiVersion2)
+}
+
+func (c *Client) search(jql string, from, limit uint, ver string) (*SearchResult, error) {
 	var (
 		res *http.Response
 		err error
 	)
 
 	path := fmt.Sprintf("/search?jql=%s&maxResults=%d", url.QueryEscape(jql), limit)
+	if from > 0 {
+		path += fmt.Sprintf("&startAt=%d", from)
+	}
 
 	switch ver {
 	case apiVersion2:
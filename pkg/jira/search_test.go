@@ -140,3 +140,27 @@ func TestSearch(t *testing.T) {
 	_, err = client.SearchV2("project=TEST", 100)
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestSearchFrom(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search", r.URL.Path)
+		assert.Equal(t, url.Values{
+			"jql":        []string{"project=TEST"},
+			"startAt":    []string{"50"},
+			"maxResults": []string{"50"},
+		}, r.URL.Query())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"startAt":50,"maxResults":50,"total":51,"issues":[{"key":"TEST-1"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.SearchFrom("project=TEST", 50, 50)
+	assert.NoError(t, err)
+	assert.Equal(t, 50, actual.StartAt)
+	assert.Equal(t, 51, actual.Total)
+	assert.Len(t, actual.Issues, 1)
+}
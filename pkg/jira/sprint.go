@@ -94,7 +94,16 @@ func (c *Client) SprintsInBoards(boardIDs []int, qp string, limit int) []*Sprint
 
 // SprintIssues fetches issues in the given sprint.
 func (c *Client) SprintIssues(boardID, sprintID int, jql string, limit uint) (*SearchResult, error) {
+	return c.SprintIssuesFrom(boardID, sprintID, jql, 0, limit)
+}
+
+// SprintIssuesFrom fetches issues in the given sprint starting at the
+// given offset. It is used to paginate through the results.
+func (c *Client) SprintIssuesFrom(boardID, sprintID int, jql string, from, limit uint) (*SearchResult, error) {
 	path := fmt.Sprintf("/board/%d/sprint/%d/issue?maxResults=%d", boardID, sprintID, limit)
+	if from > 0 {
+		path += fmt.Sprintf("&startAt=%d", from)
+	}
 	if jql != "" {
 		path += fmt.Sprintf("&jql=%s", url.QueryEscape(jql))
 	}
@@ -150,6 +159,72 @@ func (c *Client) SprintIssuesAdd(id string, issues ...string) error {
 	return nil
 }
 
+// GetSprint fetches a sprint using GET /sprint/{sprintID} endpoint.
+func (c *Client) GetSprint(id int) (*Sprint, error) {
+	res, err := c.GetV1(context.Background(), fmt.Sprintf("/sprint/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Sprint
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// SprintUpdateRequest is a partial update request for a sprint.
+// Only the fields that are set are updated.
+type SprintUpdateRequest struct {
+	Name      string `json:"name,omitempty"`
+	State     string `json:"state,omitempty"`
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+	Goal      string `json:"goal,omitempty"`
+}
+
+// UpdateSprint partially updates a sprint using POST /sprint/{sprintID} endpoint.
+//
+// A future sprint is started by setting its state to active, and an active sprint
+// is closed by setting its state to closed. Jira requires start and end date to
+// start a sprint.
+func (c *Client) UpdateSprint(id int, req *SprintUpdateRequest) (*Sprint, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV1(context.Background(), fmt.Sprintf("/sprint/%d", id), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Sprint
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
 // LastNSprints fetches sprint in descending order.
 //
 // Jira api to get all sprints doesn't provide an option to sort results and
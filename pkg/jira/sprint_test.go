@@ -341,3 +341,84 @@ func TestSprintIssuesAdd(t *testing.T) {
 	err = client.SprintIssuesAdd("5", "TEST-1")
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestGetSprint(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint/5", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, "GET", r.Method)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id":5,"state":"future","name":"Sprint 5","originBoardId":2}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.GetSprint(5)
+	assert.NoError(t, err)
+	assert.Equal(t, &Sprint{ID: 5, Name: "Sprint 5", Status: SprintStateFuture, BoardID: 2}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.GetSprint(5)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateSprint(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/sprint/5", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			assert.Equal(t, "POST", r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Accept"))
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			expectedBody := `{"state":"active","startDate":"2022-01-10T09:00:00Z","endDate":"2022-01-24T09:00:00Z"}`
+			actualBody := new(strings.Builder)
+			_, _ = io.Copy(actualBody, r.Body)
+
+			assert.Equal(t, expectedBody, actualBody.String())
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"id":5,"state":"active","name":"Sprint 5","startDate":"2022-01-10T09:00:00Z","endDate":"2022-01-24T09:00:00Z"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	req := SprintUpdateRequest{
+		State:     SprintStateActive,
+		StartDate: "2022-01-10T09:00:00Z",
+		EndDate:   "2022-01-24T09:00:00Z",
+	}
+	actual, err := client.UpdateSprint(5, &req)
+	assert.NoError(t, err)
+
+	expected := &Sprint{
+		ID:        5,
+		Name:      "Sprint 5",
+		Status:    SprintStateActive,
+		StartDate: "2022-01-10T09:00:00Z",
+		EndDate:   "2022-01-24T09:00:00Z",
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.UpdateSprint(5, &SprintUpdateRequest{State: SprintStateClosed})
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
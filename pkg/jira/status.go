@@ -0,0 +1,29 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// Statuses fetches all issue statuses using GET /status endpoint.
+func (c *Client) Statuses() ([]*Status, error) {
+	res, err := c.GetV2(context.Background(), "/status", nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Status
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
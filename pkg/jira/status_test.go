@@ -0,0 +1,44 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatuses(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/status", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`[
+				{"id": "1", "name": "Open", "statusCategory": {"key": "new"}},
+				{"id": "3", "name": "In Progress", "statusCategory": {"key": "indeterminate"}}
+			]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.Statuses()
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "1", actual[0].ID)
+	assert.Equal(t, "Open", actual[0].Name)
+	assert.Equal(t, "indeterminate", actual[1].StatusCategory.Key)
+
+	unexpectedStatusCode = true
+
+	_, err = client.Statuses()
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,66 @@
+package jira
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Jira defaults used to convert days and weeks to seconds.
+const (
+	hoursPerDay = 8
+	daysPerWeek = 5
+)
+
+// ParseTimeSpent converts a Jira style duration, eg: '1d 4h 30m', to seconds.
+// A day is considered to be 8 hours and a week 5 days as in default Jira settings.
+func ParseTimeSpent(s string) (int, error) {
+	units := map[byte]int{
+		'w': daysPerWeek * hoursPerDay * 3600,
+		'd': hoursPerDay * 3600,
+		'h': 3600,
+		'm': 60,
+		's': 1,
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("jira: empty time spent")
+	}
+
+	total := 0
+	for _, f := range fields {
+		mul, ok := units[f[len(f)-1]]
+		if !ok {
+			return 0, fmt.Errorf("jira: invalid time spent %q", s)
+		}
+		n, err := strconv.ParseFloat(f[:len(f)-1], 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("jira: invalid time spent %q", s)
+		}
+		total += int(n * float64(mul))
+	}
+
+	return total, nil
+}
+
+// FormatTimeSpent formats duration in a jira time spent format, eg: 2h 30m.
+// The duration is rounded to the nearest minute with one minute being the minimum.
+func FormatTimeSpent(d time.Duration) string {
+	mins := int(d.Round(time.Minute).Minutes())
+	if mins < 1 {
+		mins = 1
+	}
+
+	h, m := mins/60, mins%60
+
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	default:
+		return fmt.Sprintf("%dh %dm", h, m)
+	}
+}
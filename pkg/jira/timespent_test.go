@@ -0,0 +1,76 @@
+package jira
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeSpent(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected int
+		err      bool
+	}{
+		{input: "30m", expected: 1800},
+		{input: "4h 20m", expected: 15600},
+		{input: "1d", expected: 28800},
+		{input: "1w 1d", expected: 172800},
+		{input: "1.5h", expected: 5400},
+		{input: "", err: true},
+		{input: "2x", err: true},
+		{input: "h", err: true},
+	}
+
+	for _, tc := range cases {
+		got, err := ParseTimeSpent(tc.input)
+		if tc.err {
+			assert.Error(t, err, tc.input)
+			continue
+		}
+		assert.NoError(t, err, tc.input)
+		assert.Equal(t, tc.expected, got, tc.input)
+	}
+}
+
+func TestFormatTimeSpent(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    time.Duration
+		expected string
+	}{
+		{
+			name:     "it returns minimum of one minute",
+			input:    10 * time.Second,
+			expected: "1m",
+		},
+		{
+			name:     "it rounds to the nearest minute",
+			input:    25*time.Minute + 40*time.Second,
+			expected: "26m",
+		},
+		{
+			name:     "it formats whole hours",
+			input:    2 * time.Hour,
+			expected: "2h",
+		},
+		{
+			name:     "it formats hours and minutes",
+			input:    4*time.Hour + 20*time.Minute,
+			expected: "4h 20m",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, FormatTimeSpent(tc.input))
+		})
+	}
+}
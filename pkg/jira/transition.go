@@ -5,11 +5,39 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 )
 
 // TransitionRequest struct holds request data for transition request.
 type TransitionRequest struct {
 	Transition *TransitionRequestData `json:"transition"`
+	// Fields holds values of the fields in the transition screen keyed by field id.
+	Fields map[string]interface{}   `json:"fields,omitempty"`
+	Update *TransitionRequestUpdate `json:"update,omitempty"`
+}
+
+// TransitionRequestUpdate holds operations performed on the issue during the transition.
+type TransitionRequestUpdate struct {
+	Comment []struct {
+		Add struct {
+			Body string `json:"body"`
+		} `json:"add"`
+	} `json:"comment,omitempty"`
+}
+
+// AddComment adds a plain text comment to the issue along with the transition.
+func (tr *TransitionRequest) AddComment(body string) {
+	var comment struct {
+		Add struct {
+			Body string `json:"body"`
+		} `json:"add"`
+	}
+	comment.Add.Body = body
+
+	if tr.Update == nil {
+		tr.Update = &TransitionRequestUpdate{}
+	}
+	tr.Update.Comment = append(tr.Update.Comment, comment)
 }
 
 // TransitionRequestData is a transition request data.
@@ -34,7 +62,7 @@ func (c *Client) TransitionsV2(key string) ([]*Transition, error) {
 }
 
 func (c *Client) transitions(key, ver string) ([]*Transition, error) {
-	path := fmt.Sprintf("/issue/%s/transitions", key)
+	path := fmt.Sprintf("/issue/%s/transitions?expand=transitions.fields", key)
 
 	var (
 		res *http.Response
@@ -93,3 +121,39 @@ func (c *Client) Transition(key string, data *TransitionRequest) (int, error) {
 	}
 	return res.StatusCode, nil
 }
+
+// TransitionField holds metadata of a field in the transition screen.
+type TransitionField struct {
+	FieldMeta
+	Required      bool `json:"required"`
+	AllowedValues []struct {
+		ID    string `json:"id"`
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"allowedValues,omitempty"`
+}
+
+// Options returns names of the allowed values of the field.
+func (f *TransitionField) Options() []string {
+	out := make([]string, 0, len(f.AllowedValues))
+	for _, v := range f.AllowedValues {
+		if v.Value != "" {
+			out = append(out, v.Value)
+		} else {
+			out = append(out, v.Name)
+		}
+	}
+	return out
+}
+
+// ScreenFields returns fields of the transition screen sorted by id.
+func (t *Transition) ScreenFields() []*TransitionField {
+	out := make([]*TransitionField, 0, len(t.Fields))
+	for id, f := range t.Fields {
+		f.ID = id
+		out = append(out, f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+
+	return out
+}
@@ -24,6 +24,7 @@ func TestTransitions(t *testing.T) {
 		} else {
 			assert.Equal(t, "/rest/api/3/issue/TEST/transitions", r.URL.Path)
 		}
+		assert.Equal(t, "transitions.fields", r.URL.Query().Get("expand"))
 
 		assert.Equal(t, "GET", r.Method)
 
@@ -45,6 +46,9 @@ func TestTransitions(t *testing.T) {
 	actual, err := client.Transitions("TEST")
 	assert.NoError(t, err)
 
+	fields := actual[2].Fields
+	actual[2].Fields = nil
+
 	expected := []*Transition{
 		{
 			ID:          "11",
@@ -64,6 +68,19 @@ func TestTransitions(t *testing.T) {
 	}
 	assert.Equal(t, expected, actual)
 
+	actual[2].Fields = fields
+	screen := actual[2].ScreenFields()
+
+	assert.Len(t, screen, 2)
+	assert.Equal(t, "customfield_10001", screen[0].ID)
+	assert.False(t, screen[0].Required)
+	assert.Equal(t, []string{"Code"}, screen[0].Options())
+	assert.Equal(t, "resolution", screen[1].ID)
+	assert.Equal(t, "Resolution", screen[1].Name)
+	assert.Equal(t, FieldTypeResolution, screen[1].Schema.Type)
+	assert.True(t, screen[1].Required)
+	assert.Equal(t, []string{"Fixed", "Won't Fix"}, screen[1].Options())
+
 	apiVersion2 = true
 	unexpectedStatusCode = true
 
@@ -74,6 +91,8 @@ func TestTransitions(t *testing.T) {
 func TestTransition(t *testing.T) {
 	var unexpectedStatusCode bool
 
+	expectedBody := `{"transition":{"id":"31","name":"Done"}}`
+
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/rest/api/2/issue/TEST/transitions", r.URL.Path)
 		assert.Equal(t, "POST", r.Method)
@@ -83,7 +102,6 @@ func TestTransition(t *testing.T) {
 		actualBody := new(strings.Builder)
 		_, _ = io.Copy(actualBody, r.Body)
 
-		expectedBody := `{"transition":{"id":"31","name":"Done"}}`
 		assert.Equal(t, expectedBody, actualBody.String())
 
 		if unexpectedStatusCode {
@@ -103,4 +121,14 @@ func TestTransition(t *testing.T) {
 	code, err := client.Transition("TEST", &requestData)
 	assert.NoError(t, err)
 	assert.Equal(t, code, 204)
+
+	expectedBody = `{"transition":{"id":"31","name":"Done"},"fields":{"resolution":{"name":"Fixed"}},` +
+		`"update":{"comment":[{"add":{"body":"Fixed in v1.2"}}]}}`
+
+	requestData.Fields = map[string]interface{}{"resolution": map[string]string{"name": "Fixed"}}
+	requestData.AddComment("Fixed in v1.2")
+
+	code, err = client.Transition("TEST", &requestData)
+	assert.NoError(t, err)
+	assert.Equal(t, code, 204)
 }
@@ -2,6 +2,7 @@ package jira
 
 import (
 	"encoding/json"
+	"strings"
 )
 
 const (
@@ -9,10 +10,13 @@ const (
 	AuthTypeBasic AuthType = "basic"
 	// AuthTypeBearer is a bearer auth.
 	AuthTypeBearer AuthType = "bearer"
+	// AuthTypeOAuth is an OAuth 2.0 auth. The access token is added
+	// by the transport of the client, see WithTransport.
+	AuthTypeOAuth AuthType = "oauth"
 )
 
 // AuthType is a jira authentication type.
-// Currently supports basic and bearer (PAT).
+// Currently supports basic, bearer (PAT) and oauth.
 // Defaults to basic for empty or invalid value.
 type AuthType string
 
@@ -26,12 +30,14 @@ func (at AuthType) String() string {
 
 // Project holds project info.
 type Project struct {
+	ID   string `json:"id,omitempty"`
 	Key  string `json:"key"`
 	Name string `json:"name"`
 	Lead struct {
 		Name string `json:"displayName"`
 	} `json:"lead"`
-	Type string `json:"style"`
+	Type           string `json:"style"`
+	ProjectTypeKey string `json:"projectTypeKey"`
 }
 
 // Board holds board info.
@@ -75,13 +81,18 @@ type IssueFields struct {
 		IsWatching bool `json:"isWatching"`
 		WatchCount int  `json:"watchCount"`
 	} `json:"watches"`
+	Votes struct {
+		HasVoted bool `json:"hasVoted"`
+		Votes    int  `json:"votes"`
+	} `json:"votes"`
 	Status struct {
 		Name string `json:"name"`
 	} `json:"status"`
 	Components []struct {
 		Name string `json:"name"`
 	} `json:"components"`
-	Comment struct {
+	FixVersions []*Version `json:"fixVersions,omitempty"`
+	Comment     struct {
 		Comments []struct {
 			ID      string      `json:"id"`
 			Author  User        `json:"author"`
@@ -91,6 +102,7 @@ type IssueFields struct {
 		Total int `json:"total"`
 	} `json:"comment"`
 	IssueLinks []struct {
+		ID       string `json:"id"`
 		LinkType struct {
 			Name    string `json:"name"`
 			Inward  string `json:"inward"`
@@ -99,8 +111,48 @@ type IssueFields struct {
 		InwardIssue  *Issue `json:"inwardIssue,omitempty"`
 		OutwardIssue *Issue `json:"outwardIssue,omitempty"`
 	} `json:"issueLinks"`
-	Created string `json:"created"`
-	Updated string `json:"updated"`
+	Parent       *Issue        `json:"parent,omitempty"`
+	Subtasks     []*Issue      `json:"subtasks,omitempty"`
+	Attachments  []*Attachment `json:"attachment,omitempty"`
+	TimeTracking TimeTracking  `json:"timetracking"`
+	Created      string        `json:"created"`
+	Updated      string        `json:"updated"`
+	// CustomFields holds values of custom fields that are set,
+	// keyed by field id, eg: customfield_10001.
+	CustomFields map[string]interface{} `json:"-"`
+}
+
+// UnmarshalJSON is a custom unmarshaler to keep values of custom fields.
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	type fields IssueFields
+
+	if err := json.Unmarshal(data, (*fields)(f)); err != nil {
+		return err
+	}
+
+	var all map[string]interface{}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for k, v := range all {
+		if v == nil || !strings.HasPrefix(k, customFieldPrefix) {
+			continue
+		}
+		if f.CustomFields == nil {
+			f.CustomFields = make(map[string]interface{})
+		}
+		f.CustomFields[k] = v
+	}
+
+	return nil
+}
+
+// TimeTracking holds time tracking info of an issue.
+type TimeTracking struct {
+	OriginalEstimate  string `json:"originalEstimate"`
+	RemainingEstimate string `json:"remainingEstimate"`
+	TimeSpent         string `json:"timeSpent"`
+	TimeSpentSeconds  int    `json:"timeSpentSeconds"`
 }
 
 // IssueType holds issue type info.
@@ -132,15 +184,53 @@ type Sprint struct {
 
 // Transition holds issue transition info.
 type Transition struct {
-	ID          json.Number `json:"id"`
-	Name        string      `json:"name"`
-	IsAvailable bool        `json:"isAvailable"`
+	ID          json.Number                 `json:"id"`
+	Name        string                      `json:"name"`
+	IsAvailable bool                        `json:"isAvailable"`
+	To          *Status                     `json:"to,omitempty"`
+	Fields      map[string]*TransitionField `json:"fields,omitempty"`
+}
+
+// Status holds issue status info.
+type Status struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	StatusCategory struct {
+		Key string `json:"key"`
+	} `json:"statusCategory"`
 }
 
 // User holds user info.
 type User struct {
 	AccountID string `json:"accountId"`
+	Login     string `json:"name,omitempty"` // Only available in local installation.
 	Email     string `json:"emailAddress"`
 	Name      string `json:"displayName"`
 	Active    bool   `json:"active"`
 }
+
+// Worklog holds worklog info.
+type Worklog struct {
+	ID               string             `json:"id"`
+	IssueID          string             `json:"issueId"`
+	Author           User               `json:"author"`
+	Comment          string             `json:"comment"`
+	Started          string             `json:"started"`
+	TimeSpent        string             `json:"timeSpent"`
+	TimeSpentSeconds int                `json:"timeSpentSeconds"`
+	Created          string             `json:"created"`
+	Updated          string             `json:"updated"`
+	Visibility       *WorklogVisibility `json:"visibility,omitempty"`
+}
+
+// Worklog visibility types.
+const (
+	VisibilityTypeRole  = "role"
+	VisibilityTypeGroup = "group"
+)
+
+// WorklogVisibility restricts a worklog to the users of a role or group.
+type WorklogVisibility struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,63 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// IssueUpdate holds update operations of issue fields keyed by field id, eg: labels.
+// Operations of all fields are applied at once when the issue is updated.
+type IssueUpdate map[string][]map[string]interface{}
+
+// Labels adds an operation on labels of the issue.
+func (u IssueUpdate) Labels(op string, labels ...string) IssueUpdate {
+	for _, l := range labels {
+		u["labels"] = append(u["labels"], map[string]interface{}{op: l})
+	}
+	return u
+}
+
+// Components adds an operation on components of the issue.
+func (u IssueUpdate) Components(op string, components ...string) IssueUpdate {
+	for _, cmp := range components {
+		u["components"] = append(u["components"], map[string]interface{}{op: map[string]string{"name": cmp}})
+	}
+	return u
+}
+
+// Priority sets priority of the issue.
+func (u IssueUpdate) Priority(priority string) IssueUpdate {
+	u["priority"] = []map[string]interface{}{{UpdateOpSet: map[string]string{"name": priority}}}
+	return u
+}
+
+// UpdateIssue applies update operations to an issue using PUT /issue/{key} endpoint.
+func (c *Client) UpdateIssue(key string, update IssueUpdate) error {
+	body, err := json.Marshal(map[string]interface{}{"update": update})
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PutV2(context.Background(), "/issue/"+key, body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// updateIssueField performs update operations on a field of the issue using PUT /issue/{key} endpoint.
+func (c *Client) updateIssueField(key, field string, ops []map[string]interface{}) error {
+	return c.UpdateIssue(key, IssueUpdate{field: ops})
+}
@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateIssue(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"update":{"components":[{"remove":{"name":"UI"}}],` +
+			`"labels":[{"add":"new"},{"remove":"old"}],"priority":[{"set":{"name":"High"}}]}}`
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	update := IssueUpdate{}.
+		Labels(UpdateOpAdd, "new").
+		Labels(UpdateOpRemove, "old").
+		Components(UpdateOpRemove, "UI").
+		Priority("High")
+
+	assert.NoError(t, client.UpdateIssue("TEST-1", update))
+
+	unexpectedStatusCode = true
+
+	assert.Error(t, &ErrUnexpectedResponse{}, client.UpdateIssue("TEST-1", update))
+}
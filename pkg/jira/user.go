@@ -24,15 +24,27 @@ type UserSearchOptions struct {
 
 // UserSearch search for user details using v3 version of the GET /user/assignable/search endpoint.
 func (c *Client) UserSearch(opt *UserSearchOptions) ([]*User, error) {
-	return c.userSearch(opt, apiVersion3)
+	return c.userSearch("/user/assignable/search", opt, apiVersion3)
 }
 
 // UserSearchV2 search for user details using v2 version of the GET /user/assignable/search endpoint.
 func (c *Client) UserSearchV2(opt *UserSearchOptions) ([]*User, error) {
-	return c.userSearch(opt, apiVersion2)
+	return c.userSearch("/user/assignable/search", opt, apiVersion2)
 }
 
-func (c *Client) userSearch(opt *UserSearchOptions, ver string) ([]*User, error) {
+// FindUsers search for active users by their name or email using v3 version of the
+// GET /user/search endpoint.
+func (c *Client) FindUsers(opt *UserSearchOptions) ([]*User, error) {
+	return c.userSearch("/user/search", opt, apiVersion3)
+}
+
+// FindUsersV2 search for active users by their username, name or email using v2 version
+// of the GET /user/search endpoint.
+func (c *Client) FindUsersV2(opt *UserSearchOptions) ([]*User, error) {
+	return c.userSearch("/user/search", opt, apiVersion2)
+}
+
+func (c *Client) userSearch(endpoint string, opt *UserSearchOptions, ver string) ([]*User, error) {
 	if opt == nil {
 		return nil, ErrInvalidSearchOption
 	}
@@ -65,7 +77,7 @@ func (c *Client) userSearch(opt *UserSearchOptions, ver string) ([]*User, error)
 		return nil, ErrInvalidSearchOption
 	}
 
-	path := fmt.Sprintf("%s?%s", "/user/assignable/search", strings.Join(opts, "&"))
+	path := fmt.Sprintf("%s?%s", endpoint, strings.Join(opts, "&"))
 
 	switch ver {
 	case apiVersion2:
@@ -85,3 +85,38 @@ func TestUserSearch(t *testing.T) {
 	})
 	assert.Error(t, &ErrUnexpectedResponse{}, err)
 }
+
+func TestFindUsers(t *testing.T) {
+	var apiVersion2 bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if apiVersion2 {
+			assert.Equal(t, "/rest/api/2/user/search", r.URL.Path)
+			assert.Equal(t, url.Values{"username": []string{"doe"}}, r.URL.Query())
+		} else {
+			assert.Equal(t, "/rest/api/3/user/search", r.URL.Path)
+			assert.Equal(t, url.Values{"query": []string{"jane doe"}, "maxResults": []string{"5"}}, r.URL.Query())
+		}
+
+		resp, err := ioutil.ReadFile("./testdata/users.json")
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.FindUsers(&UserSearchOptions{Query: "jane doe", MaxResults: 5})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, "Jane Doe", actual[0].Name)
+
+	apiVersion2 = true
+
+	actual, err = client.FindUsersV2(&UserSearchOptions{Username: "doe"})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+}
@@ -0,0 +1,92 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// There is no public API for board reports, so the reports are fetched
+// from the endpoints used by the Jira web UI.
+const baseURLGreenhopper = "/rest/greenhopper/1.0"
+
+// SprintVelocity holds committed and completed estimate of a sprint.
+type SprintVelocity struct {
+	Sprint    *Sprint
+	Committed float64
+	Completed float64
+}
+
+type velocityResponse struct {
+	Sprints []struct {
+		ID       int    `json:"id"`
+		Sequence int    `json:"sequence"`
+		Name     string `json:"name"`
+		State    string `json:"state"`
+	} `json:"sprints"`
+	Entries map[string]struct {
+		Estimated struct {
+			Value float64 `json:"value"`
+		} `json:"estimated"`
+		Completed struct {
+			Value float64 `json:"value"`
+		} `json:"completed"`
+	} `json:"velocityStatEntries"`
+}
+
+// BoardVelocity fetches committed and completed estimates of recently closed sprints
+// of a board, latest sprint first. Jira reports velocity of up to the last 7 sprints.
+func (c *Client) BoardVelocity(boardID int) ([]*SprintVelocity, error) {
+	endpoint := fmt.Sprintf("%s%s/rapid/charts/velocity?rapidViewId=%d", c.server, baseURLGreenhopper, boardID)
+
+	res, err := c.request(context.Background(), http.MethodGet, endpoint, nil, Header{
+		"Accept": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out velocityResponse
+
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(out.Sprints, func(i, j int) bool {
+		if out.Sprints[i].Sequence != out.Sprints[j].Sequence {
+			return out.Sprints[i].Sequence > out.Sprints[j].Sequence
+		}
+		return out.Sprints[i].ID > out.Sprints[j].ID
+	})
+
+	velocity := make([]*SprintVelocity, 0, len(out.Sprints))
+	for _, s := range out.Sprints {
+		if !strings.EqualFold(s.State, SprintStateClosed) {
+			continue
+		}
+		entry := out.Entries[strconv.Itoa(s.ID)]
+		velocity = append(velocity, &SprintVelocity{
+			Sprint: &Sprint{
+				ID:      s.ID,
+				Name:    s.Name,
+				Status:  SprintStateClosed,
+				BoardID: boardID,
+			},
+			Committed: entry.Estimated.Value,
+			Completed: entry.Completed.Value,
+		})
+	}
+	return velocity, nil
+}
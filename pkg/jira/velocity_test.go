@@ -0,0 +1,64 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoardVelocity(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/greenhopper/1.0/rapid/charts/velocity", r.URL.Path)
+		assert.Equal(t, url.Values{"rapidViewId": []string{"1"}}, r.URL.Query())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{
+				"sprints": [
+					{"id": 2, "sequence": 2, "name": "Sprint 2", "state": "CLOSED"},
+					{"id": 4, "sequence": 4, "name": "Sprint 4", "state": "ACTIVE"},
+					{"id": 3, "sequence": 3, "name": "Sprint 3", "state": "CLOSED"}
+				],
+				"velocityStatEntries": {
+					"2": {"estimated": {"value": 20.0, "text": "20.0"}, "completed": {"value": 18.0, "text": "18.0"}},
+					"3": {"estimated": {"value": 13.5, "text": "13.5"}, "completed": {"value": 8.0, "text": "8.0"}},
+					"4": {"estimated": {"value": 10.0, "text": "10.0"}, "completed": {"value": 0, "text": "0.0"}}
+				}
+			}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.BoardVelocity(1)
+	assert.NoError(t, err)
+
+	expected := []*SprintVelocity{
+		{
+			Sprint:    &Sprint{ID: 3, Name: "Sprint 3", Status: SprintStateClosed, BoardID: 1},
+			Committed: 13.5,
+			Completed: 8,
+		},
+		{
+			Sprint:    &Sprint{ID: 2, Name: "Sprint 2", Status: SprintStateClosed, BoardID: 1},
+			Committed: 20,
+			Completed: 18,
+		},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.BoardVelocity(1)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,140 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Issue fields holding versions.
+const (
+	VersionFieldFix     = "fixVersions"
+	VersionFieldAffects = "versions"
+)
+
+// Version holds project version info.
+type Version struct {
+	ID          string `json:"id"`
+	Self        string `json:"self,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Archived    bool   `json:"archived"`
+	Released    bool   `json:"released"`
+	StartDate   string `json:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+}
+
+// VersionRequest holds request data for version create and update requests.
+// Empty fields are left unchanged on update.
+type VersionRequest struct {
+	Name        string `json:"name,omitempty"`
+	Project     string `json:"project,omitempty"`
+	Description string `json:"description,omitempty"`
+	StartDate   string `json:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	Released    *bool  `json:"released,omitempty"`
+	Archived    *bool  `json:"archived,omitempty"`
+
+	// MoveUnfixedIssuesTo is the self URL of the version to move unresolved
+	// issues of the released version to.
+	MoveUnfixedIssuesTo string `json:"moveUnfixedIssuesTo,omitempty"`
+}
+
+// ProjectVersions fetches versions of a project using GET /project/{key}/versions endpoint.
+func (c *Client) ProjectVersions(project string) ([]*Version, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/project/%s/versions", project), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Version
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+// CreateVersion creates a version in the project using POST /version endpoint.
+func (c *Client) CreateVersion(req *VersionRequest) (*Version, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PostV2(context.Background(), "/version", body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusCreated {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Version
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// UpdateVersion updates a version using PUT /version/{id} endpoint. A version is
+// released or archived by updating its released or archived flag.
+func (c *Client) UpdateVersion(id string, req *VersionRequest) (*Version, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.PutV2(context.Background(), fmt.Sprintf("/version/%s", id), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Version
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// UpdateVersions adds or removes versions of an issue using PUT /issue/{key} endpoint.
+// Field is either VersionFieldFix or VersionFieldAffects.
+func (c *Client) UpdateVersions(key, field, op string, versions []string) error {
+	if op != UpdateOpAdd && op != UpdateOpRemove {
+		return fmt.Errorf("jira: invalid version operation %q", op)
+	}
+
+	ops := make([]map[string]interface{}, 0, len(versions))
+	for _, v := range versions {
+		ops = append(ops, map[string]interface{}{op: map[string]string{"name": v}})
+	}
+
+	return c.updateIssueField(key, field, ops)
+}
@@ -0,0 +1,143 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectVersions(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/project/PRJ/versions", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[
+			{"id": "10000", "name": "v1.0", "archived": false, "released": true, "releaseDate": "2022-01-10"},
+			{"id": "10001", "name": "v2.0", "archived": false, "released": false}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.ProjectVersions("PRJ")
+	assert.NoError(t, err)
+	assert.Equal(t, []*Version{
+		{ID: "10000", Name: "v1.0", Released: true, ReleaseDate: "2022-01-10"},
+		{ID: "10001", Name: "v2.0"},
+	}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.ProjectVersions("PRJ")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestCreateVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/version", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, `{"name":"v3.0","project":"PRJ"}`, actualBody.String())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		_, _ = w.Write([]byte(`{"id": "10002", "name": "v3.0", "archived": false, "released": false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.CreateVersion(&VersionRequest{Name: "v3.0", Project: "PRJ"})
+	assert.NoError(t, err)
+	assert.Equal(t, &Version{ID: "10002", Name: "v3.0"}, actual)
+}
+
+func TestUpdateVersion(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/version/10001", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(
+			t,
+			`{"releaseDate":"2022-01-21","released":true,"moveUnfixedIssuesTo":"https://test.atlassian.net/rest/api/2/version/10002"}`,
+			actualBody.String(),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{"id": "10001", "name": "v2.0", "archived": false, "released": true, "releaseDate": "2022-01-21"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	released := true
+	req := VersionRequest{
+		ReleaseDate:         "2022-01-21",
+		Released:            &released,
+		MoveUnfixedIssuesTo: "https://test.atlassian.net/rest/api/2/version/10002",
+	}
+	actual, err := client.UpdateVersion("10001", &req)
+	assert.NoError(t, err)
+	assert.Equal(t, &Version{ID: "10001", Name: "v2.0", Released: true, ReleaseDate: "2022-01-21"}, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.UpdateVersion("10001", &req)
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUpdateVersions(t *testing.T) {
+	var expectedBody string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1", r.URL.Path)
+		assert.Equal(t, "PUT", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	expectedBody = `{"update":{"fixVersions":[{"add":{"name":"v1.0"}}]}}`
+	assert.NoError(t, client.UpdateVersions("TEST-1", VersionFieldFix, UpdateOpAdd, []string{"v1.0"}))
+
+	expectedBody = `{"update":{"versions":[{"remove":{"name":"v1.0"}}]}}`
+	assert.NoError(t, client.UpdateVersions("TEST-1", VersionFieldAffects, UpdateOpRemove, []string{"v1.0"}))
+
+	assert.Error(t, client.UpdateVersions("TEST-1", VersionFieldFix, UpdateOpSet, []string{"v1.0"}))
+}
@@ -0,0 +1,43 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Vote casts a vote on the issue as the authenticated user using POST /issue/{key}/votes endpoint.
+func (c *Client) Vote(key string) error {
+	res, err := c.PostV2(context.Background(), fmt.Sprintf("/issue/%s/votes", key), nil, Header{
+		"Accept": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// Unvote removes the vote of the authenticated user using DELETE /issue/{key}/votes endpoint.
+func (c *Client) Unvote(key string) error {
+	res, err := c.DeleteV2(context.Background(), fmt.Sprintf("/issue/%s/votes", key), nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
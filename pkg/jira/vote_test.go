@@ -0,0 +1,62 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVote(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/votes", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.Vote("TEST-1")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.Vote("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestUnvote(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/votes", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.Unvote("TEST-1")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.Unvote("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
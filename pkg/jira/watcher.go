@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type watchersResponse struct {
+	IsWatching bool    `json:"isWatching"`
+	WatchCount int     `json:"watchCount"`
+	Watchers   []*User `json:"watchers"`
+}
+
+// Watchers fetches users watching the issue using GET /issue/{key}/watchers endpoint.
+func (c *Client) Watchers(key string) ([]*User, error) {
+	res, err := c.GetV2(context.Background(), fmt.Sprintf("/issue/%s/watchers", key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out watchersResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out.Watchers, nil
+}
+
+// AddWatcher adds a user to the issue watchers using POST /issue/{key}/watchers endpoint.
+// User is an account id in cloud installation and a username in local installation.
+func (c *Client) AddWatcher(key, user string) error {
+	body, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PostV2(context.Background(), fmt.Sprintf("/issue/%s/watchers", key), body, Header{
+		"Accept":       "application/json",
+		"Content-Type": "application/json",
+	})
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
+
+// RemoveWatcher removes a user from the issue watchers using DELETE /issue/{key}/watchers
+// endpoint. The user is identified by the account id.
+func (c *Client) RemoveWatcher(key, accountID string) error {
+	return c.removeWatcher(key, "accountId", accountID)
+}
+
+// RemoveWatcherV2 removes a user from the issue watchers using DELETE /issue/{key}/watchers
+// endpoint. The user is identified by the username.
+func (c *Client) RemoveWatcherV2(key, username string) error {
+	return c.removeWatcher(key, "username", username)
+}
+
+func (c *Client) removeWatcher(key, param, user string) error {
+	path := fmt.Sprintf("/issue/%s/watchers?%s=%s", key, param, url.QueryEscape(user))
+
+	res, err := c.DeleteV2(context.Background(), path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
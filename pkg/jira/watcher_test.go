@@ -0,0 +1,121 @@
+package jira
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchers(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/watchers", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`{
+			"isWatching": true,
+			"watchCount": 2,
+			"watchers": [
+				{"accountId": "a-1", "displayName": "Person A", "emailAddress": "a@domain.tld", "active": true},
+				{"accountId": "b-2", "displayName": "Person B", "active": false}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	actual, err := client.Watchers("TEST-1")
+	assert.NoError(t, err)
+
+	expected := []*User{
+		{AccountID: "a-1", Name: "Person A", Email: "a@domain.tld", Active: true},
+		{AccountID: "b-2", Name: "Person B"},
+	}
+	assert.Equal(t, expected, actual)
+
+	unexpectedStatusCode = true
+
+	_, err = client.Watchers("TEST-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestAddWatcher(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/watchers", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		assert.Equal(t, `"a-1"`, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	err := client.AddWatcher("TEST-1", "a-1")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.AddWatcher("TEST-1", "a-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
+
+func TestRemoveWatcher(t *testing.T) {
+	var (
+		unexpectedStatusCode bool
+		expectedQuery        string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/TEST-1/watchers", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, expectedQuery, r.URL.RawQuery)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+		w.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL}, WithTimeout(3*time.Second))
+
+	expectedQuery = "accountId=a-1"
+	err := client.RemoveWatcher("TEST-1", "a-1")
+	assert.NoError(t, err)
+
+	expectedQuery = "username=jon.doe"
+	err = client.RemoveWatcherV2("TEST-1", "jon.doe")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+	expectedQuery = "accountId=a-1"
+
+	err = client.RemoveWatcher("TEST-1", "a-1")
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -142,6 +142,16 @@ func (j *JQL) OrderBy(field, dir string) *JQL {
 	return j
 }
 
+// ThenBy adds a secondary order to the output, eg: ORDER BY priority DESC, updated ASC.
+// It behaves like OrderBy if no order is set yet.
+func (j *JQL) ThenBy(field, dir string) *JQL {
+	if j.orderBy == "" {
+		return j.OrderBy(field, dir)
+	}
+	j.orderBy += fmt.Sprintf(", %s %s", field, dir)
+	return j
+}
+
 // And combines filter with AND operator.
 func (j *JQL) And(fn GroupFunc) *JQL {
 	fn()
@@ -156,6 +166,15 @@ func (j *JQL) Or(fn GroupFunc) *JQL {
 	return j
 }
 
+// Group wraps the filters combined so far in parentheses, so that
+// they are evaluated together when combined with other filters.
+func (j *JQL) Group() *JQL {
+	if len(j.filters) > 0 {
+		j.filters = []string{fmt.Sprintf("(%s)", strings.Join(j.filters, " "))}
+	}
+	return j
+}
+
 // Raw sets the passed JQL query along with project context.
 func (j *JQL) Raw(q string) *JQL {
 	if q == "" {
@@ -28,6 +28,15 @@ func TestJQL(t *testing.T) {
 			},
 			expected: "project=\"TEST\" ORDER BY updated DESC",
 		},
+		{
+			name: "it orders by multiple fields",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.ThenBy("priority", "DESC").ThenBy("updated", "ASC")
+				return jql
+			},
+			expected: "project=\"TEST\" ORDER BY priority DESC, updated ASC",
+		},
 		{
 			name: "it queries history",
 			initialize: func() *JQL {
@@ -57,6 +66,20 @@ func TestJQL(t *testing.T) {
 			},
 			expected: "project=\"TEST\" AND issue IN watchedIssues() AND issue IN issueHistory()",
 		},
+		{
+			name: "it groups filters before combining them with or",
+			initialize: func() *JQL {
+				jql := NewJQL("TEST")
+				jql.And(func() {
+					jql.FilterBy("type", "Bug")
+				})
+				jql.Group().Or(func() {
+					jql.Raw("labels = urgent")
+				})
+				return jql
+			},
+			expected: "(project=\"TEST\" AND type=\"Bug\") OR labels = urgent",
+		},
 		{
 			name: "it queries with single field filters",
 			initialize: func() *JQL {
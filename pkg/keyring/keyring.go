@@ -0,0 +1,27 @@
+// Package keyring stores secrets in the keyring of the operating system: Keychain on macOS,
+// Secret Service on Linux and BSDs, and Credential Manager on Windows.
+package keyring
+
+import "fmt"
+
+// ErrNotFound is returned when there is no secret for the service and the account.
+var ErrNotFound = fmt.Errorf("secret not found in keyring")
+
+// ErrUnsupported is returned when the keyring of the platform is not supported.
+var ErrUnsupported = fmt.Errorf("keyring is not supported on this platform")
+
+// Set saves the secret of the account for the service, replacing the existing one.
+func Set(service, account, secret string) error {
+	return set(service, account, secret)
+}
+
+// Get returns the secret of the account for the service.
+func Get(service, account string) (string, error) {
+	return get(service, account)
+}
+
+// Delete removes the secret of the account for the service.
+// It returns ErrNotFound if there is no such secret.
+func Delete(service, account string) error {
+	return del(service, account)
+}
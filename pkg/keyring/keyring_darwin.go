@@ -0,0 +1,57 @@
+//go:build darwin
+// +build darwin
+
+package keyring
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Exit code of the security command when the item is not in the keychain.
+const errSecItemNotFound = 44
+
+func set(service, account, secret string) error {
+	// Commands are passed on the standard input in interactive mode and the secret is
+	// hex encoded, so that the secret isn't visible in the process list.
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf(
+		"add-generic-password -U -s %s -a %s -X %s\n",
+		quote(service), quote(account), hex.EncodeToString([]byte(secret)),
+	))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("keychain: %s", strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func get(service, account string) (string, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", service, "-a", account, "-w").Output()
+	if err != nil {
+		return "", keychainError(err)
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func del(service, account string) error {
+	err := exec.Command("security", "delete-generic-password", "-s", service, "-a", account).Run()
+	if err != nil {
+		return keychainError(err)
+	}
+	return nil
+}
+
+func keychainError(err error) error {
+	var e *exec.ExitError
+	if errors.As(err, &e) && e.ExitCode() == errSecItemNotFound {
+		return ErrNotFound
+	}
+	return fmt.Errorf("keychain: %w", err)
+}
+
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
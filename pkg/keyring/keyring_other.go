@@ -0,0 +1,16 @@
+//go:build !darwin && !linux && !freebsd && !openbsd && !netbsd && !windows
+// +build !darwin,!linux,!freebsd,!openbsd,!netbsd,!windows
+
+package keyring
+
+func set(string, string, string) error {
+	return ErrUnsupported
+}
+
+func get(string, string) (string, error) {
+	return "", ErrUnsupported
+}
+
+func del(string, string) error {
+	return ErrUnsupported
+}
@@ -0,0 +1,69 @@
+//go:build linux || freebsd || openbsd || netbsd
+// +build linux freebsd openbsd netbsd
+
+package keyring
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Secrets are managed with secret-tool, the command line client of the Secret Service API
+// implemented by GNOME Keyring and KWallet. The secret is passed on the standard input.
+
+func set(service, account, secret string) error {
+	cmd := exec.Command(
+		"secret-tool", "store", "--label", fmt.Sprintf("%s (%s)", service, account),
+		"service", service, "account", account,
+	)
+	cmd.Stdin = strings.NewReader(secret)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return secretToolError(err, out)
+	}
+	return nil
+}
+
+func get(service, account string) (string, error) {
+	cmd := exec.Command("secret-tool", "lookup", "service", service, "account", account)
+	out, err := cmd.Output()
+	if err != nil {
+		var e *exec.ExitError
+		// Lookup exits with 1 without printing anything if there is no such secret.
+		if errors.As(err, &e) && len(e.Stderr) == 0 {
+			return "", ErrNotFound
+		}
+		return "", secretToolError(err, nil)
+	}
+	if len(out) == 0 {
+		return "", ErrNotFound
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func del(service, account string) error {
+	if _, err := get(service, account); err != nil {
+		return err
+	}
+
+	cmd := exec.Command("secret-tool", "clear", "service", service, "account", account)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return secretToolError(err, out)
+	}
+	return nil
+}
+
+func secretToolError(err error, out []byte) error {
+	if errors.Is(err, exec.ErrNotFound) {
+		return fmt.Errorf("%w: secret-tool is not installed", ErrUnsupported)
+	}
+	var e *exec.ExitError
+	if errors.As(err, &e) && len(out) == 0 {
+		out = e.Stderr
+	}
+	if msg := strings.TrimSpace(string(out)); msg != "" {
+		return fmt.Errorf("secret service: %s", msg)
+	}
+	return fmt.Errorf("secret service: %w", err)
+}
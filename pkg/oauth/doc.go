@@ -0,0 +1,5 @@
+// Package oauth implements the OAuth 2.0 authorization code grant (3LO) of Atlassian Cloud.
+// Requests authorized with OAuth are sent to the Atlassian API gateway instead of the site.
+//
+// See: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/
+package oauth
@@ -0,0 +1,238 @@
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// AuthURL is the URL users authorize the app at.
+	AuthURL = "https://auth.atlassian.com/authorize"
+	// TokenURL is the URL tokens are requested from.
+	TokenURL = "https://auth.atlassian.com/oauth/token"
+	// ResourcesURL is the URL that lists the sites the app is authorized for.
+	ResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+	// APIURL is the base URL of the Jira API of the sites on the API gateway.
+	APIURL = "https://api.atlassian.com/ex/jira"
+
+	// expiryDelta refreshes tokens a bit before they expire so that they
+	// don't expire while the request is in flight.
+	expiryDelta = time.Minute
+)
+
+// DefaultScopes are the scopes needed by the CLI. The offline_access
+// scope is required to get a refresh token.
+var DefaultScopes = []string{
+	"read:jira-user",
+	"read:jira-work",
+	"write:jira-work",
+	"manage:jira-project",
+	"read:board-scope:jira-software",
+	"read:sprint:jira-software",
+	"write:sprint:jira-software",
+	"offline_access",
+}
+
+// Config is an OAuth app config. URLs default to the Atlassian ones if not set.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+
+	AuthURL      string
+	TokenURL     string
+	ResourcesURL string
+
+	HTTPClient *http.Client
+}
+
+// Token is an OAuth token.
+type Token struct {
+	AccessToken  string    `json:"accessToken"`
+	RefreshToken string    `json:"refreshToken"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// Valid tells if the access token can be used, ie: it is set and has not expired.
+func (t *Token) Valid() bool {
+	return t != nil && t.AccessToken != "" && time.Now().Add(expiryDelta).Before(t.Expiry)
+}
+
+// Resource is a site the app is authorized to access.
+type Resource struct {
+	ID     string   `json:"id"`
+	URL    string   `json:"url"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// APIURL returns the base URL of the Jira API of the site on the API gateway.
+func (r *Resource) APIURL() string {
+	return fmt.Sprintf("%s/%s", APIURL, r.ID)
+}
+
+// ErrUnexpectedResponse denotes a failed OAuth request.
+type ErrUnexpectedResponse struct {
+	Status      string
+	Code        string
+	Description string
+}
+
+func (e *ErrUnexpectedResponse) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("oauth: %s: %s", e.Code, e.Description)
+	}
+	if e.Code != "" {
+		return fmt.Sprintf("oauth: %s", e.Code)
+	}
+	return fmt.Sprintf("oauth: unexpected response %s", e.Status)
+}
+
+// AuthCodeURL returns the URL to authorize the app at. The state is sent back to
+// the redirect URL along with the code and needs to be checked to prevent CSRF.
+func (c *Config) AuthCodeURL(state string) string {
+	v := url.Values{
+		"audience":      {"api.atlassian.com"},
+		"client_id":     {c.ClientID},
+		"scope":         {strings.Join(c.scopes(), " ")},
+		"redirect_uri":  {c.RedirectURL},
+		"state":         {state},
+		"response_type": {"code"},
+		"prompt":        {"consent"},
+	}
+	return fmt.Sprintf("%s?%s", or(c.AuthURL, AuthURL), v.Encode())
+}
+
+// Exchange exchanges the authorization code for a token.
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	return c.token(ctx, map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"code":          code,
+		"redirect_uri":  c.RedirectURL,
+	})
+}
+
+// Refresh gets a new token with the refresh token. Refresh tokens are rotated, so
+// the refresh token of the returned token needs to be used for the next refresh.
+func (c *Config) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	t, err := c.token(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"refresh_token": refreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if t.RefreshToken == "" {
+		t.RefreshToken = refreshToken
+	}
+	return t, nil
+}
+
+// Resources lists the sites the token can access.
+func (c *Config) Resources(ctx context.Context, accessToken string) ([]*Resource, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, or(c.ResourcesURL, ResourcesURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	res, err := c.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out []*Resource
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *Config) token(ctx context.Context, params map[string]string) (*Token, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, or(c.TokenURL, TokenURL), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		AccessToken:  out.AccessToken,
+		RefreshToken: out.RefreshToken,
+		Expiry:       time.Now().Add(time.Duration(out.ExpiresIn) * time.Second),
+	}, nil
+}
+
+func (c *Config) scopes() []string {
+	if len(c.Scopes) > 0 {
+		return c.Scopes
+	}
+	return DefaultScopes
+}
+
+func (c *Config) client() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func formatUnexpectedResponse(res *http.Response) *ErrUnexpectedResponse {
+	var b struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+
+	// We don't care about decoding error here.
+	_ = json.NewDecoder(res.Body).Decode(&b)
+
+	return &ErrUnexpectedResponse{Status: res.Status, Code: b.Error, Description: b.ErrorDescription}
+}
+
+func or(val, def string) string {
+	if val != "" {
+		return val
+	}
+	return def
+}
@@ -0,0 +1,130 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthCodeURL(t *testing.T) {
+	c := Config{ClientID: "abc", RedirectURL: "http://localhost:8085/callback"}
+
+	u, err := url.Parse(c.AuthCodeURL("xyz"))
+	assert.NoError(t, err)
+
+	q := u.Query()
+	assert.Equal(t, "auth.atlassian.com", u.Host)
+	assert.Equal(t, "abc", q.Get("client_id"))
+	assert.Equal(t, "xyz", q.Get("state"))
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, "api.atlassian.com", q.Get("audience"))
+	assert.Equal(t, "http://localhost:8085/callback", q.Get("redirect_uri"))
+	assert.Contains(t, q.Get("scope"), "offline_access")
+}
+
+func TestExchangeAndRefresh(t *testing.T) {
+	var got map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth/token", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+
+		switch got["grant_type"] {
+		case "authorization_code":
+			_, _ = w.Write([]byte(`{"access_token": "access-1", "refresh_token": "refresh-1", "expires_in": 3600}`))
+		case "refresh_token":
+			if got["refresh_token"] != "refresh-1" {
+				w.WriteHeader(403)
+				_, _ = w.Write([]byte(`{"error": "invalid_grant", "error_description": "Unknown or invalid refresh token."}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"access_token": "access-2", "expires_in": 3600}`))
+		}
+	}))
+	defer server.Close()
+
+	c := Config{ClientID: "abc", ClientSecret: "s3cr3t", TokenURL: server.URL + "/oauth/token"}
+
+	tok, err := c.Exchange(context.Background(), "code-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "code-1", got["code"])
+	assert.Equal(t, "s3cr3t", got["client_secret"])
+	assert.Equal(t, "access-1", tok.AccessToken)
+	assert.Equal(t, "refresh-1", tok.RefreshToken)
+	assert.True(t, tok.Valid())
+
+	// Refresh token is kept if a new one is not returned.
+	tok, err = c.Refresh(context.Background(), "refresh-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "access-2", tok.AccessToken)
+	assert.Equal(t, "refresh-1", tok.RefreshToken)
+
+	_, err = c.Refresh(context.Background(), "refresh-0")
+	assert.EqualError(t, err, "oauth: invalid_grant: Unknown or invalid refresh token.")
+}
+
+func TestResources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer access-1", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`[{"id": "1324a887", "url": "https://test.atlassian.net", "name": "test"}]`))
+	}))
+	defer server.Close()
+
+	c := Config{ResourcesURL: server.URL}
+
+	resources, err := c.Resources(context.Background(), "access-1")
+	assert.NoError(t, err)
+	assert.Len(t, resources, 1)
+	assert.Equal(t, "https://test.atlassian.net", resources[0].URL)
+	assert.Equal(t, "https://api.atlassian.com/ex/jira/1324a887", resources[0].APIURL())
+}
+
+func TestTransportRefreshesToken(t *testing.T) {
+	refreshed := 0
+
+	auth := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		refreshed++
+		_, _ = w.Write([]byte(`{"access_token": "access-2", "refresh_token": "refresh-2", "expires_in": 3600}`))
+	}))
+	defer auth.Close()
+
+	var header string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("Authorization")
+	}))
+	defer api.Close()
+
+	var saved *Token
+	source := NewTokenSource(
+		&Config{TokenURL: auth.URL},
+		&Token{AccessToken: "access-1", RefreshToken: "refresh-1", Expiry: time.Now()},
+		func(t *Token) error {
+			saved = t
+			return nil
+		},
+	)
+	client := http.Client{Transport: NewTransport(http.DefaultTransport, source)}
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Get(api.URL)
+		assert.NoError(t, err)
+		_ = res.Body.Close()
+	}
+
+	assert.Equal(t, "Bearer access-2", header)
+	assert.Equal(t, 1, refreshed)
+	assert.Equal(t, "refresh-2", saved.RefreshToken)
+
+	// Tokens without a refresh token can't be refreshed.
+	source = NewTokenSource(&Config{TokenURL: auth.URL}, &Token{AccessToken: "access-1"}, nil)
+	client = http.Client{Transport: NewTransport(http.DefaultTransport, source)}
+
+	_, err := client.Get(api.URL)
+	assert.Error(t, err)
+}
@@ -0,0 +1,74 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// TokenSource returns a valid token, refreshing it when it expires.
+type TokenSource struct {
+	config    *Config
+	token     *Token
+	onRefresh func(*Token) error
+	mu        sync.Mutex
+}
+
+// NewTokenSource creates a token source that starts with the given token. The refreshed
+// token is passed to onRefresh, eg: to save it, as the refresh token is rotated.
+func NewTokenSource(c *Config, t *Token, onRefresh func(*Token) error) *TokenSource {
+	return &TokenSource{config: c, token: t, onRefresh: onRefresh}
+}
+
+// Token returns the current token if it is valid, otherwise it is refreshed.
+func (s *TokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, fmt.Errorf("oauth: token expired and can't be refreshed, please log in again")
+	}
+
+	t, err := s.config.Refresh(ctx, s.token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	s.token = t
+
+	if s.onRefresh != nil {
+		if err := s.onRefresh(t); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// Transport is an http.RoundTripper that authorizes requests with the token of the source.
+type Transport struct {
+	next   http.RoundTripper
+	source *TokenSource
+}
+
+// NewTransport creates a transport that authorizes requests with the token
+// of the source and sends them using next.
+func NewTransport(next http.RoundTripper, source *TokenSource) *Transport {
+	return &Transport{next: next, source: source}
+}
+
+// RoundTrip adds the access token to the request and sends it.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	tok, err := t.source.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// Requests must not be modified by round trippers.
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+tok.AccessToken)
+
+	return t.next.RoundTrip(r)
+}
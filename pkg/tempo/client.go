@@ -0,0 +1,134 @@
+package tempo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// DefaultServer is the base URL of the Tempo cloud API.
+const DefaultServer = "https://api.tempo.io/core/3"
+
+// Config is a tempo config.
+type Config struct {
+	Server string
+	Token  string
+	Debug  bool
+}
+
+// Client is a tempo client.
+type Client struct {
+	transport http.RoundTripper
+	server    string
+	token     string
+	timeout   time.Duration
+	proxy     *url.URL
+	debug     bool
+}
+
+// ClientFunc decorates option for client.
+type ClientFunc func(*Client)
+
+// NewClient instantiates new tempo client.
+func NewClient(c Config, opts ...ClientFunc) *Client {
+	server := c.Server
+	if server == "" {
+		server = DefaultServer
+	}
+
+	client := Client{
+		server: strings.TrimSuffix(server, "/"),
+		token:  c.Token,
+		debug:  c.Debug,
+	}
+
+	for _, opt := range opts {
+		opt(&client)
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if client.proxy != nil {
+		proxy = http.ProxyURL(client.proxy)
+	}
+
+	client.transport = &http.Transport{
+		Proxy: proxy,
+		DialContext: (&net.Dialer{
+			Timeout: client.timeout,
+		}).DialContext,
+	}
+
+	return &client
+}
+
+// WithTimeout is a functional opt to attach timeout to the client.
+func WithTimeout(to time.Duration) ClientFunc {
+	return func(c *Client) {
+		c.timeout = to
+	}
+}
+
+// WithProxy is a functional opt to send requests through the given proxy, credentials
+// are taken from the url. Proxy is taken from HTTPS_PROXY and NO_PROXY env by default.
+func WithProxy(u *url.URL) ClientFunc {
+	return func(c *Client) {
+		c.proxy = u
+	}
+}
+
+func (c *Client) request(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	var (
+		req *http.Request
+		res *http.Response
+		err error
+	)
+
+	req, err = http.NewRequest(method, c.server+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if c.debug {
+			jira.Dump(req, res)
+		}
+	}()
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	res, err = c.transport.RoundTrip(req.WithContext(ctx))
+
+	return res, err
+}
+
+// formatUnexpectedResponse converts errors returned by tempo to a jira error so
+// that they are displayed the same way as the errors of the jira api.
+func formatUnexpectedResponse(res *http.Response) *jira.ErrUnexpectedResponse {
+	var b struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	// We don't care about decoding error here.
+	_ = json.NewDecoder(res.Body).Decode(&b)
+
+	e := jira.ErrUnexpectedResponse{
+		Status:     res.Status,
+		StatusCode: res.StatusCode,
+	}
+	for _, v := range b.Errors {
+		e.Body.ErrorMessages = append(e.Body.ErrorMessages, v.Message)
+	}
+
+	return &e
+}
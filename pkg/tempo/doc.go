@@ -0,0 +1,5 @@
+// Package tempo interacts with the Tempo Timesheets cloud API.
+// Tempo stores worklogs behind a separate API that requires its own token.
+//
+// See: https://apidocs.tempo.io/
+package tempo
@@ -0,0 +1,152 @@
+package tempo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Attribute is a Tempo work attribute, eg: _Account_.
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// AttributeAccount is the key of the Tempo account work attribute.
+const AttributeAccount = "_Account_"
+
+// Worklog holds Tempo worklog info.
+type Worklog struct {
+	ID               int    `json:"tempoWorklogId"`
+	JiraWorklogID    int    `json:"jiraWorklogId"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds"`
+	StartDate        string `json:"startDate"`
+	StartTime        string `json:"startTime"`
+	Description      string `json:"description"`
+	Issue            struct {
+		Key string `json:"key"`
+	} `json:"issue"`
+	Author struct {
+		AccountID string `json:"accountId"`
+		Name      string `json:"displayName"`
+	} `json:"author"`
+	Attributes struct {
+		Values []Attribute `json:"values"`
+	} `json:"attributes"`
+}
+
+// WorklogRequest holds request data to create a Tempo worklog.
+type WorklogRequest struct {
+	IssueKey         string      `json:"issueKey"`
+	TimeSpentSeconds int         `json:"timeSpentSeconds"`
+	StartDate        string      `json:"startDate"`
+	StartTime        string      `json:"startTime"`
+	Description      string      `json:"description"`
+	AuthorAccountID  string      `json:"authorAccountId"`
+	Attributes       []Attribute `json:"attributes,omitempty"`
+}
+
+// WorklogResult holds response from GET /worklogs/issue/{key} endpoint.
+type WorklogResult struct {
+	Metadata struct {
+		Count  int    `json:"count"`
+		Offset int    `json:"offset"`
+		Limit  int    `json:"limit"`
+		Next   string `json:"next"`
+	} `json:"metadata"`
+	Worklogs []*Worklog `json:"results"`
+}
+
+// AddWorklog creates a worklog using POST /worklogs endpoint.
+func (c *Client) AddWorklog(wr *WorklogRequest) (*Worklog, error) {
+	body, err := json.Marshal(wr)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.request(context.Background(), http.MethodPost, "/worklogs", body)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, jira.ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out Worklog
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// IssueWorklogs fetches a page of worklogs of an issue using GET /worklogs/issue/{key} endpoint.
+func (c *Client) IssueWorklogs(key string, offset, limit int) (*WorklogResult, error) {
+	path := fmt.Sprintf("/worklogs/issue/%s?offset=%d&limit=%d", url.PathEscape(key), offset, limit)
+
+	res, err := c.request(context.Background(), http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, jira.ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, formatUnexpectedResponse(res)
+	}
+
+	var out WorklogResult
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return &out, err
+}
+
+// AllIssueWorklogs fetches all worklogs of an issue.
+func (c *Client) AllIssueWorklogs(key string, limit int) ([]*Worklog, error) {
+	var worklogs []*Worklog
+
+	for offset := 0; ; {
+		wr, err := c.IssueWorklogs(key, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+		worklogs = append(worklogs, wr.Worklogs...)
+
+		offset += len(wr.Worklogs)
+		if len(wr.Worklogs) == 0 || wr.Metadata.Next == "" {
+			break
+		}
+	}
+
+	return worklogs, nil
+}
+
+// DeleteWorklog deletes a worklog using DELETE /worklogs/{id} endpoint.
+func (c *Client) DeleteWorklog(id string) error {
+	path := fmt.Sprintf("/worklogs/%s", url.PathEscape(id))
+
+	res, err := c.request(context.Background(), http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return jira.ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusNoContent {
+		return formatUnexpectedResponse(res)
+	}
+	return nil
+}
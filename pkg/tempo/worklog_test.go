@@ -0,0 +1,132 @@
+package tempo
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+func TestAddWorklog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/worklogs", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		actualBody := new(strings.Builder)
+		_, _ = io.Copy(actualBody, r.Body)
+
+		expectedBody := `{"issueKey":"TEST-1","timeSpentSeconds":1800,"startDate":"2022-02-02","startTime":"13:35:00",` +
+			`"description":"comment","authorAccountId":"a-1","attributes":[{"key":"_Account_","value":"DEV"}]}`
+		assert.Equal(t, expectedBody, actualBody.String())
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(200)
+			_, _ = w.Write([]byte(`{"tempoWorklogId":101,"jiraWorklogId":10001}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, Token: "token"}, WithTimeout(3*time.Second))
+
+	req := WorklogRequest{
+		IssueKey:         "TEST-1",
+		TimeSpentSeconds: 1800,
+		StartDate:        "2022-02-02",
+		StartTime:        "13:35:00",
+		Description:      "comment",
+		AuthorAccountID:  "a-1",
+		Attributes:       []Attribute{{Key: AttributeAccount, Value: "DEV"}},
+	}
+
+	wl, err := client.AddWorklog(&req)
+	assert.NoError(t, err)
+	assert.Equal(t, 101, wl.ID)
+	assert.Equal(t, 10001, wl.JiraWorklogID)
+
+	unexpectedStatusCode = true
+
+	_, err = client.AddWorklog(&req)
+	assert.Error(t, &jira.ErrUnexpectedResponse{}, err)
+}
+
+func TestAllIssueWorklogs(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/worklogs/issue/TEST-1", r.URL.Path)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(400)
+			return
+		}
+
+		file := "./testdata/worklogs.json"
+		if r.URL.Query().Get("offset") == "1" {
+			file = "./testdata/worklogs-2.json"
+		}
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+
+		resp, err := ioutil.ReadFile(file)
+		assert.NoError(t, err)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, Token: "token"}, WithTimeout(3*time.Second))
+
+	actual, err := client.AllIssueWorklogs("TEST-1", 1)
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, 101, actual[0].ID)
+	assert.Equal(t, "Person A", actual[0].Author.Name)
+	assert.Equal(t, []Attribute{{Key: AttributeAccount, Value: "DEV"}}, actual[0].Attributes.Values)
+	assert.Equal(t, 102, actual[1].ID)
+	assert.Equal(t, 1800, actual[1].TimeSpentSeconds)
+
+	unexpectedStatusCode = true
+
+	_, err = client.AllIssueWorklogs("TEST-1", 1)
+	assert.Error(t, &jira.ErrUnexpectedResponse{}, err)
+}
+
+func TestDeleteWorklog(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/worklogs/101", r.URL.Path)
+		assert.Equal(t, "DELETE", r.Method)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(404)
+		} else {
+			w.WriteHeader(204)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, Token: "token"}, WithTimeout(3*time.Second))
+
+	err := client.DeleteWorklog("101")
+	assert.NoError(t, err)
+
+	unexpectedStatusCode = true
+
+	err = client.DeleteWorklog("101")
+	assert.Error(t, &jira.ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,140 @@
+// Package toggl fetches time entries from the Toggl Track API.
+//
+// See: https://developers.track.toggl.com/docs/
+package toggl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultServer is the base URL of the Toggl Track API.
+const DefaultServer = "https://api.track.toggl.com/api/v9"
+
+// ErrEmptyResponse denotes empty response from the server.
+var ErrEmptyResponse = fmt.Errorf("toggl: empty response from server")
+
+// ErrUnexpectedResponse denotes response code other than the expected one.
+type ErrUnexpectedResponse struct {
+	Status     string
+	StatusCode int
+}
+
+func (e *ErrUnexpectedResponse) Error() string {
+	return fmt.Sprintf("toggl: received unexpected response '%s'", e.Status)
+}
+
+// Config is a toggl config.
+type Config struct {
+	Server string
+	Token  string
+	Debug  bool
+}
+
+// Client is a toggl client.
+type Client struct {
+	transport http.RoundTripper
+	server    string
+	token     string
+	timeout   time.Duration
+	debug     bool
+}
+
+// ClientFunc decorates option for client.
+type ClientFunc func(*Client)
+
+// TimeEntry is a toggl time entry. Duration is in seconds and is negative
+// for a running time entry.
+type TimeEntry struct {
+	ID          int64     `json:"id"`
+	Description string    `json:"description"`
+	Tags        []string  `json:"tags"`
+	Start       time.Time `json:"start"`
+	Duration    int       `json:"duration"`
+}
+
+// NewClient instantiates new toggl client.
+func NewClient(c Config, opts ...ClientFunc) *Client {
+	server := c.Server
+	if server == "" {
+		server = DefaultServer
+	}
+
+	client := Client{
+		server: strings.TrimSuffix(server, "/"),
+		token:  c.Token,
+		debug:  c.Debug,
+	}
+
+	for _, opt := range opts {
+		opt(&client)
+	}
+
+	client.transport = &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout: client.timeout,
+		}).DialContext,
+	}
+
+	return &client
+}
+
+// WithTimeout is a functional opt to attach timeout to the client.
+func WithTimeout(to time.Duration) ClientFunc {
+	return func(c *Client) {
+		c.timeout = to
+	}
+}
+
+// TimeEntries fetches time entries of the current user started between start
+// and end using GET /me/time_entries endpoint.
+func (c *Client) TimeEntries(start, end time.Time) ([]*TimeEntry, error) {
+	q := url.Values{}
+	q.Set("start_date", start.Format(time.RFC3339))
+	q.Set("end_date", end.Format(time.RFC3339))
+
+	req, err := http.NewRequest(http.MethodGet, c.server+"/me/time_entries?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(c.token, "api_token")
+
+	res, err := c.transport.RoundTrip(req.WithContext(context.Background()))
+	if c.debug && err == nil {
+		dump(req, res)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, ErrEmptyResponse
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, &ErrUnexpectedResponse{Status: res.Status, StatusCode: res.StatusCode}
+	}
+
+	var out []*TimeEntry
+
+	err = json.NewDecoder(res.Body).Decode(&out)
+
+	return out, err
+}
+
+func dump(req *http.Request, res *http.Response) {
+	reqDump, _ := httputil.DumpRequest(req, true)
+	respDump, _ := httputil.DumpResponse(res, false)
+
+	fmt.Printf("\n\nREQUEST DETAILS\n%s\n\n%s", strings.Repeat("-", 60), reqDump)
+	fmt.Printf("\n\nRESPONSE DETAILS\n%s\n\n%s", strings.Repeat("-", 60), respDump)
+}
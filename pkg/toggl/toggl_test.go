@@ -0,0 +1,59 @@
+package toggl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeEntries(t *testing.T) {
+	var unexpectedStatusCode bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/me/time_entries", r.URL.Path)
+		assert.Equal(t, "2022-02-07T00:00:00Z", r.URL.Query().Get("start_date"))
+		assert.Equal(t, "2022-02-08T00:00:00Z", r.URL.Query().Get("end_date"))
+
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "token", user)
+		assert.Equal(t, "api_token", pass)
+
+		if unexpectedStatusCode {
+			w.WriteHeader(403)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		_, _ = w.Write([]byte(`[
+  {"id": 1, "description": "TEST-1 Implementation", "tags": ["dev"], "start": "2022-02-07T09:00:00Z", "duration": 3600},
+  {"id": 2, "description": "Running", "tags": null, "start": "2022-02-07T11:00:00Z", "duration": -1644231600}
+]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(Config{Server: server.URL, Token: "token"}, WithTimeout(3*time.Second))
+
+	start := time.Date(2022, 2, 7, 0, 0, 0, 0, time.UTC)
+
+	actual, err := client.TimeEntries(start, start.AddDate(0, 0, 1))
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	assert.Equal(t, &TimeEntry{
+		ID:          1,
+		Description: "TEST-1 Implementation",
+		Tags:        []string{"dev"},
+		Start:       time.Date(2022, 2, 7, 9, 0, 0, 0, time.UTC),
+		Duration:    3600,
+	}, actual[0])
+	assert.Negative(t, actual[1].Duration)
+
+	unexpectedStatusCode = true
+
+	_, err = client.TimeEntries(start, start.AddDate(0, 0, 1))
+	assert.Error(t, &ErrUnexpectedResponse{}, err)
+}
@@ -0,0 +1,218 @@
+package tui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// CellValidateFunc validates the value of an edited sheet cell.
+type CellValidateFunc func(value string) error
+
+// SheetSummaryFunc returns a summary of the sheet data that is displayed above the footer.
+type SheetSummaryFunc func(data TableData) string
+
+// Sheet is an editable table layout. First row of the data is treated as a header
+// and first column as row labels, rest of the cells can be edited by typing into them.
+type Sheet struct {
+	screen       *Screen
+	view         *tview.Table
+	summary      *tview.TextView
+	footer       *tview.TextView
+	data         TableData
+	colPad       uint
+	footerText   string
+	validateFunc CellValidateFunc
+	summaryFunc  SheetSummaryFunc
+	editing      bool
+	saved        bool
+}
+
+// SheetOption is a functional option to wrap sheet properties.
+type SheetOption func(*Sheet)
+
+// NewSheet constructs a new sheet layout.
+func NewSheet(opts ...SheetOption) *Sheet {
+	tview.Styles.PrimitiveBackgroundColor = tcell.ColorDefault
+
+	sh := Sheet{
+		screen:  NewScreen(),
+		view:    tview.NewTable(),
+		summary: tview.NewTextView(),
+		footer:  tview.NewTextView(),
+		colPad:  defaultColPad,
+	}
+	for _, opt := range opts {
+		opt(&sh)
+	}
+
+	sh.initSheet()
+	sh.footer.
+		SetWordWrap(true).
+		SetText(pad(sh.footerText, 1)).
+		SetTextColor(tcell.ColorDefault)
+	sh.summary.SetTextColor(tcell.ColorDefault)
+
+	return &sh
+}
+
+// WithSheetFooterText sets footer text that is displayed after the sheet.
+func WithSheetFooterText(text string) SheetOption {
+	return func(s *Sheet) {
+		s.footerText = text
+	}
+}
+
+// WithCellValidateFunc sets a func that validates edited cells.
+func WithCellValidateFunc(fn CellValidateFunc) SheetOption {
+	return func(s *Sheet) {
+		s.validateFunc = fn
+	}
+}
+
+// WithSheetSummaryFunc sets a func that summarizes the sheet after every edit.
+func WithSheetSummaryFunc(fn SheetSummaryFunc) SheetOption {
+	return func(s *Sheet) {
+		s.summaryFunc = fn
+	}
+}
+
+// Paint paints the sheet layout and blocks until the user saves or quits.
+func (s *Sheet) Paint(data TableData) error {
+	if len(data) < 2 || len(data[0]) < 2 {
+		return errNoData
+	}
+	s.data = data
+	s.render()
+
+	grid := tview.NewGrid().
+		SetRows(0, 1, 1, 2).
+		AddItem(s.view, 0, 0, 1, 1, 0, 0, true).
+		AddItem(tview.NewTextView(), 1, 0, 1, 1, 0, 0, false). // Dummy view to fake row padding.
+		AddItem(s.summary, 2, 0, 1, 1, 0, 0, false).
+		AddItem(s.footer, 3, 0, 1, 1, 0, 0, false)
+
+	return s.screen.Paint(grid)
+}
+
+// Data returns the edited sheet data.
+func (s *Sheet) Data() TableData {
+	return s.data
+}
+
+// Saved tells if the user quit the sheet by saving it.
+func (s *Sheet) Saved() bool {
+	return s.saved
+}
+
+func (s *Sheet) initSheet() {
+	s.view.SetSelectable(true, true).
+		SetSelectedStyle(tcell.StyleDefault.Bold(true).Reverse(true)).
+		SetSelectionChangedFunc(func(_, _ int) {
+			s.editing = false
+		}).
+		SetDoneFunc(func(key tcell.Key) {
+			if key == tcell.KeyEsc {
+				s.screen.Stop()
+			}
+		}).
+		SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
+			r, c := s.view.GetSelection()
+
+			switch ev.Key() {
+			case tcell.KeyCtrlS:
+				s.save()
+				return nil
+			case tcell.KeyBackspace, tcell.KeyBackspace2:
+				val := []rune(s.data[r][c])
+				if len(val) > 0 {
+					s.setCell(r, c, string(val[:len(val)-1]))
+				}
+				s.editing = true
+				return nil
+			case tcell.KeyDelete:
+				s.setCell(r, c, "")
+				return nil
+			case tcell.KeyEnter:
+				s.editing = false
+				if r < len(s.data)-1 {
+					s.view.Select(r+1, c)
+				}
+				return nil
+			case tcell.KeyRune:
+				val := s.data[r][c]
+				if !s.editing {
+					val = ""
+				}
+				s.setCell(r, c, val+string(ev.Rune()))
+				s.editing = true
+				return nil
+			}
+			return ev
+		})
+
+	s.view.SetFixed(1, 1)
+}
+
+func (s *Sheet) render() {
+	style := tcell.StyleDefault.Bold(true)
+
+	for c, h := range s.data[0] {
+		s.view.SetCell(0, c, tview.NewTableCell(" "+h).
+			SetStyle(style).
+			SetSelectable(false).
+			SetTextColor(tcell.ColorSnow).
+			SetBackgroundColor(tcell.ColorDarkCyan))
+	}
+
+	for r := 1; r < len(s.data); r++ {
+		s.view.SetCell(r, 0, tview.NewTableCell(pad(s.data[r][0], s.colPad)).
+			SetSelectable(false).
+			SetMaxWidth(defaultColWidth).
+			SetTextColor(tcell.ColorDefault))
+
+		for c := 1; c < len(s.data[r]); c++ {
+			s.setCell(r, c, s.data[r][c])
+		}
+	}
+
+	s.view.Select(1, 1)
+}
+
+func (s *Sheet) setCell(r, c int, val string) {
+	s.data[r][c] = val
+
+	color := tcell.ColorDefault
+	if s.validateFunc != nil && s.validateFunc(val) != nil {
+		color = tcell.ColorRed
+	}
+
+	s.view.SetCell(r, c, tview.NewTableCell(pad(val, s.colPad)).
+		SetExpansion(1).
+		SetTextColor(color))
+
+	s.updateSummary()
+}
+
+func (s *Sheet) updateSummary() {
+	if s.summaryFunc == nil {
+		return
+	}
+	s.summary.SetText(pad(s.summaryFunc(s.data), 1)).SetTextColor(tcell.ColorDefault)
+}
+
+func (s *Sheet) save() {
+	if s.validateFunc != nil {
+		for r := 1; r < len(s.data); r++ {
+			for c := 1; c < len(s.data[r]); c++ {
+				if err := s.validateFunc(s.data[r][c]); err != nil {
+					s.view.Select(r, c)
+					s.summary.SetText(pad("Invalid value: "+err.Error(), 1)).SetTextColor(tcell.ColorRed)
+					return
+				}
+			}
+		}
+	}
+
+	s.saved = true
+	s.screen.Stop()
+}
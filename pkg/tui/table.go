@@ -3,6 +3,7 @@ package tui
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -30,6 +31,24 @@ type CopyFunc func(row, column int, data interface{})
 // CopyKeyFunc is fired when a user press 'CTRL+K' character in the table cell.
 type CopyKeyFunc func(row, column int, data interface{})
 
+// PreviewFunc returns the contents of the preview pane for the selected row, eg: details of an issue.
+// It is called in a goroutine, so it can fetch the contents. ANSI colors in the contents are kept.
+type PreviewFunc func(row, column int, data interface{}) string
+
+// BulkActionFunc applies an action with the given input to a row of the table, eg: transitions an issue.
+type BulkActionFunc func(row int, data interface{}, input string) error
+
+// BulkAction is an action applied to all rows marked with 'SPACE' at once.
+type BulkAction struct {
+	// Name is displayed in the list of actions, eg: Transition.
+	Name string
+	// Prompt asks for the input of the action, eg: Transition to.
+	Prompt string
+	// Run applies the action to a single row. Rows are processed one after another
+	// and errors are reported in the footer, so they should tell the row they are for.
+	Run BulkActionFunc
+}
+
 // TableData is the data to be displayed in a table.
 type TableData [][]string
 
@@ -37,9 +56,17 @@ type TableData [][]string
 type Table struct {
 	screen       *Screen
 	painter      *tview.Pages
+	grid         *tview.Grid
+	layout       *tview.Flex
 	view         *tview.Table
+	preview      *tview.TextView
 	footer       *tview.TextView
+	filter       *tview.InputField
+	input        *tview.InputField
 	data         TableData
+	all          TableData
+	rows         []int
+	query        string
 	colPad       uint
 	maxColWidth  uint
 	footerText   string
@@ -48,6 +75,16 @@ type Table struct {
 	refreshFunc  RefreshFunc
 	copyFunc     CopyFunc
 	copyKeyFunc  CopyKeyFunc
+	previewFunc  PreviewFunc
+	sections     map[int]struct{}
+	actions      []BulkAction
+	marked       map[int]struct{}
+	running      bool
+
+	previewVisible bool
+	previewBottom  bool
+	previewSeq     int
+	pendingG       bool
 }
 
 // TableOption is a functional option to wrap table properties.
@@ -60,7 +97,11 @@ func NewTable(opts ...TableOption) *Table {
 	tbl := Table{
 		screen:      NewScreen(),
 		view:        tview.NewTable(),
+		preview:     tview.NewTextView(),
 		footer:      tview.NewTextView(),
+		filter:      tview.NewInputField(),
+		input:       tview.NewInputField(),
+		marked:      make(map[int]struct{}),
 		colPad:      defaultColPad,
 		maxColWidth: defaultColWidth,
 	}
@@ -69,16 +110,20 @@ func NewTable(opts ...TableOption) *Table {
 	}
 
 	tbl.initTable()
+	tbl.initPreview()
 	tbl.initFooter()
+	tbl.initFilter()
+
+	tbl.layout = tview.NewFlex().AddItem(tbl.view, 0, 1, true)
 
-	grid := tview.NewGrid().
+	tbl.grid = tview.NewGrid().
 		SetRows(0, 1, 2).
-		AddItem(tbl.view, 0, 0, 1, 1, 0, 0, true).
+		AddItem(tbl.layout, 0, 0, 1, 1, 0, 0, true).
 		AddItem(tview.NewTextView(), 1, 0, 1, 1, 0, 0, false). // Dummy view to fake row padding.
 		AddItem(tbl.footer, 2, 0, 1, 1, 0, 0, false)
 
 	tbl.painter = tview.NewPages().
-		AddPage("primary", grid, true, true).
+		AddPage("primary", tbl.grid, true, true).
 		AddPage("secondary", getInfoModal(), true, false)
 
 	return &tbl
@@ -140,17 +185,51 @@ func WithCopyKeyFunc(fn CopyKeyFunc) TableOption {
 	}
 }
 
+// WithPreviewFunc sets a func that returns the contents of the preview pane.
+// The pane is toggled with 'p' and moved between the side and the bottom with 'P'.
+func WithPreviewFunc(fn PreviewFunc) TableOption {
+	return func(t *Table) {
+		t.previewFunc = fn
+	}
+}
+
+// WithBulkActions sets actions that can be applied to all marked rows at once.
+// Rows are marked with 'SPACE' and the list of actions is opened with 'a'.
+func WithBulkActions(actions ...BulkAction) TableOption {
+	return func(t *Table) {
+		t.actions = actions
+	}
+}
+
+// WithSections marks rows of the table data as section headers, eg: of grouped rows.
+// Only the first column of a section row is displayed and the row can't be selected.
+func WithSections(rows ...int) TableOption {
+	return func(t *Table) {
+		t.sections = make(map[int]struct{}, len(rows))
+		for _, r := range rows {
+			t.sections[r] = struct{}{}
+		}
+	}
+}
+
 // Paint paints the table layout. First row is treated as a table header.
 func (t *Table) Paint(data TableData) error {
 	if len(data) == 0 {
 		return errNoData
 	}
-	t.data = data
+	t.all = data
+	t.rows = make([]int, len(data))
+	for r := range data {
+		t.rows[r] = r
+	}
 	t.render(data)
 	return t.screen.Paint(t.painter)
 }
 
 func (t *Table) render(data TableData) {
+	t.data = data
+	t.view.Clear()
+
 	if t.selectedFunc != nil {
 		t.view.SetSelectedFunc(func(r, c int) {
 			t.selectedFunc(r, c, data)
@@ -172,10 +251,26 @@ func (t *Table) initTable() {
 		SetSelectedStyle(tcell.StyleDefault.Bold(true).Dim(true)).
 		SetDoneFunc(func(key tcell.Key) {
 			if key == tcell.KeyEsc {
+				// Escape clears the filter first, if any.
+				if t.query != "" {
+					t.applyFilter("")
+					return
+				}
 				t.screen.Stop()
 			}
 		}).
+		SetSelectionChangedFunc(func(r, c int) {
+			t.updatePreview(r, c)
+		}).
 		SetInputCapture(func(ev *tcell.EventKey) *tcell.EventKey {
+			// Top of the table is reached with 'gg' like in vim, single
+			// 'g' is swallowed until it is known if another one follows.
+			if ev.Key() == tcell.KeyRune && ev.Rune() == 'g' && !t.pendingG {
+				t.pendingG = true
+				return nil
+			}
+			t.pendingG = false
+
 			if ev.Key() == tcell.KeyCtrlR || ev.Key() == tcell.KeyF5 {
 				if t.refreshFunc == nil {
 					return ev
@@ -195,6 +290,19 @@ func (t *Table) initTable() {
 				case 'q':
 					t.screen.Stop()
 					os.Exit(0)
+				case '/':
+					t.showFilter()
+					return nil
+				case ' ':
+					t.toggleMark()
+					return nil
+				case 'a':
+					t.showActions()
+					return nil
+				case 'p':
+					t.togglePreview()
+				case 'P':
+					t.movePreview()
 				case 'c':
 					if t.copyFunc == nil {
 						break
@@ -231,6 +339,269 @@ func (t *Table) initTable() {
 	t.view.SetFixed(1, 1)
 }
 
+func (t *Table) initPreview() {
+	t.preview.
+		SetDynamicColors(true).
+		SetWrap(true).
+		SetBorder(true).
+		SetBorderColor(tcell.ColorDarkGray)
+}
+
+func (t *Table) initFilter() {
+	t.input.SetFieldBackgroundColor(tcell.ColorDefault)
+
+	t.filter.
+		SetLabel(" /").
+		SetFieldBackgroundColor(tcell.ColorDefault).
+		SetChangedFunc(t.applyFilter).
+		SetDoneFunc(func(key tcell.Key) {
+			if key == tcell.KeyEsc {
+				t.applyFilter("")
+			}
+			t.hideInput(t.filter)
+		})
+}
+
+// togglePreview shows or hides the preview pane next to the table.
+func (t *Table) togglePreview() {
+	if t.previewFunc == nil {
+		return
+	}
+
+	t.previewVisible = !t.previewVisible
+	if !t.previewVisible {
+		t.layout.RemoveItem(t.preview)
+		return
+	}
+
+	t.layout.AddItem(t.preview, 0, 1, false)
+	t.updatePreview(t.view.GetSelection())
+}
+
+// movePreview moves the preview pane between the side and the bottom of the table.
+func (t *Table) movePreview() {
+	t.previewBottom = !t.previewBottom
+	if t.previewBottom {
+		t.layout.SetDirection(tview.FlexRow)
+	} else {
+		t.layout.SetDirection(tview.FlexColumn)
+	}
+}
+
+func (t *Table) updatePreview(r, c int) {
+	if !t.previewVisible || r < 1 || r >= len(t.data) {
+		return
+	}
+
+	// Contents of a row are discarded if another row is selected before they are fetched.
+	t.previewSeq++
+	seq, data := t.previewSeq, t.data
+
+	t.preview.SetText("Loading...")
+
+	go func() {
+		out := t.previewFunc(r, c, data)
+
+		t.screen.QueueUpdateDraw(func() {
+			if seq != t.previewSeq {
+				return
+			}
+			t.preview.SetText(tview.TranslateANSI(out)).ScrollToBeginning()
+		})
+	}()
+}
+
+func (t *Table) showFilter() {
+	t.filter.SetText(t.query)
+	t.showInput(t.filter)
+}
+
+// showInput displays the input field in place of the footer.
+func (t *Table) showInput(in *tview.InputField) {
+	t.grid.RemoveItem(t.footer)
+	t.grid.AddItem(in, 2, 0, 1, 1, 0, 0, true)
+	t.screen.SetFocus(in)
+}
+
+func (t *Table) hideInput(in *tview.InputField) {
+	t.grid.RemoveItem(in)
+	t.grid.AddItem(t.footer, 2, 0, 1, 1, 0, 0, false)
+	t.screen.SetFocus(t.view)
+}
+
+// toggleMark marks or unmarks the selected row for bulk actions and selects the next row.
+func (t *Table) toggleMark() {
+	if len(t.actions) == 0 || t.running {
+		return
+	}
+
+	r, c := t.view.GetSelection()
+	if r < 1 || r >= len(t.data) {
+		return
+	}
+
+	row := t.rows[r]
+	if _, ok := t.marked[row]; ok {
+		delete(t.marked, row)
+	} else {
+		t.marked[row] = struct{}{}
+	}
+	renderTableRow(t, r, t.data[r])
+
+	if len(t.marked) > 0 {
+		t.footer.SetText(pad(fmt.Sprintf("%d rows marked, press 'a' to apply an action", len(t.marked)), 1))
+	} else {
+		t.footer.SetText(pad(t.footerText, 1))
+	}
+	if r < len(t.data)-1 {
+		t.view.Select(r+1, c)
+	}
+}
+
+// showActions displays the list of bulk actions for the marked rows, or the selected row if none is marked.
+func (t *Table) showActions() {
+	if len(t.actions) == 0 || t.running {
+		return
+	}
+
+	rows := t.markedRows()
+	if len(rows) == 0 {
+		r, _ := t.view.GetSelection()
+		if r < 1 || r >= len(t.data) {
+			return
+		}
+		rows = []int{t.rows[r]}
+	}
+
+	names := make([]string, 0, len(t.actions)+1)
+	for _, a := range t.actions {
+		names = append(names, a.Name)
+	}
+	names = append(names, "Cancel")
+
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Apply an action to %d rows", len(rows))).
+		AddButtons(names).
+		SetDoneFunc(func(i int, _ string) {
+			t.painter.HidePage("actions")
+			t.screen.SetFocus(t.view)
+
+			if i < 0 || i >= len(t.actions) {
+				return
+			}
+			t.askInput(t.actions[i], rows)
+		})
+
+	t.painter.AddPage("actions", modal, true, true)
+	t.screen.SetFocus(modal)
+}
+
+// askInput asks for the input of the action in place of the footer and runs the action once it is submitted.
+func (t *Table) askInput(action BulkAction, rows []int) {
+	t.input.
+		SetLabel(fmt.Sprintf(" %s: ", action.Prompt)).
+		SetText("").
+		SetDoneFunc(func(key tcell.Key) {
+			t.hideInput(t.input)
+
+			in := strings.TrimSpace(t.input.GetText())
+			if key != tcell.KeyEnter || in == "" {
+				return
+			}
+			t.runAction(action, rows, in)
+		})
+
+	t.showInput(t.input)
+}
+
+// runAction applies the action to the rows one after another and reports the progress in the footer.
+func (t *Table) runAction(action BulkAction, rows []int, input string) {
+	t.running = true
+	data := t.all
+
+	go func() {
+		var failed []string
+
+		for i, r := range rows {
+			if err := action.Run(r, data, input); err != nil {
+				failed = append(failed, err.Error())
+			}
+
+			done := i + 1
+			t.screen.QueueUpdateDraw(func() {
+				t.footer.SetText(pad(fmt.Sprintf("%s: %d of %d rows processed...", action.Name, done, len(rows)), 1))
+			})
+		}
+
+		t.screen.QueueUpdateDraw(func() {
+			t.running = false
+			t.marked = make(map[int]struct{})
+			t.render(t.data)
+
+			text := fmt.Sprintf("%s: applied to %d of %d rows", action.Name, len(rows)-len(failed), len(rows))
+			if len(failed) > 0 {
+				text += ", failed " + strings.Join(failed, "; ")
+			}
+			t.footer.SetText(pad(text, 1))
+		})
+	}()
+}
+
+// markedRows returns the marked rows in the order they are displayed.
+func (t *Table) markedRows() []int {
+	rows := make([]int, 0, len(t.marked))
+	for r := 1; r < len(t.all); r++ {
+		if _, ok := t.marked[r]; ok {
+			rows = append(rows, r)
+		}
+	}
+	return rows
+}
+
+// applyFilter displays the rows that contain the query in any column, ignoring the case.
+// Sections are not displayed while the rows are filtered.
+func (t *Table) applyFilter(query string) {
+	t.query = query
+
+	data, rows, text := t.all, make([]int, 0, len(t.all)), t.footerText
+	if query == "" {
+		for r := range t.all {
+			rows = append(rows, r)
+		}
+	} else {
+		q := strings.ToLower(query)
+
+		data, rows = TableData{t.all[0]}, append(rows, 0)
+		for r := 1; r < len(t.all); r++ {
+			if _, ok := t.sections[r]; ok {
+				continue
+			}
+			for _, v := range t.all[r] {
+				if strings.Contains(strings.ToLower(v), q) {
+					data, rows = append(data, t.all[r]), append(rows, r)
+					break
+				}
+			}
+		}
+		text = fmt.Sprintf("%d rows matching %q, press ESC to clear the filter", len(data)-1, query)
+	}
+
+	t.rows = rows
+	t.render(data)
+	t.footer.SetText(pad(text, 1))
+	t.view.ScrollToBeginning().Select(t.firstRow(), 0)
+}
+
+// firstRow returns the first row of the table that can be selected.
+func (t *Table) firstRow() int {
+	for r := 1; r < len(t.data); r++ {
+		if _, ok := t.sections[r]; !ok || t.query != "" {
+			return r
+		}
+	}
+	return 0
+}
+
 func renderTableHeader(t *Table, data []string) {
 	style := tcell.StyleDefault.Bold(true)
 
@@ -252,12 +623,39 @@ func renderTableCell(t *Table, data [][]string) {
 	rows, cols := len(data), len(data[0])
 
 	for r := 1; r < rows; r++ {
-		for c := 0; c < cols; c++ {
-			cell := tview.NewTableCell(pad(data[r][c], t.colPad)).
-				SetMaxWidth(int(t.maxColWidth)).
-				SetTextColor(tcell.ColorDefault)
-
-			t.view.SetCell(r, c, cell)
+		if _, ok := t.sections[r]; ok && t.query == "" {
+			renderTableSection(t, r, data[r][0], cols)
+			continue
 		}
+		renderTableRow(t, r, data[r])
+	}
+}
+
+// renderTableRow renders a row of the table, highlighting it if it is marked for bulk actions.
+func renderTableRow(t *Table, r int, data []string) {
+	color := tcell.ColorDefault
+	if _, ok := t.marked[t.rows[r]]; ok {
+		color = tcell.ColorOrange
+	}
+
+	for c := 0; c < len(data); c++ {
+		cell := tview.NewTableCell(pad(data[c], t.colPad)).
+			SetMaxWidth(int(t.maxColWidth)).
+			SetTextColor(color)
+
+		t.view.SetCell(r, c, cell)
+	}
+}
+
+func renderTableSection(t *Table, row int, text string, cols int) {
+	cell := tview.NewTableCell(pad(text, t.colPad)).
+		SetStyle(tcell.StyleDefault.Bold(true)).
+		SetSelectable(false).
+		SetMaxWidth(int(t.maxColWidth)).
+		SetTextColor(tcell.ColorDefault)
+
+	t.view.SetCell(row, 0, cell)
+	for c := 1; c < cols; c++ {
+		t.view.SetCell(row, c, tview.NewTableCell("").SetSelectable(false))
 	}
 }